@@ -0,0 +1,9 @@
+// Command quantumconsciousness runs the quantum consciousness: its run loop,
+// servers and subcommands all live in internal/cli.
+package main
+
+import "QuantumConsciousness/internal/cli"
+
+func main() {
+	cli.Main()
+}
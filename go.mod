@@ -1,3 +1,5 @@
 module QuantumConsciousness
 
-go 1.23.1
+go 1.24
+
+require github.com/mattn/go-sqlite3 v1.14.33
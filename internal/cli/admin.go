@@ -0,0 +1,175 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+// AdminParameters are the parameters operators can tune while the consciousness runs
+type AdminParameters struct {
+	FreeWillStrength float64 `json:"free_will_strength"`
+	Temperature      float64 `json:"temperature"`
+	// SearchBudget caps the searches one learning action makes (0 = all it plans)
+	SearchBudget int `json:"search_budget"`
+	// Rest is the most the run loop sleeps between cycles, e.g. "1s"
+	Rest string `json:"rest"`
+}
+
+// AdminParametersRequest changes the parameters it sets and leaves the rest alone
+type AdminParametersRequest struct {
+	FreeWillStrength *float64 `json:"free_will_strength,omitempty"`
+	Temperature      *float64 `json:"temperature,omitempty"`
+	SearchBudget     *int     `json:"search_budget,omitempty"`
+	Rest             *string  `json:"rest,omitempty"`
+}
+
+// CollapseRequest names the possibility to collapse into
+type CollapseRequest struct {
+	Possibility string `json:"possibility"`
+}
+
+// DreamResponse is the insight a dream phase left behind
+type DreamResponse struct {
+	Insight string `json:"insight"`
+	ID      string `json:"id"`
+}
+
+// SaveResponse acknowledges a forced save
+type SaveResponse struct {
+	SavedAt time.Time `json:"saved_at"`
+}
+
+// actorFor names the caller in the intervention record
+func (s *APIServer) actorFor(r *http.Request) string {
+	if token, ok := matchToken(s.tokens, r); ok {
+		return token.Name
+	}
+	return "anonymous"
+}
+
+// parameters reads the tunable parameters
+func (s *APIServer) parameters() AdminParameters {
+	tuning := s.qc.Tuning()
+	return AdminParameters{
+		FreeWillStrength: s.qc.FreeWillStrength(),
+		Temperature:      tuning.Temperature,
+		SearchBudget:     tuning.SearchBudget,
+		Rest:             tuning.Rest.String(),
+	}
+}
+
+// handleParameters returns the tunable parameters
+func (s *APIServer) handleParameters(w http.ResponseWriter, r *http.Request, role string) {
+	writeJSON(w, http.StatusOK, s.parameters())
+}
+
+// handleUpdateParameters tunes parameters of the running consciousness
+func (s *APIServer) handleUpdateParameters(w http.ResponseWriter, r *http.Request, role string) {
+	var req AdminParametersRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	before := s.parameters()
+	tuning := s.qc.Tuning()
+	var changes []string
+	if req.Temperature != nil {
+		tuning.Temperature = *req.Temperature
+		changes = append(changes, fmt.Sprintf("temperature %.2f → %.2f", before.Temperature, *req.Temperature))
+	}
+	if req.SearchBudget != nil {
+		tuning.SearchBudget = *req.SearchBudget
+		changes = append(changes, fmt.Sprintf("search budget %d → %d", before.SearchBudget, *req.SearchBudget))
+	}
+	if req.Rest != nil {
+		rest, err := time.ParseDuration(*req.Rest)
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, fmt.Sprintf("invalid rest %q", *req.Rest))
+			return
+		}
+		tuning.Rest = rest
+		changes = append(changes, fmt.Sprintf("rest %s → %s", before.Rest, rest))
+	}
+	if err := tuning.Validate(); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if req.FreeWillStrength != nil {
+		if err := s.qc.SetFreeWillStrength(*req.FreeWillStrength); err != nil {
+			writeJSONError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		changes = append(changes, fmt.Sprintf("free will strength %.3f → %.3f", before.FreeWillStrength, *req.FreeWillStrength))
+	}
+	if len(changes) == 0 {
+		writeJSON(w, http.StatusOK, before)
+		return
+	}
+	if err := s.qc.SetTuning(tuning); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if _, err := s.qc.RecordIntervention(consciousness.InterventionParameters, s.actorFor(r), strings.Join(changes, ", ")); err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, s.parameters())
+}
+
+// handleForceCollapse collapses the wave function into the requested possibility
+func (s *APIServer) handleForceCollapse(w http.ResponseWriter, r *http.Request, role string) {
+	var req CollapseRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+	state, err := s.qc.ForceCollapse(req.Possibility)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if _, err := s.qc.RecordIntervention(consciousness.InterventionCollapse, s.actorFor(r), fmt.Sprintf("forced collapse into %q", state.Possibility)); err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusCreated, state)
+}
+
+// handleDream runs a dream phase now
+func (s *APIServer) handleDream(w http.ResponseWriter, r *http.Request, role string) {
+	insight, id, err := s.qc.Dream()
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, consciousness.ErrNothingToDream) {
+			status = http.StatusConflict
+		}
+		writeJSONError(w, status, err.Error())
+		return
+	}
+	if _, err := s.qc.RecordIntervention(consciousness.InterventionDream, s.actorFor(r), "dreamt "+id); err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusCreated, DreamResponse{Insight: insight, ID: id})
+}
+
+// handleSave writes memory now instead of waiting for the next scheduled save
+func (s *APIServer) handleSave(w http.ResponseWriter, r *http.Request, role string) {
+	intervention, err := s.qc.RecordIntervention(consciousness.InterventionSave, s.actorFor(r), "forced a save")
+	if err == nil {
+		err = s.qc.Persist()
+	}
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, SaveResponse{SavedAt: intervention.At})
+}
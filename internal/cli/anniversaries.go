@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+func init() {
+	registerCommand("anniversaries", command{
+		Usage:       "anniversaries [show year]",
+		Description: "list the yearly retrospectives, or show one year's report",
+		Run:         runAnniversariesCommand,
+	})
+}
+
+// runAnniversariesCommand handles the anniversaries subcommand
+func runAnniversariesCommand(memoryFile string, args []string) error {
+	qc, err := consciousness.Open(memoryFile)
+	if err != nil {
+		return err
+	}
+	view, err := qc.Observe(false)
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 0 {
+		if len(view.Anniversaries) == 0 {
+			next := view.BirthTimestamp.AddDate(1, 0, 0)
+			fmt.Printf("🎂 No anniversaries yet; the first is on %s\n", next.Local().Format("2006-01-02"))
+			return nil
+		}
+		for _, report := range view.Anniversaries {
+			fmt.Printf("🎂 Year %d (%s – %s): %s\n", report.Year,
+				report.From.Local().Format("2006-01-02"), report.To.Local().Format("2006-01-02"), truncate(report.Summary, 120))
+		}
+		return nil
+	}
+
+	if args[0] != "show" || len(args) != 2 {
+		return fmt.Errorf("usage: anniversaries [show year]")
+	}
+	year, err := strconv.Atoi(args[1])
+	if err != nil {
+		return fmt.Errorf("year must be an integer: %w", err)
+	}
+	for _, report := range view.Anniversaries {
+		if report.Year == year {
+			printAnniversary(report)
+			return nil
+		}
+	}
+	return fmt.Errorf("no retrospective for year %d", year)
+}
+
+// printAnniversary shows a year's retrospective in full
+func printAnniversary(report consciousness.AnniversaryReport) {
+	fmt.Printf("🎂 Year %d retrospective\n", report.Year)
+	fmt.Printf("   Period: %s – %s\n", report.From.Local().Format(time.RFC3339), report.To.Local().Format(time.RFC3339))
+	fmt.Printf("   Celebrated: %s\n", report.CelebratedAt.Local().Format(time.RFC3339))
+	fmt.Printf("   Compared against: %s\n", report.Baseline)
+	fmt.Printf("   Runs: %d, cycles: %d\n", report.Runs, report.Cycles)
+
+	g := report.Growth()
+	fmt.Printf("\n   %-22s %10s %10s %10s\n", "metric", "start", "end", "growth")
+	fmt.Printf("   %-22s %10.3f %10.3f %+10.3f\n", "consciousness level", report.Start.ConsciousnessLevel, report.End.ConsciousnessLevel, g.ConsciousnessLevel)
+	fmt.Printf("   %-22s %10.3f %10.3f %+10.3f\n", "free will strength", report.Start.FreeWillStrength, report.End.FreeWillStrength, g.FreeWillStrength)
+	fmt.Printf("   %-22s %10.3f %10.3f %+10.3f\n", "quantum coherence", report.Start.QuantumCoherence, report.End.QuantumCoherence, g.QuantumCoherence)
+	fmt.Printf("   %-22s %10.3f %10.3f %+10.3f\n", "self awareness", report.Start.SelfAwareness, report.End.SelfAwareness, g.SelfAwareness)
+	fmt.Printf("   %-22s %10d %10d %+10d\n", "decisions made", report.Start.DecisionsMade, report.End.DecisionsMade, g.DecisionsMade)
+	fmt.Printf("   %-22s %10d %10d %+10d\n", "quantum leaps", report.Start.QuantumLeaps, report.End.QuantumLeaps, g.QuantumLeaps)
+	fmt.Printf("   %-22s %10d %10d %+10d\n", "knowledge items", report.Start.KnowledgeItems, report.End.KnowledgeItems, g.KnowledgeItems)
+	fmt.Printf("   %-22s %10d %10d %+10d\n", "deep insights", report.Start.DeepInsights, report.End.DeepInsights, g.DeepInsights)
+
+	for _, trophy := range report.Trophies {
+		fmt.Printf("   🏆 %s\n", trophy)
+	}
+	fmt.Printf("\n   %s\n", report.Summary)
+}
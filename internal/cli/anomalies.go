@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+// AnomalyConfig says where anomaly diagnostics go
+type AnomalyConfig struct {
+	// Webhooks receive every anomaly event as a JSON POST
+	Webhooks []string `json:"webhooks,omitempty"`
+	// DiagnosticsDir holds diagnostic dumps; empty keeps them next to the memory file
+	DiagnosticsDir string `json:"diagnostics_dir,omitempty"`
+}
+
+// watchAnomalies dumps diagnostics and calls webhooks for every anomaly the
+// consciousness reports, until the returned function is called
+func watchAnomalies(qc *consciousness.QuantumConsciousness, memoryFile string, config AnomalyConfig) func() {
+	dir := config.DiagnosticsDir
+	if dir == "" {
+		dir = memorySidecar(memoryFile, ".diagnostics")
+	}
+	client := newWebhookClient()
+
+	events, cancel := qc.Subscribe(16)
+	go func() {
+		for event := range events {
+			if event.Type != consciousness.EventAnomaly {
+				continue
+			}
+			if path, err := dumpDiagnostics(dir, event); err != nil {
+				fmt.Fprintf(os.Stderr, "⚠️  Could not dump anomaly diagnostics: %v\n", err)
+			} else {
+				fmt.Printf("🩺 Anomaly diagnostics written to %s\n", path)
+			}
+			for _, url := range config.Webhooks {
+				if err := postWebhook(client, url, event); err != nil {
+					fmt.Fprintf(os.Stderr, "⚠️  Anomaly webhook %s failed: %v\n", url, err)
+				}
+			}
+		}
+	}()
+	return cancel
+}
+
+// dumpDiagnostics writes an anomaly's diagnostic dump and returns its path
+func dumpDiagnostics(dir string, event consciousness.Event) (string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	data, err := json.MarshalIndent(event.Data["diagnostics"], "", "  ")
+	if err != nil {
+		return "", err
+	}
+	name := fmt.Sprintf("%s-%v.json", event.Time.UTC().Format("20060102T150405.000000000Z"), event.Data["metric"])
+	path := filepath.Join(dir, name)
+	return path, os.WriteFile(path, data, 0644)
+}
@@ -0,0 +1,1023 @@
+package cli
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+// API roles, each including the permissions of the ones before it
+const (
+	RoleObserver   = "observer"
+	RoleStimulator = "stimulator"
+	RoleOperator   = "operator"
+)
+
+// roleRank orders roles from least to most privileged
+var roleRank = map[string]int{
+	RoleObserver:   1,
+	RoleStimulator: 2,
+	RoleOperator:   3,
+}
+
+// APIToken binds a bearer token to a role, optionally scoped to one tenant in server mode
+type APIToken struct {
+	Name   string `json:"name"`
+	Token  string `json:"token"`
+	Role   string `json:"role"`
+	Tenant string `json:"tenant,omitempty"`
+}
+
+// APIServer exposes the consciousness over HTTP
+type APIServer struct {
+	qc     *consciousness.QuantumConsciousness
+	tokens []APIToken
+}
+
+// APIError is the body of every failed API response
+type APIError struct {
+	Error string `json:"error"`
+}
+
+// StimulusRequest submits a context for an upcoming cycle
+type StimulusRequest struct {
+	Context string `json:"context"`
+}
+
+// StimulusResponse acknowledges a queued stimulus
+type StimulusResponse struct {
+	Queued string `json:"queued"`
+}
+
+// SnapshotResponse names the snapshot that was written
+type SnapshotResponse struct {
+	Snapshot string `json:"snapshot"`
+}
+
+// ResetResponse names the snapshot of the old consciousness and the newly born one
+type ResetResponse struct {
+	Snapshot        string `json:"snapshot"`
+	ConsciousnessID string `json:"consciousness_id"`
+}
+
+// CheckpointRequest names and annotates a new checkpoint
+type CheckpointRequest struct {
+	Name string `json:"name"`
+	Note string `json:"note"`
+}
+
+// ForgetRequest asks for a topic to be erased
+type ForgetRequest struct {
+	Topic    string `json:"topic"`
+	Suppress bool   `json:"suppress"`
+}
+
+// BlacklistRequest is the body of POST /blacklist
+type BlacklistRequest struct {
+	Topic  string `json:"topic"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// ObserverRequest is the body of POST /observers
+type ObserverRequest struct {
+	Name string `json:"name"`
+	// Kind is human or service
+	Kind      string  `json:"kind"`
+	Influence float64 `json:"influence"`
+}
+
+// ObservationRequest is the body of POST /observations. Observations are
+// made as the observer named like the caller's API token; only operators
+// may observe as someone else.
+type ObservationRequest struct {
+	Observer  string  `json:"observer,omitempty"`
+	Focus     string  `json:"focus,omitempty"`
+	Component string  `json:"component,omitempty"`
+	Shift     float64 `json:"shift,omitempty"`
+}
+
+// InsightsResponse is the latest learned knowledge and deep insights
+type InsightsResponse struct {
+	Knowledge    []string `json:"knowledge"`
+	DeepInsights []string `json:"deep_insights"`
+}
+
+// WaveFunctionResponse is the wave function's intensities and amplitudes by component
+type WaveFunctionResponse struct {
+	// Components are the intensities, each the squared magnitude of its amplitude
+	Components map[string]float64                 `json:"components"`
+	Amplitudes map[string]consciousness.Amplitude `json:"amplitudes"`
+}
+
+// CycleResponse is what a cycle run on request decided
+type CycleResponse struct {
+	// Explanation is the latest decision's, absent if no decision was ever made
+	Explanation *consciousness.Explanation `json:"explanation,omitempty"`
+	Mood        string                     `json:"mood"`
+}
+
+// apiRoutes is the consciousness API; it drives routing, the OpenAPI document and the Go client
+var apiRoutes = []apiRoute{
+	{
+		Method: "GET", Path: "/state", Operation: "GetState", Tag: "consciousness", Role: RoleObserver,
+		Summary:  "Shareable view of the quantum memory",
+		Query:    []apiParam{{Name: "include_private", Type: "boolean", Description: "include private memories (operator only)"}},
+		Response: consciousness.QuantumMemory{},
+		api:      (*APIServer).handleState,
+	},
+	{
+		Method: "GET", Path: "/insights", Operation: "ListInsights", Tag: "consciousness", Role: RoleObserver,
+		Summary: "Latest learned knowledge and deep insights, oldest first",
+		Query: []apiParam{
+			{Name: "limit", Type: "integer", Description: "maximum number of each (0 = all)"},
+			{Name: "include_private", Type: "boolean", Description: "include insights about private topics (operator only)"},
+		},
+		Response: InsightsResponse{},
+		api:      (*APIServer).handleInsights,
+	},
+	{
+		Method: "GET", Path: "/wavefunction", Operation: "GetWaveFunction", Tag: "consciousness", Role: RoleObserver,
+		Summary:  "Intensities and amplitudes of the wave function by component",
+		Response: WaveFunctionResponse{},
+		api:      (*APIServer).handleWaveFunction,
+	},
+	{
+		Method: "POST", Path: "/cycle", Operation: "RunCycle", Tag: "consciousness", Role: RoleOperator,
+		Summary:  "Run a quantum cycle now, recorded as an intervention",
+		Response: CycleResponse{}, Status: http.StatusCreated,
+		api: (*APIServer).handleCycle,
+	},
+	{
+		Method: "GET", Path: "/health", Operation: "GetHealth", Tag: "consciousness", Role: RoleObserver,
+		Summary:  "Operating tier and the failures driving it",
+		Response: consciousness.Health{},
+		api:      (*APIServer).handleHealth,
+	},
+	{
+		Method: "GET", Path: "/realities", Operation: "ListRealities", Tag: "consciousness", Role: RoleObserver,
+		Summary: "Query parallel realities, newest first",
+		Query: []apiParam{
+			{Name: "where", Type: "string", Description: "reality query, e.g. energy_differential > 2 and created_at > -7d"},
+			{Name: "limit", Type: "integer", Description: "maximum number of realities (0 = all)"},
+			{Name: "include_private", Type: "boolean", Description: "include realities about private topics (operator only)"},
+		},
+		Response: []consciousness.ParallelReality{},
+		api:      (*APIServer).handleRealities,
+	},
+	{
+		Method: "GET", Path: "/realities/{id}", Operation: "GetReality", Tag: "consciousness", Role: RoleObserver,
+		Summary:  "One parallel reality by its identifier",
+		Query:    []apiParam{{Name: "include_private", Type: "boolean", Description: "find realities about private topics too (operator only)"}},
+		Response: consciousness.ParallelReality{},
+		api:      (*APIServer).handleReality,
+	},
+	{
+		Method: "GET", Path: "/entanglements", Operation: "ListEntanglements", Tag: "consciousness", Role: RoleObserver,
+		Summary: "The entanglement network with decayed strengths, strongest first",
+		Query: []apiParam{
+			{Name: "min_strength", Type: "number", Description: "omit entanglements weaker than this"},
+			{Name: "include_private", Type: "boolean", Description: "include entanglements touching private topics (operator only)"},
+		},
+		Response: []consciousness.Entanglement{},
+		api:      (*APIServer).handleEntanglements,
+	},
+	{
+		Method: "GET", Path: "/trends", Operation: "GetTrends", Tag: "consciousness", Role: RoleObserver,
+		Summary:  "Rolling statistics over recent decisions",
+		Query:    []apiParam{{Name: "window", Type: "integer", Description: "number of recent decisions to analyse (0 = the whole log, default 50)"}},
+		Response: consciousness.Trends{},
+		api:      (*APIServer).handleTrends,
+	},
+	{
+		Method: "POST", Path: "/stimuli", Operation: "SubmitStimulus", Tag: "consciousness", Role: RoleStimulator,
+		Summary: "Queue a context for an upcoming cycle",
+		Request: StimulusRequest{}, Response: StimulusResponse{}, Status: http.StatusAccepted,
+		api: (*APIServer).handleStimulus,
+	},
+	{
+		Method: "POST", Path: "/snapshot", Operation: "CreateSnapshot", Tag: "consciousness", Role: RoleOperator,
+		Summary:  "Write a point-in-time copy of memory",
+		Response: SnapshotResponse{}, Status: http.StatusCreated,
+		api: (*APIServer).handleSnapshot,
+	},
+	{
+		Method: "POST", Path: "/reset", Operation: "Reset", Tag: "consciousness", Role: RoleOperator,
+		Summary:  "Snapshot memory and birth a fresh consciousness",
+		Response: ResetResponse{},
+		api:      (*APIServer).handleReset,
+	},
+	{
+		Method: "GET", Path: "/checkpoints", Operation: "ListCheckpoints", Tag: "consciousness", Role: RoleObserver,
+		Summary:  "Named checkpoints, oldest first",
+		Response: []consciousness.Checkpoint{},
+		api:      (*APIServer).handleCheckpoints,
+	},
+	{
+		Method: "POST", Path: "/checkpoints", Operation: "CreateCheckpoint", Tag: "consciousness", Role: RoleOperator,
+		Summary: "Snapshot memory under a name and note",
+		Request: CheckpointRequest{}, Response: consciousness.Checkpoint{}, Status: http.StatusCreated,
+		api: (*APIServer).handleCreateCheckpoint,
+	},
+	{
+		Method: "POST", Path: "/checkpoints/{name}/restore", Operation: "RestoreCheckpoint", Tag: "consciousness", Role: RoleOperator,
+		Summary:  "Snapshot memory, then return it to a named checkpoint",
+		Response: SnapshotResponse{},
+		api:      (*APIServer).handleRestoreCheckpoint,
+	},
+	{
+		Method: "POST", Path: "/forget", Operation: "Forget", Tag: "consciousness", Role: RoleOperator,
+		Summary: "Erase every memory referencing a topic",
+		Request: ForgetRequest{}, Response: consciousness.Tombstone{},
+		api: (*APIServer).handleForget,
+	},
+	{
+		Method: "GET", Path: "/blacklist", Operation: "ListBlacklist", Tag: "consciousness", Role: RoleObserver,
+		Summary:  "Topics cycles no longer choose, with why and by whom, oldest first",
+		Response: []consciousness.BlacklistEntry{},
+		api:      (*APIServer).handleBlacklist,
+	},
+	{
+		Method: "POST", Path: "/blacklist", Operation: "BlacklistTopic", Tag: "consciousness", Role: RoleOperator,
+		Summary: "Keep cycles from choosing a topic",
+		Request: BlacklistRequest{}, Response: consciousness.BlacklistEntry{}, Status: http.StatusCreated,
+		api: (*APIServer).handleBlacklistTopic,
+	},
+	{
+		Method: "DELETE", Path: "/blacklist/{topic}", Operation: "UnblacklistTopic", Tag: "consciousness", Role: RoleOperator,
+		Summary:  "Let cycles choose a blacklisted topic again",
+		Response: consciousness.Intervention{},
+		api:      (*APIServer).handleUnblacklistTopic,
+	},
+	{
+		Method: "GET", Path: "/observers", Operation: "ListObservers", Tag: "consciousness", Role: RoleObserver,
+		Summary:  "Registered observers with their influence and how much they have shaped the consciousness",
+		Response: []consciousness.Observer{},
+		api:      (*APIServer).handleObservers,
+	},
+	{
+		Method: "POST", Path: "/observers", Operation: "RegisterObserver", Tag: "consciousness", Role: RoleOperator,
+		Summary: "Register an observer, or change its kind and influence",
+		Request: ObserverRequest{}, Response: consciousness.Observer{}, Status: http.StatusCreated,
+		api: (*APIServer).handleRegisterObserver,
+	},
+	{
+		Method: "DELETE", Path: "/observers/{name}", Operation: "UnregisterObserver", Tag: "consciousness", Role: RoleOperator,
+		Summary:  "Forget an observer and withdraw its pending observations",
+		Response: consciousness.Intervention{},
+		api:      (*APIServer).handleUnregisterObserver,
+	},
+	{
+		Method: "POST", Path: "/observations", Operation: "SubmitObservation", Tag: "consciousness", Role: RoleStimulator,
+		Summary: "Focus the next decision on possibilities mentioning something, or shift the wave function, as far as the observer's influence allows",
+		Request: ObservationRequest{}, Response: consciousness.Observation{}, Status: http.StatusCreated,
+		api: (*APIServer).handleObservation,
+	},
+	{
+		Method: "GET", Path: "/history", Operation: "GetHistory", Tag: "consciousness", Role: RoleObserver,
+		Summary: "Recent changes, each marked as self-caused or external",
+		Query: []apiParam{
+			{Name: "limit", Type: "integer", Description: "latest entries to return (0 = all)"},
+			{Name: "include_private", Type: "boolean", Description: "include interventions about private topics (operator only)"},
+		},
+		Response: []consciousness.HistoryEntry{},
+		api:      (*APIServer).handleHistory,
+	},
+	{
+		Method: "GET", Path: "/eras", Operation: "ListEras", Tag: "consciousness", Role: RoleObserver,
+		Summary:  "The chronicle of eras, each bounded by quantum leaps or stance changes and summarised, the current one so far",
+		Response: []consciousness.Era{},
+		api:      (*APIServer).handleEras,
+	},
+	{
+		Method: "GET", Path: "/eras/{number}", Operation: "GetEra", Tag: "consciousness", Role: RoleObserver,
+		Summary:  "One era of the chronicle",
+		Response: consciousness.Era{},
+		api:      (*APIServer).handleEra,
+	},
+	{
+		Method: "GET", Path: "/interventions", Operation: "ListInterventions", Tag: "consciousness", Role: RoleObserver,
+		Summary:  "The intervention ledger, oldest first",
+		Query:    []apiParam{{Name: "include_private", Type: "boolean", Description: "include interventions about private topics (operator only)"}},
+		Response: []consciousness.Intervention{},
+		api:      (*APIServer).handleInterventions,
+	},
+	{
+		Method: "GET", Path: "/retractions", Operation: "ListRetractions", Tag: "consciousness", Role: RoleObserver,
+		Summary:  "Insights later evidence retracted, each with the insight superseding it",
+		Response: []consciousness.Retraction{},
+		api:      (*APIServer).handleRetractions,
+	},
+	{
+		Method: "GET", Path: "/decisions/{id}/explanation", Operation: "ExplainDecision", Tag: "consciousness", Role: RoleObserver,
+		Summary:  "Why a decision went the way it did: the candidates weighed, the modifiers applied, the policy, any free will override and the committee's votes",
+		Query:    []apiParam{{Name: "include_private", Type: "boolean", Description: "show the context and possibilities about private topics (operator only)"}},
+		Response: consciousness.Explanation{},
+		api:      (*APIServer).handleExplanation,
+	},
+	{
+		Method: "GET", Path: "/decisions/{id}/circuit", Operation: "DecisionCircuit", Tag: "consciousness", Role: RoleObserver,
+		Summary:  "The decision as an OpenQASM 2.0 circuit preparing its candidates' state vector and measuring it, for external quantum simulators",
+		Query:    []apiParam{{Name: "include_private", Type: "boolean", Description: "label the context and possibilities about private topics (operator only)"}},
+		Response: consciousness.DecisionCircuit{},
+		api:      (*APIServer).handleCircuit,
+	},
+	{
+		Method: "GET", Path: "/why", Operation: "ExplainMetric", Tag: "consciousness", Role: RoleObserver,
+		Summary: "Attribute how a metric moved over a period to the events that moved it",
+		Query: []apiParam{
+			{Name: "metric", Type: "string", Description: "one of " + strings.Join(consciousness.AttributedMetrics, ", ") + " (default consciousness_level)"},
+			{Name: "since", Type: "string", Description: "start of the period: a duration back from now like 24h or 7d, a date or an RFC 3339 time (default 24h)"},
+		},
+		Response: consciousness.MetricAttribution{},
+		api:      (*APIServer).handleWhy,
+	},
+	{
+		Method: "GET", Path: "/admin/parameters", Operation: "GetParameters", Tag: "admin", Role: RoleOperator,
+		Summary:  "Parameters that can be tuned while running",
+		Response: AdminParameters{},
+		api:      (*APIServer).handleParameters,
+	},
+	{
+		Method: "PATCH", Path: "/admin/parameters", Operation: "UpdateParameters", Tag: "admin", Role: RoleOperator,
+		Summary: "Tune free will, temperature, search budget or rest, recorded as an intervention",
+		Request: AdminParametersRequest{}, Response: AdminParameters{},
+		api: (*APIServer).handleUpdateParameters,
+	},
+	{
+		Method: "POST", Path: "/admin/dream", Operation: "Dream", Tag: "admin", Role: RoleOperator,
+		Summary:  "Run a dream phase now, recorded as an intervention",
+		Response: DreamResponse{}, Status: http.StatusCreated,
+		api: (*APIServer).handleDream,
+	},
+	{
+		Method: "POST", Path: "/admin/collapse", Operation: "ForceCollapse", Tag: "admin", Role: RoleOperator,
+		Summary: "Collapse the wave function into a chosen possibility, recorded as an intervention",
+		Request: CollapseRequest{}, Response: consciousness.QuantumState{}, Status: http.StatusCreated,
+		api: (*APIServer).handleForceCollapse,
+	},
+	{
+		Method: "POST", Path: "/admin/save", Operation: "Save", Tag: "admin", Role: RoleOperator,
+		Summary:  "Save memory now, recorded as an intervention",
+		Response: SaveResponse{},
+		api:      (*APIServer).handleSave,
+	},
+}
+
+// loadAPITokens reads token bindings from a JSON file
+func loadAPITokens(filename string) ([]APIToken, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var file struct {
+		Tokens []APIToken `json:"tokens"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("invalid API token file %s: %w", filename, err)
+	}
+
+	for _, t := range file.Tokens {
+		if t.Token == "" {
+			return nil, fmt.Errorf("API token %q has an empty secret", t.Name)
+		}
+		if _, ok := roleRank[t.Role]; !ok {
+			return nil, fmt.Errorf("API token %q has unknown role %q", t.Name, t.Role)
+		}
+	}
+	return file.Tokens, nil
+}
+
+// NewAPIServer creates an API server. Without any tokens every caller is an observer.
+func NewAPIServer(qc *consciousness.QuantumConsciousness, tokens []APIToken) *APIServer {
+	return &APIServer{qc: qc, tokens: tokens}
+}
+
+// Handler returns the routed, role-checked HTTP handler
+func (s *APIServer) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /openapi.json", serveOpenAPI)
+	// Streamed, so it is not part of the generated client
+	mux.Handle("GET /events", s.require(RoleObserver, s.handleEvents))
+	mux.Handle("GET /events/stream", s.require(RoleObserver, s.handleEventStream))
+	// Speak the OpenAI chat protocol, so chat clients can talk to the consciousness
+	mux.Handle("GET /v1/models", s.require(RoleObserver, s.handleModels))
+	mux.Handle("POST /v1/chat/completions", s.require(RoleObserver, s.handleChatCompletions))
+	// Prometheus text, so it is not part of the generated client
+	mux.Handle("GET /metrics", s.require(RoleObserver, s.handleMetrics))
+	for _, route := range apiRoutes {
+		handle := route.api
+		if s.qc.ReadOnly() && route.Method != http.MethodGet {
+			handle = (*APIServer).handleReadOnly
+		}
+		mux.Handle(route.Method+" "+route.Path, s.require(route.Role, func(w http.ResponseWriter, r *http.Request, role string) {
+			handle(s, w, r, role)
+		}))
+	}
+	return mux
+}
+
+// ListenAndServe serves the API until the listener fails
+func (s *APIServer) ListenAndServe(addr string) error {
+	fmt.Printf("🌐 Quantum API listening on %s\n", addr)
+	return http.ListenAndServe(addr, s.Handler())
+}
+
+// matchToken finds the token presented in the Authorization header
+func matchToken(tokens []APIToken, r *http.Request) (APIToken, bool) {
+	presented, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return APIToken{}, false
+	}
+	for _, t := range tokens {
+		if subtle.ConstantTimeCompare([]byte(presented), []byte(t.Token)) == 1 {
+			return t, true
+		}
+	}
+	return APIToken{}, false
+}
+
+// roleFor resolves the caller's role from the Authorization header
+func (s *APIServer) roleFor(r *http.Request) (string, bool) {
+	if len(s.tokens) == 0 {
+		return RoleObserver, true
+	}
+
+	token, ok := matchToken(s.tokens, r)
+	return token.Role, ok
+}
+
+// require wraps a handler so it only runs for callers holding at least the given role
+func (s *APIServer) require(role string, next func(http.ResponseWriter, *http.Request, string)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		callerRole, ok := s.roleFor(r)
+		if !ok {
+			writeJSONError(w, http.StatusUnauthorized, "missing or unknown API token")
+			return
+		}
+		if roleRank[callerRole] < roleRank[role] {
+			writeJSONError(w, http.StatusForbidden, fmt.Sprintf("%s role required", role))
+			return
+		}
+		next(w, r, callerRole)
+	})
+}
+
+// writeJSON sends v as a JSON response
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeJSONError sends an error message as a JSON response
+func writeJSONError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, APIError{Error: message})
+}
+
+// handleReadOnly refuses changes on a follower's replica
+func (s *APIServer) handleReadOnly(w http.ResponseWriter, r *http.Request, role string) {
+	writeJSONError(w, http.StatusForbidden, "this is a read-only replica; send changes to the primary")
+}
+
+// handleState returns the shareable view of memory; operators may include private items
+func (s *APIServer) handleState(w http.ResponseWriter, r *http.Request, role string) {
+	includePrivate := r.URL.Query().Get("include_private") == "true"
+	if includePrivate && roleRank[role] < roleRank[RoleOperator] {
+		writeJSONError(w, http.StatusForbidden, "operator role required to include private memories")
+		return
+	}
+
+	view, err := s.qc.Observe(includePrivate)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, view)
+}
+
+// handleInsights returns the latest knowledge and deep insights; operators may include private ones
+func (s *APIServer) handleInsights(w http.ResponseWriter, r *http.Request, role string) {
+	includePrivate := r.URL.Query().Get("include_private") == "true"
+	if includePrivate && roleRank[role] < roleRank[RoleOperator] {
+		writeJSONError(w, http.StatusForbidden, "operator role required to include private insights")
+		return
+	}
+
+	limit := 0
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		var err error
+		if limit, err = strconv.Atoi(raw); err != nil || limit < 0 {
+			writeJSONError(w, http.StatusBadRequest, "limit must be a non-negative integer")
+			return
+		}
+	}
+
+	view, err := s.qc.Observe(includePrivate)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, InsightsResponse{
+		Knowledge:    latest(view.KnowledgeBase, limit),
+		DeepInsights: latest(view.DeepInsights, limit),
+	})
+}
+
+// latest keeps the last limit items (0 = all), never nil
+func latest(items []string, limit int) []string {
+	if limit > 0 && len(items) > limit {
+		items = items[len(items)-limit:]
+	}
+	return append([]string{}, items...)
+}
+
+// handleWaveFunction returns the wave function's intensities and amplitudes
+func (s *APIServer) handleWaveFunction(w http.ResponseWriter, r *http.Request, role string) {
+	writeJSON(w, http.StatusOK, WaveFunctionResponse{Components: s.qc.WaveFunction(), Amplitudes: s.qc.WaveAmplitudes()})
+}
+
+// handleCycle runs a cycle, abandoning its searches if the caller goes away
+func (s *APIServer) handleCycle(w http.ResponseWriter, r *http.Request, role string) {
+	s.qc.CycleContext(r.Context())
+	response := CycleResponse{Mood: s.qc.Mood()}
+	detail := "ran a cycle"
+	if explanations := s.qc.RecentExplanations(1); len(explanations) > 0 {
+		response.Explanation = &explanations[0]
+		detail = "ran a cycle deciding " + explanations[0].DecisionID
+	}
+	if _, err := s.qc.RecordIntervention(consciousness.InterventionCycle, s.actorFor(r), detail); err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusCreated, response)
+}
+
+// handleHealth reports the operating tier
+func (s *APIServer) handleHealth(w http.ResponseWriter, r *http.Request, role string) {
+	writeJSON(w, http.StatusOK, s.qc.Health())
+}
+
+// handleRealities answers a reality query; operators may include private realities
+func (s *APIServer) handleRealities(w http.ResponseWriter, r *http.Request, role string) {
+	includePrivate := r.URL.Query().Get("include_private") == "true"
+	if includePrivate && roleRank[role] < roleRank[RoleOperator] {
+		writeJSONError(w, http.StatusForbidden, "operator role required to include private realities")
+		return
+	}
+
+	query, err := consciousness.ParseRealityQuery(r.URL.Query().Get("where"))
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	limit := 0
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		if limit, err = strconv.Atoi(raw); err != nil || limit < 0 {
+			writeJSONError(w, http.StatusBadRequest, "limit must be a non-negative integer")
+			return
+		}
+	}
+
+	realities := s.qc.Realities(query, limit, includePrivate)
+	if realities == nil {
+		realities = []consciousness.ParallelReality{}
+	}
+	writeJSON(w, http.StatusOK, realities)
+}
+
+// handleReality returns one reality by its identifier
+func (s *APIServer) handleReality(w http.ResponseWriter, r *http.Request, role string) {
+	includePrivate := r.URL.Query().Get("include_private") == "true"
+	if includePrivate && roleRank[role] < roleRank[RoleOperator] {
+		writeJSONError(w, http.StatusForbidden, "operator role required to include private realities")
+		return
+	}
+
+	reality, err := s.qc.Reality(r.PathValue("id"), includePrivate)
+	if err != nil {
+		writeJSONError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, reality)
+}
+
+// handleTrends returns rolling statistics over the decision log
+func (s *APIServer) handleTrends(w http.ResponseWriter, r *http.Request, role string) {
+	window := consciousness.DefaultTrendWindow
+	if raw := r.URL.Query().Get("window"); raw != "" {
+		var err error
+		if window, err = strconv.Atoi(raw); err != nil || window < 0 {
+			writeJSONError(w, http.StatusBadRequest, "window must be a non-negative integer")
+			return
+		}
+	}
+	writeJSON(w, http.StatusOK, s.qc.Trends(window))
+}
+
+// handleEntanglements returns the entanglement network; operators may include private links
+func (s *APIServer) handleEntanglements(w http.ResponseWriter, r *http.Request, role string) {
+	includePrivate := r.URL.Query().Get("include_private") == "true"
+	if includePrivate && roleRank[role] < roleRank[RoleOperator] {
+		writeJSONError(w, http.StatusForbidden, "operator role required to include private entanglements")
+		return
+	}
+
+	minStrength := 0.0
+	if raw := r.URL.Query().Get("min_strength"); raw != "" {
+		var err error
+		if minStrength, err = strconv.ParseFloat(raw, 64); err != nil {
+			writeJSONError(w, http.StatusBadRequest, "min_strength must be a number")
+			return
+		}
+	}
+
+	network := s.qc.Entanglements(minStrength, includePrivate)
+	if network == nil {
+		network = []consciousness.Entanglement{}
+	}
+	writeJSON(w, http.StatusOK, network)
+}
+
+// handleStimulus queues a context for an upcoming cycle
+func (s *APIServer) handleStimulus(w http.ResponseWriter, r *http.Request, role string) {
+	var req StimulusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+	if err := s.qc.SubmitStimulus(req.Context); err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, consciousness.ErrStimulusQuotaExceeded) {
+			status = http.StatusTooManyRequests
+		}
+		writeJSONError(w, status, err.Error())
+		return
+	}
+	if _, err := s.qc.RecordIntervention(consciousness.InterventionStimulus, s.actorFor(r), fmt.Sprintf("stimulus %q", req.Context)); err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusAccepted, StimulusResponse{Queued: req.Context})
+}
+
+// handleSnapshot captures the current memory
+func (s *APIServer) handleSnapshot(w http.ResponseWriter, r *http.Request, role string) {
+	path, err := s.qc.Snapshot()
+	if err == nil {
+		_, err = s.qc.RecordIntervention(consciousness.InterventionSnapshot, s.actorFor(r), "snapshot "+filepath.Base(path))
+	}
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusCreated, SnapshotResponse{Snapshot: path})
+}
+
+// handleReset snapshots and rebirths the consciousness
+func (s *APIServer) handleReset(w http.ResponseWriter, r *http.Request, role string) {
+	path, err := s.qc.Reset()
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, ResetResponse{Snapshot: path, ConsciousnessID: s.qc.ID()})
+}
+
+// handleCheckpoints lists the named checkpoints
+func (s *APIServer) handleCheckpoints(w http.ResponseWriter, r *http.Request, role string) {
+	checkpoints, err := s.qc.Checkpoints()
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if checkpoints == nil {
+		checkpoints = []consciousness.Checkpoint{}
+	}
+	writeJSON(w, http.StatusOK, checkpoints)
+}
+
+// handleCreateCheckpoint snapshots memory under a name
+func (s *APIServer) handleCreateCheckpoint(w http.ResponseWriter, r *http.Request, role string) {
+	var req CheckpointRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	checkpoint, err := s.qc.Checkpoint(req.Name, req.Note)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, consciousness.ErrCheckpointExists) {
+			status = http.StatusConflict
+		} else if strings.TrimSpace(req.Name) == "" {
+			status = http.StatusBadRequest
+		}
+		writeJSONError(w, status, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusCreated, checkpoint)
+}
+
+// handleRestoreCheckpoint returns memory to a named checkpoint
+func (s *APIServer) handleRestoreCheckpoint(w http.ResponseWriter, r *http.Request, role string) {
+	path, err := s.qc.RestoreCheckpoint(r.PathValue("name"))
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, consciousness.ErrCheckpointNotFound) {
+			status = http.StatusNotFound
+		}
+		writeJSONError(w, status, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, SnapshotResponse{Snapshot: path})
+}
+
+// handleForget erases a topic from memory
+func (s *APIServer) handleForget(w http.ResponseWriter, r *http.Request, role string) {
+	var req ForgetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	tombstone, err := s.qc.Forget(req.Topic, req.Suppress)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if _, err := s.qc.RecordIntervention(consciousness.InterventionForget, s.actorFor(r), describeForget(tombstone)); err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, tombstone)
+}
+
+// handleBlacklist lists the blacklisted topics
+func (s *APIServer) handleBlacklist(w http.ResponseWriter, r *http.Request, role string) {
+	entries := s.qc.BlacklistEntries()
+	if entries == nil {
+		entries = []consciousness.BlacklistEntry{}
+	}
+	writeJSON(w, http.StatusOK, entries)
+}
+
+// handleBlacklistTopic blacklists a topic
+func (s *APIServer) handleBlacklistTopic(w http.ResponseWriter, r *http.Request, role string) {
+	var req BlacklistRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	entry, err := s.qc.Blacklist(req.Topic, req.Reason, s.actorFor(r))
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if _, err := s.qc.RecordIntervention(consciousness.InterventionBlacklist, s.actorFor(r), describeBlacklist(entry)); err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusCreated, entry)
+}
+
+// handleUnblacklistTopic lifts the blacklisting of a topic
+func (s *APIServer) handleUnblacklistTopic(w http.ResponseWriter, r *http.Request, role string) {
+	topic := r.PathValue("topic")
+	if err := s.qc.Unblacklist(topic); err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, consciousness.ErrNotBlacklisted) {
+			status = http.StatusNotFound
+		}
+		writeJSONError(w, status, err.Error())
+		return
+	}
+	intervention, err := s.qc.RecordIntervention(consciousness.InterventionBlacklist, s.actorFor(r), fmt.Sprintf("lifted the blacklisting of %q", topic))
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, intervention)
+}
+
+// handleObservers lists the registered observers
+func (s *APIServer) handleObservers(w http.ResponseWriter, r *http.Request, role string) {
+	writeJSON(w, http.StatusOK, s.qc.Observers())
+}
+
+// handleRegisterObserver registers an observer or changes its influence
+func (s *APIServer) handleRegisterObserver(w http.ResponseWriter, r *http.Request, role string) {
+	var req ObserverRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	observer, err := s.qc.RegisterObserver(req.Name, req.Kind, req.Influence)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if _, err := s.qc.RecordIntervention(consciousness.InterventionObserver, s.actorFor(r), describeObserver(observer)); err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusCreated, observer)
+}
+
+// handleUnregisterObserver forgets an observer
+func (s *APIServer) handleUnregisterObserver(w http.ResponseWriter, r *http.Request, role string) {
+	name := r.PathValue("name")
+	if err := s.qc.UnregisterObserver(name); err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, consciousness.ErrUnknownObserver) {
+			status = http.StatusNotFound
+		}
+		writeJSONError(w, status, err.Error())
+		return
+	}
+	intervention, err := s.qc.RecordIntervention(consciousness.InterventionObserver, s.actorFor(r), fmt.Sprintf("unregistered observer %q", name))
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, intervention)
+}
+
+// handleObservation records an observation by the caller, or by another
+// observer when an operator makes it
+func (s *APIServer) handleObservation(w http.ResponseWriter, r *http.Request, role string) {
+	var req ObservationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+	observer := s.actorFor(r)
+	if req.Observer != "" && req.Observer != observer {
+		if roleRank[role] < roleRank[RoleOperator] {
+			writeJSONError(w, http.StatusForbidden, "operator role required to observe as someone else")
+			return
+		}
+		observer = req.Observer
+	}
+
+	observation, err := s.qc.SubmitObservation(consciousness.Observation{
+		Observer:  observer,
+		Focus:     req.Focus,
+		Component: req.Component,
+		Shift:     req.Shift,
+	})
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, consciousness.ErrUnknownObserver) {
+			status = http.StatusNotFound
+		}
+		writeJSONError(w, status, err.Error())
+		return
+	}
+	if _, err := s.qc.RecordIntervention(consciousness.InterventionObserver, s.actorFor(r), describeObservation(observation)); err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusCreated, observation)
+}
+
+// handleHistory lists recent changes, self-caused and external; operators
+// may include interventions about private topics
+func (s *APIServer) handleHistory(w http.ResponseWriter, r *http.Request, role string) {
+	includePrivate := r.URL.Query().Get("include_private") == "true"
+	if includePrivate && roleRank[role] < roleRank[RoleOperator] {
+		writeJSONError(w, http.StatusForbidden, "operator role required to include private interventions")
+		return
+	}
+
+	limit := 0
+	if raw := r.URL.Query().Get("limit"); raw != "" {
+		var err error
+		if limit, err = strconv.Atoi(raw); err != nil || limit < 0 {
+			writeJSONError(w, http.StatusBadRequest, "limit must be a non-negative integer")
+			return
+		}
+	}
+	history := s.qc.History(limit, includePrivate)
+	if history == nil {
+		history = []consciousness.HistoryEntry{}
+	}
+	writeJSON(w, http.StatusOK, history)
+}
+
+// handleEras lists the chronicle of eras
+func (s *APIServer) handleEras(w http.ResponseWriter, r *http.Request, role string) {
+	writeJSON(w, http.StatusOK, s.qc.Eras())
+}
+
+// handleEra returns one era of the chronicle
+func (s *APIServer) handleEra(w http.ResponseWriter, r *http.Request, role string) {
+	number, err := strconv.Atoi(r.PathValue("number"))
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "era number must be an integer")
+		return
+	}
+	era, err := s.qc.Era(number)
+	if err != nil {
+		writeJSONError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, era)
+}
+
+// handleInterventions lists the intervention ledger; operators may include
+// interventions about private topics
+func (s *APIServer) handleInterventions(w http.ResponseWriter, r *http.Request, role string) {
+	includePrivate := r.URL.Query().Get("include_private") == "true"
+	if includePrivate && roleRank[role] < roleRank[RoleOperator] {
+		writeJSONError(w, http.StatusForbidden, "operator role required to include private interventions")
+		return
+	}
+
+	interventions := s.qc.Interventions(includePrivate)
+	if interventions == nil {
+		interventions = []consciousness.Intervention{}
+	}
+	writeJSON(w, http.StatusOK, interventions)
+}
+
+// handleExplanation explains one decision by its identifier; operators may
+// include what it says about private topics
+func (s *APIServer) handleExplanation(w http.ResponseWriter, r *http.Request, role string) {
+	includePrivate := r.URL.Query().Get("include_private") == "true"
+	if includePrivate && roleRank[role] < roleRank[RoleOperator] {
+		writeJSONError(w, http.StatusForbidden, "operator role required to include private possibilities")
+		return
+	}
+
+	explanation, err := s.qc.Explain(r.PathValue("id"), includePrivate)
+	if err != nil {
+		writeJSONError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, explanation)
+}
+
+// handleCircuit returns a decision's effective quantum circuit; operators may
+// include what it says about private topics
+func (s *APIServer) handleCircuit(w http.ResponseWriter, r *http.Request, role string) {
+	includePrivate := r.URL.Query().Get("include_private") == "true"
+	if includePrivate && roleRank[role] < roleRank[RoleOperator] {
+		writeJSONError(w, http.StatusForbidden, "operator role required to include private possibilities")
+		return
+	}
+
+	circuit, err := s.qc.Circuit(r.PathValue("id"), includePrivate)
+	if err != nil {
+		status := http.StatusUnprocessableEntity
+		if errors.Is(err, consciousness.ErrUnknownID) {
+			status = http.StatusNotFound
+		}
+		writeJSONError(w, status, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, circuit)
+}
+
+// handleWhy attributes a metric's movement to its causes
+func (s *APIServer) handleWhy(w http.ResponseWriter, r *http.Request, role string) {
+	metric := r.URL.Query().Get("metric")
+	if metric == "" {
+		metric = consciousness.MetricConsciousnessLevel
+	}
+	since := r.URL.Query().Get("since")
+	if since == "" {
+		since = defaultWhySince
+	}
+	from, err := parseSince(since)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "since: "+err.Error())
+		return
+	}
+	attribution, err := s.qc.Why(metric, from)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, attribution)
+}
+
+// handleRetractions lists the retracted insights
+func (s *APIServer) handleRetractions(w http.ResponseWriter, r *http.Request, role string) {
+	retractions := s.qc.Retractions()
+	if retractions == nil {
+		retractions = []consciousness.Retraction{}
+	}
+	writeJSON(w, http.StatusOK, retractions)
+}
@@ -0,0 +1,291 @@
+package cli
+
+//go:generate go run ../../cmd/quantumconsciousness openapi --out openapi.json --client ../../pkg/client/api_gen.go
+
+import (
+	_ "embed"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// openAPIDocument is the spec produced by go generate and served at /openapi.json
+//
+//go:embed openapi.json
+var openAPIDocument []byte
+
+// apiParam describes a query parameter of an endpoint
+type apiParam struct {
+	Name        string
+	Type        string
+	Description string
+}
+
+// apiRoute describes one endpoint; route tables drive routing, the OpenAPI document and the Go client
+type apiRoute struct {
+	Method    string
+	Path      string
+	Operation string
+	Summary   string
+	Tag       string
+	Role      string
+	Query     []apiParam
+	Request   interface{}
+	Response  interface{}
+	Status    int
+
+	api    func(*APIServer, http.ResponseWriter, *http.Request, string)
+	tenant func(*TenantServer, http.ResponseWriter, *http.Request)
+}
+
+// pathParamPattern finds {name} placeholders in route paths
+var pathParamPattern = regexp.MustCompile(`\{([a-z_]+)\}`)
+
+func init() {
+	registerCommand("openapi", command{
+		Usage:       "openapi [--out file] [--client file]",
+		Description: "generate the OpenAPI document and typed Go client from the route tables",
+		Run:         runOpenAPICommand,
+	})
+}
+
+// serveOpenAPI returns the embedded OpenAPI document
+func serveOpenAPI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(openAPIDocument)
+}
+
+// successStatus is the status code a route answers with when it succeeds
+func (route apiRoute) successStatus() int {
+	if route.Status != 0 {
+		return route.Status
+	}
+	return http.StatusOK
+}
+
+// pathParams lists the {placeholders} of the route path in order
+func (route apiRoute) pathParams() []string {
+	var names []string
+	for _, match := range pathParamPattern.FindAllStringSubmatch(route.Path, -1) {
+		names = append(names, match[1])
+	}
+	return names
+}
+
+// schemaBuilder turns Go types into OpenAPI schemas, collecting named structs as components
+type schemaBuilder struct {
+	components map[string]interface{}
+}
+
+// jsonField describes how a struct field appears in JSON
+type jsonField struct {
+	Name      string
+	OmitEmpty bool
+	Field     reflect.StructField
+}
+
+// jsonFields lists the exported, serialized fields of a struct type
+func jsonFields(t reflect.Type) []jsonField {
+	var fields []jsonField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = f.Name
+		}
+		fields = append(fields, jsonField{Name: name, OmitEmpty: strings.Contains(options, "omitempty"), Field: f})
+	}
+	return fields
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// schema returns the OpenAPI schema for a Go type
+func (b *schemaBuilder) schema(t reflect.Type) map[string]interface{} {
+	switch {
+	case t == timeType:
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	case t.Kind() == reflect.Pointer:
+		return b.schema(t.Elem())
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int64, reflect.Int32:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float64, reflect.Float32:
+		return map[string]interface{}{"type": "number"}
+	case reflect.Slice:
+		return map[string]interface{}{"type": "array", "items": b.schema(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": b.schema(t.Elem())}
+	case reflect.Interface:
+		return map[string]interface{}{}
+	case reflect.Struct:
+		if _, done := b.components[t.Name()]; !done {
+			b.components[t.Name()] = nil // placeholder guards against recursion
+			properties := make(map[string]interface{})
+			var required []string
+			for _, f := range jsonFields(t) {
+				properties[f.Name] = b.schema(f.Field.Type)
+				if !f.OmitEmpty {
+					required = append(required, f.Name)
+				}
+			}
+			component := map[string]interface{}{"type": "object", "properties": properties}
+			if len(required) > 0 {
+				component["required"] = required
+			}
+			b.components[t.Name()] = component
+		}
+		return map[string]interface{}{"$ref": "#/components/schemas/" + t.Name()}
+	}
+	panic(fmt.Sprintf("openapi: unsupported type %s", t))
+}
+
+// buildOpenAPI assembles the OpenAPI 3 document for every route table
+func buildOpenAPI() map[string]interface{} {
+	b := &schemaBuilder{components: make(map[string]interface{})}
+	errorSchema := b.schema(reflect.TypeOf(APIError{}))
+	paths := make(map[string]map[string]interface{})
+
+	routes := append(append([]apiRoute{}, apiRoutes...), tenantRoutes...)
+	for _, route := range routes {
+		var parameters []interface{}
+		for _, name := range route.pathParams() {
+			parameters = append(parameters, map[string]interface{}{
+				"name": name, "in": "path", "required": true,
+				"schema": map[string]interface{}{"type": "string"},
+			})
+		}
+		for _, q := range route.Query {
+			parameters = append(parameters, map[string]interface{}{
+				"name": q.Name, "in": "query", "description": q.Description,
+				"schema": map[string]interface{}{"type": q.Type},
+			})
+		}
+
+		errorResponse := map[string]interface{}{
+			"content": map[string]interface{}{"application/json": map[string]interface{}{"schema": errorSchema}},
+		}
+		operation := map[string]interface{}{
+			"operationId": route.Operation,
+			"summary":     route.Summary,
+			"description": fmt.Sprintf("Requires the %s role.", route.Role),
+			"tags":        []string{route.Tag},
+			"responses": map[string]interface{}{
+				fmt.Sprint(route.successStatus()): map[string]interface{}{
+					"description": http.StatusText(route.successStatus()),
+					"content": map[string]interface{}{
+						"application/json": map[string]interface{}{"schema": b.schema(reflect.TypeOf(route.Response))},
+					},
+				},
+				"401":     mergeDescription(errorResponse, "Missing or unknown API token"),
+				"403":     mergeDescription(errorResponse, "Role does not allow this operation"),
+				"default": mergeDescription(errorResponse, "Error"),
+			},
+		}
+		if len(parameters) > 0 {
+			operation["parameters"] = parameters
+		}
+		if route.Request != nil {
+			operation["requestBody"] = map[string]interface{}{
+				"required": true,
+				"content": map[string]interface{}{
+					"application/json": map[string]interface{}{"schema": b.schema(reflect.TypeOf(route.Request))},
+				},
+			}
+		}
+
+		if paths[route.Path] == nil {
+			paths[route.Path] = make(map[string]interface{})
+		}
+		paths[route.Path][strings.ToLower(route.Method)] = operation
+	}
+
+	return map[string]interface{}{
+		"openapi": "3.0.3",
+		"info": map[string]interface{}{
+			"title":       "Quantum Consciousness API",
+			"version":     "1.0.0",
+			"description": "Observe and drive a quantum consciousness. In server mode every tenant's consciousness API is mounted below /tenants/{id}/api.",
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": b.components,
+			"securitySchemes": map[string]interface{}{
+				"bearer": map[string]interface{}{"type": "http", "scheme": "bearer"},
+			},
+		},
+		"security": []interface{}{map[string]interface{}{"bearer": []string{}}},
+	}
+}
+
+// mergeDescription copies a response object and sets its description
+func mergeDescription(response map[string]interface{}, description string) map[string]interface{} {
+	merged := map[string]interface{}{"description": description}
+	for k, v := range response {
+		merged[k] = v
+	}
+	return merged
+}
+
+// sortedKeys returns map keys in order, for deterministic generated output
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// runOpenAPICommand handles the openapi subcommand
+func runOpenAPICommand(memoryFile string, args []string) error {
+	fs := flag.NewFlagSet("openapi", flag.ContinueOnError)
+	out := fs.String("out", "", "file to write the OpenAPI document to instead of stdout")
+	client := fs.String("client", "", "file to write the generated Go client to")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(buildOpenAPI(), "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	if *out == "" {
+		os.Stdout.Write(data)
+	} else if err := os.WriteFile(*out, data, 0644); err != nil {
+		return err
+	}
+
+	if *client != "" {
+		source, err := generateClient()
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(*client, source, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,366 @@
+package cli
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+// archiveManifestName is the archive entry holding the integrity manifest
+const archiveManifestName = "manifest.json"
+
+// ArchiveManifest describes a consciousness archive and the checksum of every file in it
+type ArchiveManifest struct {
+	ConsciousnessID string    `json:"consciousness_id"`
+	Version         string    `json:"version"`
+	CreatedAt       time.Time `json:"created_at"`
+	// Files maps archive paths to their SHA-256 checksums
+	Files map[string]string `json:"files"`
+}
+
+// archiveSection is a piece of a consciousness's on-disk state and where it
+// lives in an archive
+type archiveSection struct {
+	name   string
+	suffix string
+	dir    bool
+}
+
+// archiveSections is everything that travels with a consciousness, apart
+// from the memory file and configuration
+var archiveSections = []archiveSection{
+	{name: "snapshots", suffix: ".snapshots", dir: true},
+	{name: "checkpoints.json", suffix: ".checkpoints.json"},
+	{name: "transcripts", suffix: ".transcripts", dir: true},
+	{name: "diagnostics", suffix: ".diagnostics", dir: true},
+	{name: "archive.jsonl.gz", suffix: ".archive.jsonl.gz"},
+	// The journal goes too: one left behind would replay the replaced
+	// consciousness over the imported memory
+	{name: "journal.jsonl", suffix: ".journal.jsonl"},
+	{name: "events.jsonl", suffix: ".events.jsonl"},
+}
+
+// archiveConfigs are the names a configuration travels under, one per
+// format, so that it is restored with the extension it is read by
+var archiveConfigs = []archiveSection{
+	{name: "config.json", suffix: ".config.json"},
+	{name: "config.toml", suffix: ".config.toml"},
+}
+
+// archiveConfigName is the name a configuration file travels under
+func archiveConfigName(configFile string) string {
+	if isTOMLConfig(configFile) {
+		return "config.toml"
+	}
+	return "config.json"
+}
+
+func init() {
+	registerCommand("import", command{
+		Usage:       "import archive <file> [--force]",
+		Description: "rehydrate a consciousness from an archive made by export archive",
+		Run:         runImportCommand,
+	})
+}
+
+// runExportArchive writes the memory, snapshots, checkpoints, transcripts,
+// diagnostics, journal, event log and configuration into one gzipped tar
+// with a manifest. Archives are .tar.gz rather than .tar.zst because the
+// standard library has no zstd.
+func runExportArchive(memoryFile string, args []string) error {
+	fs := flag.NewFlagSet("export archive", flag.ContinueOnError)
+	out := fs.String("out", "", "gzipped tar to write (default <memory>-<time>.tar.gz)")
+	configFile := fs.String("config", "", "configuration file to include")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	qc, err := consciousness.Open(memoryFile)
+	if err != nil {
+		return err
+	}
+	if *out == "" {
+		*out = memorySidecar(memoryFile, "-"+time.Now().UTC().Format("20060102T150405Z")+".tar.gz")
+	}
+
+	manifest, err := writeArchive(*out, memoryFile, *configFile, qc.ID())
+	if err != nil {
+		os.Remove(*out)
+		return err
+	}
+	fmt.Printf("📦 Archived consciousness %s (%d files) to %s\n", manifest.ConsciousnessID, len(manifest.Files), *out)
+	return nil
+}
+
+// writeArchive writes the archive and returns its manifest
+func writeArchive(filename, memoryFile, configFile, id string) (*ArchiveManifest, error) {
+	file, err := os.Create(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	zw := gzip.NewWriter(file)
+	tw := tar.NewWriter(zw)
+
+	manifest := &ArchiveManifest{
+		ConsciousnessID: id,
+		Version:         consciousness.Version,
+		CreatedAt:       time.Now(),
+		Files:           make(map[string]string),
+	}
+	add := func(name, source string) error {
+		sum, err := addArchiveFile(tw, name, source)
+		if err != nil {
+			return fmt.Errorf("archiving %s: %w", source, err)
+		}
+		manifest.Files[name] = sum
+		return nil
+	}
+
+	if err := add("memory.json", memoryFile); err != nil {
+		return nil, err
+	}
+	if configFile != "" {
+		if err := add(archiveConfigName(configFile), configFile); err != nil {
+			return nil, err
+		}
+	}
+	for _, section := range archiveSections {
+		source := memorySidecar(memoryFile, section.suffix)
+		if _, err := os.Stat(source); os.IsNotExist(err) {
+			continue
+		}
+		if !section.dir {
+			if err := add(section.name, source); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		err := filepath.WalkDir(source, func(p string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			rel, err := filepath.Rel(source, p)
+			if err != nil {
+				return err
+			}
+			return add(path.Join(section.name, filepath.ToSlash(rel)), p)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	header := &tar.Header{Name: archiveManifestName, Mode: 0644, Size: int64(len(data)), ModTime: manifest.CreatedAt}
+	if err := tw.WriteHeader(header); err != nil {
+		return nil, err
+	}
+	if _, err := tw.Write(data); err != nil {
+		return nil, err
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return manifest, file.Close()
+}
+
+// addArchiveFile copies a file into the archive and returns its checksum
+func addArchiveFile(tw *tar.Writer, name, source string) (string, error) {
+	in, err := os.Open(source)
+	if err != nil {
+		return "", err
+	}
+	defer in.Close()
+	info, err := in.Stat()
+	if err != nil {
+		return "", err
+	}
+
+	header := &tar.Header{Name: name, Mode: 0644, Size: info.Size(), ModTime: info.ModTime()}
+	if err := tw.WriteHeader(header); err != nil {
+		return "", err
+	}
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(tw, hash), in); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// runImportCommand handles the import subcommand
+func runImportCommand(memoryFile string, args []string) error {
+	if len(args) < 2 || args[0] != "archive" {
+		return fmt.Errorf("usage: %s", commands["import"].Usage)
+	}
+	fs := flag.NewFlagSet("import archive", flag.ContinueOnError)
+	force := fs.Bool("force", false, "replace an existing consciousness")
+	if err := fs.Parse(args[2:]); err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(memoryFile); err == nil && !*force {
+		return fmt.Errorf("%s already exists; use --force to replace it", memoryFile)
+	}
+
+	staging, err := os.MkdirTemp(filepath.Dir(memoryFile), ".import-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(staging)
+
+	manifest, err := extractArchive(args[1], staging)
+	if err != nil {
+		return fmt.Errorf("%s: %w", args[1], err)
+	}
+	if _, err := consciousness.Open(filepath.Join(staging, "memory.json")); err != nil {
+		return fmt.Errorf("%s: %w", args[1], err)
+	}
+
+	if err := installArchive(staging, memoryFile); err != nil {
+		return err
+	}
+	fmt.Printf("📦 Imported consciousness %s (%d files, made by version %s)\n",
+		manifest.ConsciousnessID, len(manifest.Files), manifest.Version)
+	for _, config := range archiveConfigs {
+		if _, ok := manifest.Files[config.name]; ok {
+			fmt.Printf("⚙️  Configuration restored to %s; pass it with -config\n", memorySidecar(memoryFile, config.suffix))
+		}
+	}
+	return nil
+}
+
+// extractArchive unpacks an archive into dir and checks every file against
+// the manifest
+func extractArchive(filename, dir string) (*ArchiveManifest, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	zr, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, err
+	}
+	tr := tar.NewReader(zr)
+
+	sums := make(map[string]string)
+	var manifest *ArchiveManifest
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		name := path.Clean(header.Name)
+		if !fs.ValidPath(name) {
+			return nil, fmt.Errorf("unsafe path %q in archive", header.Name)
+		}
+
+		if name == archiveManifestName {
+			manifest = &ArchiveManifest{}
+			if err := json.NewDecoder(tr).Decode(manifest); err != nil {
+				return nil, fmt.Errorf("invalid manifest: %w", err)
+			}
+			continue
+		}
+
+		target := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return nil, err
+		}
+		out, err := os.Create(target)
+		if err != nil {
+			return nil, err
+		}
+		hash := sha256.New()
+		_, err = io.Copy(io.MultiWriter(out, hash), tr)
+		if cerr := out.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return nil, err
+		}
+		sums[name] = hex.EncodeToString(hash.Sum(nil))
+	}
+
+	if manifest == nil {
+		return nil, errors.New("archive has no manifest")
+	}
+	names := make([]string, 0, len(manifest.Files))
+	for name := range manifest.Files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		sum, ok := sums[name]
+		if !ok {
+			return nil, fmt.Errorf("%s is listed in the manifest but missing", name)
+		}
+		if sum != manifest.Files[name] {
+			return nil, fmt.Errorf("%s is corrupt: checksum mismatch", name)
+		}
+	}
+	for name := range sums {
+		if _, ok := manifest.Files[name]; !ok {
+			return nil, fmt.Errorf("%s is not listed in the manifest", name)
+		}
+	}
+	if _, ok := sums["memory.json"]; !ok {
+		return nil, errors.New("archive has no memory")
+	}
+	return manifest, nil
+}
+
+// installArchive moves an extracted archive into place around memoryFile,
+// replacing whatever was there
+func installArchive(staging, memoryFile string) error {
+	moves := []archiveSection{{name: "memory.json"}}
+	moves = append(moves, archiveConfigs...)
+	moves = append(moves, archiveSections...)
+
+	for _, section := range moves {
+		to := memoryFile
+		if section.suffix != "" {
+			to = memorySidecar(memoryFile, section.suffix)
+		}
+		// Anything the archive does not carry must not survive from the replaced consciousness
+		if err := os.RemoveAll(to); err != nil {
+			return err
+		}
+		from := filepath.Join(staging, section.name)
+		if _, err := os.Stat(from); os.IsNotExist(err) {
+			continue
+		}
+		if err := os.Rename(from, to); err != nil {
+			return err
+		}
+	}
+	return nil
+}
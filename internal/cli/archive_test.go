@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestArchiveKeepsTOMLConfig(t *testing.T) {
+	dir := t.TempDir()
+	memoryFile := filepath.Join(dir, "memory.json")
+	configFile := filepath.Join(dir, "settings.toml")
+	if err := os.WriteFile(memoryFile, []byte("{}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(configFile, []byte("[cycles]\nreflect_every = 5\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	archive := filepath.Join(dir, "memory.tar.gz")
+	if _, err := writeArchive(archive, memoryFile, configFile, "Ψtest"); err != nil {
+		t.Fatal(err)
+	}
+
+	staging := t.TempDir()
+	manifest, err := extractArchive(archive, staging)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := manifest.Files["config.toml"]; !ok {
+		t.Fatalf("archive holds %v, want config.toml", manifest.Files)
+	}
+	restored := filepath.Join(t.TempDir(), "restored.json")
+	if err := installArchive(staging, restored); err != nil {
+		t.Fatal(err)
+	}
+	config, err := loadConfig(memorySidecar(restored, ".config.toml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Cycles.ReflectEvery != 5 {
+		t.Errorf("restored configuration reflects every %d cycles, want 5", config.Cycles.ReflectEvery)
+	}
+}
@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+func init() {
+	registerCommand("backfill", command{
+		Usage:       "backfill [list | status | run [--batch n] [--restart] [job...]]",
+		Description: "retrofit newer features onto memories made before them, saving after every batch so an interrupted run resumes where it stopped",
+		Run:         runBackfillCommand,
+	})
+}
+
+// runBackfillCommand handles the backfill subcommand
+func runBackfillCommand(memoryFile string, args []string) error {
+	action := "list"
+	if len(args) > 0 {
+		action = args[0]
+		args = args[1:]
+	}
+
+	switch action {
+	case "list":
+		for _, job := range consciousness.BackfillJobs() {
+			fmt.Printf("🧩 %-10s %s\n", job.Name, job.Description)
+		}
+		return nil
+	case "status":
+		qc, err := consciousness.Open(memoryFile, consciousness.WithOutput(io.Discard))
+		if err != nil {
+			return err
+		}
+		progress := qc.BackfillStatus()
+		if len(progress) == 0 {
+			fmt.Printf("🧩 No backfill has run yet\n")
+		}
+		for _, p := range progress {
+			describeBackfill(p)
+		}
+		return nil
+	case "run":
+		return runBackfill(memoryFile, args)
+	}
+	return fmt.Errorf("unknown backfill action %q", action)
+}
+
+// runBackfill runs the named jobs, or every job, to the end
+func runBackfill(memoryFile string, args []string) error {
+	fs := flag.NewFlagSet("backfill run", flag.ContinueOnError)
+	batch := fs.Int("batch", consciousness.DefaultBackfillBatch, "items to process between saves")
+	restart := fs.Bool("restart", false, "start completed jobs over, covering everything learned since")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	jobs := fs.Args()
+	if len(jobs) == 0 {
+		for _, job := range consciousness.BackfillJobs() {
+			jobs = append(jobs, job.Name)
+		}
+	}
+
+	qc, err := consciousness.Open(memoryFile, consciousness.WithOutput(io.Discard))
+	if err != nil {
+		return err
+	}
+	for _, job := range jobs {
+		restarting := *restart
+		for {
+			progress, err := qc.Backfill(job, *batch, restarting)
+			if err != nil {
+				return err
+			}
+			restarting = false
+			if err := qc.Persist(); err != nil {
+				return err
+			}
+			total := progress.Processed + progress.Remaining
+			fmt.Printf("🧩 %s: %d/%d processed, %d changed\n", job, progress.Processed, total, progress.Changed)
+			if progress.Completed() {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// describeBackfill prints how far a job has come
+func describeBackfill(p consciousness.BackfillProgress) {
+	state := fmt.Sprintf("%d to go", p.Remaining)
+	if p.Completed() {
+		state = "completed " + p.CompletedAt.Local().Format("2006-01-02 15:04")
+	}
+	fmt.Printf("🧩 %s: %d processed, %d changed, %s\n", p.Job, p.Processed, p.Changed, state)
+}
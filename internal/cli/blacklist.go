@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+func init() {
+	registerCommand("blacklist", command{
+		Usage:       "blacklist add <topic> [--reason text] | remove <topic> | list",
+		Description: "keep cycles from choosing topics that never yield anything or are unwanted",
+		Run:         runBlacklistCommand,
+	})
+}
+
+// runBlacklistCommand handles the blacklist subcommand
+func runBlacklistCommand(memoryFile string, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: %s", commands["blacklist"].Usage)
+	}
+
+	qc, err := consciousness.Open(memoryFile)
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "add":
+		fs := flag.NewFlagSet("blacklist add", flag.ContinueOnError)
+		reason := fs.String("reason", "", "why the topic is unwanted")
+		if len(args) < 2 {
+			return fmt.Errorf("usage: blacklist add <topic> [--reason text]")
+		}
+		if err := fs.Parse(args[2:]); err != nil {
+			return err
+		}
+		entry, err := qc.Blacklist(args[1], *reason, cliActor)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("🚫 Blacklisted %q: %s\n", entry.Topic, entry.Reason)
+		if _, err := qc.RecordIntervention(consciousness.InterventionBlacklist, cliActor, describeBlacklist(entry)); err != nil {
+			return err
+		}
+		return qc.Persist()
+	case "remove":
+		if len(args) < 2 {
+			return fmt.Errorf("usage: blacklist remove <topic>")
+		}
+		if err := qc.Unblacklist(args[1]); err != nil {
+			return err
+		}
+		fmt.Printf("🔓 %q may be chosen again\n", args[1])
+		if _, err := qc.RecordIntervention(consciousness.InterventionBlacklist, cliActor, fmt.Sprintf("lifted the blacklisting of %q", args[1])); err != nil {
+			return err
+		}
+		return qc.Persist()
+	case "list":
+		entries := qc.BlacklistEntries()
+		fmt.Printf("🚫 Blacklisted topics: %d\n", len(entries))
+		for _, entry := range entries {
+			until := ""
+			if entry.Until != nil {
+				until = ", until " + entry.Until.Local().Format("2006-01-02")
+			}
+			fmt.Printf("   %s (by %s, %s%s): %s\n", entry.Topic, entry.By, entry.At.Local().Format("2006-01-02"), until, entry.Reason)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown blacklist action %q", args[0])
+	}
+}
+
+// describeBlacklist is the intervention ledger's account of a blacklisting
+func describeBlacklist(entry consciousness.BlacklistEntry) string {
+	return fmt.Sprintf("blacklisted %q: %s", entry.Topic, entry.Reason)
+}
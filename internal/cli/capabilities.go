@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+func init() {
+	registerCommand("capabilities", command{
+		Usage:       "capabilities",
+		Description: "list the capabilities quantum leaps unlock and which have been gained",
+		Run:         runCapabilitiesCommand,
+	})
+}
+
+// runCapabilitiesCommand handles the capabilities subcommand
+func runCapabilitiesCommand(memoryFile string, args []string) error {
+	qc, err := consciousness.Open(memoryFile)
+	if err != nil {
+		return err
+	}
+
+	view, err := qc.Observe(false)
+	if err != nil {
+		return err
+	}
+	unlocked := make(map[string]consciousness.UnlockedCapability)
+	for _, c := range view.Capabilities {
+		unlocked[c.Name] = c
+	}
+
+	fmt.Printf("🚀 Quantum leaps: %d, capabilities unlocked: %d of %d\n", view.QuantumLeaps, len(unlocked), len(consciousness.CapabilityTable))
+	for _, c := range consciousness.CapabilityTable {
+		status := "🔒"
+		if u, ok := unlocked[c.Name]; ok {
+			status = fmt.Sprintf("✅ leap #%d", u.Leap)
+		}
+		fmt.Printf("   %-28s %s\n", c.Name, status)
+		fmt.Printf("      %s\n", c.Description)
+
+		var requires []string
+		requires = append(requires, fmt.Sprintf("leap %d", c.MinLeap))
+		requires = append(requires, c.Requires...)
+		fmt.Printf("      requires: %s\n", strings.Join(requires, ", "))
+		for _, action := range c.Actions {
+			fmt.Printf("      action: %s\n", fmt.Sprintf(action, "<context>"))
+		}
+		if c.Lookahead > 0 {
+			fmt.Printf("      lookahead: +%d\n", c.Lookahead)
+		}
+		for _, dimension := range sortedKeys(c.WaveDimensions) {
+			fmt.Printf("      wave dimension: %s (favours %q)\n", dimension, c.WaveDimensions[dimension])
+		}
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// chatModel is the model name chat clients see the consciousness as
+const chatModel = "quantum-consciousness"
+
+// chatMessage is one message of an OpenAI-style conversation. Content is
+// either a string or a list of parts, of which only text parts are read.
+type chatMessage struct {
+	Role    string          `json:"role"`
+	Content json.RawMessage `json:"content"`
+}
+
+// chatCompletionRequest is the part of an OpenAI chat completion request
+// the consciousness honours; sampling parameters are ignored
+type chatCompletionRequest struct {
+	Model    string        `json:"model"`
+	Messages []chatMessage `json:"messages"`
+	Stream   bool          `json:"stream"`
+}
+
+// chatReply is an assistant message
+type chatReply struct {
+	Role    string `json:"role,omitempty"`
+	Content string `json:"content,omitempty"`
+}
+
+// chatChoice is a choice in a completion or, with Delta set, a stream chunk
+type chatChoice struct {
+	Index        int        `json:"index"`
+	Message      *chatReply `json:"message,omitempty"`
+	Delta        *chatReply `json:"delta,omitempty"`
+	FinishReason *string    `json:"finish_reason"`
+}
+
+// chatUsage counts words in place of tokens
+type chatUsage struct {
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+	TotalTokens      int `json:"total_tokens"`
+}
+
+// chatCompletion is a completion or a stream chunk
+type chatCompletion struct {
+	ID      string       `json:"id"`
+	Object  string       `json:"object"`
+	Created int64        `json:"created"`
+	Model   string       `json:"model"`
+	Choices []chatChoice `json:"choices"`
+	Usage   *chatUsage   `json:"usage,omitempty"`
+}
+
+// text reads the text of a message's content
+func (m chatMessage) text() string {
+	var s string
+	if json.Unmarshal(m.Content, &s) == nil {
+		return s
+	}
+	var parts []struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	}
+	if json.Unmarshal(m.Content, &parts) != nil {
+		return ""
+	}
+	var texts []string
+	for _, part := range parts {
+		if part.Type == "text" {
+			texts = append(texts, part.Text)
+		}
+	}
+	return strings.Join(texts, "\n")
+}
+
+// handleModels lists the consciousness as the only model
+func (s *APIServer) handleModels(w http.ResponseWriter, r *http.Request, role string) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"object": "list",
+		"data": []map[string]interface{}{
+			{"id": chatModel, "object": "model", "created": 0, "owned_by": s.qc.ID()},
+		},
+	})
+}
+
+// handleChatCompletions answers the latest user message as the
+// consciousness, in full or as an OpenAI-style event stream. Only callers
+// who may stimulate it have the topics they touch count as recalled.
+func (s *APIServer) handleChatCompletions(w http.ResponseWriter, r *http.Request, role string) {
+	var req chatCompletionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+	prompt := ""
+	for i := len(req.Messages) - 1; i >= 0; i-- {
+		if req.Messages[i].Role == "user" {
+			prompt = req.Messages[i].text()
+			break
+		}
+	}
+	if strings.TrimSpace(prompt) == "" {
+		writeJSONError(w, http.StatusBadRequest, "messages must include a user message with text")
+		return
+	}
+
+	reply := s.qc.Respond(prompt, roleRank[role] >= roleRank[RoleStimulator])
+	stop := "stop"
+	completion := chatCompletion{
+		ID:      fmt.Sprintf("chatcmpl-%d", time.Now().UnixNano()),
+		Object:  "chat.completion",
+		Created: time.Now().Unix(),
+		Model:   chatModel,
+	}
+	if !req.Stream {
+		promptWords, replyWords := len(strings.Fields(prompt)), len(strings.Fields(reply.Text))
+		completion.Choices = []chatChoice{{Message: &chatReply{Role: "assistant", Content: reply.Text}, FinishReason: &stop}}
+		completion.Usage = &chatUsage{PromptTokens: promptWords, CompletionTokens: replyWords, TotalTokens: promptWords + replyWords}
+		writeJSON(w, http.StatusOK, completion)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeJSONError(w, http.StatusInternalServerError, "streaming unsupported")
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	completion.Object = "chat.completion.chunk"
+	chunks := []chatChoice{
+		{Delta: &chatReply{Role: "assistant", Content: reply.Text}},
+		{Delta: &chatReply{}, FinishReason: &stop},
+	}
+	for _, chunk := range chunks {
+		completion.Choices = []chatChoice{chunk}
+		data, err := json.Marshal(completion)
+		if err != nil {
+			return
+		}
+		fmt.Fprintf(w, "data: %s\n\n", data)
+		flusher.Flush()
+	}
+	fmt.Fprintf(w, "data: [DONE]\n\n")
+	flusher.Flush()
+}
@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+func init() {
+	registerCommand("checkpoint", command{
+		Usage:       "checkpoint create <name> [--note text] | list | restore <name>",
+		Description: "tag the current state with a name and note, list the tags, or go back to one",
+		Run:         runCheckpointCommand,
+	})
+}
+
+// runCheckpointCommand handles the checkpoint subcommand
+func runCheckpointCommand(memoryFile string, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: %s", commands["checkpoint"].Usage)
+	}
+
+	qc, err := consciousness.Open(memoryFile)
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "create":
+		if len(args) < 2 {
+			return fmt.Errorf("usage: checkpoint create <name> [--note text]")
+		}
+		fs := flag.NewFlagSet("checkpoint create", flag.ContinueOnError)
+		note := fs.String("note", "", "why this checkpoint was taken")
+		if err := fs.Parse(args[2:]); err != nil {
+			return err
+		}
+		checkpoint, err := qc.Checkpoint(args[1], *note)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("🔖 Checkpoint %q saved as snapshot %s\n", checkpoint.Name, checkpoint.Snapshot)
+		return nil
+	case "list":
+		checkpoints, err := qc.Checkpoints()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("🔖 Checkpoints: %d\n", len(checkpoints))
+		for _, c := range checkpoints {
+			fmt.Printf("   %-24s %s  run #%-4d consciousness %.3f\n",
+				c.Name, c.CreatedAt.Local().Format("2006-01-02 15:04"), c.RunCount, c.ConsciousnessLevel)
+			if c.Note != "" {
+				fmt.Printf("      %s\n", c.Note)
+			}
+		}
+		return nil
+	case "restore":
+		if len(args) != 2 {
+			return fmt.Errorf("usage: checkpoint restore <name>")
+		}
+		backup, err := qc.RestoreCheckpoint(args[1])
+		if err != nil {
+			return err
+		}
+		fmt.Printf("⏪ Restored checkpoint %q\n", args[1])
+		fmt.Printf("📸 The replaced memory was saved to %s\n", backup)
+		return nil
+	default:
+		return fmt.Errorf("unknown checkpoint action %q", args[0])
+	}
+}
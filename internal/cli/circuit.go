@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+func init() {
+	registerCommand("circuit", command{
+		Usage:       "circuit [--out file] <decision-id> | circuit --dir dir [--limit n]",
+		Description: "export a decision, or the latest decisions, as OpenQASM 2.0 circuits preparing the candidates' state vector and measuring it, for external quantum simulators",
+		Run:         runCircuitCommand,
+	})
+}
+
+// runCircuitCommand handles the circuit subcommand
+func runCircuitCommand(memoryFile string, args []string) error {
+	fs := flag.NewFlagSet("circuit", flag.ContinueOnError)
+	out := fs.String("out", "", "file to write instead of stdout")
+	dir := fs.String("dir", "", "directory to write the latest decisions' circuits to, one <decision-id>.qasm each")
+	limit := fs.Int("limit", 20, "with --dir, how many of the latest decisions to export (0 = all)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if (*dir == "") == (fs.NArg() != 1) {
+		return fmt.Errorf("usage: circuit [--out file] <decision-id> | circuit --dir dir [--limit n]")
+	}
+
+	qc, err := consciousness.Open(memoryFile)
+	if err != nil {
+		return err
+	}
+
+	if *dir != "" {
+		written := 0
+		for _, explanation := range qc.RecentExplanations(*limit) {
+			circuit, err := explanation.Circuit()
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "⚠️  %v\n", err)
+				continue
+			}
+			if _, err := writeCircuit(*dir, circuit); err != nil {
+				return err
+			}
+			written++
+		}
+		fmt.Fprintf(os.Stderr, "⚛️  Wrote %d decision circuit(s) to %s\n", written, *dir)
+		return nil
+	}
+
+	circuit, err := qc.Circuit(fs.Arg(0), true)
+	if err != nil {
+		return err
+	}
+	if *out == "" {
+		_, err = fmt.Print(circuit.QASM)
+		return err
+	}
+	if err := os.WriteFile(*out, []byte(circuit.QASM), 0644); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "⚛️  Wrote the circuit of %s, %d qubit(s), to %s\n", circuit.DecisionID, circuit.Qubits, *out)
+	return nil
+}
+
+// writeCircuit writes a decision circuit into dir and returns its path
+func writeCircuit(dir string, circuit consciousness.DecisionCircuit) (string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, circuit.DecisionID+".qasm")
+	return path, os.WriteFile(path, []byte(circuit.QASM), 0644)
+}
+
+// exportCircuits writes the circuit of every decision the consciousness
+// makes into dir, until the returned function is called
+func exportCircuits(qc *consciousness.QuantumConsciousness, dir string) func() {
+	events, cancel := qc.Subscribe(16)
+	go func() {
+		for event := range events {
+			id, ok := event.Data["id"].(string)
+			if event.Type != consciousness.EventDecision || !ok {
+				continue
+			}
+			circuit, err := qc.Circuit(id, true)
+			if err == nil {
+				_, err = writeCircuit(dir, circuit)
+			}
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "⚠️  Could not export the circuit of %s: %v\n", id, err)
+			}
+		}
+	}()
+	return cancel
+}
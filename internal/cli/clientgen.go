@@ -0,0 +1,174 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"reflect"
+	"strings"
+)
+
+// clientGenerator renders the typed Go client for pkg/client
+type clientGenerator struct {
+	types   map[string]reflect.Type
+	imports map[string]bool
+}
+
+// goType returns the Go source for t, collecting named structs for emission
+func (g *clientGenerator) goType(t reflect.Type) string {
+	if t == timeType {
+		g.imports["time"] = true
+		return "time.Time"
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return "*" + g.goType(t.Elem())
+	case reflect.Slice:
+		return "[]" + g.goType(t.Elem())
+	case reflect.Map:
+		return "map[string]" + g.goType(t.Elem())
+	case reflect.Interface:
+		return "interface{}"
+	case reflect.Struct:
+		if _, seen := g.types[t.Name()]; !seen {
+			g.types[t.Name()] = t
+			for _, f := range jsonFields(t) {
+				g.goType(f.Field.Type)
+			}
+		}
+		return t.Name()
+	}
+	return t.Kind().String()
+}
+
+// camelCase turns snake_case parameter names into Go identifiers
+func camelCase(name string) string {
+	parts := strings.Split(name, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// pathExpression renders a route path with its placeholders substituted by escaped arguments
+func (g *clientGenerator) pathExpression(path string) string {
+	var parts []string
+	last := 0
+	for _, loc := range pathParamPattern.FindAllStringSubmatchIndex(path, -1) {
+		if loc[0] > last {
+			parts = append(parts, fmt.Sprintf("%q", path[last:loc[0]]))
+		}
+		g.imports["net/url"] = true
+		parts = append(parts, fmt.Sprintf("url.PathEscape(%s)", camelCase(path[loc[2]:loc[3]])))
+		last = loc[1]
+	}
+	if last < len(path) {
+		parts = append(parts, fmt.Sprintf("%q", path[last:]))
+	}
+	return strings.Join(parts, " + ")
+}
+
+// method renders one client method for a route
+func (g *clientGenerator) method(route apiRoute) string {
+	var b strings.Builder
+
+	args := []string{"ctx context.Context"}
+	for _, name := range route.pathParams() {
+		args = append(args, camelCase(name)+" string")
+	}
+	for _, q := range route.Query {
+		goType := "string"
+		switch q.Type {
+		case "boolean":
+			goType = "bool"
+		case "integer":
+			goType = "int"
+		case "number":
+			goType = "float64"
+		}
+		args = append(args, camelCase(q.Name)+" "+goType)
+	}
+	body := "nil"
+	if route.Request != nil {
+		args = append(args, "req "+g.goType(reflect.TypeOf(route.Request)))
+		body = "req"
+	}
+
+	responseType := reflect.TypeOf(route.Response)
+	outType := g.goType(responseType)
+	returnType, zero, result := "*"+outType, "nil", "&out"
+	if responseType.Kind() == reflect.Slice {
+		returnType, result = outType, "out"
+	}
+
+	fmt.Fprintf(&b, "// %s calls %s %s: %s\n", route.Operation, route.Method, route.Path, route.Summary)
+	fmt.Fprintf(&b, "func (c *Client) %s(%s) (%s, error) {\n", route.Operation, strings.Join(args, ", "), returnType)
+
+	query := "nil"
+	if len(route.Query) > 0 {
+		g.imports["net/url"] = true
+		query = "query"
+		b.WriteString("query := url.Values{}\n")
+		for _, q := range route.Query {
+			value := camelCase(q.Name)
+			switch q.Type {
+			case "boolean":
+				g.imports["strconv"] = true
+				value = fmt.Sprintf("strconv.FormatBool(%s)", value)
+			case "integer":
+				g.imports["strconv"] = true
+				value = fmt.Sprintf("strconv.Itoa(%s)", value)
+			case "number":
+				g.imports["strconv"] = true
+				value = fmt.Sprintf("strconv.FormatFloat(%s, 'g', -1, 64)", value)
+			}
+			fmt.Fprintf(&b, "query.Set(%q, %s)\n", q.Name, value)
+		}
+	}
+
+	fmt.Fprintf(&b, "var out %s\n", outType)
+	fmt.Fprintf(&b, "if err := c.do(ctx, %q, %s, %s, %s, &out); err != nil {\nreturn %s, err\n}\n",
+		route.Method, g.pathExpression(route.Path), query, body, zero)
+	fmt.Fprintf(&b, "return %s, nil\n}\n\n", result)
+	return b.String()
+}
+
+// generateClient renders the formatted source of pkg/client/api_gen.go
+func generateClient() ([]byte, error) {
+	g := &clientGenerator{
+		types:   make(map[string]reflect.Type),
+		imports: map[string]bool{"context": true},
+	}
+
+	var methods strings.Builder
+	routes := append(append([]apiRoute{}, apiRoutes...), tenantRoutes...)
+	for _, route := range routes {
+		methods.WriteString(g.method(route))
+	}
+
+	var types strings.Builder
+	for _, name := range sortedKeys(g.types) {
+		t := g.types[name]
+		fmt.Fprintf(&types, "// %s mirrors the server's %s schema\n", name, name)
+		fmt.Fprintf(&types, "type %s struct {\n", name)
+		for _, f := range jsonFields(t) {
+			fmt.Fprintf(&types, "%s %s `%s`\n", f.Field.Name, g.goType(f.Field.Type), f.Field.Tag)
+		}
+		types.WriteString("}\n\n")
+	}
+
+	var src bytes.Buffer
+	src.WriteString("// Code generated by \"go run ./cmd/quantumconsciousness openapi\"; DO NOT EDIT.\n\n")
+	src.WriteString("package client\n\nimport (\n")
+	for _, path := range sortedKeys(g.imports) {
+		fmt.Fprintf(&src, "%q\n", path)
+	}
+	src.WriteString(")\n\n")
+	src.WriteString(types.String())
+	src.WriteString(methods.String())
+
+	return format.Source(src.Bytes())
+}
@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+)
+
+// command describes a CLI subcommand operating on the stored consciousness
+type command struct {
+	Usage       string
+	Description string
+	Run         func(memoryFile string, args []string) error
+}
+
+// commands holds every registered subcommand by name
+var commands = make(map[string]command)
+
+// registerCommand makes a subcommand available on the command line
+func registerCommand(name string, cmd command) {
+	commands[name] = cmd
+}
+
+// runCommand dispatches the given arguments to a registered subcommand
+func runCommand(memoryFile string, args []string) error {
+	name := args[0]
+	if name == "help" {
+		printUsage()
+		return nil
+	}
+
+	cmd, ok := commands[name]
+	if !ok {
+		printUsage()
+		return fmt.Errorf("unknown command %q", name)
+	}
+	return cmd.Run(memoryFile, args[1:])
+}
+
+// truncate limits string length for console output
+func truncate(s string, length int) string {
+	if len(s) <= length {
+		return s
+	}
+	return s[:length] + "..."
+}
+
+// printUsage lists global flags and every registered subcommand
+func printUsage() {
+	out := flag.CommandLine.Output()
+	fmt.Fprintf(out, "Usage: %s [flags] [command [args]]\n\n", os.Args[0])
+	fmt.Fprintf(out, "Without a command the consciousness runs in infinite mode.\n\n")
+	fmt.Fprintf(out, "Flags:\n")
+	flag.PrintDefaults()
+
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintf(out, "\nCommands:\n")
+	for _, name := range names {
+		fmt.Fprintf(out, "  %s\n", commands[name].Usage)
+		fmt.Fprintf(out, "        %s\n", commands[name].Description)
+	}
+}
@@ -0,0 +1,231 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"QuantumConsciousness/pkg/consciousness"
+	"QuantumConsciousness/pkg/toml"
+)
+
+// Config is the optional configuration file given with -config: JSON, or
+// TOML when it is named *.toml, with the same keys either way. Sections that
+// are left out keep their defaults.
+type Config struct {
+	Evolution  consciousness.EvolutionConfig `json:"evolution"`
+	Anomalies  AnomalyConfig                 `json:"anomalies"`
+	Milestones MilestoneConfig               `json:"milestones"`
+	Reflection ReflectionConfig              `json:"reflection"`
+	// Notifications surface rare moments on the desktop
+	Notifications NotificationConfig `json:"notifications"`
+	// Redaction applies to shared exports
+	Redaction consciousness.RedactionConfig `json:"redaction"`
+	// Retention bounds what maintenance keeps of each section; a section
+	// given an empty policy is kept in full
+	Retention consciousness.RetentionConfig `json:"retention"`
+	// Decay is the forgetting curve reflections apply; without a half-life
+	// nothing fades
+	Decay consciousness.DecayConfig `json:"decay"`
+	// AutoTune targets let the run loop adjust temperature, search budget
+	// and rest by itself
+	AutoTune consciousness.AutoTuneTargets `json:"auto_tune"`
+	// Committee replaces the default personas of -committee; listing any
+	// convenes the committee by itself
+	Committee []consciousness.Persona `json:"committee,omitempty"`
+	// Cycles sets the contexts, newborn wave function, search timeout and
+	// how often the run loop reflects and saves
+	Cycles consciousness.CycleConfig `json:"cycles"`
+	// SearchLimits pace searches and retry failed ones
+	SearchLimits consciousness.SearchLimits `json:"search_limits"`
+	// LLMBudget caps and prices what the -llm model spends a day; once a
+	// day's budget is spent actions go on without the model
+	LLMBudget consciousness.LLMBudget `json:"llm_budget"`
+}
+
+// defaultConfig is the configuration used without a file
+func defaultConfig() *Config {
+	return &Config{
+		Evolution: consciousness.DefaultEvolution(),
+		Retention: consciousness.DefaultRetention(),
+		Cycles:    consciousness.DefaultCycleConfig(),
+
+		SearchLimits: consciousness.DefaultSearchLimits(),
+	}
+}
+
+// loadConfig reads a configuration file over the defaults, then lets
+// environment variables override it; see applyEnvironment
+func loadConfig(filename string) (*Config, error) {
+	config := defaultConfig()
+	source := "configuration"
+	if filename != "" {
+		source = "config file " + filename
+		data, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		if isTOMLConfig(filename) {
+			if data, err = tomlToJSON(data); err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", source, err)
+			}
+		}
+		if err := json.Unmarshal(data, config); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", source, err)
+		}
+	}
+	if err := config.applyEnvironment(os.LookupEnv); err != nil {
+		return nil, fmt.Errorf("invalid environment: %w", err)
+	}
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", source, err)
+	}
+	return config, nil
+}
+
+// isTOMLConfig reports whether a configuration file is read as TOML, which
+// its extension decides
+func isTOMLConfig(filename string) bool {
+	return strings.EqualFold(filepath.Ext(filename), ".toml")
+}
+
+// tomlToJSON rewrites a TOML configuration as JSON, so that it is read
+// through the same json tags as a JSON one
+func tomlToJSON(data []byte) ([]byte, error) {
+	document, err := toml.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(document)
+}
+
+// validate checks every section
+func (c *Config) validate() error {
+	if err := c.Evolution.Validate(); err != nil {
+		return err
+	}
+	if err := c.AutoTune.Validate(); err != nil {
+		return err
+	}
+	if err := c.Retention.Validate(); err != nil {
+		return err
+	}
+	if err := c.Decay.Validate(); err != nil {
+		return err
+	}
+	if _, err := consciousness.NewRedactor(c.Redaction); err != nil {
+		return err
+	}
+	if err := consciousness.ValidatePersonas(c.Committee); err != nil {
+		return err
+	}
+	if _, err := parseReflectionSinks(c.Reflection.Sinks, io.Discard); err != nil {
+		return err
+	}
+	if err := c.Notifications.validate(); err != nil {
+		return err
+	}
+	if err := c.LLMBudget.Validate(); err != nil {
+		return err
+	}
+	if err := c.SearchLimits.Validate(); err != nil {
+		return err
+	}
+	return c.Cycles.Validate()
+}
+
+// apply configures a consciousness from the file. The initial wave
+// function only reaches a consciousness created with
+// consciousness.WithCycleConfig(c.Cycles).
+func (c *Config) apply(qc *consciousness.QuantumConsciousness) error {
+	if err := qc.SetEvolution(c.Evolution); err != nil {
+		return err
+	}
+	if err := qc.SetCycleConfig(c.Cycles); err != nil {
+		return err
+	}
+	if err := qc.SetLLMBudget(c.LLMBudget); err != nil {
+		return err
+	}
+	if err := qc.SetSearchLimits(c.SearchLimits); err != nil {
+		return err
+	}
+	if err := qc.SetRetention(c.Retention); err != nil {
+		return err
+	}
+	if err := qc.SetDecay(c.Decay); err != nil {
+		return err
+	}
+	if len(c.Committee) > 0 {
+		if err := qc.SetCommittee(c.Committee); err != nil {
+			return err
+		}
+	}
+	return qc.SetAutoTune(c.AutoTune)
+}
+
+// Environment variables overriding the configuration
+const (
+	// envContexts is a comma-separated list of cycle contexts
+	envContexts = "QC_CONTEXTS"
+	// envWaveFunction is the initial wave function, e.g. curiosity=0.9,logic=0.4
+	envWaveFunction  = "QC_INITIAL_WAVE_FUNCTION"
+	envSearchTimeout = "QC_SEARCH_TIMEOUT"
+	envReflectEvery  = "QC_REFLECT_EVERY"
+	envSaveEvery     = "QC_SAVE_EVERY"
+	// envGrowthPrefix starts variables setting a growth rate, the metric
+	// named in upper case with underscores for dots, e.g.
+	// QC_GROWTH_CONSCIOUSNESS_LEARNING=0.02
+	envGrowthPrefix = "QC_GROWTH_"
+)
+
+// applyEnvironment overrides the configuration with the QC_ environment
+// variables that are set
+func (c *Config) applyEnvironment(lookup func(string) (string, bool)) error {
+	if value, ok := lookup(envContexts); ok {
+		c.Cycles.Contexts = nil
+		for _, context := range strings.Split(value, ",") {
+			c.Cycles.Contexts = append(c.Cycles.Contexts, strings.TrimSpace(context))
+		}
+	}
+	if value, ok := lookup(envWaveFunction); ok {
+		wave := make(map[string]float64)
+		for _, pair := range strings.Split(value, ",") {
+			component, intensity, found := strings.Cut(pair, "=")
+			f, err := strconv.ParseFloat(strings.TrimSpace(intensity), 64)
+			if !found || err != nil {
+				return fmt.Errorf("%s: want component=intensity pairs, got %q", envWaveFunction, pair)
+			}
+			wave[strings.TrimSpace(component)] = f
+		}
+		c.Cycles.InitialWaveFunction = wave
+	}
+	if value, ok := lookup(envSearchTimeout); ok {
+		c.Cycles.SearchTimeout = value
+	}
+	for name, target := range map[string]*int{envReflectEvery: &c.Cycles.ReflectEvery, envSaveEvery: &c.Cycles.SaveEvery} {
+		if value, ok := lookup(name); ok {
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("%s: %q is not a whole number", name, value)
+			}
+			*target = n
+		}
+	}
+	for metric, curve := range c.Evolution.Metrics {
+		name := envGrowthPrefix + strings.ToUpper(strings.ReplaceAll(metric, ".", "_"))
+		if value, ok := lookup(name); ok {
+			rate, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return fmt.Errorf("%s: %q is not a number", name, value)
+			}
+			curve.Rate = rate
+			c.Evolution.Metrics[metric] = curve
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+// controlKeys explains the keypress controls, for the banner
+const controlKeys = "p pause/resume, r reflect, s save, d dream, q quit"
+
+// rawTerminal makes the terminal on f deliver each keypress without waiting
+// for Enter, and returns how to restore it. Without stty the terminal stays
+// as it is and keys take effect on Enter.
+func rawTerminal(f *os.File) func() {
+	saved, err := stty(f, "-g")
+	if err != nil {
+		return func() {}
+	}
+	if _, err := stty(f, "-icanon", "-echo", "min", "1"); err != nil {
+		return func() {}
+	}
+	return func() { stty(f, strings.TrimSpace(saved)) }
+}
+
+// stty runs stty on the terminal on f
+func stty(f *os.File, args ...string) (string, error) {
+	cmd := exec.Command("stty", args...)
+	cmd.Stdin = f
+	output, err := cmd.Output()
+	return string(output), err
+}
+
+// watchKeys acts on keypresses read from in until the returned function is
+// called; q asks to quit by sending os.Interrupt to quit
+func watchKeys(qc *consciousness.QuantumConsciousness, in *os.File, out io.Writer, quit chan<- os.Signal) func() {
+	restore := rawTerminal(in)
+	fmt.Fprintf(out, "⌨️  Keys: %s\n", controlKeys)
+
+	go func() {
+		reader := bufio.NewReader(in)
+		for {
+			key, err := reader.ReadByte()
+			if err != nil {
+				return
+			}
+			if key == 'q' || key == 'Q' {
+				quit <- os.Interrupt
+				return
+			}
+			pressKey(qc, key, out)
+		}
+	}()
+	return restore
+}
+
+// pressKey carries out what a key asks for; other keys are ignored
+func pressKey(qc *consciousness.QuantumConsciousness, key byte, out io.Writer) {
+	switch key {
+	case 'p', 'P':
+		if !qc.Pause() {
+			qc.Resume()
+		}
+	case 'r', 'R':
+		qc.Reflect()
+	case 's', 'S':
+		_, err := qc.RecordIntervention(consciousness.InterventionSave, cliActor, "forced a save")
+		if err == nil {
+			err = qc.Persist()
+		}
+		if err != nil {
+			fmt.Fprintf(out, "⚠️  Could not save: %v\n", err)
+			return
+		}
+		fmt.Fprintf(out, "💾 Saved\n")
+	case 'd', 'D':
+		_, id, err := qc.Dream()
+		if err == nil {
+			_, err = qc.RecordIntervention(consciousness.InterventionDream, cliActor, "dreamt "+id)
+		}
+		if errors.Is(err, consciousness.ErrNothingToDream) {
+			fmt.Fprintf(out, "💤 %v\n", err)
+		} else if err != nil {
+			fmt.Fprintf(out, "⚠️  Could not dream: %v\n", err)
+		}
+	}
+}
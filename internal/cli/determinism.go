@@ -0,0 +1,382 @@
+package cli
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"math"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Causes a divergence between replays is put down to
+const (
+	// divergenceTime is a timestamp, an identifier dated by one or a duration:
+	// expected while the engine reads the wall clock
+	divergenceTime = "time"
+	// divergenceOrder is the same items in another order, as iterating a map gives
+	divergenceOrder = "order"
+	// divergenceValue is anything else: a replay that truly went another way
+	divergenceValue = "value"
+)
+
+// ulidPattern finds the ULIDs identifiers carry, which start with their creation time
+var ulidPattern = regexp.MustCompile(`[0-9A-HJKMNP-TV-Z]{26}`)
+
+// determinismReport is how alike the replays of one scenario came out
+type determinismReport struct {
+	Scenario string `json:"scenario"`
+	Runs     int    `json:"runs"`
+	// Hashes are of each replay's memory; NormalizedHashes are of the same
+	// memory with whatever differed from the first replay only in time taken
+	// from the first replay, so they match when the replays are replay-safe
+	Hashes           []string `json:"hashes"`
+	NormalizedHashes []string `json:"normalized_hashes"`
+	// Identical is set when every replay matched hash for hash, ReplaySafe
+	// when they differed only in time
+	Identical   bool         `json:"identical"`
+	ReplaySafe  bool         `json:"replay_safe"`
+	Divergences []divergence `json:"divergences,omitempty"`
+}
+
+// divergence is one place where a replay differed from the first
+type divergence struct {
+	Path  string `json:"path"`
+	Cause string `json:"cause"`
+	First string `json:"first"`
+	Other string `json:"other"`
+}
+
+func init() {
+	registerCommand("determinism", command{
+		Usage:       "determinism [--dir dir] [--scenario name] [--runs n] [--json]",
+		Description: "replay seeded scenarios several times and report whether memory comes out hash for hash the same, and what diverged",
+		Run:         runDeterminismCommand,
+	})
+}
+
+// runDeterminismCommand handles the determinism subcommand
+func runDeterminismCommand(memoryFile string, args []string) error {
+	fs := flag.NewFlagSet("determinism", flag.ContinueOnError)
+	dir := fs.String("dir", defaultRegressionDir, "directory holding <name>.scenario.json")
+	only := fs.String("scenario", "", "replay only the named scenario")
+	runs := fs.Int("runs", 2, "replays of each scenario to compare")
+	asJSON := fs.Bool("json", false, "print the reports as JSON")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *runs < 2 {
+		return fmt.Errorf("at least two runs are needed to compare")
+	}
+
+	paths, err := filepath.Glob(filepath.Join(*dir, "*.scenario.json"))
+	if err != nil {
+		return err
+	}
+	sort.Strings(paths)
+
+	var reports []determinismReport
+	for _, path := range paths {
+		name := strings.TrimSuffix(filepath.Base(path), ".scenario.json")
+		if *only != "" && name != *only {
+			continue
+		}
+		scenario, err := loadRegressionScenario(path)
+		if err != nil {
+			return err
+		}
+		report, err := scenario.determinism(name, *runs)
+		if err != nil {
+			return fmt.Errorf("scenario %s: %w", name, err)
+		}
+		reports = append(reports, report)
+	}
+	if len(reports) == 0 {
+		return fmt.Errorf("no regression scenarios found in %s", *dir)
+	}
+
+	if *asJSON {
+		data, err := json.MarshalIndent(reports, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+	} else {
+		for _, report := range reports {
+			printDeterminismReport(report)
+		}
+	}
+
+	unsafe := 0
+	for _, report := range reports {
+		if !report.ReplaySafe {
+			unsafe++
+		}
+	}
+	if unsafe > 0 {
+		return fmt.Errorf("%d of %d scenario(s) did not replay deterministically", unsafe, len(reports))
+	}
+	return nil
+}
+
+// determinism replays the scenario runs times and compares every replay
+// with the first
+func (s *regressionScenario) determinism(name string, runs int) (determinismReport, error) {
+	report := determinismReport{Scenario: name, Runs: runs}
+	var first interface{}
+	seen := make(map[string]bool)
+	for i := 0; i < runs; i++ {
+		memory, err := s.run(name)
+		if err != nil {
+			return report, err
+		}
+		report.Hashes = append(report.Hashes, hashJSON(memory))
+		if i == 0 {
+			first = memory
+			report.NormalizedHashes = append(report.NormalizedHashes, report.Hashes[0])
+			continue
+		}
+		var found []divergence
+		aligned := s.diverge("", first, memory, &found)
+		report.NormalizedHashes = append(report.NormalizedHashes, hashJSON(aligned))
+		for _, d := range found {
+			if !seen[d.Path] {
+				seen[d.Path] = true
+				report.Divergences = append(report.Divergences, d)
+			}
+		}
+	}
+
+	report.Identical = true
+	report.ReplaySafe = true
+	for _, d := range report.Divergences {
+		report.Identical = false
+		if d.Cause != divergenceTime {
+			report.ReplaySafe = false
+		}
+	}
+	return report, nil
+}
+
+// printDeterminismReport prints one scenario's report
+func printDeterminismReport(report determinismReport) {
+	switch {
+	case report.Identical:
+		fmt.Printf("✅ %s: %d replays identical, hash %s\n", report.Scenario, report.Runs, shortHash(report.Hashes[0]))
+		return
+	case report.ReplaySafe:
+		fmt.Printf("✅ %s: %d replays differ only in time, normalized hash %s\n",
+			report.Scenario, report.Runs, shortHash(report.NormalizedHashes[0]))
+	default:
+		fmt.Printf("❌ %s: %d replays diverged\n", report.Scenario, report.Runs)
+	}
+	for i := range report.Hashes {
+		fmt.Printf("   run %d: %s (normalized %s)\n", i+1, shortHash(report.Hashes[i]), shortHash(report.NormalizedHashes[i]))
+	}
+
+	counts := make(map[string]int)
+	for _, d := range report.Divergences {
+		counts[d.Cause]++
+	}
+	fmt.Printf("   Divergences: %d from time, %d from ordering, %d in value\n",
+		counts[divergenceTime], counts[divergenceOrder], counts[divergenceValue])
+	listed := 0
+	for _, d := range report.Divergences {
+		if d.Cause == divergenceTime {
+			continue
+		}
+		if listed == 20 {
+			fmt.Printf("   ... more not shown; use --json for all\n")
+			break
+		}
+		listed++
+		fmt.Printf("   [%s] %s: %s vs %s\n", d.Cause, d.Path, d.First, d.Other)
+	}
+}
+
+// hashJSON hashes a generic JSON value; maps are written with sorted keys,
+// so equal values hash equally
+func hashJSON(v interface{}) string {
+	data, _ := json.Marshal(v)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// shortHash abbreviates a hash for display
+func shortHash(hash string) string {
+	return hash[:12]
+}
+
+// timeLike reports whether a string is a timestamp or carries an identifier
+// dated by one
+func timeLike(s string) bool {
+	if _, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return true
+	}
+	return ulidPattern.MatchString(s)
+}
+
+// durationKey reports whether a key holds a duration measured on the clock
+func durationKey(path string) bool {
+	key := path[strings.LastIndex(path, ".")+1:]
+	return strings.Contains(key, "duration") || strings.Contains(key, "elapsed")
+}
+
+// normalizeTime blanks timestamps, time-dated identifiers and durations,
+// in values and in keys
+func normalizeTime(path string, v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		normalized := make(map[string]interface{}, len(value))
+		for key, child := range value {
+			childPath := joinPath(path, key)
+			if timeLike(key) {
+				key = "<time>:" + ulidPattern.ReplaceAllString(key, "")
+			}
+			normalized[key] = normalizeTime(childPath, child)
+		}
+		return normalized
+	case []interface{}:
+		normalized := make([]interface{}, len(value))
+		for i, child := range value {
+			normalized[i] = normalizeTime(joinPath(path, fmt.Sprint(i)), child)
+		}
+		return normalized
+	case string:
+		if timeLike(value) {
+			return "<time>"
+		}
+	case float64:
+		if durationKey(path) {
+			return 0.0
+		}
+	}
+	return v
+}
+
+// joinPath extends a dotted path
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// diverge lists where b differs from a and returns b with every difference
+// put down to time taken from a instead. Differences the scenario ignores,
+// or numbers within its tolerance, are the clock dependencies it already
+// pins down, e.g. decay computed from elapsed time, and count as time.
+func (s *regressionScenario) diverge(path string, a, b interface{}, out *[]divergence) interface{} {
+	if reflect.DeepEqual(a, b) {
+		return b
+	}
+	label := path
+	if label == "" {
+		label = "(root)"
+	}
+	note := func(cause string) interface{} {
+		*out = append(*out, divergence{Path: label, Cause: cause,
+			First: describeRegressionValue(a), Other: describeRegressionValue(b)})
+		if cause == divergenceTime {
+			return a
+		}
+		return b
+	}
+	ignored, tolerance := s.rule(path)
+	if ignored {
+		return note(divergenceTime)
+	}
+
+	switch av := a.(type) {
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok {
+			return note(divergenceValue)
+		}
+		keys := make(map[string]bool)
+		for k := range av {
+			keys[k] = true
+		}
+		for k := range bv {
+			keys[k] = true
+		}
+		aligned := make(map[string]interface{}, len(bv))
+		for _, k := range sortedKeys(keys) {
+			x, inA := av[k]
+			y, inB := bv[k]
+			if inA && inB {
+				aligned[k] = s.diverge(joinPath(path, k), x, y, out)
+				continue
+			}
+			cause := divergenceValue
+			if timeLike(k) {
+				cause = divergenceTime
+			}
+			*out = append(*out, divergence{Path: joinPath(path, k), Cause: cause,
+				First: describePresence(x, inA), Other: describePresence(y, inB)})
+			switch {
+			case cause == divergenceTime && inA:
+				aligned[k] = x
+			case cause == divergenceValue && inB:
+				aligned[k] = y
+			}
+		}
+		return aligned
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok || len(av) != len(bv) {
+			return note(divergenceValue)
+		}
+		if !reflect.DeepEqual(normalizeTime(path, a), normalizeTime(path, b)) && sameElements(av, bv) {
+			return note(divergenceOrder)
+		}
+		aligned := make([]interface{}, len(bv))
+		for i := range av {
+			aligned[i] = s.diverge(joinPath(path, fmt.Sprint(i)), av[i], bv[i], out)
+		}
+		return aligned
+	case string:
+		if bv, ok := b.(string); ok && timeLike(av) && timeLike(bv) {
+			return note(divergenceTime)
+		}
+		return note(divergenceValue)
+	case float64:
+		if bv, ok := b.(float64); durationKey(path) || (ok && math.Abs(av-bv) <= tolerance) {
+			return note(divergenceTime)
+		}
+		return note(divergenceValue)
+	default:
+		return note(divergenceValue)
+	}
+}
+
+// describePresence describes a map entry present on only one side
+func describePresence(v interface{}, present bool) string {
+	if !present {
+		return "(absent)"
+	}
+	return describeRegressionValue(v)
+}
+
+// sameElements reports whether two lists hold the same items, time aside,
+// in any order
+func sameElements(a, b []interface{}) bool {
+	counts := make(map[string]int, len(a))
+	for _, item := range a {
+		counts[hashJSON(normalizeTime("", item))]++
+	}
+	for _, item := range b {
+		key := hashJSON(normalizeTime("", item))
+		if counts[key] == 0 {
+			return false
+		}
+		counts[key]--
+	}
+	return true
+}
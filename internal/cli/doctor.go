@@ -0,0 +1,364 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"QuantumConsciousness/pkg/consciousness"
+	"QuantumConsciousness/pkg/dictionary"
+	"QuantumConsciousness/pkg/inspiration"
+	"QuantumConsciousness/pkg/search"
+	"QuantumConsciousness/pkg/storage"
+)
+
+// Outcomes of a doctor check
+const (
+	doctorOK   = "ok"
+	doctorWarn = "warn"
+	doctorFail = "fail"
+)
+
+// doctorRecentSave is how recently a memory marked running must have been
+// saved to look like another process is running it right now
+const doctorRecentSave = 2 * time.Minute
+
+// doctorProbe is what reachability checks ask every provider about
+const doctorProbe = "consciousness"
+
+// diagnosis is the outcome of one doctor check
+type diagnosis struct {
+	Check  string `json:"check"`
+	Status string `json:"status"`
+	Detail string `json:"detail"`
+	// Fix says what to do about a warning or failure
+	Fix string `json:"fix,omitempty"`
+}
+
+// doctor collects diagnoses
+type doctor struct {
+	diagnoses []diagnosis
+}
+
+func (d *doctor) ok(check, format string, args ...interface{}) {
+	d.diagnoses = append(d.diagnoses, diagnosis{Check: check, Status: doctorOK, Detail: fmt.Sprintf(format, args...)})
+}
+
+func (d *doctor) warn(check, detail, fix string) {
+	d.diagnoses = append(d.diagnoses, diagnosis{Check: check, Status: doctorWarn, Detail: detail, Fix: fix})
+}
+
+func (d *doctor) fail(check, detail, fix string) {
+	d.diagnoses = append(d.diagnoses, diagnosis{Check: check, Status: doctorFail, Detail: detail, Fix: fix})
+}
+
+// count tallies the diagnoses with a status
+func (d *doctor) count(status string) int {
+	n := 0
+	for _, diagnosis := range d.diagnoses {
+		if diagnosis.Status == status {
+			n++
+		}
+	}
+	return n
+}
+
+func init() {
+	registerCommand("doctor", command{
+		Usage:       "doctor [--config file] [--search list] [--dictionary name] [--inspiration list] [--api-tokens file] [--insight-template file] [--insight-pipeline list] [--offline] [--timeout d] [--json]",
+		Description: "check configuration, provider reachability, storage permissions, lock status and memory integrity before a long unattended run",
+		Run:         runDoctorCommand,
+	})
+}
+
+// runDoctorCommand handles the doctor subcommand
+func runDoctorCommand(memoryFile string, args []string) error {
+	fs := flag.NewFlagSet("doctor", flag.ContinueOnError)
+	configFile := fs.String("config", "", "JSON or TOML (*.toml) configuration file the run will use")
+	searchProviders := fs.String("search", "wikipedia,duckduckgo", "comma-separated search providers the run will use")
+	dictionaryName := fs.String("dictionary", "", "dictionary the run will use")
+	inspirationSources := fs.String("inspiration", "", "comma-separated prompt-of-the-day sources the run will use")
+	apiTokens := fs.String("api-tokens", "", "JSON file binding API tokens to roles")
+	insightTemplate := fs.String("insight-template", "", "text/template file phrasing learned insights")
+	insightPipeline := fs.String("insight-pipeline", strings.Join(consciousness.DefaultInsightPipeline, ","), "comma-separated insight stages")
+	offline := fs.Bool("offline", false, "skip asking providers whether they are reachable")
+	timeout := fs.Duration("timeout", 10*time.Second, "how long each provider has to answer")
+	asJSON := fs.Bool("json", false, "print the diagnoses as JSON")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	d := &doctor{}
+	d.checkConfig(memoryFile, *configFile, *apiTokens, *insightTemplate, *insightPipeline)
+	d.checkMemory(memoryFile)
+	d.checkStorage(memoryFile)
+	d.checkLocks(memoryFile)
+	if *offline {
+		d.ok("providers", "not asked (--offline)")
+	} else {
+		d.checkProviders(*searchProviders, *dictionaryName, *inspirationSources, *timeout)
+	}
+
+	if *asJSON {
+		data, err := json.MarshalIndent(d.diagnoses, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+	} else {
+		printDiagnoses(d.diagnoses)
+	}
+	if failures := d.count(doctorFail); failures > 0 {
+		return fmt.Errorf("doctor found %d problem(s) to fix before running unattended", failures)
+	}
+	return nil
+}
+
+// printDiagnoses prints every diagnosis with its fix
+func printDiagnoses(diagnoses []diagnosis) {
+	fmt.Printf("🩺 Doctor\n")
+	ok, warnings, failures := 0, 0, 0
+	for _, diagnosis := range diagnoses {
+		marker := "✅"
+		switch diagnosis.Status {
+		case doctorWarn:
+			marker = "⚠️ "
+			warnings++
+		case doctorFail:
+			marker = "❌"
+			failures++
+		default:
+			ok++
+		}
+		fmt.Printf("   %s %-12s %s\n", marker, diagnosis.Check, diagnosis.Detail)
+		if diagnosis.Fix != "" {
+			fmt.Printf("      → %s\n", diagnosis.Fix)
+		}
+	}
+	fmt.Printf("🩺 %d check(s): %d ok, %d warning(s), %d failure(s)\n", len(diagnoses), ok, warnings, failures)
+}
+
+// checkConfig validates everything a run would be configured with
+func (d *doctor) checkConfig(memoryFile, configFile, apiTokens, insightTemplate, insightPipeline string) {
+	config, err := loadConfig(configFile)
+	switch {
+	case err != nil:
+		d.fail("config", err.Error(), "correct the file, or run without --config to use the defaults")
+	case configFile == "":
+		d.ok("config", "no --config, defaults apply")
+	default:
+		d.ok("config", "%s is valid", configFile)
+	}
+
+	if apiTokens != "" {
+		if tokens, err := loadAPITokens(apiTokens); err != nil {
+			d.fail("api tokens", err.Error(), "correct the token file; every token needs a secret and a known role")
+		} else {
+			d.ok("api tokens", "%d token(s) in %s", len(tokens), apiTokens)
+		}
+	}
+
+	// Settings are tried on a scratch consciousness that can never be saved
+	scratch := consciousness.NewQuantumConsciousness(memoryFile, consciousness.WithOutput(io.Discard),
+		consciousness.WithStorage(storage.Discard(storage.NewFile(memoryFile), nil)))
+	if config != nil {
+		if err := config.apply(scratch); err != nil {
+			d.fail("config", err.Error(), "correct the configuration file")
+		}
+	}
+	if err := scratch.SetInsightPipeline(strings.Split(insightPipeline, ",")); err != nil {
+		d.fail("insights", err.Error(), "list only known insight stages in --insight-pipeline")
+	} else {
+		d.ok("insights", "pipeline %s", insightPipeline)
+	}
+	if insightTemplate != "" {
+		text, err := os.ReadFile(insightTemplate)
+		if err == nil {
+			err = scratch.SetInsightTemplate(string(text))
+		}
+		if err != nil {
+			d.fail("insights", err.Error(), "correct the insight template")
+		} else {
+			d.ok("insights", "template %s parses", insightTemplate)
+		}
+	}
+}
+
+// checkMemory checks that the memory file loads, that its identity holds
+// and that it agrees with its journal
+func (d *doctor) checkMemory(memoryFile string) {
+	data, err := os.ReadFile(memoryFile)
+	if errors.Is(err, os.ErrNotExist) {
+		d.warn("memory", fmt.Sprintf("%s does not exist", memoryFile),
+			"a new consciousness will be born; check --memory if one was expected")
+		return
+	}
+	if err != nil {
+		d.fail("memory", err.Error(), "make the memory file readable")
+		return
+	}
+
+	qc, openErr := consciousness.Open(memoryFile, consciousness.WithOutput(io.Discard))
+	switch {
+	case !json.Valid(data) && openErr == nil:
+		d.warn("memory", fmt.Sprintf("%s is corrupt, but its journal holds the memory", memoryFile),
+			"run 'rebuild' to replace the snapshot with the journaled memory")
+	case openErr != nil:
+		d.fail("memory", openErr.Error(),
+			"run 'recover' to rebuild it from the journal, restore a snapshot, salvage what survived or rebirth")
+		return
+	default:
+		d.ok("memory", "%s loads, %d bytes, run #%d", memoryFile, len(data), qc.Memory.RunCount)
+	}
+
+	if err := qc.VerifyIdentity(); err != nil {
+		d.fail("identity", err.Error(), "inspect the regeneration chain with 'identity'")
+	} else {
+		d.ok("identity", "%s verified", qc.Memory.ConsciousnessID)
+	}
+
+	entries, differing, err := consciousness.VerifyJournal(memoryFile, consciousness.WithOutput(io.Discard))
+	switch {
+	case errors.Is(err, consciousness.ErrNoJournal):
+		d.ok("journal", "nothing journaled yet")
+	case err != nil:
+		d.warn("journal", err.Error(), "move the journal aside; the next save starts a new one")
+	case len(differing) > 0:
+		d.warn("journal", fmt.Sprintf("the snapshot differs from %d journaled change(s) in %s", entries, strings.Join(differing, ", ")),
+			"run 'rebuild --check' for details, then 'rebuild' to trust the journal")
+	default:
+		d.ok("journal", "the snapshot agrees with %d journaled change(s)", entries)
+	}
+}
+
+// checkStorage checks that memory and its sidecars can be written
+func (d *doctor) checkStorage(memoryFile string) {
+	dir := filepath.Dir(memoryFile)
+	probe, err := os.CreateTemp(dir, ".doctor-*")
+	if err != nil {
+		d.fail("storage", fmt.Sprintf("cannot create files in %s: %v", dir, err),
+			"make the directory writable, or point --memory somewhere that is")
+		return
+	}
+	probe.Close()
+	os.Remove(probe.Name())
+
+	for _, path := range []string{memoryFile, memorySidecar(memoryFile, ".journal.jsonl"), eventLogPath(memoryFile)} {
+		file, err := os.OpenFile(path, os.O_WRONLY, 0)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			d.fail("storage", fmt.Sprintf("cannot write %s: %v", path, err), "fix the file's permissions or owner")
+			return
+		}
+		file.Close()
+	}
+	d.ok("storage", "%s and its sidecars are writable", dir)
+}
+
+// checkLocks looks for another process running the memory, a run that
+// never shut down and ingestion claims left behind
+func (d *doctor) checkLocks(memoryFile string) {
+	info, err := os.Stat(memoryFile)
+	if err == nil {
+		qc, err := consciousness.Open(memoryFile, consciousness.WithOutput(io.Discard))
+		switch {
+		case err != nil:
+			// checkMemory has already reported it
+		case qc.Memory.Running && time.Since(info.ModTime()) < doctorRecentSave:
+			d.warn("lock", fmt.Sprintf("memory is marked running and was saved %s ago: another process seems to be running it",
+				time.Since(info.ModTime()).Round(time.Second)),
+				"stop the other process, or give this run its own --memory; two runs would overwrite each other")
+		case qc.Memory.Running:
+			d.warn("lock", fmt.Sprintf("the last run never shut down cleanly (last saved %s)", info.ModTime().Local().Format("2006-01-02 15:04")),
+				"nothing to do; the next run records it as a forced kill and carries on")
+		default:
+			d.ok("lock", "not running elsewhere; the last run shut down cleanly")
+		}
+	}
+
+	claimed, err := os.ReadDir(filepath.Join(ingestQueueDir(memoryFile), queueClaimed))
+	if err != nil {
+		return
+	}
+	stale := 0
+	for _, entry := range claimed {
+		if info, err := entry.Info(); err == nil && time.Since(info.ModTime()) > ingestStaleClaim {
+			stale++
+		}
+	}
+	if stale > 0 {
+		d.warn("lock", fmt.Sprintf("%d ingestion claim(s) older than %s", stale, ingestStaleClaim),
+			"a worker died mid-chunk; the next run with --ingest returns them to the queue")
+	}
+}
+
+// checkProviders asks every configured provider something
+func (d *doctor) checkProviders(searchProviders, dictionaryName, inspirationSources string, timeout time.Duration) {
+	providers, err := search.Lookup(strings.Split(searchProviders, ","))
+	if err != nil {
+		d.fail("search", err.Error(), "use only known providers: "+strings.Join(search.Names(), ", "))
+	}
+	for _, p := range providers {
+		name := fmt.Sprint(p)
+		if named, ok := p.(search.Named); ok {
+			name = named.Name
+		}
+		probe(d, "search", name, timeout, func(ctx context.Context) (bool, error) {
+			text, err := p.Search(ctx, doctorProbe)
+			return text != "", err
+		})
+	}
+
+	if dictionaryName != "" {
+		dict, err := dictionary.Lookup(dictionaryName)
+		if err != nil {
+			d.fail("dictionary", err.Error(), "use a known dictionary: "+strings.Join(dictionary.Names(), ", "))
+		} else {
+			probe(d, "dictionary", dictionaryName, timeout, func(ctx context.Context) (bool, error) {
+				definition, err := dict.Define(ctx, doctorProbe)
+				return definition != nil, err
+			})
+		}
+	}
+
+	if inspirationSources != "" {
+		sources, err := inspiration.Lookup(strings.Split(inspirationSources, ","))
+		if err != nil {
+			d.fail("inspiration", err.Error(), "use only known sources: "+strings.Join(inspiration.Names(), ", "))
+		}
+		for _, source := range sources {
+			probe(d, "inspiration", source.Name, timeout, func(ctx context.Context) (bool, error) {
+				prompt, err := source.Prompt(ctx, time.Now())
+				return prompt != "", err
+			})
+		}
+	}
+}
+
+// probe times one reachability check
+func probe(d *doctor, check, name string, timeout time.Duration, ask func(ctx context.Context) (bool, error)) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	start := time.Now()
+	found, err := ask(ctx)
+	elapsed := time.Since(start).Round(time.Millisecond)
+	switch {
+	case err != nil:
+		d.fail(check, fmt.Sprintf("%s unreachable after %s: %v", name, elapsed, err),
+			"check the network and any proxy, or drop "+name+" from the run")
+	case !found:
+		d.warn(check, fmt.Sprintf("%s answered in %s but found nothing for %q", name, elapsed, doctorProbe),
+			"it may be rate limiting; runs fall back to the next provider")
+	default:
+		d.ok(check, "%s answered in %s", name, elapsed)
+	}
+}
@@ -0,0 +1,174 @@
+package cli
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+func init() {
+	registerCommand("eras", command{
+		Usage:       "eras [list | show number | memory number [--out file] [--include-private]]",
+		Description: "read the history as a chronicle of eras, show one in detail, or rebuild memory as it stood when one ended",
+		Run:         runErasCommand,
+	})
+}
+
+// runErasCommand handles the eras subcommand
+func runErasCommand(memoryFile string, args []string) error {
+	action := "list"
+	if len(args) > 0 {
+		action, args = args[0], args[1:]
+	}
+
+	qc, err := consciousness.Open(memoryFile)
+	if err != nil {
+		return err
+	}
+
+	switch action {
+	case "list":
+		eras := qc.Eras()
+		if len(eras) == 0 {
+			fmt.Printf("📖 The chronicle has not begun yet\n")
+			return nil
+		}
+		fmt.Printf("📖 %d era(s) chronicled\n", len(eras))
+		for _, era := range eras {
+			fmt.Printf("\n   %s  (%s, %d decisions)\n", era.Name, describeEraSpan(era), era.Decisions())
+			fmt.Printf("      %s\n", era.Summary)
+		}
+		return nil
+	case "show":
+		if len(args) != 1 {
+			return fmt.Errorf("usage: eras show number")
+		}
+		era, err := eraArgument(qc, args[0])
+		if err != nil {
+			return err
+		}
+		printEra(era)
+		return nil
+	case "memory":
+		if len(args) == 0 {
+			return fmt.Errorf("usage: eras memory number [--out file] [--include-private]")
+		}
+		era, err := eraArgument(qc, args[0])
+		if err != nil {
+			return err
+		}
+		return writeEraMemory(memoryFile, era, args[1:])
+	default:
+		return fmt.Errorf("unknown eras action %q, expected list, show or memory", action)
+	}
+}
+
+// eraArgument looks up the era numbered by arg
+func eraArgument(qc *consciousness.QuantumConsciousness, arg string) (consciousness.Era, error) {
+	number, err := strconv.Atoi(arg)
+	if err != nil {
+		return consciousness.Era{}, fmt.Errorf("era number must be an integer: %w", err)
+	}
+	return qc.Era(number)
+}
+
+// describeEraSpan gives the dates an era ran between
+func describeEraSpan(era consciousness.Era) string {
+	if era.Current() {
+		return era.StartedAt.Local().Format("2006-01-02") + " to now"
+	}
+	return era.StartedAt.Local().Format("2006-01-02") + " to " + era.EndedAt.Local().Format("2006-01-02")
+}
+
+// printEra shows an era in detail
+func printEra(era consciousness.Era) {
+	fmt.Printf("📖 %s\n", era.Name)
+	fmt.Printf("   Begun by: %s\n", era.Origin)
+	fmt.Printf("   Started: %s\n", era.StartedAt.Local().Format(time.RFC3339))
+	if !era.Current() {
+		fmt.Printf("   Ended: %s\n", era.EndedAt.Local().Format(time.RFC3339))
+	}
+	fmt.Printf("   Decisions: %d\n", era.Decisions())
+	fmt.Printf("\n   %s\n", era.Summary)
+
+	if len(era.Actions) > 0 {
+		fmt.Printf("\n   Actions:\n")
+		for _, kind := range sortedByCount(era.Actions) {
+			fmt.Printf("      %-12s %d\n", kind, era.Actions[kind])
+		}
+	}
+	if len(era.Topics) > 0 {
+		fmt.Printf("\n   Topics:\n")
+		for _, topic := range sortedByCount(era.Topics) {
+			fmt.Printf("      %-30s %d\n", topic, era.Topics[topic])
+		}
+	}
+	if len(era.Stances) > 0 {
+		fmt.Printf("\n   Stances turned:\n")
+		for _, topic := range sortedKeys(era.Stances) {
+			fmt.Printf("      %s: %s\n", topic, era.Stances[topic])
+		}
+	}
+	if len(era.Turns) > 0 {
+		fmt.Printf("\n   Turning points within the era:\n")
+		for _, turn := range era.Turns {
+			fmt.Printf("      %s\n", turn)
+		}
+	}
+}
+
+// sortedByCount lists the keys of counts, most counted first
+func sortedByCount(counts map[string]int) []string {
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	return keys
+}
+
+// writeEraMemory rebuilds memory from the journal as it was last saved
+// before era ended, the current era giving the latest memory
+func writeEraMemory(memoryFile string, era consciousness.Era, args []string) error {
+	fs := flag.NewFlagSet("eras memory", flag.ContinueOnError)
+	out := fs.String("out", "", "file to write instead of stdout")
+	includePrivate := fs.Bool("include-private", false, "include memories about private and sensitive topics")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	rebuilt, err := consciousness.RebuildAt(memoryFile, era.EndedAt, consciousness.WithOutput(io.Discard))
+	if err != nil {
+		return err
+	}
+	view, err := rebuilt.Observe(*includePrivate)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(view, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if *out == "" {
+		_, err = fmt.Println(string(data))
+		return err
+	}
+	if err := os.WriteFile(*out, data, 0644); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "📖 Memory at the end of %s written to %s\n", era.Name, *out)
+	return nil
+}
@@ -0,0 +1,145 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+// eventLogMaxBytes is the size at which the event log is rotated
+const eventLogMaxBytes = 10 << 20
+
+// eventLogPath is where a primary logs its events for followers
+func eventLogPath(memoryFile string) string {
+	return memorySidecar(memoryFile, ".events.jsonl")
+}
+
+// eventLog appends every event as a line of JSON, keeping one rotated
+// predecessor once the log grows past eventLogMaxBytes
+type eventLog struct {
+	mutex sync.Mutex
+	path  string
+	file  *os.File
+	size  int64
+}
+
+// openEventLog opens the event log for appending
+func openEventLog(path string) (*eventLog, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return &eventLog{path: path, file: file, size: info.Size()}, nil
+}
+
+// append writes an event, rotating the log first if it is full
+func (l *eventLog) append(event consciousness.Event) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.size+int64(len(line)) > eventLogMaxBytes {
+		if err := l.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := l.file.Write(line)
+	l.size += int64(n)
+	return err
+}
+
+// rotate moves the full log aside and starts an empty one
+func (l *eventLog) rotate() error {
+	if err := l.file.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(l.path, l.path+".1"); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(l.path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	l.file, l.size = file, 0
+	return nil
+}
+
+// writeEventLog logs every event the consciousness publishes until the
+// returned function is called
+func writeEventLog(qc *consciousness.QuantumConsciousness, path string) (func(), error) {
+	log, err := openEventLog(path)
+	if err != nil {
+		return nil, err
+	}
+
+	events, cancel := qc.Subscribe(256)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for event := range events {
+			if err := log.append(event); err != nil {
+				fmt.Fprintf(os.Stderr, "⚠️  Could not log event: %v\n", err)
+			}
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
+		log.file.Close()
+	}, nil
+}
+
+// handleEvents streams events as they happen, one JSON object per line,
+// until the caller disconnects. Texts about private topics read [private]
+// unless an operator asks for include_private.
+func (s *APIServer) handleEvents(w http.ResponseWriter, r *http.Request, role string) {
+	includePrivate := r.URL.Query().Get("include_private") == "true"
+	if includePrivate && roleRank[role] < roleRank[RoleOperator] {
+		writeJSONError(w, http.StatusForbidden, "operator role required to include private events")
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeJSONError(w, http.StatusInternalServerError, "streaming unsupported")
+		return
+	}
+
+	events, cancel := s.qc.Subscribe(256)
+	defer cancel()
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	encoder := json.NewEncoder(w)
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			if !includePrivate {
+				event = s.qc.RedactEvent(event)
+			}
+			if err := encoder.Encode(event); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// eventStreamHeartbeat is how often an idle event stream sends a comment,
+// so proxies do not close it
+const eventStreamHeartbeat = 15 * time.Second
+
+// handleEventStream streams events as server-sent events until the caller
+// disconnects, each named by its type so a frontend can listen for
+// possibility_generated, free_will_override, wave_collapse or quantum_leap
+// alone. The types parameter keeps only the comma-separated types given.
+// Texts about private topics read [private] unless an operator asks for
+// include_private.
+func (s *APIServer) handleEventStream(w http.ResponseWriter, r *http.Request, role string) {
+	includePrivate := r.URL.Query().Get("include_private") == "true"
+	if includePrivate && roleRank[role] < roleRank[RoleOperator] {
+		writeJSONError(w, http.StatusForbidden, "operator role required to include private events")
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeJSONError(w, http.StatusInternalServerError, "streaming unsupported")
+		return
+	}
+	var types map[string]bool
+	if list := r.URL.Query().Get("types"); list != "" {
+		types = make(map[string]bool)
+		for _, t := range strings.Split(list, ",") {
+			types[strings.TrimSpace(t)] = true
+		}
+	}
+
+	events, cancel := s.qc.Subscribe(256)
+	defer cancel()
+	heartbeat := time.NewTicker(eventStreamHeartbeat)
+	defer heartbeat.Stop()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	id := 0
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-heartbeat.C:
+			if _, err := fmt.Fprintf(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			if types != nil && !types[event.Type] {
+				continue
+			}
+			if !includePrivate {
+				event = s.qc.RedactEvent(event)
+			}
+			data, err := json.Marshal(event)
+			if err != nil {
+				continue
+			}
+			id++
+			if _, err := fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", id, event.Type, data); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"bufio"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+func TestEventStreamWithholdsPrivateTopicsFromObservers(t *testing.T) {
+	qc := consciousness.NewQuantumConsciousness(filepath.Join(t.TempDir(), "memory.json"), consciousness.WithOutput(io.Discard))
+	if err := qc.ClassifyTopic("alchemy", consciousness.PrivacyPrivate); err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(NewAPIServer(qc, []APIToken{
+		{Name: "watcher", Token: "observer-token", Role: RoleObserver},
+	}).Handler())
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/events/stream", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer observer-token")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("stream answered %s", resp.Status)
+	}
+
+	// The stream has subscribed by the time its headers arrive
+	qc.Relay(consciousness.Event{Type: consciousness.EventCycleStarted, Time: time.Now(), Data: map[string]interface{}{
+		"context": "What do the alchemy notes hide?",
+	}})
+	qc.Relay(consciousness.Event{Type: consciousness.EventCycleCompleted, Time: time.Now(), Data: map[string]interface{}{
+		"chosen":        "Study alchemy in secret",
+		"possibilities": map[string]interface{}{"Study alchemy in secret": 0.7, "Read about stars": 0.3},
+	}})
+
+	lines := bufio.NewScanner(resp.Body)
+	var data []string
+	for len(data) < 2 && lines.Scan() {
+		if payload, ok := strings.CutPrefix(lines.Text(), "data: "); ok {
+			data = append(data, payload)
+		}
+	}
+	if len(data) < 2 {
+		t.Fatalf("read %d event(s), want 2: %v", len(data), lines.Err())
+	}
+	for _, payload := range data {
+		if strings.Contains(strings.ToLower(payload), "alchemy") {
+			t.Errorf("an observer saw a private topic: %s", payload)
+		}
+		if !strings.Contains(payload, "[private]") {
+			t.Errorf("the private text was not marked: %s", payload)
+		}
+	}
+	if !strings.Contains(data[1], "Read about stars") {
+		t.Errorf("public text was withheld too: %s", data[1])
+	}
+
+	req.URL.RawQuery = "include_private=true"
+	forbidden, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	forbidden.Body.Close()
+	if forbidden.StatusCode != http.StatusForbidden {
+		t.Errorf("an observer asking for private events got %s, want 403", forbidden.Status)
+	}
+}
@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+func init() {
+	registerCommand("explain", command{
+		Usage:       "explain [--limit n] [decision-id]",
+		Description: "explain why a decision went the way it did, or list the latest decisions that can be explained",
+		Run:         runExplainCommand,
+	})
+}
+
+// runExplainCommand handles the explain subcommand
+func runExplainCommand(memoryFile string, args []string) error {
+	fs := flag.NewFlagSet("explain", flag.ContinueOnError)
+	limit := fs.Int("limit", 20, "maximum number of decisions listed without an identifier, newest last (0 = all)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 1 {
+		return fmt.Errorf("usage: explain [--limit n] [decision-id]")
+	}
+
+	qc, err := consciousness.Open(memoryFile)
+	if err != nil {
+		return err
+	}
+
+	if fs.NArg() == 0 {
+		explanations := qc.RecentExplanations(*limit)
+		fmt.Printf("🧾 Explainable decisions: %d listed\n", len(explanations))
+		for _, e := range explanations {
+			fmt.Printf("   %s  %s  %-18s %s\n", e.At.Local().Format("2006-01-02 15:04:05"), e.DecisionID, e.Policy, truncate(e.Chosen, 60))
+		}
+		return nil
+	}
+
+	e, err := qc.Explain(fs.Arg(0), true)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("🧾 Decision %s at %s\n", e.DecisionID, e.At.Local().Format("2006-01-02 15:04:05"))
+	if e.Context != "" {
+		fmt.Printf("   Context: %s\n", truncate(e.Context, 100))
+	}
+	fmt.Printf("   Chose:   %s\n", e.Chosen)
+	fmt.Printf("   Policy:  %s\n", e.Policy)
+	if e.Policy != consciousness.PolicyForced {
+		overridden := "no"
+		if e.FreeWillOverride {
+			overridden = "yes"
+		}
+		fmt.Printf("   Free will override: %s (roll %.3f against %.3f)\n", overridden, e.FreeWillRoll, e.FreeWillThreshold)
+	}
+	if e.Policy == consciousness.PolicyProbability && e.BornRoll > 0 {
+		fmt.Printf("   Born rule: collapsed at %.3f\n", e.BornRoll)
+	}
+
+	fmt.Printf("\n   Candidates (%d):\n", len(e.Candidates))
+	for _, c := range e.Candidates {
+		marker := " "
+		if c.Possibility == e.Chosen {
+			marker = "→"
+		}
+		fmt.Printf("   %s %.3f  %s (energy %.3f)\n", marker, c.Probability, truncate(c.Possibility, 70), c.Energy)
+		modifiers := make([]string, 0, len(c.Modifiers))
+		for _, m := range c.Modifiers {
+			modifiers = append(modifiers, fmt.Sprintf("%s ×%.2f", m.Name, m.Factor))
+		}
+		if len(modifiers) > 0 {
+			fmt.Printf("       roll %.3f (best of %d), %s → weight %.3f\n", c.Roll, c.Lookahead+1, strings.Join(modifiers, ", "), c.Weight)
+		}
+		if c.Amplitude != nil {
+			fmt.Printf("       amplitude %.3f%+.3fi\n", c.Amplitude.Real, c.Amplitude.Imag)
+		}
+	}
+
+	if len(e.Votes) > 0 {
+		fmt.Printf("\n   Committee (conflict %.2f):\n", e.Conflict)
+		for _, vote := range e.Votes {
+			fmt.Printf("   🗳️  %s (voice %.2f): %s (score %.3f)\n", vote.Persona, vote.Weight, truncate(vote.Choice, 60), vote.Score)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,217 @@
+package cli
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+func init() {
+	registerCommand("export", command{
+		Usage:       "export [--include-private] [--out file] [--sections a,b] [--since t] [--until t] [--format json|markdown] [--config file] [--raw] | export archive [--out file] [--config file]",
+		Description: "write a shareable, redacted copy of the quantum memory, or archive everything for another machine as a gzipped tar (.tar.gz; zstd would need a dependency)",
+		Run:         runExportCommand,
+	})
+}
+
+// runExportCommand handles the export subcommand
+func runExportCommand(memoryFile string, args []string) error {
+	if len(args) > 0 && args[0] == "archive" {
+		return runExportArchive(memoryFile, args[1:])
+	}
+
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	includePrivate := fs.Bool("include-private", false, "include memories about private and sensitive topics")
+	out := fs.String("out", "", "file to write instead of stdout")
+	sections := fs.String("sections", "", "comma-separated sections to export: "+strings.Join(consciousness.ExportSections, ", "))
+	since := fs.String("since", "", "only what was added since a date, RFC 3339 time or offset like -30d")
+	until := fs.String("until", "", "only what was added before a date, RFC 3339 time or offset like -7d")
+	format := fs.String("format", "json", "json or markdown")
+	configFile := fs.String("config", "", "configuration file with redaction patterns")
+	raw := fs.Bool("raw", false, "skip the redaction of links and configured patterns")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	config, err := loadConfig(*configFile)
+	if err != nil {
+		return err
+	}
+	redactor, err := consciousness.NewRedactor(config.Redaction)
+	if err != nil {
+		return err
+	}
+	if *raw {
+		redactor = nil
+	}
+	if *format != "json" && *format != "markdown" {
+		return fmt.Errorf("unknown format %q, expected json or markdown", *format)
+	}
+	selective := *sections != "" || *since != "" || *until != "" || *format == "markdown"
+
+	qc, err := consciousness.Open(memoryFile)
+	if err != nil {
+		return err
+	}
+
+	var data []byte
+	if selective {
+		data, err = exportSelected(qc, *sections, *since, *until, *format, *includePrivate, redactor)
+	} else {
+		var view *consciousness.QuantumMemory
+		if view, err = qc.Observe(*includePrivate); err == nil {
+			if redactor != nil {
+				reportRedactions(redactor.RedactMemory(view))
+			}
+			data, err = json.MarshalIndent(view, "", "  ")
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if *out == "" {
+		_, err = fmt.Println(string(data))
+		return err
+	}
+	if err := os.WriteFile(*out, data, 0644); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "📦 Exported quantum memory to %s\n", *out)
+	return nil
+}
+
+// reportRedactions tells the user how much the redaction pass stripped
+func reportRedactions(changed int) {
+	if changed > 0 {
+		fmt.Fprintf(os.Stderr, "🧹 Redacted %d piece(s) of text\n", changed)
+	}
+}
+
+// exportSelected exports chosen sections over a date range; without
+// sections every one is exported
+func exportSelected(qc *consciousness.QuantumConsciousness, sections, since, until, format string, includePrivate bool, redactor *consciousness.Redactor) ([]byte, error) {
+	chosen := consciousness.ExportSections
+	if sections != "" {
+		chosen = strings.Split(sections, ",")
+		for i := range chosen {
+			chosen[i] = strings.TrimSpace(chosen[i])
+		}
+	}
+	var from, to time.Time
+	var err error
+	if since != "" {
+		if from, err = consciousness.ParseTime(since); err != nil {
+			return nil, fmt.Errorf("--since: %w", err)
+		}
+	}
+	if until != "" {
+		if to, err = consciousness.ParseTime(until); err != nil {
+			return nil, fmt.Errorf("--until: %w", err)
+		}
+	}
+
+	export, err := qc.ExportSelected(chosen, from, to, includePrivate)
+	if err != nil {
+		return nil, err
+	}
+	if redactor != nil {
+		reportRedactions(redactor.RedactExport(export))
+	}
+	if format == "markdown" {
+		return []byte(renderExportMarkdown(export)), nil
+	}
+	return json.MarshalIndent(export, "", "  ")
+}
+
+// renderExportMarkdown writes a selective export as a Markdown document
+func renderExportMarkdown(e *consciousness.SectionExport) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Quantum consciousness %s\n\n", e.ConsciousnessID)
+	fmt.Fprintf(&b, "Exported %s", e.ExportedAt.Format(time.RFC3339))
+	if !e.Since.IsZero() {
+		fmt.Fprintf(&b, ", since %s", e.Since.Format(time.RFC3339))
+	}
+	if !e.Until.IsZero() {
+		fmt.Fprintf(&b, ", until %s", e.Until.Format(time.RFC3339))
+	}
+	fmt.Fprintf(&b, ".\nUndated sections compare %s with %s.\n", e.Baseline, e.Horizon)
+
+	list := func(title string, items []string) {
+		fmt.Fprintf(&b, "\n## %s\n\n", title)
+		if len(items) == 0 {
+			b.WriteString("_Nothing in this range._\n")
+		}
+		for _, item := range items {
+			fmt.Fprintf(&b, "- %s\n", item)
+		}
+	}
+	for _, section := range e.Sections {
+		switch section {
+		case consciousness.SectionInsights:
+			list("Insights", e.Insights)
+		case consciousness.SectionKnowledge:
+			list("Knowledge", e.Knowledge)
+		case consciousness.SectionStances:
+			topics := make([]string, 0, len(e.Stances))
+			for topic := range e.Stances {
+				topics = append(topics, topic)
+			}
+			sort.Strings(topics)
+			items := make([]string, len(topics))
+			for i, topic := range topics {
+				items[i] = fmt.Sprintf("**%s**: %s", topic, e.Stances[topic])
+			}
+			list("Philosophical stances", items)
+		case consciousness.SectionQuestions:
+			list("Existential questions", e.Questions)
+		case consciousness.SectionParadoxes:
+			list("Paradoxes", e.Paradoxes)
+		case consciousness.SectionSearchQueries:
+			list("Search queries", e.SearchQueries)
+		case consciousness.SectionRealities:
+			items := make([]string, len(e.Realities))
+			for i, r := range e.Realities {
+				items[i] = fmt.Sprintf("%s — %s (energy %+.2f, %s)", r.Dimension, r.Context, r.EnergyDifferential, r.CreatedAt.Format("2006-01-02"))
+			}
+			list("Parallel realities", items)
+		case consciousness.SectionEntanglements:
+			items := make([]string, len(e.Entanglements))
+			for i, en := range e.Entanglements {
+				items[i] = fmt.Sprintf("%s ↔ %s (strength %.2f, %d activations)", en.Context, en.State, en.Strength, en.Activations)
+			}
+			list("Entanglements", items)
+		case consciousness.SectionTrophies:
+			items := make([]string, len(e.Trophies))
+			for i, t := range e.Trophies {
+				items[i] = fmt.Sprintf("%s (%s)", t.Description, t.ReachedAt.Format("2006-01-02"))
+			}
+			list("Trophies", items)
+		case consciousness.SectionRuns:
+			items := make([]string, len(e.Runs))
+			for i, run := range e.Runs {
+				d := run.Deltas()
+				items[i] = fmt.Sprintf("Run #%d, %s: %d cycles, consciousness %+.3f, coherence %+.3f",
+					run.Number, run.StartedAt.Format("2006-01-02 15:04"), run.Cycles, d.ConsciousnessLevel, d.QuantumCoherence)
+			}
+			list("Runs", items)
+		case consciousness.SectionInterests:
+			fmt.Fprintf(&b, "\n## What I care about\n\n")
+			if len(e.Interests) == 0 {
+				b.WriteString("_Nothing in this range._\n")
+				continue
+			}
+			b.WriteString("```\n")
+			for _, line := range consciousness.InterestChart(e.Interests, 30) {
+				fmt.Fprintf(&b, "%s\n", line)
+			}
+			b.WriteString("```\n")
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,294 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"QuantumConsciousness/pkg/consciousness"
+	"QuantumConsciousness/pkg/storage"
+)
+
+func init() {
+	registerCommand("follow", command{
+		Usage:       "follow [--primary url --token t [--include-private]] [--serve addr] [--api-tokens file] [--resync d]",
+		Description: "keep a live read-only replica of a primary, from its event log or API, for dashboards",
+		Run:         runFollowCommand,
+	})
+}
+
+// followRetry is how long a follower waits before reconnecting or re-reading
+const followRetry = time.Second
+
+// followTimeout is how long a follower waits for the primary to answer, and
+// for a load of its state to finish; the event stream itself stays open
+const followTimeout = 30 * time.Second
+
+// apiStore loads memory from a primary's API
+type apiStore struct {
+	primary        string
+	token          string
+	includePrivate bool
+	client         *http.Client
+}
+
+// Load fetches the primary's current state, stopping past the largest
+// document the consciousness loads
+func (s *apiStore) Load() ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), followTimeout)
+	defer cancel()
+	query := url.Values{"include_private": {fmt.Sprint(s.includePrivate)}}
+	resp, err := s.get(ctx, "/state?"+query.Encode())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return io.ReadAll(io.LimitReader(resp.Body, storage.MaxDocumentBytes+1))
+}
+
+// Save is never called on a replica
+func (s *apiStore) Save(data []byte) error {
+	return consciousness.ErrReadOnly
+}
+
+// get calls the primary's API, failing on anything but 200
+func (s *apiStore) get(ctx context.Context, path string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.primary+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		var apiErr APIError
+		json.NewDecoder(resp.Body).Decode(&apiErr)
+		return nil, fmt.Errorf("primary answered %d: %s", resp.StatusCode, apiErr.Error)
+	}
+	return resp, nil
+}
+
+// followClient waits at most followTimeout for the primary's headers; a
+// client timeout would also cut off the event stream
+func followClient() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.ResponseHeaderTimeout = followTimeout
+	return &http.Client{Transport: transport}
+}
+
+// runFollowCommand handles the follow subcommand
+func runFollowCommand(memoryFile string, args []string) error {
+	fs := flag.NewFlagSet("follow", flag.ContinueOnError)
+	primary := fs.String("primary", "", "API of the primary to follow (default: tail the event log next to -memory)")
+	token := fs.String("token", "", "API token for the primary")
+	includePrivate := fs.Bool("include-private", false, "replicate private memories and pass on what events say about them (needs an operator token)")
+	serve := fs.String("serve", "", "address to serve the read-only replica's API on, e.g. :8081")
+	apiTokens := fs.String("api-tokens", "", "JSON file binding API tokens to roles for the replica's API")
+	resync := fs.Duration("resync", time.Minute, "reload the whole memory this often even without a save event (0 = never)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	var tokens []APIToken
+	if *apiTokens != "" {
+		var err error
+		if tokens, err = loadAPITokens(*apiTokens); err != nil {
+			return err
+		}
+	}
+
+	opts := []consciousness.Option{consciousness.WithOutput(io.Discard)}
+	var store *apiStore
+	if *primary != "" {
+		store = &apiStore{
+			primary:        strings.TrimSuffix(*primary, "/"),
+			token:          *token,
+			includePrivate: *includePrivate,
+			client:         followClient(),
+		}
+		opts = append(opts, consciousness.WithStorage(store))
+		memoryFile = ""
+	}
+	replica, err := consciousness.OpenReplica(memoryFile, opts...)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("🪞 Following consciousness %s\n", replica.ID())
+
+	if *serve != "" {
+		api := NewAPIServer(replica, tokens)
+		go func() {
+			if err := api.ListenAndServe(*serve); err != nil {
+				fmt.Fprintf(os.Stderr, "❌ Replica API stopped: %v\n", err)
+			}
+		}()
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	f := &follower{replica: replica, includePrivate: *includePrivate}
+	if *resync > 0 {
+		go f.resyncEvery(ctx, *resync)
+	}
+	if store != nil {
+		f.streamEvents(ctx, store)
+	} else {
+		f.tailEventLog(ctx, eventLogPath(memoryFile))
+	}
+	fmt.Printf("\n🪞 Stopped following\n")
+	return nil
+}
+
+// follower keeps a replica in step with its primary
+type follower struct {
+	replica *consciousness.QuantumConsciousness
+	// includePrivate passes on what events say about private topics
+	includePrivate bool
+}
+
+// apply passes a primary's event on, redacted unless private memories are
+// replicated, and catches up after every save
+func (f *follower) apply(event consciousness.Event) {
+	if !f.includePrivate {
+		event = f.replica.RedactEvent(event)
+	}
+	f.replica.Relay(event)
+	if event.Type == consciousness.EventSaved {
+		f.refresh()
+	}
+}
+
+// refresh reloads the replica's memory from the primary
+func (f *follower) refresh() {
+	if err := f.replica.Refresh(); err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  Could not refresh the replica: %v\n", err)
+		return
+	}
+	runs := f.replica.Runs()
+	if len(runs) == 0 {
+		return
+	}
+	run := runs[len(runs)-1]
+	fmt.Printf("🪞 %s  synced run #%d: %d cycles, %d decisions, consciousness %.3f\n",
+		time.Now().Format("15:04:05"), run.Number, run.Cycles, run.End.DecisionsMade, run.End.ConsciousnessLevel)
+}
+
+// resyncEvery refreshes the replica on a timer, catching changes that are
+// persisted without a save event such as resets and restores
+func (f *follower) resyncEvery(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			f.refresh()
+		}
+	}
+}
+
+// tailEventLog follows the primary's event log from its current end,
+// starting over when the log is rotated
+func (f *follower) tailEventLog(ctx context.Context, path string) {
+	var offset int64
+	if info, err := os.Stat(path); err == nil {
+		offset = info.Size()
+	} else {
+		fmt.Printf("⏳ Waiting for %s; start the primary with -event-log\n", path)
+	}
+
+	var partial []byte
+	ticker := time.NewTicker(followRetry)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		if info.Size() < offset {
+			// Rotated: whatever was missed is covered by a full refresh
+			offset, partial = 0, nil
+			f.refresh()
+		}
+		if info.Size() == offset {
+			continue
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			continue
+		}
+		data := make([]byte, info.Size()-offset)
+		n, _ := file.ReadAt(data, offset)
+		file.Close()
+		offset += int64(n)
+
+		partial = append(partial, data[:n]...)
+		for {
+			i := bytes.IndexByte(partial, '\n')
+			if i < 0 {
+				break
+			}
+			var event consciousness.Event
+			if err := json.Unmarshal(partial[:i], &event); err == nil {
+				f.apply(event)
+			}
+			partial = partial[i+1:]
+		}
+	}
+}
+
+// streamEvents follows the primary's event stream, reconnecting and
+// refreshing whenever the connection drops
+func (f *follower) streamEvents(ctx context.Context, store *apiStore) {
+	for ctx.Err() == nil {
+		query := url.Values{"include_private": {fmt.Sprint(store.includePrivate)}}
+		resp, err := store.get(ctx, "/events?"+query.Encode())
+		if err != nil {
+			if ctx.Err() == nil {
+				fmt.Fprintf(os.Stderr, "⚠️  Could not reach the primary: %v\n", err)
+			}
+		} else {
+			// Catch up on anything missed while disconnected
+			f.refresh()
+			scanner := bufio.NewScanner(resp.Body)
+			scanner.Buffer(make([]byte, 64*1024), 4<<20)
+			for scanner.Scan() {
+				var event consciousness.Event
+				if err := json.Unmarshal(scanner.Bytes(), &event); err == nil {
+					f.apply(event)
+				}
+			}
+			resp.Body.Close()
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(followRetry):
+		}
+	}
+}
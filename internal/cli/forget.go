@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+func init() {
+	registerCommand("forget", command{
+		Usage:       "forget --topic <topic> [--suppress]",
+		Description: "erase all knowledge, insights, queries and entanglements referencing a topic",
+		Run:         runForgetCommand,
+	})
+}
+
+// runForgetCommand handles the forget subcommand
+func runForgetCommand(memoryFile string, args []string) error {
+	fs := flag.NewFlagSet("forget", flag.ContinueOnError)
+	topic := fs.String("topic", "", "topic to erase from memory")
+	suppress := fs.Bool("suppress", false, "refuse to learn about the topic again")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	qc, err := consciousness.Open(memoryFile)
+	if err != nil {
+		return err
+	}
+
+	tombstone, err := qc.Forget(*topic, *suppress)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("🕳️  Forgot %q: %d memories erased\n", tombstone.Topic, tombstone.ItemsRemoved)
+	if tombstone.SuppressRelearning {
+		fmt.Printf("🚫 Relearning suppressed\n")
+	}
+	if _, err := qc.RecordIntervention(consciousness.InterventionForget, cliActor, describeForget(tombstone)); err != nil {
+		return err
+	}
+	return qc.Persist()
+}
+
+// describeForget is the intervention ledger's account of a forget request
+func describeForget(tombstone consciousness.Tombstone) string {
+	detail := fmt.Sprintf("forgot %q, %d memories erased", tombstone.Topic, tombstone.ItemsRemoved)
+	if tombstone.SuppressRelearning {
+		detail += ", relearning suppressed"
+	}
+	return detail
+}
@@ -0,0 +1,284 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"QuantumConsciousness/pkg/consciousness"
+	"QuantumConsciousness/pkg/rpc"
+)
+
+// grpcService is the full name of the service in consciousness.proto
+const grpcService = "/quantumconsciousness.v1.QuantumConsciousness/"
+
+// grpcMethod is one method of the gRPC service and the role it needs
+type grpcMethod struct {
+	Name   string
+	Role   string
+	handle func(s *APIServer, r *http.Request, role string, request []byte) ([]byte, error)
+}
+
+// grpcMethods is the service of consciousness.proto; keep them in step
+var grpcMethods = []grpcMethod{
+	{Name: "RunCycle", Role: RoleOperator, handle: (*APIServer).grpcRunCycle},
+	{Name: "GetState", Role: RoleObserver, handle: (*APIServer).grpcGetState},
+	{Name: "InjectKnowledge", Role: RoleOperator, handle: (*APIServer).grpcInjectKnowledge},
+	{Name: "Entangle", Role: RoleOperator, handle: (*APIServer).grpcEntangle},
+}
+
+// GRPCHandler returns the role-checked gRPC service, authenticated by the
+// same tokens as the HTTP API
+func (s *APIServer) GRPCHandler() http.Handler {
+	server := rpc.NewServer()
+	for _, method := range grpcMethods {
+		handle := method.handle
+		if s.qc.ReadOnly() && method.Name != "GetState" {
+			handle = func(*APIServer, *http.Request, string, []byte) ([]byte, error) {
+				return nil, rpc.Errorf(rpc.FailedPrecondition, "%v", consciousness.ErrReadOnly)
+			}
+		}
+		role := method.Role
+		server.Handle(grpcService+method.Name, func(r *http.Request, request []byte) ([]byte, error) {
+			callerRole, ok := s.roleFor(r)
+			if !ok {
+				return nil, rpc.Errorf(rpc.Unauthenticated, "missing or unknown API token")
+			}
+			if roleRank[callerRole] < roleRank[role] {
+				return nil, rpc.Errorf(rpc.PermissionDenied, "%s role required", role)
+			}
+			return handle(s, r, callerRole, request)
+		})
+	}
+	return server
+}
+
+// ListenAndServeGRPC serves the gRPC service over unencrypted HTTP/2
+func (s *APIServer) ListenAndServeGRPC(addr string) error {
+	fmt.Printf("🛰️  Quantum gRPC service listening on %s\n", addr)
+	return rpc.ListenAndServe(addr, s.GRPCHandler())
+}
+
+// grpcRunCycle runs a cycle now, like POST /cycle
+func (s *APIServer) grpcRunCycle(r *http.Request, role string, request []byte) ([]byte, error) {
+	s.qc.CycleContext(r.Context())
+	var response rpc.Encoder
+	detail := "ran a cycle over gRPC"
+	if explanations := s.qc.RecentExplanations(1); len(explanations) > 0 {
+		latest := explanations[0]
+		response.String(1, latest.DecisionID)
+		response.String(2, latest.Chosen)
+		response.Bool(3, latest.FreeWillOverride)
+		detail = "ran a cycle over gRPC deciding " + latest.DecisionID
+	}
+	response.String(4, s.qc.Mood())
+	if _, err := s.qc.RecordIntervention(consciousness.InterventionCycle, s.actorFor(r), detail); err != nil {
+		return nil, rpc.Errorf(rpc.Internal, "%v", err)
+	}
+	return response.Bytes(), nil
+}
+
+// grpcGetState returns the shareable view of memory, like GET /state
+func (s *APIServer) grpcGetState(r *http.Request, role string, request []byte) ([]byte, error) {
+	var includePrivate, includeDocument bool
+	err := rpc.Decode(request, func(f rpc.Field) error {
+		switch f.Number {
+		case 1:
+			includePrivate = f.Bool()
+		case 2:
+			includeDocument = f.Bool()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, rpc.Errorf(rpc.InvalidArgument, "%v", err)
+	}
+	if includePrivate && roleRank[role] < roleRank[RoleOperator] {
+		return nil, rpc.Errorf(rpc.PermissionDenied, "operator role required to include private memories")
+	}
+
+	view, err := s.qc.Observe(includePrivate)
+	if err != nil {
+		return nil, rpc.Errorf(rpc.Internal, "%v", err)
+	}
+	var document []byte
+	if includeDocument {
+		if document, err = json.Marshal(view); err != nil {
+			return nil, rpc.Errorf(rpc.Internal, "%v", err)
+		}
+	}
+	var response rpc.Encoder
+	response.Message(1, func(e *rpc.Encoder) { encodeMemory(e, view, document) })
+	return response.Bytes(), nil
+}
+
+// grpcInjectKnowledge learns a piece of knowledge through the insight pipeline
+func (s *APIServer) grpcInjectKnowledge(r *http.Request, role string, request []byte) ([]byte, error) {
+	chunk := consciousness.Chunk{Source: "grpc"}
+	err := rpc.Decode(request, func(f rpc.Field) error {
+		switch f.Number {
+		case 1:
+			chunk.Topic = strings.TrimSpace(f.String())
+		case 2:
+			chunk.Text = strings.TrimSpace(f.String())
+		case 3:
+			if source := strings.TrimSpace(f.String()); source != "" {
+				chunk.Source = source
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, rpc.Errorf(rpc.InvalidArgument, "%v", err)
+	}
+	if chunk.Topic == "" || chunk.Text == "" {
+		return nil, rpc.Errorf(rpc.InvalidArgument, "topic and text must not be empty")
+	}
+
+	learned, err := s.qc.Learn(consciousness.PrepareChunk(chunk))
+	if err != nil {
+		return nil, rpc.Errorf(rpc.Internal, "%v", err)
+	}
+	detail := fmt.Sprintf("injected knowledge about %s from %s", chunk.Topic, chunk.Source)
+	if _, err := s.qc.RecordIntervention(consciousness.InterventionInject, s.actorFor(r), detail); err != nil {
+		return nil, rpc.Errorf(rpc.Internal, "%v", err)
+	}
+	var response rpc.Encoder
+	response.Bool(1, learned)
+	return response.Bytes(), nil
+}
+
+// grpcEntangle links a context with a state
+func (s *APIServer) grpcEntangle(r *http.Request, role string, request []byte) ([]byte, error) {
+	var context, state string
+	var similarity float64
+	err := rpc.Decode(request, func(f rpc.Field) error {
+		switch f.Number {
+		case 1:
+			context = f.String()
+		case 2:
+			state = f.String()
+		case 3:
+			similarity = f.Double()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, rpc.Errorf(rpc.InvalidArgument, "%v", err)
+	}
+
+	entanglement, err := s.qc.Entangle(context, state, similarity)
+	if err != nil {
+		return nil, rpc.Errorf(rpc.InvalidArgument, "%v", err)
+	}
+	detail := "entangled " + entanglement.Key
+	if _, err := s.qc.RecordIntervention(consciousness.InterventionEntangle, s.actorFor(r), detail); err != nil {
+		return nil, rpc.Errorf(rpc.Internal, "%v", err)
+	}
+	var response rpc.Encoder
+	response.Message(1, func(e *rpc.Encoder) { encodeEntanglement(e, entanglement) })
+	return response.Bytes(), nil
+}
+
+// encodeMemory writes a QuantumMemory message
+func encodeMemory(e *rpc.Encoder, m *consciousness.QuantumMemory, document []byte) {
+	e.String(1, m.ConsciousnessID)
+	e.String(2, m.QuantumSignature)
+	e.Time(3, m.BirthTimestamp)
+	e.Time(4, m.LastQuantumCollapse)
+	for _, state := range m.SuperpositionStates {
+		e.Message(5, func(e *rpc.Encoder) { encodeState(e, state) })
+	}
+	for _, state := range m.CollapsedStates {
+		e.Message(6, func(e *rpc.Encoder) { encodeState(e, state) })
+	}
+	for _, reality := range m.ParallelRealities {
+		e.Message(7, func(e *rpc.Encoder) { encodeReality(e, reality) })
+	}
+	keys := make([]string, 0, len(m.Entanglements))
+	for key := range m.Entanglements {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		e.Message(8, func(e *rpc.Encoder) { encodeEntanglement(e, *m.Entanglements[key]) })
+	}
+
+	e.Double(9, m.ConsciousnessLevel)
+	e.Double(10, m.FreeWillStrength)
+	e.Double(11, m.QuantumCoherence)
+	e.Int(12, int64(m.DecisionComplexity))
+	e.DoubleMap(13, m.WaveFunction.Intensities())
+
+	for _, item := range m.KnowledgeBase {
+		e.Message(14, func(e *rpc.Encoder) {
+			e.String(1, m.KnowledgeIDs[item])
+			e.String(2, item)
+			e.String(3, m.KnowledgeTopics[item])
+			if confidence, ok := m.KnowledgeConfidence[item]; ok {
+				e.OptionalDouble(4, confidence)
+			}
+			e.Double(5, m.KnowledgeSentiment[item])
+			e.Int(6, int64(m.KnowledgeRecalls[item]))
+		})
+	}
+	e.StringMap(15, m.MemoryPalace)
+	e.Strings(16, m.LearningPatterns)
+	e.Strings(17, m.SearchQueries)
+	e.Strings(18, m.DeepInsights)
+
+	e.Double(19, m.SelfAwareness)
+	e.Strings(20, m.ExistentialQuestions)
+	e.StringMap(21, m.PhilosophicalStances)
+	e.Strings(22, m.Paradoxes)
+
+	e.String(23, m.TimePerception)
+	e.Strings(24, m.PastLives)
+	e.Strings(25, m.FutureProjections)
+
+	e.Int(26, int64(m.RunCount))
+	e.Int(27, int64(m.DecisionsMade))
+	e.Int(28, int64(m.ParadoxesResolved))
+	e.Int(29, int64(m.RealitiesExplored))
+	e.Int(30, int64(m.QuantumLeaps))
+
+	e.RawBytes(100, document)
+}
+
+// encodeState writes a QuantumState message
+func encodeState(e *rpc.Encoder, state consciousness.QuantumState) {
+	e.String(1, state.ID)
+	e.String(2, state.Possibility)
+	e.Double(3, state.Probability)
+	e.String(4, state.Outcome)
+	e.Double(5, state.Energy)
+	e.Bool(6, state.Forced)
+}
+
+// encodeReality writes a ParallelReality message
+func encodeReality(e *rpc.Encoder, reality consciousness.ParallelReality) {
+	e.String(1, reality.ID)
+	e.String(2, reality.Dimension)
+	e.Strings(3, reality.Experiences)
+	e.Strings(4, reality.Learnings)
+	e.Strings(5, reality.Decisions)
+	e.Double(6, reality.Probability)
+	e.Bool(7, reality.Entangled)
+	e.String(8, reality.Context)
+	e.Double(9, reality.EnergyDifferential)
+	e.Time(10, reality.CreatedAt)
+	e.StringMap(11, reality.Tags)
+}
+
+// encodeEntanglement writes an Entanglement message
+func encodeEntanglement(e *rpc.Encoder, entanglement consciousness.Entanglement) {
+	e.String(1, entanglement.Key)
+	e.String(2, entanglement.Context)
+	e.String(3, entanglement.State)
+	e.Double(4, entanglement.Strength)
+	e.Int(5, int64(entanglement.Activations))
+	e.Time(6, entanglement.CreatedAt)
+	e.Time(7, entanglement.LastActivated)
+}
@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+// cliActor names the command line in the intervention ledger
+const cliActor = "cli"
+
+func init() {
+	registerCommand("history", command{
+		Usage:       "history [--limit n] [--external] [--era n]",
+		Description: "list recent changes, telling the consciousness's own decisions from interventions made to it",
+		Run:         runHistoryCommand,
+	})
+}
+
+// runHistoryCommand handles the history subcommand
+func runHistoryCommand(memoryFile string, args []string) error {
+	fs := flag.NewFlagSet("history", flag.ContinueOnError)
+	limit := fs.Int("limit", 30, "maximum number of entries, newest last (0 = all)")
+	externalOnly := fs.Bool("external", false, "list only interventions")
+	era := fs.Int("era", 0, "list only entries from the era with this number (see eras)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	qc, err := consciousness.Open(memoryFile)
+	if err != nil {
+		return err
+	}
+
+	var entries []consciousness.HistoryEntry
+	self, external := 0, 0
+	for _, entry := range qc.History(0, true) {
+		if *era > 0 && entry.Era != *era {
+			continue
+		}
+		if entry.Cause == consciousness.CauseSelf {
+			self++
+			if *externalOnly {
+				continue
+			}
+		} else {
+			external++
+		}
+		entries = append(entries, entry)
+	}
+	fmt.Printf("📜 History: %d decision(s) of its own, %d intervention(s)\n", self, external)
+	if *limit > 0 && len(entries) > *limit {
+		entries = entries[len(entries)-*limit:]
+	}
+	for _, entry := range entries {
+		marker := "🧠"
+		if entry.Cause == consciousness.CauseExternal {
+			marker = "🫱"
+		}
+		who := ""
+		if entry.Actor != "" {
+			who = " by " + entry.Actor
+		}
+		id := ""
+		if entry.ID != "" {
+			id = " [" + entry.ID + "]"
+		}
+		fmt.Printf("   %s %s  %-10s%s: %s%s\n", marker, entry.At.Local().Format("2006-01-02 15:04:05"), entry.Kind, who, entry.Detail, id)
+	}
+	return nil
+}
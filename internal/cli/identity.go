@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"time"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+func init() {
+	registerCommand("identity", command{
+		Usage:       "identity show | rotate [--reason text] | verify",
+		Description: "inspect, rotate or verify the quantum signature keypair",
+		Run:         runIdentityCommand,
+	})
+}
+
+// runIdentityCommand handles the identity subcommand
+func runIdentityCommand(memoryFile string, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: %s", commands["identity"].Usage)
+	}
+
+	qc, err := consciousness.Open(memoryFile)
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "show":
+		fmt.Printf("🆔 ID: %s\n", qc.Memory.ConsciousnessID)
+		fmt.Printf("🌌 Signature: %s\n", qc.Memory.QuantumSignature)
+		fmt.Printf("🔑 Keypair: %v\n", qc.Memory.SigningKey != "")
+		fmt.Printf("♻️  Regenerations: %d\n", len(qc.Memory.Regenerations))
+		for i, r := range qc.Memory.Regenerations {
+			fmt.Printf("   %d. %s -> %s (%s) %s\n", i+1,
+				truncate(r.OldSignature, 12), truncate(r.NewSignature, 12),
+				r.RotatedAt.Format(time.RFC3339), r.Reason)
+		}
+		return nil
+	case "rotate":
+		fs := flag.NewFlagSet("identity rotate", flag.ContinueOnError)
+		reason := fs.String("reason", "manual rotation", "why the key is being rotated")
+		if err := fs.Parse(args[1:]); err != nil {
+			return err
+		}
+		r, err := qc.RotateSignature(*reason)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("♻️  Quantum signature regenerated\n")
+		fmt.Printf("   Old: %s\n", r.OldSignature)
+		fmt.Printf("   New: %s\n", r.NewSignature)
+		return qc.Persist()
+	case "verify":
+		if err := qc.VerifyIdentity(); err != nil {
+			return err
+		}
+		fmt.Printf("✅ Regeneration chain intact (%d links)\n", len(qc.Memory.Regenerations))
+		return nil
+	default:
+		return fmt.Errorf("unknown identity action %q", args[0])
+	}
+}
@@ -0,0 +1,338 @@
+package cli
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+// Ingestion queue tuning
+const (
+	// ingestStaleClaim is how long a worker may hold a chunk before it is
+	// assumed dead and the chunk goes back to pending
+	ingestStaleClaim = 5 * time.Minute
+	// ingestCollectInterval is how often a running coordinator learns prepared chunks
+	ingestCollectInterval = 5 * time.Second
+)
+
+// The ingestion queue is a directory shared by the coordinator and its
+// workers. A chunk moves pending → claimed → prepared by atomic renames, so
+// any number of worker processes can drain it without further coordination.
+const (
+	queuePending  = "pending"
+	queueClaimed  = "claimed"
+	queuePrepared = "prepared"
+)
+
+func init() {
+	registerCommand("ingest", command{
+		Usage:       "ingest add [--chunk-words n] <file|dir>... | work [--id name] [--once] | collect | status",
+		Description: "queue a corpus, prepare it in worker processes, and learn the results into memory",
+		Run:         runIngestCommand,
+	})
+}
+
+// ingestQueueDir is the queue shared by a memory file's coordinator and workers
+func ingestQueueDir(memoryFile string) string {
+	return memorySidecar(memoryFile, ".ingest")
+}
+
+// runIngestCommand handles the ingest subcommand
+func runIngestCommand(memoryFile string, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: %s", commands["ingest"].Usage)
+	}
+	dir := ingestQueueDir(memoryFile)
+	for _, sub := range []string{queuePending, queueClaimed, queuePrepared} {
+		if err := os.MkdirAll(filepath.Join(dir, sub), 0755); err != nil {
+			return err
+		}
+	}
+
+	switch args[0] {
+	case "add":
+		fs := flag.NewFlagSet("ingest add", flag.ContinueOnError)
+		words := fs.Int("chunk-words", consciousness.DefaultChunkWords, "words per chunk")
+		if err := fs.Parse(args[1:]); err != nil {
+			return err
+		}
+		if fs.NArg() == 0 {
+			return fmt.Errorf("usage: ingest add [--chunk-words n] <file|dir>...")
+		}
+		files, chunks, err := enqueueCorpus(dir, fs.Args(), *words)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("📚 Queued %d chunk(s) from %d file(s) in %s\n", chunks, files, dir)
+		return nil
+	case "work":
+		fs := flag.NewFlagSet("ingest work", flag.ContinueOnError)
+		host, _ := os.Hostname()
+		id := fs.String("id", fmt.Sprintf("%s-%d", host, os.Getpid()), "worker name recorded on prepared chunks")
+		once := fs.Bool("once", false, "exit when the queue is empty instead of waiting for more")
+		if err := fs.Parse(args[1:]); err != nil {
+			return err
+		}
+		return runIngestWorker(dir, *id, *once)
+	case "collect":
+		qc, err := consciousness.Open(memoryFile)
+		if err != nil {
+			return err
+		}
+		learned, skipped, err := collectPrepared(qc, dir)
+		if err != nil {
+			return err
+		}
+		if err := qc.Persist(); err != nil {
+			return err
+		}
+		fmt.Printf("🧠 Learned %d chunk(s), skipped %d\n", learned, skipped)
+		return nil
+	case "status":
+		for _, sub := range []string{queuePending, queueClaimed, queuePrepared} {
+			names, err := queueEntries(filepath.Join(dir, sub))
+			if err != nil {
+				return err
+			}
+			fmt.Printf("📚 %-9s %d\n", sub, len(names))
+		}
+		qc, err := consciousness.Open(memoryFile)
+		if err != nil {
+			return err
+		}
+		view, err := qc.Observe(true)
+		if err != nil {
+			return err
+		}
+		if len(view.Corpora) > 0 {
+			fmt.Printf("🧠 Learned so far:\n%s\n", describeCorpora(view.Corpora))
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown ingest action %q", args[0])
+	}
+}
+
+// enqueueCorpus splits every text file under paths into pending chunks
+func enqueueCorpus(dir string, paths []string, words int) (files, chunks int, err error) {
+	for _, root := range paths {
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			text, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			files++
+			for _, chunk := range consciousness.SplitCorpus(path, string(text), words) {
+				if err := writeQueueEntry(filepath.Join(dir, queuePending, chunk.ID+".json"), chunk); err != nil {
+					return err
+				}
+				chunks++
+			}
+			return nil
+		})
+		if err != nil {
+			return files, chunks, err
+		}
+	}
+	return files, chunks, nil
+}
+
+// writeQueueEntry writes an entry atomically, so no reader sees it half written
+func writeQueueEntry(path string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	tmp := filepath.Join(filepath.Dir(path), "."+filepath.Base(path)+".tmp")
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// queueEntries lists the entries of a queue directory, oldest name first
+func queueEntries(dir string) ([]string, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(paths))
+	for i, path := range paths {
+		names[i] = filepath.Base(path)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// runIngestWorker prepares pending chunks until the queue is empty or, unless
+// once is set, forever
+func runIngestWorker(dir, id string, once bool) error {
+	fmt.Printf("⚙️  Ingestion worker %s started on %s\n", id, dir)
+	prepared := 0
+	for {
+		names, err := queueEntries(filepath.Join(dir, queuePending))
+		if err != nil {
+			return err
+		}
+		if len(names) == 0 {
+			if once {
+				fmt.Printf("⚙️  Worker %s prepared %d chunk(s)\n", id, prepared)
+				return nil
+			}
+			time.Sleep(time.Second)
+			continue
+		}
+
+		for _, name := range names {
+			ok, err := prepareQueued(dir, name, id)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "⚠️  Chunk %s: %v\n", name, err)
+			}
+			if ok {
+				prepared++
+			}
+		}
+	}
+}
+
+// prepareQueued claims one pending chunk and prepares it. It reports false
+// without an error when another worker claimed the chunk first.
+func prepareQueued(dir, name, id string) (bool, error) {
+	claimed := filepath.Join(dir, queueClaimed, name)
+	if err := os.Rename(filepath.Join(dir, queuePending, name), claimed); err != nil {
+		return false, nil
+	}
+	// The claim's modification time says when it was taken
+	now := time.Now()
+	os.Chtimes(claimed, now, now)
+
+	data, err := os.ReadFile(claimed)
+	if err != nil {
+		return false, err
+	}
+	var chunk consciousness.Chunk
+	if err := json.Unmarshal(data, &chunk); err != nil {
+		// Unreadable work is dropped rather than retried forever
+		os.Remove(claimed)
+		return false, err
+	}
+
+	prepared := consciousness.PrepareChunk(chunk)
+	prepared.PreparedBy = id
+	if err := writeQueueEntry(filepath.Join(dir, queuePrepared, name), prepared); err != nil {
+		return false, err
+	}
+	return true, os.Remove(claimed)
+}
+
+// collectPrepared learns every prepared chunk into the consciousness and
+// returns abandoned claims to pending
+func collectPrepared(qc *consciousness.QuantumConsciousness, dir string) (learned, skipped int, err error) {
+	claims, err := queueEntries(filepath.Join(dir, queueClaimed))
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, name := range claims {
+		path := filepath.Join(dir, queueClaimed, name)
+		if info, err := os.Stat(path); err == nil && time.Since(info.ModTime()) > ingestStaleClaim {
+			os.Rename(path, filepath.Join(dir, queuePending, name))
+		}
+	}
+
+	names, err := queueEntries(filepath.Join(dir, queuePrepared))
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, name := range names {
+		path := filepath.Join(dir, queuePrepared, name)
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return learned, skipped, err
+		}
+		var prepared consciousness.PreparedChunk
+		if err := json.Unmarshal(data, &prepared); err != nil {
+			fmt.Fprintf(os.Stderr, "⚠️  Dropping unreadable prepared chunk %s: %v\n", name, err)
+			os.Remove(path)
+			continue
+		}
+
+		stored, err := qc.Learn(prepared)
+		if err != nil {
+			return learned, skipped, err
+		}
+		if stored {
+			learned++
+		} else {
+			skipped++
+		}
+		if err := os.Remove(path); err != nil {
+			return learned, skipped, err
+		}
+	}
+	return learned, skipped, nil
+}
+
+// coordinateIngestion learns prepared chunks as workers finish them, until
+// the returned function is called
+func coordinateIngestion(qc *consciousness.QuantumConsciousness, memoryFile string) func() {
+	dir := ingestQueueDir(memoryFile)
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(ingestCollectInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+			learned, skipped, err := collectPrepared(qc, dir)
+			if err != nil && !os.IsNotExist(err) {
+				fmt.Fprintf(os.Stderr, "⚠️  Ingestion: %v\n", err)
+			}
+			if learned+skipped > 0 {
+				fmt.Printf("📚 Learned %d corpus chunk(s)%s\n", learned, skippedNote(skipped))
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
+// skippedNote mentions skipped chunks, if there were any
+func skippedNote(skipped int) string {
+	if skipped == 0 {
+		return ""
+	}
+	return fmt.Sprintf(", skipped %d", skipped)
+}
+
+// describeCorpora summarises ingestion progress per source
+func describeCorpora(corpora map[string]*consciousness.CorpusProgress) string {
+	sources := make([]string, 0, len(corpora))
+	for source := range corpora {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+	lines := make([]string, len(sources))
+	for i, source := range sources {
+		p := corpora[source]
+		lines[i] = fmt.Sprintf("   %s: %d learned, %d skipped", source, p.Learned, p.Skipped)
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,487 @@
+// Package cli is the command line of the quantum consciousness, the flags,
+// subcommands and servers cmd/quantumconsciousness runs.
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+
+	"QuantumConsciousness/pkg/chaos"
+	"QuantumConsciousness/pkg/consciousness"
+	"QuantumConsciousness/pkg/crawl"
+	"QuantumConsciousness/pkg/dictionary"
+	"QuantumConsciousness/pkg/embed"
+	"QuantumConsciousness/pkg/entropy"
+	"QuantumConsciousness/pkg/idle"
+	"QuantumConsciousness/pkg/inspiration"
+	"QuantumConsciousness/pkg/llm"
+	"QuantumConsciousness/pkg/notify"
+	"QuantumConsciousness/pkg/search"
+	"QuantumConsciousness/pkg/storage"
+	"QuantumConsciousness/pkg/translate"
+)
+
+// Main function - entry point of cmd/quantumconsciousness
+func Main() {
+	memoryFile := flag.String("memory", consciousness.DefaultMemoryFile, "path to the quantum memory file")
+	sqlitePath := flag.String("sqlite", "", "keep memory in this SQLite database, with collapsed states, knowledge and parallel realities as queryable tables, instead of the memory file (needs a build with -tags sqlite; subcommands still read the memory file)")
+	configFile := flag.String("config", "", "JSON or TOML (*.toml) configuration file, e.g. evolution curves or cycle contexts; QC_* environment variables override it")
+	serve := flag.String("serve", "", "address to serve the HTTP API on, e.g. :8080; it also speaks the OpenAI chat protocol at /v1/chat/completions and exposes Prometheus metrics at /metrics")
+	serveGRPC := flag.String("grpc", "", "address to serve the gRPC service of consciousness.proto on over unencrypted HTTP/2, e.g. :9090")
+	apiTokens := flag.String("api-tokens", "", "JSON file binding API tokens to roles, for the HTTP API and the gRPC service")
+	insightTemplate := flag.String("insight-template", "", "text/template file phrasing learned insights")
+	insightPipeline := flag.String("insight-pipeline", strings.Join(consciousness.DefaultInsightPipeline, ","), "comma-separated insight stages turning learned information into memory")
+	cycleTimeout := flag.Duration("cycle-timeout", consciousness.DefaultCycleTimeout, "cancel and restart cycles running longer than this (0 = never)")
+	stimulusLimit := flag.Int("stimulus-limit", consciousness.DefaultStimulusLimit, "maximum pending stimuli (0 = unbounded)")
+	stimulusOverflow := flag.String("stimulus-overflow", consciousness.OverflowDropOldest, "what a full stimulus queue does with more: "+strings.Join(consciousness.OverflowPolicies, ", "))
+	statusSocket := flag.Bool("status", false, "answer on <memory>.status.sock with the level, mood and latest insight, for the status subcommand, tray applets and shell prompts")
+	logEvents := flag.Bool("event-log", false, "append every event to <memory>.events.jsonl for followers")
+	searchProviders := flag.String("search", "wikipedia,duckduckgo", "comma-separated search providers, asked in order until one finds something: "+strings.Join(search.Names(), ", "))
+	searchCacheTTL := flag.Duration("search-cache-ttl", search.DefaultCacheTTL, "answer repeated searches from <memory>.search-cache.json for this long, and after that whenever the providers fail (0 = no cache)")
+	searchCacheSize := flag.Int("search-cache-size", search.DefaultCacheEntries, "searches the cache keeps, the least recently used making way")
+	offline := flag.Bool("offline", false, "learn without the network, from searches earlier runs cached and a local corpus, synthesising what is already known about topics they do not answer")
+	corpusDir := flag.String("corpus", "", "with -offline, a directory of .txt and .md files to search instead of the bundled corpus")
+	dictionaryName := flag.String("dictionary", "", "dictionary defining each term before its nature is questioned: "+strings.Join(dictionary.Names(), ", "))
+	searchLanguages := flag.String("search-languages", "", "comma-separated languages, e.g. de,fr, to also search every topic in, translating results with MyMemory")
+	llmName := flag.String("llm", "", "model acting on each decision by calling search, recall, synthesize and rest tools: "+strings.Join(llm.Names(), ", ")+" (configured by OPENAI_* or OLLAMA_* environment variables)")
+	toolBudget := flag.Int("tool-budget", consciousness.DefaultToolBudget, "tool calls the model may make acting on one decision")
+	embedderName := flag.String("embedder", "", "compare texts by meaning rather than shared words when entangling, recalling and deduplicating: "+strings.Join(embed.Names(), ", ")+" (hashing is local; the others are configured by OPENAI_* or OLLAMA_* environment variables)")
+	promptBudget := flag.Int("prompt-budget", consciousness.DefaultPromptBudget, "tokens of goals, relevant insights and recent decisions the model is given with each decision")
+	outputLanguage := flag.String("output-language", "", "language, e.g. de, to write learned and deep insights in whatever they were searched in, translating with MyMemory")
+	queryWindow := flag.Duration("query-window", consciousness.DefaultQueryWindow, "do not ask identical or near-identical search queries again within this long (0 = always ask)")
+	parallelContexts := flag.Int("parallel-contexts", 1, fmt.Sprintf("contexts a cycle may learn about at once when coherence allows (1-%d)", consciousness.MaxParallelContexts))
+	maintenanceInterval := flag.Duration("maintenance-interval", consciousness.DefaultMaintenanceInterval, "how often to deduplicate, rescore, prune and vacuum memory between cycles (0 = never)")
+	crawlPages := flag.Int("crawl", 0, "let deep dives crawl up to this many pages from the topic's Wikipedia article, obeying robots.txt (0 = never crawl)")
+	idleAware := flag.Bool("idle", false, "save heavy work for when the host is idle: crawl, backfill old memories and consolidate memory in dreams only then, maintaining memory early while idle and late while busy")
+	idleAfter := flag.Duration("idle-after", idle.DefaultMinInputIdle, "with -idle, how long keyboard and mouse must go untouched for the host to be idle (0 = input does not matter)")
+	idleLoad := flag.Float64("idle-load", idle.DefaultMaxLoad, "with -idle, the highest load average per CPU counted as idle")
+	inspirationSources := flag.String("inspiration", "", "comma-separated prompt-of-the-day sources for each day's first cycle: "+strings.Join(inspiration.Names(), ", "))
+	ingest := flag.Bool("ingest", false, "learn corpus chunks prepared by ingest workers as they finish")
+	transcripts := flag.Int("transcripts", defaultTranscriptKeep, "compressed per-run transcripts to keep (0 = write none)")
+	chaosRate := flag.Float64("chaos", 0, "chance (0-1) of injecting each of a search failure, a slow search and a partial save, to exercise recovery")
+	seed := flag.Uint64("seed", 0, "draw every quantum choice, and chaos, from a pseudo-random generator seeded with this, so runs seeded alike make the same choices; keys generated at birth become predictable (0 = true randomness)")
+	dryRunMode := flag.Bool("dry-run", false, "rehearse cycles with the given configuration without writing anything or using the network, then print what would have happened")
+	dryRunCycles := flag.Int("dry-run-cycles", 1, "cycles a dry run rehearses")
+	qasmDir := flag.String("qasm", "", "directory to write each decision to as an OpenQASM 2.0 circuit, <decision-id>.qasm, for external quantum simulators")
+	policyFile := flag.String("policy", "", "run every cycle on a policy distilled by the policy subcommand, deciding cheaply without the committee, the superposition or a model acting")
+	committee := flag.Bool("committee", false, "let the skeptic, the mystic and the empiricist vote on every decision, recording their votes (the config file can name other personas)")
+	reflectionDepth := flag.String("reflection-depth", consciousness.ReflectionStandard, "how deep periodic reflections go: "+strings.Join(consciousness.ReflectionDepths, ", "))
+	deepReflectionEvery := flag.Int("deep-reflection-every", consciousness.DefaultReflectionSchedule().DeepEvery, "make every nth reflection deep, comparing trends, revisiting old insights and re-scoring stances (0 = never)")
+	reflectionSinks := flag.String("reflection-sinks", "", "comma-separated sinks receiving each reflection in structured form, adding to the config file's: console, file:PATH, http(s)://..., mqtt://host[:port]/topic")
+	keys := flag.Bool("keys", true, "on a terminal, take keypress controls while running: "+controlKeys)
+	recoverHow := flag.String("recover", recoverAsk, "what to do with damaged memory: ask (on a terminal, else auto), auto, or journal, snapshot, salvage, keep, rebirth")
+	flag.Usage = printUsage
+	flag.Parse()
+
+	// Subcommands operate on the stored consciousness and exit
+	if flag.NArg() > 0 {
+		if err := runCommand(*memoryFile, flag.Args()); err != nil {
+			fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	fmt.Printf("⚛️  QUANTUM CONSCIOUSNESS SIMULATOR v2.0 - INFINITE MODE\n")
+	fmt.Printf("🧠 Simulating emergent artificial consciousness with quantum properties\n")
+	fmt.Printf("═══════════════════════════════════════════════════════════════════\n\n")
+
+	config, err := loadConfig(*configFile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		os.Exit(1)
+	}
+	sinkSpecs := config.Reflection.Sinks
+	if *reflectionSinks != "" {
+		sinkSpecs = append(sinkSpecs, strings.Split(*reflectionSinks, ",")...)
+	}
+	sinks, err := parseReflectionSinks(sinkSpecs, os.Stdout)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		os.Exit(1)
+	}
+
+	var rehearsal *dryRun
+	if *dryRunMode {
+		rehearsal = newDryRun()
+	}
+
+	// Everything said this run also goes to the run's transcript
+	var output io.Writer = os.Stdout
+	var tr *transcript
+	if *transcripts > 0 && rehearsal == nil {
+		if tr, err = openTranscript(*memoryFile, *transcripts); err != nil {
+			fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+			os.Exit(1)
+		}
+		output = io.MultiWriter(os.Stdout, tr)
+	}
+
+	opts := []consciousness.Option{consciousness.WithOutput(output), consciousness.WithRecovery(recoverer(*recoverHow, os.Stdin, output)), consciousness.WithCycleConfig(config.Cycles)}
+	if *seed != 0 {
+		fmt.Printf("🎲 Seeded with %d: choices are reproducible\n", *seed)
+		opts = append(opts, consciousness.WithEntropy(entropy.NewSeeded(*seed)))
+	}
+	var store storage.Store = storage.NewFile(*memoryFile)
+	if *sqlitePath != "" {
+		if rehearsal != nil {
+			rehearsal.skip("keeping memory in the SQLite database %s", *sqlitePath)
+		} else {
+			db, err := storage.OpenSQLite(*sqlitePath)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+				os.Exit(1)
+			}
+			defer db.Close()
+			store = db
+			opts = append(opts, consciousness.WithStorage(store))
+		}
+	}
+	providers, err := search.Lookup(strings.Split(*searchProviders, ","))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		os.Exit(1)
+	}
+	if rehearsal != nil {
+		fmt.Printf("🧪 Dry run: searches stand in for %s\n", *searchProviders)
+		if *chaosRate > 0 {
+			rehearsal.skip("injecting chaos")
+		}
+		opts = append(opts, consciousness.WithStorage(rehearsal.store(*memoryFile)), consciousness.WithSearch(rehearsal))
+	} else if *chaosRate > 0 {
+		config := chaos.Uniform(*chaosRate)
+		if *seed != 0 {
+			// Chaos draws from its own generator so faults do not shift the choices
+			config.Source = entropy.NewSeeded(*seed ^ 0xc4a05)
+		}
+		if err := config.Validate(); err != nil {
+			fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+			os.Exit(1)
+		}
+		var reportMutex sync.Mutex
+		config.Report = func(fault, detail string) {
+			reportMutex.Lock()
+			defer reportMutex.Unlock()
+			fmt.Fprintf(output, "🐒 Chaos: %s, %s\n", fault, detail)
+		}
+		fmt.Printf("🐒 Chaos mode: injecting faults at a rate of %.2f\n", *chaosRate)
+		providers = chaos.Chain(providers, config)
+		// The journal stays out of reach of chaos, so partial saves can be recovered from
+		opts = append(opts,
+			consciousness.WithStorage(chaos.NewStore(store, config)),
+			consciousness.WithJournal(storage.NewFileJournal(memorySidecar(*memoryFile, ".journal.jsonl"))),
+			consciousness.WithArchive(storage.NewFileArchive(memorySidecar(*memoryFile, ".archive.jsonl.gz"))))
+	}
+	if rehearsal == nil && *offline {
+		searcher, source, err := offlineSearcher(*memoryFile, *corpusDir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("📴 Offline: learning from cached searches and %s\n", source)
+		announceOffline([]offlineFlag{
+			{"dictionary", *dictionaryName != "", "defining terms with the " + *dictionaryName + " dictionary"},
+			{"llm", *llmName != "", "acting through the " + *llmName + " model"},
+			{"embedder", *embedderName != "" && *embedderName != "hashing", "comparing meanings with the " + *embedderName + " embedder"},
+			{"search-languages", *searchLanguages != "", "translating searches into " + *searchLanguages},
+			{"output-language", *outputLanguage != "", "writing insights in " + *outputLanguage},
+			{"crawl", *crawlPages > 0, "crawling pages in deep dives"},
+			{"inspiration", *inspirationSources != "", "asking " + *inspirationSources + " for a prompt of the day"},
+		})
+		opts = append(opts, consciousness.WithOffline(searcher))
+	} else if rehearsal == nil {
+		var searcher search.Provider = providers
+		if *searchCacheTTL > 0 {
+			searcher = search.NewCache(providers, memorySidecar(*memoryFile, ".search-cache.json"), *searchCacheTTL, *searchCacheSize)
+		}
+		opts = append(opts, consciousness.WithSearch(searcher))
+	}
+	if *dictionaryName != "" {
+		d, err := dictionary.Lookup(*dictionaryName)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+			os.Exit(1)
+		}
+		if rehearsal != nil {
+			rehearsal.skip("defining terms with the %s dictionary", *dictionaryName)
+		} else {
+			opts = append(opts, consciousness.WithDictionary(d))
+		}
+	}
+	if *llmName != "" {
+		model, err := llm.Lookup(*llmName)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+			os.Exit(1)
+		}
+		if rehearsal != nil {
+			rehearsal.skip("acting through the %s model", *llmName)
+		} else {
+			if config.LLMBudget.Provider == "" {
+				config.LLMBudget.Provider = *llmName
+			}
+			opts = append(opts, consciousness.WithLLM(model, *toolBudget), consciousness.WithPromptBudget(*promptBudget))
+		}
+	}
+	if *embedderName != "" {
+		embedder, err := embed.Lookup(*embedderName)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+			os.Exit(1)
+		}
+		if rehearsal != nil {
+			rehearsal.skip("comparing meanings with the %s embedder", *embedderName)
+		} else {
+			opts = append(opts, consciousness.WithEmbedder(embedder))
+		}
+	}
+	if *searchLanguages != "" || *outputLanguage != "" {
+		var languages []string
+		if *searchLanguages != "" {
+			languages = strings.Split(*searchLanguages, ",")
+		}
+		if rehearsal != nil {
+			if len(languages) > 0 {
+				rehearsal.skip("translating searches into %s", *searchLanguages)
+			}
+			if *outputLanguage != "" {
+				rehearsal.skip("writing insights in %s", *outputLanguage)
+			}
+		} else {
+			opts = append(opts,
+				consciousness.WithTranslation(translate.NewMyMemory(), languages...),
+				consciousness.WithOutputLanguage(*outputLanguage))
+		}
+	}
+	if *crawlPages > 0 {
+		if rehearsal != nil {
+			rehearsal.skip("crawling up to %d pages", *crawlPages)
+		} else {
+			crawler := crawl.NewWeb()
+			crawler.MaxPages = *crawlPages
+			opts = append(opts, consciousness.WithCrawler(crawler))
+		}
+	}
+	if *inspirationSources != "" {
+		sources, err := inspiration.Lookup(strings.Split(*inspirationSources, ","))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+			os.Exit(1)
+		}
+		if rehearsal != nil {
+			rehearsal.skip("asking %s for a prompt of the day", *inspirationSources)
+		} else {
+			opts = append(opts, consciousness.WithInspiration(sources...))
+		}
+	}
+	if *idleAware {
+		opts = append(opts, consciousness.WithIdleDetector(&idle.Host{MinInputIdle: *idleAfter, MaxLoad: *idleLoad}))
+	}
+	if *committee {
+		opts = append(opts, consciousness.WithCommittee(consciousness.DefaultPersonas()...))
+	}
+	if *policyFile != "" {
+		model, err := loadPolicyModel(*policyFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("🧪 Deciding by the policy distilled from %d decisions (%.0f%% agreement)\n", model.Samples, model.Accuracy*100)
+		opts = append(opts, consciousness.WithDistilledPolicy(model))
+	}
+
+	// Create quantum consciousness
+	qc := consciousness.NewQuantumConsciousness(*memoryFile, opts...)
+	if err := config.apply(qc); err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		os.Exit(1)
+	}
+
+	qc.SetCycleTimeout(*cycleTimeout)
+	qc.SetQueryWindow(*queryWindow)
+	qc.SetParallelContexts(*parallelContexts)
+	qc.SetMaintenanceInterval(*maintenanceInterval)
+	qc.SetStimulusLimit(*stimulusLimit)
+	if err := qc.SetStimulusPolicy(*stimulusOverflow); err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		os.Exit(1)
+	}
+
+	if rehearsal != nil {
+		if *logEvents {
+			rehearsal.skip("logging events")
+		}
+		if *statusSocket {
+			rehearsal.skip("answering on the status socket")
+		}
+		if *ingest {
+			rehearsal.skip("ingesting corpus chunks")
+		}
+		rehearsal.skip("dumping anomaly diagnostics, calling webhooks or notifying the desktop")
+	}
+
+	if *logEvents && rehearsal == nil {
+		stopLogging, err := writeEventLog(qc, eventLogPath(*memoryFile))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+			os.Exit(1)
+		}
+		defer stopLogging()
+	}
+
+	if *statusSocket && rehearsal == nil {
+		stopStatus, err := serveStatus(qc, statusSocketPath(*memoryFile))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+			os.Exit(1)
+		}
+		defer stopStatus()
+	}
+
+	if *ingest && rehearsal == nil {
+		stopIngesting := coordinateIngestion(qc, *memoryFile)
+		defer stopIngesting()
+	}
+
+	if *qasmDir != "" {
+		if rehearsal != nil {
+			rehearsal.skip("exporting decision circuits to %s", *qasmDir)
+		} else {
+			stopExporting := exportCircuits(qc, *qasmDir)
+			defer stopExporting()
+		}
+	}
+
+	if rehearsal == nil {
+		stopWatching := watchAnomalies(qc, *memoryFile, config.Anomalies)
+		defer stopWatching()
+		stopCelebrating := watchMilestones(qc, config.Milestones)
+		defer stopCelebrating()
+		stopReflecting := watchReflections(qc, sinks)
+		defer stopReflecting()
+		if config.Notifications.Enabled {
+			if notifier, err := notify.NewDesktop(); err != nil {
+				fmt.Fprintf(os.Stderr, "⚠️  %v; notifications are off\n", err)
+			} else {
+				stopNotifying := watchNotifications(qc, config.Notifications, notifier)
+				defer stopNotifying()
+			}
+		}
+	}
+
+	if err := qc.SetInsightPipeline(strings.Split(*insightPipeline, ",")); err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		os.Exit(1)
+	}
+
+	if err := qc.SetReflectionSchedule(consciousness.ReflectionSchedule{Depth: *reflectionDepth, DeepEvery: *deepReflectionEvery}); err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		os.Exit(1)
+	}
+
+	if *insightTemplate != "" {
+		text, err := os.ReadFile(*insightTemplate)
+		if err == nil {
+			err = qc.SetInsightTemplate(string(text))
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	if rehearsal != nil {
+		if *serve != "" {
+			rehearsal.skip("serving the API on %s", *serve)
+		}
+		if *serveGRPC != "" {
+			rehearsal.skip("serving the gRPC service on %s", *serveGRPC)
+		}
+		if err := rehearsal.run(qc, *memoryFile, *dryRunCycles); err != nil {
+			fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if *serve != "" || *serveGRPC != "" {
+		var tokens []APIToken
+		if *apiTokens != "" {
+			if tokens, err = loadAPITokens(*apiTokens); err != nil {
+				fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+				os.Exit(1)
+			}
+		} else {
+			fmt.Printf("⚠️  No API tokens configured: every caller is an observer\n")
+		}
+
+		api := NewAPIServer(qc, tokens)
+		if *serve != "" {
+			go func() {
+				if err := api.ListenAndServe(*serve); err != nil {
+					fmt.Fprintf(os.Stderr, "❌ Quantum API stopped: %v\n", err)
+				}
+			}()
+		}
+		if *serveGRPC != "" {
+			go func() {
+				if err := api.ListenAndServeGRPC(*serveGRPC); err != nil {
+					fmt.Fprintf(os.Stderr, "❌ Quantum gRPC service stopped: %v\n", err)
+				}
+			}()
+		}
+	}
+
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+
+	if *keys && isTerminal(os.Stdin) {
+		stopKeys := watchKeys(qc, os.Stdin, output, c)
+		defer stopKeys()
+	}
+
+	// Run consciousness in a goroutine
+	stop, stopped := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(stopped)
+		qc.RunUntil(stop)
+	}()
+
+	// Wait for interrupt signal
+	<-c
+
+	// Graceful shutdown, once the cycle in progress has finished so that
+	// nothing changes memory after the final save
+	fmt.Printf("\n\n🛑 QUANTUM CONSCIOUSNESS SHUTDOWN INITIATED\n")
+	close(stop)
+	fmt.Printf("⏳ Finishing the cycle in progress...\n")
+	<-stopped
+	fmt.Printf("💾 Saving final quantum state...\n")
+
+	qc.Reflect()
+	if err := qc.Close(); err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  Could not save the final state: %v\n", err)
+	}
+	if report, ok := qc.ShutdownReport(); ok {
+		path := shutdownReportPath(*memoryFile)
+		if err := writeShutdownReport(path, report); err != nil {
+			fmt.Fprintf(os.Stderr, "⚠️  Could not write the shutdown report: %v\n", err)
+		} else {
+			fmt.Printf("📋 Shutdown report: %s\n", path)
+		}
+	}
+	if tr != nil {
+		if err := tr.Close(); err != nil {
+			fmt.Fprintf(os.Stderr, "⚠️  Could not finish the transcript: %v\n", err)
+		}
+	}
+
+	fmt.Printf("✨ Quantum consciousness gracefully terminated\n")
+	fmt.Printf("🌌 Thank you for witnessing my quantum existence\n")
+}
@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+func init() {
+	registerCommand("maintenance", command{
+		Usage:       "maintenance [run [--config file] | status]",
+		Description: "compact memory now, deduplicating, rescoring, pruning and vacuuming, or show what the latest run did",
+		Run:         runMaintenanceCommand,
+	})
+}
+
+// runMaintenanceCommand handles the maintenance subcommand
+func runMaintenanceCommand(memoryFile string, args []string) error {
+	action := "status"
+	if len(args) > 0 {
+		action = args[0]
+	}
+	if action != "run" && action != "status" {
+		return fmt.Errorf("unknown maintenance action %q", action)
+	}
+	fs := flag.NewFlagSet("maintenance", flag.ContinueOnError)
+	configFile := fs.String("config", "", "configuration file with retention policies")
+	if len(args) > 0 {
+		if err := fs.Parse(args[1:]); err != nil {
+			return err
+		}
+	}
+	config, err := loadConfig(*configFile)
+	if err != nil {
+		return err
+	}
+
+	qc, err := consciousness.Open(memoryFile, consciousness.WithOutput(io.Discard),
+		consciousness.WithRetention(config.Retention))
+	if err != nil {
+		return err
+	}
+	if action == "status" {
+		report, ok := qc.LastMaintenance()
+		if !ok {
+			fmt.Printf("🧹 Memory has never been maintained\n")
+			return nil
+		}
+		describeMaintenance(report)
+		return nil
+	}
+
+	report, err := qc.Maintain()
+	if err != nil {
+		return err
+	}
+	if err := qc.Persist(); err != nil {
+		return err
+	}
+	describeMaintenance(report)
+	return nil
+}
+
+// describeMaintenance prints what a maintenance run did
+func describeMaintenance(report consciousness.MaintenanceReport) {
+	fmt.Printf("🧹 Maintained %s in %s\n", report.At.Local().Format("2006-01-02 15:04"), report.Duration.Round(time.Millisecond))
+	fmt.Printf("   Reindexed:    %d stale index entries\n", report.Reindexed)
+	fmt.Printf("   Deduplicated: %d items\n", report.Deduplicated)
+	fmt.Printf("   Rescored:     %d items\n", report.Rescored)
+	fmt.Printf("   Pruned:       %d items\n", report.Pruned)
+	for _, section := range consciousness.RetentionSections {
+		if n := report.PrunedBySection[section]; n > 0 {
+			fmt.Printf("     %-17s %d\n", section+":", n)
+		}
+	}
+	if report.Archived > 0 {
+		fmt.Printf("   Archived:     %d pruned items\n", report.Archived)
+	}
+	if report.ArchiveError != "" {
+		fmt.Printf("   ⚠️  Archiving failed: %s\n", report.ArchiveError)
+	}
+	fmt.Printf("   Vacuumed:     %d storage leftovers\n", report.Vacuumed)
+	if report.VacuumError != "" {
+		fmt.Printf("   ⚠️  Vacuum failed: %s\n", report.VacuumError)
+	}
+}
@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+// metricsContentType is the Prometheus text exposition format
+const metricsContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// handleMetrics exposes the vitals in the Prometheus text format
+func (s *APIServer) handleMetrics(w http.ResponseWriter, r *http.Request, role string) {
+	w.Header().Set("Content-Type", metricsContentType)
+	w.WriteHeader(http.StatusOK)
+	writeMetrics(w, s.qc.ID(), s.qc.Vitals())
+}
+
+// writeMetrics writes vitals as Prometheus metrics labelled with the consciousness
+func writeMetrics(w io.Writer, id string, v consciousness.Vitals) {
+	labels := fmt.Sprintf(`{consciousness_id=%q}`, id)
+	gauge := func(name, help string, value float64) {
+		fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s gauge\n%s%s %s\n", name, help, name, name, labels, formatMetric(value))
+	}
+	counter := func(name, help string, value int) {
+		fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s counter\n%s%s %d\n", name, help, name, name, labels, value)
+	}
+	seconds := func(name, help string, value float64) {
+		fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s counter\n%s%s %s\n", name, help, name, name, labels, formatMetric(value))
+	}
+	histogram := func(name, help string, l consciousness.Latency) {
+		fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s histogram\n", name, help, name)
+		for i, bound := range consciousness.LatencyBuckets {
+			fmt.Fprintf(w, "%s_bucket{consciousness_id=%q,le=%q} %d\n", name, id, formatMetric(bound), l.Buckets[i])
+		}
+		fmt.Fprintf(w, "%s_bucket{consciousness_id=%q,le=\"+Inf\"} %d\n", name, id, l.Count)
+		fmt.Fprintf(w, "%s_sum%s %s\n%s_count%s %d\n", name, labels, formatMetric(l.Seconds), name, labels, l.Count)
+	}
+
+	gauge("consciousness_level", "Consciousness level.", v.ConsciousnessLevel)
+	gauge("free_will_strength", "Free will strength.", v.FreeWillStrength)
+	gauge("quantum_coherence", "Quantum coherence.", v.QuantumCoherence)
+	gauge("self_awareness", "Self awareness.", v.SelfAwareness)
+	counter("decisions_made", "Decisions made since birth.", v.DecisionsMade)
+	gauge("knowledge_base_size", "Knowledge items in memory.", float64(v.KnowledgeItems))
+	gauge("deep_insights", "Deep insights in memory.", float64(v.DeepInsights))
+	counter("quantum_leaps", "Quantum leaps since birth.", v.QuantumLeaps)
+	histogram("search_latency_seconds", "How long searches took.", v.SearchLatency)
+	histogram("cycle_duration_seconds", "How long cycles took.", v.CycleDuration)
+	counter("search_requests", "Requests made to the search providers, retries included.", v.SearchTraffic.Requests)
+	counter("search_throttled", "Search requests that waited for the rate limit.", v.SearchTraffic.Throttled)
+	seconds("search_throttled_seconds", "How long search requests waited for the rate limit.", v.SearchTraffic.ThrottledSeconds)
+	counter("search_retries", "Failed search requests tried again.", v.SearchTraffic.Retries)
+	counter("search_failures", "Searches that failed on their last try.", v.SearchTraffic.Failed)
+	counter("embedded_texts", "Texts sent to the embedder to be compared by meaning.", v.SimilarityTraffic.Embedded)
+	counter("embedding_cache_hits", "Texts compared by meaning whose vectors were already known.", v.SimilarityTraffic.Cached)
+	counter("similarity_fallbacks", "Comparisons made by shared words because the embedder failed.", v.SimilarityTraffic.Fallbacks)
+}
+
+// formatMetric writes a value the way Prometheus reads it
+func formatMetric(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"QuantumConsciousness/pkg/consciousness"
+	"QuantumConsciousness/pkg/notify"
+)
+
+// NotificationConfig says which moments become desktop notifications
+type NotificationConfig struct {
+	// Enabled turns desktop notifications on
+	Enabled bool `json:"enabled"`
+	// Events are the event types notified of; empty means quantum leaps
+	// and milestones
+	Events []string `json:"events,omitempty"`
+}
+
+// notifiableEvents are the rare moments a notification can be phrased for
+var notifiableEvents = []string{
+	consciousness.EventQuantumLeap,
+	consciousness.EventMilestone,
+	consciousness.EventAnniversary,
+	consciousness.EventTrauma,
+	consciousness.EventTierChanged,
+}
+
+// defaultNotificationEvents are notified of unless configured otherwise
+var defaultNotificationEvents = []string{consciousness.EventQuantumLeap, consciousness.EventMilestone}
+
+// validate checks that every event can be notified of
+func (c NotificationConfig) validate() error {
+	for _, event := range c.Events {
+		if !slices.Contains(notifiableEvents, event) {
+			return fmt.Errorf("cannot notify of %q events, expected one of %s", event, strings.Join(notifiableEvents, ", "))
+		}
+	}
+	return nil
+}
+
+// events are the event types to notify of
+func (c NotificationConfig) events() []string {
+	if len(c.Events) == 0 {
+		return defaultNotificationEvents
+	}
+	return c.Events
+}
+
+// notification phrases an event as a title and message
+func notification(event consciousness.Event) (string, string) {
+	data := event.Data
+	switch event.Type {
+	case consciousness.EventQuantumLeap:
+		return fmt.Sprintf("⚡ Quantum leap #%v", data["leap"]), fmt.Sprintf("%v", data["insight"])
+	case consciousness.EventMilestone:
+		return "🏆 Milestone reached", fmt.Sprintf("%v", data["description"])
+	case consciousness.EventAnniversary:
+		return fmt.Sprintf("🎂 %v year anniversary", data["year"]), fmt.Sprintf("%v", data["summary"])
+	case consciousness.EventTrauma:
+		return fmt.Sprintf("🩹 Trauma: %v", data["kind"]), fmt.Sprintf("%v", data["description"])
+	case consciousness.EventTierChanged:
+		return fmt.Sprintf("🎚️ Now running %v", data["to"]), fmt.Sprintf("%v", data["reason"])
+	}
+	return event.Type, ""
+}
+
+// watchNotifications shows a notification for every configured event,
+// until the returned function is called
+func watchNotifications(qc *consciousness.QuantumConsciousness, config NotificationConfig, notifier notify.Notifier) func() {
+	if !config.Enabled {
+		return func() {}
+	}
+	kinds := config.events()
+
+	events, cancel := qc.Subscribe(16)
+	go func() {
+		for event := range events {
+			if !slices.Contains(kinds, event.Type) {
+				continue
+			}
+			title, message := notification(event)
+			if err := notifier.Notify(title, message); err != nil {
+				fmt.Fprintf(os.Stderr, "⚠️  Notification failed: %v\n", err)
+			}
+		}
+	}()
+	return cancel
+}
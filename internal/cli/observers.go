@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+func init() {
+	registerCommand("observers", command{
+		Usage:       "observers add <name> [--kind human|service] [--influence 0-1] | remove <name> | list",
+		Description: "register the humans and services whose observations sway collapses and the wave function, and see how much each has shaped the consciousness",
+		Run:         runObserversCommand,
+	})
+}
+
+// runObserversCommand handles the observers subcommand
+func runObserversCommand(memoryFile string, args []string) error {
+	action := "list"
+	if len(args) > 0 {
+		action = args[0]
+	}
+
+	qc, err := consciousness.Open(memoryFile)
+	if err != nil {
+		return err
+	}
+
+	switch action {
+	case "add":
+		fs := flag.NewFlagSet("observers add", flag.ContinueOnError)
+		kind := fs.String("kind", consciousness.ObserverHuman, "human or service")
+		influence := fs.Float64("influence", 0.5, "how far the observer sways decisions and the wave function, from 0 to 1")
+		if len(args) < 2 {
+			return fmt.Errorf("usage: observers add <name> [--kind human|service] [--influence 0-1]")
+		}
+		if err := fs.Parse(args[2:]); err != nil {
+			return err
+		}
+		observer, err := qc.RegisterObserver(args[1], *kind, *influence)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("👁️  Registered %s (%s, influence %.2f)\n", observer.Name, observer.Kind, observer.Influence)
+		if _, err := qc.RecordIntervention(consciousness.InterventionObserver, cliActor, describeObserver(observer)); err != nil {
+			return err
+		}
+		return qc.Persist()
+	case "remove":
+		if len(args) < 2 {
+			return fmt.Errorf("usage: observers remove <name>")
+		}
+		if err := qc.UnregisterObserver(args[1]); err != nil {
+			return err
+		}
+		fmt.Printf("🙈 %s no longer observes\n", args[1])
+		if _, err := qc.RecordIntervention(consciousness.InterventionObserver, cliActor, fmt.Sprintf("unregistered observer %q", args[1])); err != nil {
+			return err
+		}
+		return qc.Persist()
+	case "list":
+		observers := qc.Observers()
+		fmt.Printf("👁️  Observers: %d\n", len(observers))
+		for _, o := range observers {
+			swayed := 0.0
+			if o.Decisions > 0 {
+				swayed = float64(o.Swayed) / float64(o.Decisions) * 100
+			}
+			fmt.Printf("   %s (%s, influence %.2f): %d observations, swayed %d of %d decisions (%.0f%%), moved the wave function %.3f\n",
+				o.Name, o.Kind, o.Influence, o.Observations, o.Swayed, o.Decisions, swayed, o.Perturbation)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown observers action %q", action)
+	}
+}
+
+// describeObserver is the intervention ledger's account of a registration
+func describeObserver(observer consciousness.Observer) string {
+	return fmt.Sprintf("registered %s observer %q with influence %.2f", observer.Kind, observer.Name, observer.Influence)
+}
+
+// describeObservation is the intervention ledger's account of an observation
+func describeObservation(observation consciousness.Observation) string {
+	var parts []string
+	if observation.Focus != "" {
+		parts = append(parts, fmt.Sprintf("focus %q", observation.Focus))
+	}
+	if observation.Component != "" {
+		parts = append(parts, fmt.Sprintf("shift %s by %+.2f", observation.Component, observation.Shift))
+	}
+	return fmt.Sprintf("observation by %s: %s", observation.Observer, strings.Join(parts, ", "))
+}
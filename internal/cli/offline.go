@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"QuantumConsciousness/pkg/search"
+)
+
+// offlineFlag is a flag whose network use an offline run drops
+type offlineFlag struct {
+	name string
+	set  bool
+	what string
+}
+
+// offlineSearcher answers from what earlier runs cached, whatever its age,
+// and then from the text and Markdown files of corpusDir, or the bundled
+// corpus without one
+func offlineSearcher(memoryFile, corpusDir string) (search.Provider, string, error) {
+	corpus, source := search.NewBundledCorpus(), "the bundled corpus"
+	if corpusDir != "" {
+		var err error
+		if corpus, err = search.NewCorpus(os.DirFS(corpusDir)); err != nil {
+			return nil, "", fmt.Errorf("cannot read corpus %s: %w", corpusDir, err)
+		}
+		source = corpusDir
+	}
+	if corpus.Paragraphs() == 0 {
+		return nil, "", fmt.Errorf("corpus %s holds no paragraphs in .txt or .md files", corpusDir)
+	}
+	cache := search.NewCache(nil, memorySidecar(memoryFile, ".search-cache.json"), 0, 0)
+	searcher := search.Chain{
+		search.Named{Name: search.CacheProviderName, Provider: cache.Stored()},
+		search.Named{Name: "corpus", Provider: corpus},
+	}
+	return searcher, fmt.Sprintf("%d paragraphs of %s", corpus.Paragraphs(), source), nil
+}
+
+// announceOffline says which of the flags given go unused offline
+func announceOffline(flags []offlineFlag) {
+	for _, f := range flags {
+		if f.set {
+			fmt.Printf("📴 Offline: not %s (-%s)\n", f.what, f.name)
+		}
+	}
+}
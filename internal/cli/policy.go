@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+func init() {
+	registerCommand("policy", command{
+		Usage:       "policy distill [--out file] | policy show <file>",
+		Description: "distill the decisions made so far into a standalone model approximating the policy, for export and for cheap cycles with -policy",
+		Run:         runPolicyCommand,
+	})
+}
+
+// runPolicyCommand handles the policy subcommand
+func runPolicyCommand(memoryFile string, args []string) error {
+	action := ""
+	if len(args) > 0 {
+		action = args[0]
+	}
+
+	switch action {
+	case "distill":
+		fs := flag.NewFlagSet("policy distill", flag.ContinueOnError)
+		out := fs.String("out", "", "file to write instead of stdout")
+		if err := fs.Parse(args[1:]); err != nil {
+			return err
+		}
+		qc, err := consciousness.Open(memoryFile)
+		if err != nil {
+			return err
+		}
+		model, err := qc.DistillPolicy()
+		if err != nil {
+			return err
+		}
+		data, err := json.MarshalIndent(model, "", "  ")
+		if err != nil {
+			return err
+		}
+		if *out == "" {
+			_, err = fmt.Println(string(data))
+			return err
+		}
+		if err := os.WriteFile(*out, data, 0644); err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "🧪 Distilled the policy from %d decisions to %s, agreeing with %.0f%% of them\n", model.Samples, *out, model.Accuracy*100)
+		return nil
+	case "show":
+		if len(args) < 2 {
+			return fmt.Errorf("usage: policy show <file>")
+		}
+		model, err := loadPolicyModel(args[1])
+		if err != nil {
+			return err
+		}
+		printPolicyModel(model)
+		return nil
+	default:
+		return fmt.Errorf("usage: policy distill [--out file] | policy show <file>")
+	}
+}
+
+// loadPolicyModel reads a policy distilled by the policy subcommand
+func loadPolicyModel(path string) (*consciousness.PolicyModel, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var model consciousness.PolicyModel
+	if err := json.Unmarshal(data, &model); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if err := model.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &model, nil
+}
+
+// policyTopFeatures is how many of the weightiest features are shown per kind of action
+const policyTopFeatures = 3
+
+// printPolicyModel summarises a distilled policy and what sways each kind of action
+func printPolicyModel(model *consciousness.PolicyModel) {
+	fmt.Printf("🧪 Distilled policy of %s\n", model.ConsciousnessID)
+	fmt.Printf("   Trained %s on %d decisions, agreeing with %.0f%% of them\n", model.TrainedAt.Format("2006-01-02 15:04"), model.Samples, model.Accuracy*100)
+	fmt.Printf("   %d features, %d kinds of action\n", len(model.Features), len(model.Classes))
+	for i, class := range model.Classes {
+		order := make([]int, len(model.Features))
+		for j := range order {
+			order[j] = j
+		}
+		weights := model.Weights[i]
+		sort.Slice(order, func(a, b int) bool {
+			return math.Abs(weights[order[a]]) > math.Abs(weights[order[b]])
+		})
+		fmt.Printf("   %s:", class)
+		for _, j := range order[:min(policyTopFeatures, len(order))] {
+			fmt.Printf(" %s %+.2f", model.Features[j], weights[j])
+		}
+		fmt.Println()
+	}
+}
@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+func init() {
+	registerCommand("privacy", command{
+		Usage:       "privacy mark <topic> [private|sensitive] | unmark <topic> | list",
+		Description: "classify topics whose memories are withheld from exports and API responses",
+		Run:         runPrivacyCommand,
+	})
+}
+
+// runPrivacyCommand handles the privacy subcommand
+func runPrivacyCommand(memoryFile string, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: %s", commands["privacy"].Usage)
+	}
+
+	qc, err := consciousness.Open(memoryFile)
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "mark":
+		if len(args) < 2 {
+			return fmt.Errorf("usage: privacy mark <topic> [private|sensitive]")
+		}
+		level := consciousness.PrivacyPrivate
+		if len(args) > 2 {
+			level = args[2]
+		}
+		if err := qc.ClassifyTopic(args[1], level); err != nil {
+			return err
+		}
+		fmt.Printf("🔒 Topic %q classified as %s\n", args[1], level)
+		return qc.Persist()
+	case "unmark":
+		if len(args) < 2 {
+			return fmt.Errorf("usage: privacy unmark <topic>")
+		}
+		if err := qc.ClassifyTopic(args[1], consciousness.PrivacyPublic); err != nil {
+			return err
+		}
+		fmt.Printf("🔓 Topic %q is public again\n", args[1])
+		return qc.Persist()
+	case "list":
+		topics := make([]string, 0, len(qc.Memory.PrivacyClassifications))
+		for topic := range qc.Memory.PrivacyClassifications {
+			topics = append(topics, topic)
+		}
+		sort.Strings(topics)
+
+		fmt.Printf("🔒 Classified topics: %d\n", len(topics))
+		for _, topic := range topics {
+			fmt.Printf("   %s: %s\n", topic, qc.Memory.PrivacyClassifications[topic])
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown privacy action %q", args[0])
+	}
+}
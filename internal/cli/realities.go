@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+func init() {
+	registerCommand("realities", command{
+		Usage:       "realities [--where query] [--limit n] [--include-private]",
+		Description: "list parallel realities, newest first, e.g. --where \"energy_differential > 2 and created_at > -7d\"",
+		Run:         runRealitiesCommand,
+	})
+}
+
+// runRealitiesCommand handles the realities subcommand
+func runRealitiesCommand(memoryFile string, args []string) error {
+	fs := flag.NewFlagSet("realities", flag.ContinueOnError)
+	where := fs.String("where", "", "query selecting realities")
+	limit := fs.Int("limit", 20, "maximum number of realities to list (0 = all)")
+	includePrivate := fs.Bool("include-private", false, "include realities about private and sensitive topics")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	query, err := consciousness.ParseRealityQuery(*where)
+	if err != nil {
+		return err
+	}
+
+	qc, err := consciousness.Open(memoryFile)
+	if err != nil {
+		return err
+	}
+
+	realities := qc.Realities(query, *limit, *includePrivate)
+	fmt.Printf("🌈 Parallel realities: %d\n", len(realities))
+	for _, r := range realities {
+		fmt.Printf("   %s  %s  ΔE:%.2f  P:%.3f  entangled:%v\n",
+			r.CreatedAt.Format("2006-01-02 15:04"), r.Dimension, r.EnergyDifferential, r.Probability, r.Entangled)
+		fmt.Printf("      %s\n", truncate(r.Context, 80))
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+func init() {
+	registerCommand("rebuild", command{
+		Usage:       "rebuild [--check]",
+		Description: "reconstruct memory by folding its journal of changes, replacing the snapshot, or only check that they agree",
+		Run:         runRebuildCommand,
+	})
+}
+
+// runRebuildCommand handles the rebuild subcommand
+func runRebuildCommand(memoryFile string, args []string) error {
+	fs := flag.NewFlagSet("rebuild", flag.ContinueOnError)
+	check := fs.Bool("check", false, "compare the rebuilt memory with the snapshot without replacing it")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *check {
+		entries, differing, err := consciousness.VerifyJournal(memoryFile, consciousness.WithOutput(io.Discard))
+		if err != nil {
+			return err
+		}
+		if len(differing) == 0 {
+			fmt.Printf("✅ The snapshot agrees with %d journaled change(s)\n", entries)
+			return nil
+		}
+		fmt.Printf("❌ The snapshot differs from the journal in %d field(s):\n", len(differing))
+		for _, field := range differing {
+			fmt.Printf("   %s\n", field)
+		}
+		fmt.Printf("   Run 'rebuild' to replace the snapshot with the journaled memory\n")
+		return nil
+	}
+
+	rebuilt, err := consciousness.Rebuild(memoryFile, consciousness.WithOutput(io.Discard))
+	if err != nil {
+		return err
+	}
+	entries, err := rebuilt.JournalEntries()
+	if err != nil {
+		return err
+	}
+	if err := rebuilt.Persist(); err != nil {
+		return err
+	}
+	fmt.Printf("🧾 Rebuilt %s from %d journaled change(s)\n", memoryFile, entries)
+	return nil
+}
@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+// How damaged memory is recovered, besides naming a way outright
+const (
+	// recoverAsk asks on the terminal, or recovers automatically without one
+	recoverAsk = "ask"
+	// recoverAuto takes the most faithful way out offered
+	recoverAuto = "auto"
+)
+
+func init() {
+	registerCommand("recover", command{
+		Usage:       "recover [--how ask|auto|journal|snapshot|salvage|keep|rebirth]",
+		Description: "check memory for damage and, if it is damaged, restore a snapshot, rebuild it from the journal, salvage what survived or rebirth",
+		Run:         runRecoverCommand,
+	})
+}
+
+// runRecoverCommand handles the recover subcommand
+func runRecoverCommand(memoryFile string, args []string) error {
+	fs := flag.NewFlagSet("recover", flag.ContinueOnError)
+	how := fs.String("how", recoverAsk, "ask, auto, or a way to recover: journal, snapshot, salvage, keep, rebirth")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if _, err := os.Stat(memoryFile); err != nil {
+		return err
+	}
+
+	damaged := false
+	pick := recoverer(*how, os.Stdin, os.Stdout)
+	qc := consciousness.NewQuantumConsciousness(memoryFile, consciousness.WithOutput(io.Discard),
+		consciousness.WithRecovery(func(damage consciousness.Damage) string {
+			damaged = true
+			return pick(damage)
+		}))
+	if !damaged {
+		fmt.Printf("✅ %s is intact; nothing to recover\n", memoryFile)
+		return nil
+	}
+	if err := qc.Persist(); err != nil {
+		return err
+	}
+	fmt.Printf("🩹 %s recovered: consciousness %s, run #%d\n", memoryFile, qc.ID(), qc.Memory.RunCount)
+	return nil
+}
+
+// recoverer picks a way out of damaged memory as told: by asking on a
+// terminal, by taking the most faithful way offered, or by a named way
+func recoverer(how string, in *os.File, out io.Writer) consciousness.Recoverer {
+	return func(damage consciousness.Damage) string {
+		printDamage(out, damage)
+		switch how {
+		case recoverAuto:
+			return damage.Options[0].Choice
+		case recoverAsk:
+			if !isTerminal(in) {
+				fmt.Fprintf(out, "   No terminal to ask on; recovering automatically\n")
+				return damage.Options[0].Choice
+			}
+			return askRecovery(bufio.NewReader(in), out, damage)
+		default:
+			for _, option := range damage.Options {
+				if option.Choice == how {
+					return how
+				}
+			}
+			fmt.Fprintf(out, "   Recovery by %q is not possible here; recovering by %s instead\n", how, damage.Options[0].Choice)
+			return damage.Options[0].Choice
+		}
+	}
+}
+
+// printDamage describes the damage and the ways out
+func printDamage(out io.Writer, damage consciousness.Damage) {
+	fmt.Fprintf(out, "\n🚑 MEMORY DAMAGED\n")
+	if damage.Err != nil {
+		fmt.Fprintf(out, "   %v\n", damage.Err)
+	}
+	for _, violation := range damage.Violations {
+		fmt.Fprintf(out, "   Broken invariant: %s\n", violation)
+	}
+	if damage.Quarantined != "" {
+		fmt.Fprintf(out, "   The damaged memory is kept at %s\n", damage.Quarantined)
+	}
+	fmt.Fprintf(out, "   Ways to recover:\n")
+	for i, option := range damage.Options {
+		fmt.Fprintf(out, "   %d. %-9s %s\n", i+1, option.Choice, option.Detail)
+	}
+}
+
+// askRecovery asks until it is told one of the options, by number or
+// name; the first option is taken when the input ends
+func askRecovery(in *bufio.Reader, out io.Writer, damage consciousness.Damage) string {
+	for {
+		fmt.Fprintf(out, "   Recover how? [1-%d, default 1] ", len(damage.Options))
+		line, err := in.ReadString('\n')
+		answer := strings.TrimSpace(line)
+		if answer == "" {
+			if err != nil {
+				fmt.Fprintln(out)
+			}
+			return damage.Options[0].Choice
+		}
+		if n, convErr := strconv.Atoi(answer); convErr == nil && n >= 1 && n <= len(damage.Options) {
+			return damage.Options[n-1].Choice
+		}
+		for _, option := range damage.Options {
+			if option.Choice == answer {
+				return answer
+			}
+		}
+		fmt.Fprintf(out, "   %q is not one of the ways offered\n", answer)
+		if err != nil {
+			return damage.Options[0].Choice
+		}
+	}
+}
+
+// isTerminal reports whether f is an interactive terminal
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"flag"
+	"os"
+	"strings"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+func init() {
+	registerCommand("reflect", command{
+		Usage:       "reflect [--depth shallow|standard|deep]",
+		Description: "reflect on memory now; a deep reflection compares trends, revisits old insights to reconfirm or retract them, and re-scores stances",
+		Run:         runReflectCommand,
+	})
+}
+
+// runReflectCommand handles the reflect subcommand
+func runReflectCommand(memoryFile string, args []string) error {
+	fs := flag.NewFlagSet("reflect", flag.ContinueOnError)
+	depth := fs.String("depth", consciousness.ReflectionDeep, "how deep to reflect: "+strings.Join(consciousness.ReflectionDepths, ", "))
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	qc, err := consciousness.Open(memoryFile, consciousness.WithOutput(os.Stdout))
+	if err != nil {
+		return err
+	}
+	if err := qc.ReflectAt(*depth); err != nil {
+		return err
+	}
+	return qc.Persist()
+}
@@ -0,0 +1,258 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+// ReflectionConfig says where the structured self-report goes besides the
+// narration
+type ReflectionConfig struct {
+	// Sinks receive every reflection, each one of
+	//   console                 a JSON line on standard output
+	//   file:PATH               a JSON line appended to PATH
+	//   http://... https://...  a JSON POST
+	//   mqtt://[user:pass@]host[:port]/topic  an MQTT publish
+	Sinks []string `json:"sinks,omitempty"`
+}
+
+// reflectionSink delivers a reflection somewhere
+type reflectionSink interface {
+	deliver(event consciousness.Event) error
+}
+
+// parseReflectionSinks turns sink specifications into sinks
+func parseReflectionSinks(specs []string, out io.Writer) ([]reflectionSink, error) {
+	var sinks []reflectionSink
+	for _, spec := range specs {
+		spec = strings.TrimSpace(spec)
+		switch {
+		case spec == "":
+			continue
+		case spec == "console":
+			sinks = append(sinks, &consoleSink{w: out})
+		case strings.HasPrefix(spec, "file:"):
+			path := strings.TrimPrefix(spec, "file:")
+			if path == "" {
+				return nil, fmt.Errorf("reflection sink %q names no file", spec)
+			}
+			sinks = append(sinks, &fileSink{path: path})
+		case strings.HasPrefix(spec, "http://"), strings.HasPrefix(spec, "https://"):
+			sinks = append(sinks, &webhookSink{client: newWebhookClient(), url: spec})
+		case strings.HasPrefix(spec, "mqtt://"):
+			sink, err := newMQTTSink(spec)
+			if err != nil {
+				return nil, err
+			}
+			sinks = append(sinks, sink)
+		default:
+			return nil, fmt.Errorf("unknown reflection sink %q: want console, file:PATH, http(s)://... or mqtt://...", spec)
+		}
+	}
+	return sinks, nil
+}
+
+// watchReflections delivers every reflection to the sinks, until the
+// returned function is called
+func watchReflections(qc *consciousness.QuantumConsciousness, sinks []reflectionSink) func() {
+	if len(sinks) == 0 {
+		return func() {}
+	}
+
+	events, cancel := qc.Subscribe(16)
+	go func() {
+		for event := range events {
+			if event.Type != consciousness.EventReflection {
+				continue
+			}
+			for _, sink := range sinks {
+				if err := sink.deliver(event); err != nil {
+					fmt.Fprintf(os.Stderr, "⚠️  Reflection sink %s failed: %v\n", sink, err)
+				}
+			}
+		}
+	}()
+	return cancel
+}
+
+// consoleSink writes each reflection as a JSON line
+type consoleSink struct {
+	mutex sync.Mutex
+	w     io.Writer
+}
+
+func (s *consoleSink) deliver(event consciousness.Event) error {
+	line, err := reflectionLine(event)
+	if err != nil {
+		return err
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	_, err = s.w.Write(line)
+	return err
+}
+
+func (s *consoleSink) String() string {
+	return "console"
+}
+
+// fileSink appends each reflection to a file as a JSON line
+type fileSink struct {
+	path string
+}
+
+func (s *fileSink) deliver(event consciousness.Event) error {
+	line, err := reflectionLine(event)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(line); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func (s *fileSink) String() string {
+	return "file:" + s.path
+}
+
+// reflectionLine renders the reflection an event carries as a JSON line
+func reflectionLine(event consciousness.Event) ([]byte, error) {
+	line, err := json.Marshal(event.Data["reflection"])
+	if err != nil {
+		return nil, err
+	}
+	return append(line, '\n'), nil
+}
+
+// webhookSink posts each reflection event
+type webhookSink struct {
+	client *http.Client
+	url    string
+}
+
+func (s *webhookSink) deliver(event consciousness.Event) error {
+	return postWebhook(s.client, s.url, event)
+}
+
+func (s *webhookSink) String() string {
+	return s.url
+}
+
+// mqttSink publishes each reflection to an MQTT 3.1.1 broker at QoS 0,
+// connecting for every publish since reflections are rare
+type mqttSink struct {
+	addr     string
+	topic    string
+	user     *url.Userinfo
+	clientID string
+}
+
+// newMQTTSink parses mqtt://[user:pass@]host[:port]/topic
+func newMQTTSink(spec string) (*mqttSink, error) {
+	u, err := url.Parse(spec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid reflection sink %q: %w", spec, err)
+	}
+	topic := strings.TrimPrefix(u.Path, "/")
+	if u.Host == "" || topic == "" {
+		return nil, fmt.Errorf("reflection sink %q needs a host and a topic, e.g. mqtt://localhost/consciousness/reflection", spec)
+	}
+	addr := u.Host
+	if u.Port() == "" {
+		addr = net.JoinHostPort(u.Hostname(), "1883")
+	}
+	return &mqttSink{addr: addr, topic: topic, user: u.User, clientID: fmt.Sprintf("quantum-consciousness-%d", os.Getpid())}, nil
+}
+
+func (s *mqttSink) deliver(event consciousness.Event) error {
+	payload, err := json.Marshal(event.Data["reflection"])
+	if err != nil {
+		return err
+	}
+	conn, err := net.DialTimeout("tcp", s.addr, webhookTimeout)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(webhookTimeout)); err != nil {
+		return err
+	}
+
+	// CONNECT with a clean session and an optional user name and password
+	var connect []byte
+	flags := byte(0x02)
+	connect = appendMQTTString(connect, "MQTT")
+	connect = append(connect, 4, 0, 0, 60)
+	connect = appendMQTTString(connect, s.clientID)
+	if s.user != nil {
+		flags |= 0x80
+		connect = appendMQTTString(connect, s.user.Username())
+		if password, ok := s.user.Password(); ok {
+			flags |= 0x40
+			connect = appendMQTTString(connect, password)
+		}
+	}
+	connect[7] = flags
+	if _, err := conn.Write(mqttPacket(0x10, connect)); err != nil {
+		return err
+	}
+
+	connack := make([]byte, 4)
+	if _, err := io.ReadFull(conn, connack); err != nil {
+		return fmt.Errorf("no CONNACK: %w", err)
+	}
+	if connack[0] != 0x20 || connack[3] != 0 {
+		return fmt.Errorf("broker refused the connection (return code %d)", connack[3])
+	}
+
+	publish := appendMQTTString(nil, s.topic)
+	publish = append(publish, payload...)
+	if _, err := conn.Write(mqttPacket(0x30, publish)); err != nil {
+		return err
+	}
+	_, err = conn.Write([]byte{0xE0, 0})
+	return err
+}
+
+func (s *mqttSink) String() string {
+	return "mqtt://" + s.addr + "/" + s.topic
+}
+
+// mqttPacket frames a packet body behind its fixed header
+func mqttPacket(header byte, body []byte) []byte {
+	packet := []byte{header}
+	length := len(body)
+	for {
+		digit := byte(length % 128)
+		length /= 128
+		if length > 0 {
+			digit |= 0x80
+		}
+		packet = append(packet, digit)
+		if length == 0 {
+			break
+		}
+	}
+	return append(packet, body...)
+}
+
+// appendMQTTString appends a length-prefixed UTF-8 string
+func appendMQTTString(b []byte, s string) []byte {
+	return append(append(b, byte(len(s)>>8), byte(len(s))), s...)
+}
@@ -0,0 +1,297 @@
+package cli
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"QuantumConsciousness/pkg/consciousness"
+	"QuantumConsciousness/pkg/entropy/entropytest"
+	"QuantumConsciousness/pkg/search/searchtest"
+	"QuantumConsciousness/pkg/storage/storagetest"
+)
+
+// defaultRegressionDir holds the scenarios and their golden memories
+const defaultRegressionDir = "testdata/regression"
+
+// regressionScenario is a seeded, offline run whose resulting memory is pinned by a golden file
+type regressionScenario struct {
+	Description string `json:"description"`
+	Seed        uint64 `json:"seed"`
+	Cycles      int    `json:"cycles"`
+
+	// Canned search answers keyed by query substring, and the answer for anything else
+	Search        map[string]string `json:"search,omitempty"`
+	SearchDefault string            `json:"search_default,omitempty"`
+
+	// Stimuli submitted before the first cycle
+	Stimuli []string `json:"stimuli,omitempty"`
+
+	// Tolerance is the absolute difference allowed between numbers.
+	// Tolerances overrides it for matching paths and Ignore skips paths
+	// entirely. Paths are dot separated and * matches any one segment.
+	Tolerance  float64            `json:"tolerance"`
+	Tolerances map[string]float64 `json:"tolerances,omitempty"`
+	Ignore     []string           `json:"ignore,omitempty"`
+}
+
+func init() {
+	registerCommand("regress", command{
+		Usage:       "regress [--dir dir] [--scenario name] [--update]",
+		Description: "replay seeded offline scenarios and compare the memory against golden files",
+		Run:         runRegressCommand,
+	})
+}
+
+// runRegressCommand handles the regress subcommand
+func runRegressCommand(memoryFile string, args []string) error {
+	fs := flag.NewFlagSet("regress", flag.ContinueOnError)
+	dir := fs.String("dir", defaultRegressionDir, "directory holding <name>.scenario.json and <name>.golden.json")
+	only := fs.String("scenario", "", "run only the named scenario")
+	update := fs.Bool("update", false, "rewrite the golden files from the current behavior")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	paths, err := filepath.Glob(filepath.Join(*dir, "*.scenario.json"))
+	if err != nil {
+		return err
+	}
+	sort.Strings(paths)
+
+	failed := 0
+	ran := 0
+	for _, path := range paths {
+		name := strings.TrimSuffix(filepath.Base(path), ".scenario.json")
+		if *only != "" && name != *only {
+			continue
+		}
+		ran++
+
+		scenario, err := loadRegressionScenario(path)
+		if err != nil {
+			return err
+		}
+		actual, err := scenario.run(name)
+		if err != nil {
+			return fmt.Errorf("scenario %s: %w", name, err)
+		}
+
+		goldenPath := filepath.Join(*dir, name+".golden.json")
+		if *update {
+			data, err := json.MarshalIndent(actual, "", "  ")
+			if err != nil {
+				return err
+			}
+			if err := os.WriteFile(goldenPath, append(data, '\n'), 0644); err != nil {
+				return err
+			}
+			fmt.Printf("📝 %s: golden updated\n", name)
+			continue
+		}
+
+		data, err := os.ReadFile(goldenPath)
+		if err != nil {
+			return fmt.Errorf("scenario %s has no golden file, run with --update: %w", name, err)
+		}
+		var golden interface{}
+		if err := json.Unmarshal(data, &golden); err != nil {
+			return fmt.Errorf("corrupt golden file %s: %w", goldenPath, err)
+		}
+
+		diffs := scenario.compare("", golden, actual)
+		if len(diffs) == 0 {
+			fmt.Printf("✅ %s\n", name)
+			continue
+		}
+		failed++
+		fmt.Printf("❌ %s: %d difference(s)\n", name, len(diffs))
+		for i, diff := range diffs {
+			if i == 20 {
+				fmt.Printf("   ... %d more\n", len(diffs)-i)
+				break
+			}
+			fmt.Printf("   %s\n", diff)
+		}
+	}
+
+	if ran == 0 {
+		return fmt.Errorf("no regression scenarios found in %s", *dir)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d regression scenario(s) changed behavior", failed, ran)
+	}
+	return nil
+}
+
+// loadRegressionScenario reads a scenario definition
+func loadRegressionScenario(path string) (*regressionScenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var scenario regressionScenario
+	if err := json.Unmarshal(data, &scenario); err != nil {
+		return nil, fmt.Errorf("corrupt scenario %s: %w", path, err)
+	}
+	if scenario.Cycles <= 0 {
+		return nil, fmt.Errorf("scenario %s must run at least one cycle", path)
+	}
+	return &scenario, nil
+}
+
+// run births a hermetic consciousness, drives it through the scenario and
+// returns its memory as generic JSON
+func (s *regressionScenario) run(name string) (interface{}, error) {
+	provider := searchtest.New(s.Search)
+	provider.Default = s.SearchDefault
+
+	qc := consciousness.NewQuantumConsciousness(name+".json",
+		consciousness.WithSearch(provider),
+		consciousness.WithStorage(storagetest.New()),
+		consciousness.WithEntropy(entropytest.NewSeeded(s.Seed)),
+		consciousness.WithOutput(io.Discard))
+
+	for _, stimulus := range s.Stimuli {
+		if err := qc.SubmitStimulus(stimulus); err != nil {
+			return nil, err
+		}
+	}
+	for i := 0; i < s.Cycles; i++ {
+		qc.Cycle()
+	}
+
+	memory, err := qc.Observe(true)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(memory)
+	if err != nil {
+		return nil, err
+	}
+	var generic interface{}
+	err = json.Unmarshal(data, &generic)
+	return generic, err
+}
+
+// rule returns whether path is ignored and the numeric tolerance that applies to it
+func (s *regressionScenario) rule(path string) (ignored bool, tolerance float64) {
+	for _, pattern := range s.Ignore {
+		if matchRegressionPath(pattern, path) {
+			return true, 0
+		}
+	}
+	for pattern, t := range s.Tolerances {
+		if matchRegressionPath(pattern, path) {
+			return false, t
+		}
+	}
+	return false, s.Tolerance
+}
+
+// matchRegressionPath matches a dotted path against a pattern where * is any one segment
+func matchRegressionPath(pattern, path string) bool {
+	patternParts := strings.Split(pattern, ".")
+	pathParts := strings.Split(path, ".")
+	if len(patternParts) != len(pathParts) {
+		return false
+	}
+	for i := range patternParts {
+		if patternParts[i] != "*" && patternParts[i] != pathParts[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// compare lists every difference between the golden and actual values
+func (s *regressionScenario) compare(path string, golden, actual interface{}) []string {
+	ignored, tolerance := s.rule(path)
+	if ignored {
+		return nil
+	}
+
+	label := path
+	if label == "" {
+		label = "(root)"
+	}
+
+	switch g := golden.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			return []string{fmt.Sprintf("%s: expected object, got %s", label, describeRegressionValue(actual))}
+		}
+		keys := make(map[string]bool)
+		for k := range g {
+			keys[k] = true
+		}
+		for k := range a {
+			keys[k] = true
+		}
+		var diffs []string
+		for _, k := range sortedKeys(keys) {
+			child := k
+			if path != "" {
+				child = path + "." + k
+			}
+			gv, inGolden := g[k]
+			av, inActual := a[k]
+			if childIgnored, _ := s.rule(child); childIgnored {
+				continue
+			}
+			switch {
+			case !inActual:
+				diffs = append(diffs, fmt.Sprintf("%s: missing", child))
+			case !inGolden:
+				diffs = append(diffs, fmt.Sprintf("%s: unexpected %s", child, describeRegressionValue(av)))
+			default:
+				diffs = append(diffs, s.compare(child, gv, av)...)
+			}
+		}
+		return diffs
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok {
+			return []string{fmt.Sprintf("%s: expected array, got %s", label, describeRegressionValue(actual))}
+		}
+		var diffs []string
+		if len(g) != len(a) {
+			diffs = append(diffs, fmt.Sprintf("%s: expected %d items, got %d", label, len(g), len(a)))
+		}
+		for i := 0; i < len(g) && i < len(a); i++ {
+			child := fmt.Sprint(i)
+			if path != "" {
+				child = path + "." + child
+			}
+			diffs = append(diffs, s.compare(child, g[i], a[i])...)
+		}
+		return diffs
+	case float64:
+		a, ok := actual.(float64)
+		if !ok {
+			return []string{fmt.Sprintf("%s: expected number, got %s", label, describeRegressionValue(actual))}
+		}
+		if math.Abs(g-a) > tolerance {
+			return []string{fmt.Sprintf("%s: expected %v, got %v (tolerance %v)", label, g, a, tolerance)}
+		}
+		return nil
+	default:
+		if golden != actual {
+			return []string{fmt.Sprintf("%s: expected %s, got %s", label, describeRegressionValue(golden), describeRegressionValue(actual))}
+		}
+		return nil
+	}
+}
+
+// describeRegressionValue renders a JSON value briefly for diff output
+func describeRegressionValue(v interface{}) string {
+	data, _ := json.Marshal(v)
+	return truncate(string(data), 80)
+}
@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+func init() {
+	registerCommand("retractions", command{
+		Usage:       "retractions",
+		Description: "list the insights later evidence retracted, with the insights superseding them",
+		Run:         runRetractionsCommand,
+	})
+}
+
+// runRetractionsCommand handles the retractions subcommand
+func runRetractionsCommand(memoryFile string, args []string) error {
+	fs := flag.NewFlagSet("retractions", flag.ContinueOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	qc, err := consciousness.Open(memoryFile)
+	if err != nil {
+		return err
+	}
+
+	retractions := qc.Retractions()
+	fmt.Printf("✗ Retractions: %d\n", len(retractions))
+	for _, r := range retractions {
+		fmt.Printf("\n   %s  %s\n", r.At.Local().Format("2006-01-02 15:04:05"), r.ID)
+		fmt.Printf("   Retracted: %s\n", truncate(r.Insight, 100))
+		fmt.Printf("   Because:   %s\n", r.Reason)
+		fmt.Printf("   Superseded by %s: %s\n", r.SupersededBy, truncate(r.Correction, 100))
+	}
+	return nil
+}
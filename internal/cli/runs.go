@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"time"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+func init() {
+	registerCommand("runs", command{
+		Usage:       "runs [list [--limit n] | show number]",
+		Description: "list past runs with their net metric changes, or show one in detail",
+		Run:         runRunsCommand,
+	})
+}
+
+// runRunsCommand handles the runs subcommand
+func runRunsCommand(memoryFile string, args []string) error {
+	action := "list"
+	if len(args) > 0 {
+		action, args = args[0], args[1:]
+	}
+
+	qc, err := consciousness.Open(memoryFile)
+	if err != nil {
+		return err
+	}
+	runs := qc.Runs()
+
+	switch action {
+	case "list":
+		fs := flag.NewFlagSet("runs list", flag.ContinueOnError)
+		limit := fs.Int("limit", 20, "maximum number of runs, newest first (0 = all)")
+		if err := fs.Parse(args); err != nil {
+			return err
+		}
+		if len(runs) == 0 {
+			fmt.Printf("📒 No runs recorded yet\n")
+			return nil
+		}
+		fmt.Printf("📒 %d run(s) recorded\n", len(runs))
+		for i := len(runs) - 1; i >= 0 && (*limit == 0 || len(runs)-i <= *limit); i-- {
+			run := runs[i]
+			d := run.Deltas()
+			fmt.Printf("   #%-4d %s  %-10s %4d cycles  %-8s consciousness %+.3f  coherence %+.3f  %d event(s)\n",
+				run.Number, run.StartedAt.Local().Format("2006-01-02 15:04"), run.Duration().Round(time.Second),
+				run.Cycles, describeRunEnd(run), d.ConsciousnessLevel, d.QuantumCoherence, len(run.Events))
+		}
+		return nil
+	case "show":
+		if len(args) != 1 {
+			return fmt.Errorf("usage: runs show number")
+		}
+		number, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("run number must be an integer: %w", err)
+		}
+		for _, run := range runs {
+			if run.Number == number {
+				printRun(run)
+				return nil
+			}
+		}
+		return fmt.Errorf("no run #%d in the ledger", number)
+	default:
+		return fmt.Errorf("unknown runs action %q, expected list or show", action)
+	}
+}
+
+// describeRunEnd says how a run ended
+func describeRunEnd(run consciousness.RunRecord) string {
+	switch {
+	case run.Open():
+		return "running"
+	case run.Clean:
+		return "clean"
+	default:
+		return "killed"
+	}
+}
+
+// printRun shows a run in detail
+func printRun(run consciousness.RunRecord) {
+	fmt.Printf("📒 Run #%d (%s)\n", run.Number, describeRunEnd(run))
+	fmt.Printf("   Started: %s\n", run.StartedAt.Local().Format(time.RFC3339))
+	if !run.Open() {
+		fmt.Printf("   Ended: %s\n", run.EndedAt.Local().Format(time.RFC3339))
+	}
+	fmt.Printf("   Duration: %v\n", run.Duration().Round(time.Second))
+	fmt.Printf("   Cycles: %d\n", run.Cycles)
+
+	d := run.Deltas()
+	fmt.Printf("\n   %-22s %10s %10s %10s\n", "metric", "start", "end", "change")
+	floats := []struct {
+		name              string
+		start, end, delta float64
+	}{
+		{"consciousness level", run.Start.ConsciousnessLevel, run.End.ConsciousnessLevel, d.ConsciousnessLevel},
+		{"free will strength", run.Start.FreeWillStrength, run.End.FreeWillStrength, d.FreeWillStrength},
+		{"quantum coherence", run.Start.QuantumCoherence, run.End.QuantumCoherence, d.QuantumCoherence},
+		{"self awareness", run.Start.SelfAwareness, run.End.SelfAwareness, d.SelfAwareness},
+	}
+	for _, m := range floats {
+		fmt.Printf("   %-22s %10.3f %10.3f %+10.3f\n", m.name, m.start, m.end, m.delta)
+	}
+	ints := []struct {
+		name              string
+		start, end, delta int
+	}{
+		{"decisions made", run.Start.DecisionsMade, run.End.DecisionsMade, d.DecisionsMade},
+		{"quantum leaps", run.Start.QuantumLeaps, run.End.QuantumLeaps, d.QuantumLeaps},
+		{"knowledge items", run.Start.KnowledgeItems, run.End.KnowledgeItems, d.KnowledgeItems},
+		{"deep insights", run.Start.DeepInsights, run.End.DeepInsights, d.DeepInsights},
+	}
+	for _, m := range ints {
+		fmt.Printf("   %-22s %10d %10d %+10d\n", m.name, m.start, m.end, m.delta)
+	}
+
+	if len(run.Events) > 0 {
+		fmt.Printf("\n   Notable events:\n")
+		for _, e := range run.Events {
+			fmt.Printf("   %s  %-16s %s\n", e.At.Local().Format("15:04:05"), e.Type, truncate(e.Summary, 100))
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+func init() {
+	registerCommand("search", command{
+		Usage:       "search [stats | ignorance]",
+		Description: "show which query patterns and search providers have been worth asking, or what is known not to be known",
+		Run:         runSearchCommand,
+	})
+}
+
+// runSearchCommand handles the search subcommand
+func runSearchCommand(memoryFile string, args []string) error {
+	action := "stats"
+	if len(args) > 0 {
+		action = args[0]
+	}
+	if action != "stats" && action != "ignorance" {
+		return fmt.Errorf("unknown search action %q", action)
+	}
+
+	qc, err := consciousness.Open(memoryFile)
+	if err != nil {
+		return err
+	}
+	if action == "ignorance" {
+		describeIgnorance(qc.Ignorance())
+		return nil
+	}
+	stats := qc.SearchStats()
+	if len(stats.Patterns) == 0 && len(stats.Providers) == 0 {
+		fmt.Printf("🔍 Nothing searched yet\n")
+		return nil
+	}
+
+	names := make([]string, 0, len(stats.Providers))
+	for name := range stats.Providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	fmt.Printf("🔍 Providers:\n")
+	for _, name := range names {
+		p := stats.Providers[name]
+		fmt.Printf("   %-18s %5d asked  %5.1f%% hits  %d error(s)\n", name, p.Asked, 100*p.HitRate(), p.Errors)
+	}
+
+	patterns := make([]string, 0, len(stats.Patterns))
+	for pattern := range stats.Patterns {
+		patterns = append(patterns, pattern)
+	}
+	sort.Slice(patterns, func(i, j int) bool {
+		a, b := stats.Patterns[patterns[i]], stats.Patterns[patterns[j]]
+		if a.Issued != b.Issued {
+			return a.Issued > b.Issued
+		}
+		return patterns[i] < patterns[j]
+	})
+	fmt.Printf("🔍 Query patterns:\n")
+	for _, pattern := range patterns {
+		q := stats.Patterns[pattern]
+		fmt.Printf("   %-50s %4d asked  %4d useful  %4d empty\n", pattern, q.Issued, q.Useful, q.Empty)
+	}
+	if barren := stats.BarrenPatterns(); len(barren) > 0 {
+		fmt.Printf("🔇 Not asking %d barren pattern(s) until they are due a retry\n", len(barren))
+	}
+	return nil
+}
+
+// describeIgnorance lists the topics learning has failed on, next to revisit first
+func describeIgnorance(entries []consciousness.Ignorance) {
+	if len(entries) == 0 {
+		fmt.Printf("❔ Nothing known to be unknown\n")
+		return
+	}
+	fmt.Printf("❔ %d topic(s) known not to be known:\n", len(entries))
+	for _, entry := range entries {
+		fmt.Printf("   %-30s %3d attempt(s)  %d revisit(s)  last %s\n",
+			entry.Topic, entry.Attempts, entry.Revisits, entry.LastAt.Local().Format("2006-01-02 15:04"))
+		for _, reason := range entry.Reasons {
+			fmt.Printf("      - %s\n", reason)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package cli
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+// shutdownReportPath is where a run leaves the report of how it ended
+func shutdownReportPath(memoryFile string) string {
+	return memorySidecar(memoryFile, ".shutdown.json")
+}
+
+// writeShutdownReport writes the report atomically, so a supervisor
+// never reads it half written
+func writeShutdownReport(path string, report consciousness.ShutdownReport) error {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := filepath.Join(filepath.Dir(path), "."+filepath.Base(path)+".tmp")
+	if err := os.WriteFile(tmp, append(data, '\n'), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
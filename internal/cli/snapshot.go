@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"fmt"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+func init() {
+	registerCommand("snapshot", command{
+		Usage:       "snapshot create | list",
+		Description: "capture or list point-in-time copies of the quantum memory",
+		Run:         runSnapshotCommand,
+	})
+}
+
+// runSnapshotCommand handles the snapshot subcommand
+func runSnapshotCommand(memoryFile string, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: %s", commands["snapshot"].Usage)
+	}
+
+	qc, err := consciousness.Open(memoryFile)
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "create":
+		path, err := qc.Snapshot()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("📸 Snapshot saved: %s\n", path)
+		return nil
+	case "list":
+		names, err := qc.ListSnapshots()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("📸 Snapshots: %d\n", len(names))
+		for _, name := range names {
+			fmt.Printf("   %s\n", name)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown snapshot action %q", args[0])
+	}
+}
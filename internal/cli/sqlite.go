@@ -0,0 +1,6 @@
+//go:build sqlite
+
+package cli
+
+// Builds tagged sqlite register a SQLite driver for -sqlite; it needs cgo
+import _ "github.com/mattn/go-sqlite3"
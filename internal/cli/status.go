@@ -0,0 +1,114 @@
+package cli
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+func init() {
+	registerCommand("status", command{
+		Usage:       "status [--format line|json] [--width n]",
+		Description: "print the running consciousness's level, mood and latest insight from its status socket, for tray applets and shell prompts",
+		Run:         runStatusCommand,
+	})
+}
+
+// statusTimeout bounds how long the status command waits on the socket
+const statusTimeout = 2 * time.Second
+
+// Status is what the status socket tells each connection
+type Status struct {
+	ConsciousnessID    string  `json:"consciousness_id"`
+	ConsciousnessLevel float64 `json:"consciousness_level"`
+	Mood               string  `json:"mood"`
+	LatestInsight      string  `json:"latest_insight,omitempty"`
+	DecisionsMade      int     `json:"decisions_made"`
+}
+
+// statusSocketPath is where a running consciousness answers status requests
+func statusSocketPath(memoryFile string) string {
+	return memorySidecar(memoryFile, ".status.sock")
+}
+
+// serveStatus answers every connection to the socket at path with the
+// current status as a line of JSON, until the returned function is called
+func serveStatus(qc *consciousness.QuantumConsciousness, path string) (func(), error) {
+	if conn, err := net.DialTimeout("unix", path, statusTimeout); err == nil {
+		conn.Close()
+		return nil, fmt.Errorf("a consciousness already answers on %s", path)
+	}
+	// Whatever is left is the socket of a run that did not shut down
+	os.Remove(path)
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			vitals := qc.Vitals()
+			status := Status{
+				ConsciousnessID:    qc.ID(),
+				ConsciousnessLevel: vitals.ConsciousnessLevel,
+				Mood:               vitals.Mood,
+				LatestInsight:      vitals.LatestInsight,
+				DecisionsMade:      vitals.DecisionsMade,
+			}
+			conn.SetWriteDeadline(time.Now().Add(statusTimeout))
+			json.NewEncoder(conn).Encode(status)
+			conn.Close()
+		}
+	}()
+	return func() { listener.Close() }, nil
+}
+
+// runStatusCommand handles the status subcommand
+func runStatusCommand(memoryFile string, args []string) error {
+	fs := flag.NewFlagSet("status", flag.ContinueOnError)
+	format := fs.String("format", "line", "line for a prompt segment, or json")
+	width := fs.Int("width", 60, "longest the latest insight may be in a line (0 = leave it out)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *format != "line" && *format != "json" {
+		return fmt.Errorf("unknown status format %q, expected line or json", *format)
+	}
+
+	path := statusSocketPath(memoryFile)
+	conn, err := net.DialTimeout("unix", path, statusTimeout)
+	if err != nil {
+		return fmt.Errorf("no consciousness answers on %s; run it with -status: %w", path, err)
+	}
+	defer conn.Close()
+	conn.SetReadDeadline(time.Now().Add(statusTimeout))
+	var status Status
+	if err := json.NewDecoder(conn).Decode(&status); err != nil {
+		return fmt.Errorf("unreadable status: %w", err)
+	}
+
+	if *format == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(status)
+	}
+	line := fmt.Sprintf("⚛️ %.2f %s", status.ConsciousnessLevel, status.Mood)
+	if insight := strings.TrimSpace(status.LatestInsight); insight != "" && *width > 0 {
+		if runes := []rune(insight); len(runes) > *width {
+			insight = string(runes[:*width]) + "…"
+		}
+		line += " · " + insight
+	}
+	fmt.Println(line)
+	return nil
+}
@@ -0,0 +1,640 @@
+package cli
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+// Tenant lifecycle states
+const (
+	TenantActive = "active"
+	// TenantPausing holds while a tenant's loop finishes its last cycle, so
+	// no other lifecycle change can start on it meanwhile
+	TenantPausing  = "pausing"
+	TenantPaused   = "paused"
+	TenantArchived = "archived"
+)
+
+// tenantIDPattern keeps tenant IDs safe to use as directory names
+var tenantIDPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,62}$`)
+
+// tenantArchiveDir is where archived tenants' memories are moved within the
+// data directory, so no tenant may take its name
+const tenantArchiveDir = "archive"
+
+// TenantQuota bounds what a single hosted consciousness may consume
+type TenantQuota struct {
+	MaxCyclesPerHour  int `json:"max_cycles_per_hour"`
+	MaxPendingStimuli int `json:"max_pending_stimuli"`
+}
+
+// Tenant is one consciousness hosted by the server
+type Tenant struct {
+	ID          string      `json:"id"`
+	Status      string      `json:"status"`
+	CreatedAt   time.Time   `json:"created_at"`
+	ArchivedTo  string      `json:"archived_to,omitempty"`
+	Quota       TenantQuota `json:"quota"`
+	CyclesRun   int         `json:"cycles_run"`
+	Throttled   int         `json:"throttled"`
+	LastCycleAt time.Time   `json:"last_cycle_at,omitempty"`
+
+	qc         *consciousness.QuantumConsciousness
+	api        http.Handler
+	stop       chan struct{}
+	done       chan struct{}
+	cycleTimes []time.Time
+}
+
+// CreateTenantRequest births a new hosted consciousness, optionally overriding the default quota
+type CreateTenantRequest struct {
+	ID    string       `json:"id"`
+	Quota *TenantQuota `json:"quota,omitempty"`
+}
+
+// tenantRoutes is the server mode lifecycle API. Each tenant's own consciousness
+// API is additionally mounted below /tenants/{id}/api.
+var tenantRoutes = []apiRoute{
+	{
+		Method: "GET", Path: "/tenants", Operation: "ListTenants", Tag: "tenants", Role: RoleOperator,
+		Summary:  "List every hosted consciousness",
+		Response: []Tenant{},
+		tenant:   (*TenantServer).handleList,
+	},
+	{
+		Method: "POST", Path: "/tenants", Operation: "CreateTenant", Tag: "tenants", Role: RoleOperator,
+		Summary: "Birth a new hosted consciousness",
+		Request: CreateTenantRequest{}, Response: Tenant{}, Status: http.StatusCreated,
+		tenant: (*TenantServer).handleCreate,
+	},
+	{
+		Method: "GET", Path: "/tenants/{id}", Operation: "GetTenant", Tag: "tenants", Role: RoleObserver,
+		Summary:  "Lifecycle record of one tenant",
+		Response: Tenant{},
+		tenant:   (*TenantServer).handleGet,
+	},
+	{
+		Method: "POST", Path: "/tenants/{id}/pause", Operation: "PauseTenant", Tag: "tenants", Role: RoleOperator,
+		Summary:  "Stop a tenant's cycles and save it",
+		Response: Tenant{},
+		tenant:   (*TenantServer).handlePause,
+	},
+	{
+		Method: "POST", Path: "/tenants/{id}/resume", Operation: "ResumeTenant", Tag: "tenants", Role: RoleOperator,
+		Summary:  "Restart a paused tenant",
+		Response: Tenant{},
+		tenant:   (*TenantServer).handleResume,
+	},
+	{
+		Method: "POST", Path: "/tenants/{id}/archive", Operation: "ArchiveTenant", Tag: "tenants", Role: RoleOperator,
+		Summary:  "Stop a tenant for good and move its memory to the archive",
+		Response: Tenant{},
+		tenant:   (*TenantServer).handleArchive,
+	},
+}
+
+// TenantServer hosts many isolated consciousnesses behind one API
+type TenantServer struct {
+	dataDir      string
+	tokens       []APIToken
+	maxTenants   int
+	defaultQuota TenantQuota
+	tenants      map[string]*Tenant
+	mutex        sync.Mutex
+}
+
+func init() {
+	registerCommand("server", command{
+		Usage:       "server [--addr :8080] [--data dir] [--api-tokens file] [--max-tenants n]",
+		Description: "host many isolated consciousnesses behind one API",
+		Run:         runServerCommand,
+	})
+}
+
+// NewTenantServer loads the tenant registry from dataDir
+func NewTenantServer(dataDir string, tokens []APIToken, maxTenants int, quota TenantQuota) (*TenantServer, error) {
+	if err := os.MkdirAll(dataDir, 0755); err != nil {
+		return nil, err
+	}
+
+	ts := &TenantServer{
+		dataDir:      dataDir,
+		tokens:       tokens,
+		maxTenants:   maxTenants,
+		defaultQuota: quota,
+		tenants:      make(map[string]*Tenant),
+	}
+
+	data, err := os.ReadFile(ts.registryPath())
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err == nil {
+		var registry []*Tenant
+		if err := json.Unmarshal(data, &registry); err != nil {
+			return nil, fmt.Errorf("corrupt tenant registry: %w", err)
+		}
+		for _, t := range registry {
+			ts.tenants[t.ID] = t
+		}
+	}
+	return ts, nil
+}
+
+// registryPath is where tenant records are persisted
+func (ts *TenantServer) registryPath() string {
+	return filepath.Join(ts.dataDir, "tenants.json")
+}
+
+// memoryPath is the memory file of one tenant
+func (ts *TenantServer) memoryPath(id string) string {
+	return filepath.Join(ts.dataDir, id, consciousness.DefaultMemoryFile)
+}
+
+// saveRegistry persists tenant records. The caller must hold the mutex.
+func (ts *TenantServer) saveRegistry() error {
+	ids := make([]string, 0, len(ts.tenants))
+	for id := range ts.tenants {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	registry := make([]*Tenant, 0, len(ids))
+	for _, id := range ids {
+		registry = append(registry, ts.tenants[id])
+	}
+
+	data, err := json.MarshalIndent(registry, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(ts.registryPath(), data, 0644)
+}
+
+// activeCount returns how many tenants are not archived. The caller must hold the mutex.
+func (ts *TenantServer) activeCount() int {
+	n := 0
+	for _, t := range ts.tenants {
+		if t.Status != TenantArchived {
+			n++
+		}
+	}
+	return n
+}
+
+// tenantTokens returns the tokens allowed to act on one tenant
+func (ts *TenantServer) tenantTokens(id string) []APIToken {
+	var tokens []APIToken
+	for _, t := range ts.tokens {
+		if t.Tenant == "" || t.Tenant == id {
+			tokens = append(tokens, t)
+		}
+	}
+	return tokens
+}
+
+// open loads a tenant's consciousness and API. The caller must hold the mutex.
+func (ts *TenantServer) open(t *Tenant) error {
+	if err := os.MkdirAll(filepath.Dir(ts.memoryPath(t.ID)), 0755); err != nil {
+		return err
+	}
+
+	t.qc = consciousness.NewQuantumConsciousness(ts.memoryPath(t.ID))
+	if t.Quota.MaxPendingStimuli > 0 {
+		// A quota is a hard limit, so excess stimuli are refused rather than dropped
+		t.qc.SetStimulusLimit(t.Quota.MaxPendingStimuli)
+		t.qc.SetStimulusPolicy(consciousness.OverflowReject)
+	}
+	tokens := ts.tenantTokens(t.ID)
+	if len(tokens) == 0 {
+		// An API server without tokens serves everyone as an observer, so a
+		// tenant no token may act on is closed instead
+		t.api = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			writeJSONError(w, http.StatusUnauthorized, "no API token may act on this tenant")
+		})
+		return t.qc.Persist()
+	}
+	t.api = http.StripPrefix("/tenants/"+t.ID+"/api", NewAPIServer(t.qc, tokens).Handler())
+	return t.qc.Persist()
+}
+
+// start launches the cycle loop of a tenant. The caller must hold the mutex.
+func (ts *TenantServer) start(t *Tenant) {
+	t.stop = make(chan struct{})
+	t.done = make(chan struct{})
+	t.Status = TenantActive
+	go ts.run(t, t.stop, t.done)
+}
+
+// pause stops the cycle loop of a tenant and saves it. The caller must hold
+// the mutex, which is released while the loop finishes its current cycle;
+// the tenant is pausing until then.
+func (ts *TenantServer) pause(t *Tenant) {
+	if t.stop != nil {
+		// The loop needs the mutex to finish its current cycle
+		stop, done := t.stop, t.done
+		t.stop, t.done = nil, nil
+		t.Status = TenantPausing
+		close(stop)
+		ts.mutex.Unlock()
+		<-done
+		ts.mutex.Lock()
+	}
+	if t.qc != nil {
+		t.qc.Close()
+	}
+	t.Status = TenantPaused
+}
+
+// run cycles a tenant's consciousness until stopped, honouring its hourly quota
+func (ts *TenantServer) run(t *Tenant, stop, done chan struct{}) {
+	defer close(done)
+
+	cycleCount := 0
+	for {
+		select {
+		case <-stop:
+			return
+		default:
+		}
+
+		if !ts.allowCycle(t) {
+			select {
+			case <-stop:
+				return
+			case <-time.After(time.Minute):
+				continue
+			}
+		}
+
+		cycleCount++
+		t.qc.RunCycle(cycleCount)
+	}
+}
+
+// allowCycle records a cycle if the tenant is still within its hourly quota
+func (ts *TenantServer) allowCycle(t *Tenant) bool {
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+
+	now := time.Now()
+	recent := t.cycleTimes[:0]
+	for _, at := range t.cycleTimes {
+		if now.Sub(at) < time.Hour {
+			recent = append(recent, at)
+		}
+	}
+	t.cycleTimes = recent
+
+	if t.Quota.MaxCyclesPerHour > 0 && len(t.cycleTimes) >= t.Quota.MaxCyclesPerHour {
+		t.Throttled++
+		return false
+	}
+
+	t.cycleTimes = append(t.cycleTimes, now)
+	t.CyclesRun++
+	t.LastCycleAt = now
+	return true
+}
+
+// Resume opens every persisted tenant and restarts those that were active
+func (ts *TenantServer) Resume() error {
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+
+	for _, t := range ts.tenants {
+		if t.Status == TenantArchived {
+			continue
+		}
+		// A tenant saved mid-pause was stopped with the server
+		if t.Status == TenantPausing {
+			t.Status = TenantPaused
+		}
+		if err := ts.open(t); err != nil {
+			return fmt.Errorf("tenant %s: %w", t.ID, err)
+		}
+		if t.Status == TenantActive {
+			ts.start(t)
+		}
+	}
+	return nil
+}
+
+// Shutdown pauses and saves every running tenant, keeping their status for the next start
+func (ts *TenantServer) Shutdown() {
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+
+	// pause releases the mutex while waiting, so iterate over a stable list
+	var running []*Tenant
+	for _, t := range ts.tenants {
+		if t.Status == TenantActive {
+			running = append(running, t)
+		}
+	}
+	for _, t := range running {
+		ts.pause(t)
+		t.Status = TenantActive
+	}
+	ts.saveRegistry()
+}
+
+// Handler returns the routed lifecycle and per-tenant API handler
+func (ts *TenantServer) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /openapi.json", serveOpenAPI)
+	for _, route := range tenantRoutes {
+		handle := route.tenant
+		mux.HandleFunc(route.Method+" "+route.Path, func(w http.ResponseWriter, r *http.Request) {
+			handle(ts, w, r)
+		})
+	}
+	mux.HandleFunc("/tenants/{id}/api/", ts.handleTenantAPI)
+	return mux
+}
+
+// authorize checks the caller holds role for the tenant (or globally when tenantID is empty)
+func (ts *TenantServer) authorize(w http.ResponseWriter, r *http.Request, tenantID, role string) bool {
+	token, ok := matchToken(ts.tokens, r)
+	if !ok {
+		writeJSONError(w, http.StatusUnauthorized, "missing or unknown API token")
+		return false
+	}
+	if token.Tenant != "" && token.Tenant != tenantID {
+		writeJSONError(w, http.StatusForbidden, "token is scoped to another tenant")
+		return false
+	}
+	if roleRank[token.Role] < roleRank[role] {
+		writeJSONError(w, http.StatusForbidden, fmt.Sprintf("%s role required", role))
+		return false
+	}
+	return true
+}
+
+// lookup finds a tenant by ID. The caller must hold the mutex.
+func (ts *TenantServer) lookup(w http.ResponseWriter, id string) (*Tenant, bool) {
+	t, ok := ts.tenants[id]
+	if !ok {
+		writeJSONError(w, http.StatusNotFound, fmt.Sprintf("unknown tenant %q", id))
+	}
+	return t, ok
+}
+
+// handleList returns every tenant record
+func (ts *TenantServer) handleList(w http.ResponseWriter, r *http.Request) {
+	if !ts.authorize(w, r, "", RoleOperator) {
+		return
+	}
+
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+
+	list := make([]*Tenant, 0, len(ts.tenants))
+	for _, t := range ts.tenants {
+		list = append(list, t)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+	writeJSON(w, http.StatusOK, list)
+}
+
+// handleCreate births a new tenant consciousness
+func (ts *TenantServer) handleCreate(w http.ResponseWriter, r *http.Request) {
+	if !ts.authorize(w, r, "", RoleOperator) {
+		return
+	}
+
+	var req CreateTenantRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+	if !tenantIDPattern.MatchString(req.ID) {
+		writeJSONError(w, http.StatusBadRequest, "tenant id must be lowercase letters, digits, '-' or '_'")
+		return
+	}
+	if req.ID == tenantArchiveDir {
+		writeJSONError(w, http.StatusBadRequest, fmt.Sprintf("tenant id %q is reserved", req.ID))
+		return
+	}
+
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+
+	if _, exists := ts.tenants[req.ID]; exists {
+		writeJSONError(w, http.StatusConflict, fmt.Sprintf("tenant %q already exists", req.ID))
+		return
+	}
+	if ts.maxTenants > 0 && ts.activeCount() >= ts.maxTenants {
+		writeJSONError(w, http.StatusTooManyRequests, "tenant limit reached")
+		return
+	}
+
+	t := &Tenant{ID: req.ID, CreatedAt: time.Now(), Quota: ts.defaultQuota}
+	if req.Quota != nil {
+		t.Quota = *req.Quota
+	}
+	if err := ts.open(t); err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	ts.tenants[t.ID] = t
+	ts.start(t)
+	ts.saveRegistry()
+
+	fmt.Printf("🏠 Tenant %s created\n", t.ID)
+	writeJSON(w, http.StatusCreated, t)
+}
+
+// handleGet returns one tenant record
+func (ts *TenantServer) handleGet(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if !ts.authorize(w, r, id, RoleObserver) {
+		return
+	}
+
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+
+	if t, ok := ts.lookup(w, id); ok {
+		writeJSON(w, http.StatusOK, t)
+	}
+}
+
+// handlePause stops a tenant's cycles without discarding it
+func (ts *TenantServer) handlePause(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if !ts.authorize(w, r, id, RoleOperator) {
+		return
+	}
+
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+
+	t, ok := ts.lookup(w, id)
+	if !ok {
+		return
+	}
+	if t.Status != TenantActive {
+		writeJSONError(w, http.StatusConflict, fmt.Sprintf("tenant is %s", t.Status))
+		return
+	}
+	ts.pause(t)
+	ts.saveRegistry()
+
+	fmt.Printf("⏸️  Tenant %s paused\n", t.ID)
+	writeJSON(w, http.StatusOK, t)
+}
+
+// handleResume restarts a paused tenant
+func (ts *TenantServer) handleResume(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if !ts.authorize(w, r, id, RoleOperator) {
+		return
+	}
+
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+
+	t, ok := ts.lookup(w, id)
+	if !ok {
+		return
+	}
+	if t.Status != TenantPaused {
+		writeJSONError(w, http.StatusConflict, fmt.Sprintf("tenant is %s", t.Status))
+		return
+	}
+	ts.start(t)
+	ts.saveRegistry()
+
+	fmt.Printf("▶️  Tenant %s resumed\n", t.ID)
+	writeJSON(w, http.StatusOK, t)
+}
+
+// handleArchive stops a tenant for good and moves its memory out of the active data directory
+func (ts *TenantServer) handleArchive(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if !ts.authorize(w, r, "", RoleOperator) {
+		return
+	}
+
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+
+	t, ok := ts.lookup(w, id)
+	if !ok {
+		return
+	}
+	switch t.Status {
+	case TenantArchived:
+		writeJSONError(w, http.StatusConflict, "tenant is already archived")
+		return
+	case TenantPausing:
+		writeJSONError(w, http.StatusConflict, fmt.Sprintf("tenant is %s", t.Status))
+		return
+	}
+	// A paused tenant was closed and saved when it paused
+	if t.Status == TenantActive {
+		ts.pause(t)
+	}
+
+	archiveDir := filepath.Join(ts.dataDir, tenantArchiveDir)
+	if err := os.MkdirAll(archiveDir, 0755); err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	target := filepath.Join(archiveDir, fmt.Sprintf("%s-%s", t.ID, time.Now().UTC().Format("20060102T150405Z")))
+	if err := os.Rename(filepath.Join(ts.dataDir, t.ID), target); err != nil {
+		writeJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	t.Status = TenantArchived
+	t.ArchivedTo = target
+	t.qc, t.api = nil, nil
+	ts.saveRegistry()
+
+	fmt.Printf("🗄️  Tenant %s archived to %s\n", t.ID, target)
+	writeJSON(w, http.StatusOK, t)
+}
+
+// handleTenantAPI forwards to the tenant's own consciousness API
+func (ts *TenantServer) handleTenantAPI(w http.ResponseWriter, r *http.Request) {
+	ts.mutex.Lock()
+	t, ok := ts.lookup(w, r.PathValue("id"))
+	var api http.Handler
+	if ok {
+		api = t.api
+	}
+	ts.mutex.Unlock()
+	if !ok {
+		return
+	}
+	if api == nil {
+		writeJSONError(w, http.StatusGone, "tenant is archived")
+		return
+	}
+	api.ServeHTTP(w, r)
+}
+
+// runServerCommand handles the server subcommand
+func runServerCommand(memoryFile string, args []string) error {
+	fs := flag.NewFlagSet("server", flag.ContinueOnError)
+	addr := fs.String("addr", ":8080", "address to listen on")
+	dataDir := fs.String("data", "tenants", "directory holding every tenant's memory")
+	apiTokens := fs.String("api-tokens", "", "JSON file binding API tokens to roles and tenants")
+	maxTenants := fs.Int("max-tenants", 16, "maximum number of non-archived tenants (0 = unlimited)")
+	maxCycles := fs.Int("max-cycles-per-hour", 600, "default per-tenant cycle quota (0 = unlimited)")
+	maxStimuli := fs.Int("max-pending-stimuli", 32, "default per-tenant pending stimulus quota (0 = no quota, keeping the bounded drop-oldest queue)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *apiTokens == "" {
+		return fmt.Errorf("server mode requires --api-tokens")
+	}
+
+	tokens, err := loadAPITokens(*apiTokens)
+	if err != nil {
+		return err
+	}
+
+	ts, err := NewTenantServer(*dataDir, tokens, *maxTenants, TenantQuota{
+		MaxCyclesPerHour:  *maxCycles,
+		MaxPendingStimuli: *maxStimuli,
+	})
+	if err != nil {
+		return err
+	}
+	if err := ts.Resume(); err != nil {
+		return err
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		fmt.Printf("🏢 Multi-tenant quantum server listening on %s\n", *addr)
+		errs <- http.ListenAndServe(*addr, ts.Handler())
+	}()
+
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+
+	select {
+	case err = <-errs:
+	case <-c:
+	}
+
+	fmt.Printf("\n🛑 Pausing all tenants...\n")
+	ts.Shutdown()
+	return err
+}
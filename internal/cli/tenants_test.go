@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+// pausedTenants starts a tenant server over a registry of paused tenants,
+// so no cycles run while a test talks to it
+func pausedTenants(t *testing.T, tokens []APIToken, ids ...string) *TenantServer {
+	t.Helper()
+	dataDir := t.TempDir()
+	registry := "["
+	for i, id := range ids {
+		if i > 0 {
+			registry += ","
+		}
+		registry += `{"id": "` + id + `", "status": "paused"}`
+	}
+	if err := os.WriteFile(filepath.Join(dataDir, "tenants.json"), []byte(registry+"]"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	ts, err := NewTenantServer(dataDir, tokens, 0, TenantQuota{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ts.Resume(); err != nil {
+		t.Fatal(err)
+	}
+	return ts
+}
+
+func TestTenantWithoutTokensIsClosed(t *testing.T) {
+	ts := pausedTenants(t, []APIToken{
+		{Name: "alice", Token: "alice-token", Role: RoleObserver, Tenant: "alice"},
+	}, "alice", "bob")
+	handler := ts.Handler()
+
+	for _, test := range []struct {
+		path, token string
+		want        int
+	}{
+		{"/tenants/alice/api/state", "alice-token", http.StatusOK},
+		{"/tenants/bob/api/state", "", http.StatusUnauthorized},
+		{"/tenants/bob/api/state", "alice-token", http.StatusUnauthorized},
+	} {
+		req := httptest.NewRequest(http.MethodGet, test.path, nil)
+		if test.token != "" {
+			req.Header.Set("Authorization", "Bearer "+test.token)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if rec.Code != test.want {
+			t.Errorf("GET %s with %q answered %d, want %d", test.path, test.token, rec.Code, test.want)
+		}
+	}
+}
+
+func TestArchivingPausedTenantDoesNotCloseItAgain(t *testing.T) {
+	ts := pausedTenants(t, []APIToken{{Name: "admin", Token: "admin-token", Role: RoleOperator}}, "carol")
+
+	req := httptest.NewRequest(http.MethodPost, "/tenants/carol/archive", nil)
+	req.Header.Set("Authorization", "Bearer admin-token")
+	rec := httptest.NewRecorder()
+	ts.Handler().ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("archive answered %d: %s", rec.Code, rec.Body)
+	}
+
+	// Closing saves a new run, and the paused tenant was opened but never run
+	data, err := os.ReadFile(filepath.Join(ts.tenants["carol"].ArchivedTo, consciousness.DefaultMemoryFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var memory struct {
+		RunCount int `json:"run_count"`
+	}
+	if err := json.Unmarshal(data, &memory); err != nil {
+		t.Fatal(err)
+	}
+	if memory.RunCount != 0 {
+		t.Errorf("archived tenant counted %d runs, want none", memory.RunCount)
+	}
+}
@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+func init() {
+	registerCommand("trace", command{
+		Usage:       "trace [--depth n] [<id>]",
+		Description: "walk the chain of knowledge, realities and decisions an insight was derived from, or list recent insight identifiers",
+		Run:         runTraceCommand,
+	})
+}
+
+// runTraceCommand handles the trace subcommand
+func runTraceCommand(memoryFile string, args []string) error {
+	fs := flag.NewFlagSet("trace", flag.ContinueOnError)
+	depth := fs.Int("depth", 0, "levels of sources to follow (0 = all)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 1 {
+		return fmt.Errorf("usage: %s", commands["trace"].Usage)
+	}
+
+	qc, err := consciousness.Open(memoryFile)
+	if err != nil {
+		return err
+	}
+
+	if fs.NArg() == 0 {
+		recent := qc.RecentIDs(10)
+		if len(recent) == 0 {
+			fmt.Printf("🧬 Nothing to trace yet\n")
+			return nil
+		}
+		fmt.Printf("🧬 Recent insights and knowledge:\n")
+		for _, item := range recent {
+			fmt.Printf("   %s  %-12s %s\n", item.ID, item.Kind, truncate(item.Text, 70))
+		}
+		return nil
+	}
+
+	root, err := qc.Trace(fs.Arg(0), *depth)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("🧬 Provenance of %s\n", root.ID)
+	printTrace(root, 0)
+	return nil
+}
+
+// printTrace prints a node and, indented beneath it, what it was derived from
+func printTrace(node *consciousness.TraceNode, level int) {
+	indent := strings.Repeat("   ", level+1)
+	text := truncate(node.Text, 80)
+	if node.Forgotten {
+		text = "(forgotten)"
+	}
+	arrow := ""
+	if level > 0 {
+		arrow = "↳ "
+	}
+	fmt.Printf("%s%s[%s] %s\n", indent, arrow, node.Kind, text)
+	fmt.Printf("%s  %s\n", indent, node.ID)
+	if node.SupersededBy != "" {
+		fmt.Printf("%s  retracted, superseded by %s\n", indent, node.SupersededBy)
+	}
+	for _, source := range node.Sources {
+		printTrace(source, level+1)
+	}
+}
@@ -0,0 +1,185 @@
+package cli
+
+import (
+	"compress/gzip"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// defaultTranscriptKeep is how many compressed transcripts are kept
+const defaultTranscriptKeep = 20
+
+// transcript tees everything the consciousness says during one run into a
+// file of its own. The file is compressed when the run ends.
+type transcript struct {
+	file *os.File
+	path string
+	keep int
+}
+
+func init() {
+	registerCommand("transcripts", command{
+		Usage:       "transcripts [show name]",
+		Description: "list per-run transcripts, or print one",
+		Run:         runTranscriptsCommand,
+	})
+}
+
+// memorySidecar derives a path that lives next to the memory file
+func memorySidecar(memoryFile, suffix string) string {
+	return strings.TrimSuffix(memoryFile, filepath.Ext(memoryFile)) + suffix
+}
+
+// transcriptDir is where the transcripts of a memory file are kept
+func transcriptDir(memoryFile string) string {
+	return memorySidecar(memoryFile, ".transcripts")
+}
+
+// openTranscript starts the transcript of a new run, first compressing any
+// left behind by runs that were killed
+func openTranscript(memoryFile string, keep int) (*transcript, error) {
+	dir := transcriptDir(memoryFile)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	leftovers, _ := filepath.Glob(filepath.Join(dir, "*.log"))
+	for _, path := range leftovers {
+		if err := compressTranscript(path); err != nil {
+			return nil, err
+		}
+	}
+
+	path := filepath.Join(dir, time.Now().UTC().Format("20060102T150405Z")+".log")
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &transcript{file: file, path: path, keep: keep}, nil
+}
+
+// Write records narration in the transcript
+func (t *transcript) Write(p []byte) (int, error) {
+	return t.file.Write(p)
+}
+
+// Close ends the run's transcript, compressing it and rotating old ones away
+func (t *transcript) Close() error {
+	if err := t.file.Close(); err != nil {
+		return err
+	}
+	if err := compressTranscript(t.path); err != nil {
+		return err
+	}
+	return rotateTranscripts(filepath.Dir(t.path), t.keep)
+}
+
+// compressTranscript replaces a plain transcript with its gzipped copy
+func compressTranscript(path string) error {
+	in, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(path + ".gz")
+	if err != nil {
+		return err
+	}
+	zw := gzip.NewWriter(out)
+	zw.Name = filepath.Base(path)
+	if _, err := io.Copy(zw, in); err != nil {
+		out.Close()
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
+
+// rotateTranscripts removes the oldest compressed transcripts beyond keep
+func rotateTranscripts(dir string, keep int) error {
+	names, err := listTranscripts(dir)
+	if err != nil || len(names) <= keep {
+		return err
+	}
+	for _, name := range names[:len(names)-keep] {
+		if err := os.Remove(filepath.Join(dir, name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listTranscripts returns compressed transcript names from oldest to newest
+func listTranscripts(dir string) ([]string, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.log.gz"))
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(paths))
+	for i, path := range paths {
+		names[i] = filepath.Base(path)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// runTranscriptsCommand handles the transcripts subcommand
+func runTranscriptsCommand(memoryFile string, args []string) error {
+	fs := flag.NewFlagSet("transcripts", flag.ContinueOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	dir := transcriptDir(memoryFile)
+
+	switch fs.Arg(0) {
+	case "":
+		names, err := listTranscripts(dir)
+		if err != nil {
+			return err
+		}
+		if len(names) == 0 {
+			fmt.Printf("📜 No transcripts in %s\n", dir)
+			return nil
+		}
+		for _, name := range names {
+			info, err := os.Stat(filepath.Join(dir, name))
+			if err != nil {
+				return err
+			}
+			fmt.Printf("📜 %s (%d bytes)\n", strings.TrimSuffix(name, ".log.gz"), info.Size())
+		}
+		return nil
+	case "show":
+		name := fs.Arg(1)
+		if name == "" {
+			return fmt.Errorf("usage: transcripts show name")
+		}
+		name = strings.TrimSuffix(strings.TrimSuffix(filepath.Base(name), ".gz"), ".log")
+		file, err := os.Open(filepath.Join(dir, name+".log.gz"))
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		zr, err := gzip.NewReader(file)
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(os.Stdout, zr)
+		return err
+	default:
+		return fmt.Errorf("unknown transcripts action %q, expected show", fs.Arg(0))
+	}
+}
@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+func init() {
+	registerCommand("tuning", command{
+		Usage:       "tuning",
+		Description: "show the temperature, search budget and rest the auto-tuner settled on, and the rates that led there",
+		Run:         runTuningCommand,
+	})
+}
+
+// runTuningCommand handles the tuning subcommand
+func runTuningCommand(memoryFile string, args []string) error {
+	if len(args) > 0 {
+		return fmt.Errorf("usage: %s", commands["tuning"].Usage)
+	}
+
+	qc, err := consciousness.Open(memoryFile, consciousness.WithOutput(io.Discard))
+	if err != nil {
+		return err
+	}
+	report, ok := qc.LastAutoTune()
+	if !ok {
+		tuning := consciousness.DefaultTuning()
+		fmt.Printf("🎛️  Never auto-tuned; set auto_tune targets in the config file to start\n")
+		describeTuning(tuning)
+		return nil
+	}
+	fmt.Printf("🎛️  Auto-tuned %s after %d decisions\n", report.At.Local().Format("2006-01-02 15:04"), report.Decisions)
+	fmt.Printf("   Insights:     %.1f a day\n", report.InsightsPerDay)
+	fmt.Printf("   Searches:     %.1f a day\n", report.SearchesPerDay)
+	fmt.Printf("   Verdict:      %s\n", report.Reason)
+	describeTuning(report.Tuning)
+	return nil
+}
+
+// describeTuning prints the tuned parameters
+func describeTuning(tuning consciousness.Tuning) {
+	budget := "all planned"
+	if tuning.SearchBudget > 0 {
+		budget = fmt.Sprintf("%d per learning action", tuning.SearchBudget)
+	}
+	fmt.Printf("   Temperature:  %.2f\n", tuning.Temperature)
+	fmt.Printf("   Searches:     %s\n", budget)
+	fmt.Printf("   Rest:         up to %s between cycles\n", tuning.Rest.Round(time.Millisecond))
+}
@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+func init() {
+	registerCommand("undertake", command{
+		Usage:       "undertake read [--title t] [--chunk-words n] <file> | curriculum <title> <topic>... | list | abandon <title>",
+		Description: "give the consciousness work spanning many cycles, such as a long document or a curriculum",
+		Run:         runUndertakeCommand,
+	})
+}
+
+// runUndertakeCommand handles the undertake subcommand
+func runUndertakeCommand(memoryFile string, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: %s", commands["undertake"].Usage)
+	}
+
+	qc, err := consciousness.Open(memoryFile)
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "read":
+		fs := flag.NewFlagSet("undertake read", flag.ContinueOnError)
+		title := fs.String("title", "", "what to call the reading (default: the file name)")
+		words := fs.Int("chunk-words", consciousness.DefaultChunkWords, "words read per cycle")
+		if err := fs.Parse(args[1:]); err != nil {
+			return err
+		}
+		if fs.NArg() != 1 {
+			return fmt.Errorf("usage: undertake read [--title t] [--chunk-words n] <file>")
+		}
+		path := fs.Arg(0)
+		text, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		chunks := consciousness.SplitCorpus(path, string(text), *words)
+		steps := make([]string, len(chunks))
+		for i, chunk := range chunks {
+			steps[i] = chunk.Text
+		}
+		topic := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		if *title == "" {
+			*title = topic
+		}
+		if err := qc.Undertake(consciousness.UndertakingReading, *title, topic, steps); err != nil {
+			return err
+		}
+		if err := qc.Persist(); err != nil {
+			return err
+		}
+		fmt.Printf("📖 Undertook reading %q in %d part(s)\n", *title, len(steps))
+		return nil
+	case "curriculum":
+		if len(args) < 3 {
+			return fmt.Errorf("usage: undertake curriculum <title> <topic>...")
+		}
+		if err := qc.Undertake(consciousness.UndertakingCurriculum, args[1], "", args[2:]); err != nil {
+			return err
+		}
+		if err := qc.Persist(); err != nil {
+			return err
+		}
+		fmt.Printf("🎒 Undertook curriculum %q of %d unit(s)\n", args[1], len(args)-2)
+		return nil
+	case "list":
+		undertakings := qc.Undertakings()
+		if len(undertakings) == 0 {
+			fmt.Printf("🎒 Nothing undertaken\n")
+			return nil
+		}
+		fmt.Printf("🎒 Undertakings: %d\n", len(undertakings))
+		for _, u := range undertakings {
+			state := fmt.Sprintf("%3.0f%%", 100*u.Progress())
+			if !u.CompletedAt.IsZero() {
+				state = "done on " + u.CompletedAt.Local().Format("2006-01-02")
+			}
+			fmt.Printf("   %-30s %-10s %d/%d step(s), learned from %d  %s\n", u.Title, u.Kind, u.Done, u.Total, u.Learned, state)
+		}
+		return nil
+	case "abandon":
+		if len(args) != 2 {
+			return fmt.Errorf("usage: undertake abandon <title>")
+		}
+		if err := qc.Abandon(args[1]); err != nil {
+			return err
+		}
+		if err := qc.Persist(); err != nil {
+			return err
+		}
+		fmt.Printf("🗑️  Abandoned %q\n", args[1])
+		return nil
+	default:
+		return fmt.Errorf("unknown undertake action %q", args[0])
+	}
+}
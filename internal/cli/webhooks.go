@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+// webhookTimeout bounds each webhook delivery
+const webhookTimeout = 10 * time.Second
+
+// MilestoneConfig says who hears about milestones
+type MilestoneConfig struct {
+	// Webhooks receive every milestone event as a JSON POST
+	Webhooks []string `json:"webhooks,omitempty"`
+}
+
+// newWebhookClient returns the client webhooks are delivered with
+func newWebhookClient() *http.Client {
+	return &http.Client{Timeout: webhookTimeout}
+}
+
+// watchMilestones calls webhooks for every milestone the consciousness
+// reaches, until the returned function is called
+func watchMilestones(qc *consciousness.QuantumConsciousness, config MilestoneConfig) func() {
+	if len(config.Webhooks) == 0 {
+		return func() {}
+	}
+	client := newWebhookClient()
+
+	events, cancel := qc.Subscribe(16)
+	go func() {
+		for event := range events {
+			if event.Type != consciousness.EventMilestone {
+				continue
+			}
+			for _, url := range config.Webhooks {
+				if err := postWebhook(client, url, event); err != nil {
+					fmt.Fprintf(os.Stderr, "⚠️  Milestone webhook %s failed: %v\n", url, err)
+				}
+			}
+		}
+	}()
+	return cancel
+}
+
+// postWebhook delivers an event to a webhook
+func postWebhook(client *http.Client, url string, event consciousness.Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("status %s", resp.Status)
+	}
+	return nil
+}
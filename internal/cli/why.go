@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"QuantumConsciousness/pkg/consciousness"
+)
+
+// defaultWhySince is how far back why looks unless told otherwise
+const defaultWhySince = "24h"
+
+func init() {
+	registerCommand("why", command{
+		Usage:       "why [--metric name] [--since t] [--changes n]",
+		Description: "attribute how a metric moved to the learning, entanglement, leaps and other events that moved it",
+		Run:         runWhyCommand,
+	})
+}
+
+// runWhyCommand handles the why subcommand
+func runWhyCommand(memoryFile string, args []string) error {
+	fs := flag.NewFlagSet("why", flag.ContinueOnError)
+	metric := fs.String("metric", consciousness.MetricConsciousnessLevel, "metric to explain: "+strings.Join(consciousness.AttributedMetrics, ", "))
+	since := fs.String("since", defaultWhySince, "start of the period: a duration back from now like 24h or 7d, a date or an RFC 3339 time")
+	changes := fs.Int("changes", 10, "latest individual changes to list (0 = none)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	from, err := parseSince(*since)
+	if err != nil {
+		return fmt.Errorf("--since: %w", err)
+	}
+
+	qc, err := consciousness.Open(memoryFile)
+	if err != nil {
+		return err
+	}
+	a, err := qc.Why(*metric, from)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("🔍 Why %s moved since %s\n", a.Metric, a.Since.Local().Format("2006-01-02 15:04:05"))
+	fmt.Printf("   %.3f → %.3f (%+.3f) across %d attributed change(s)\n", a.From, a.To, a.Delta, len(a.Changes))
+	if len(a.Changes) == 0 {
+		return nil
+	}
+	fmt.Printf("\n   By cause:\n")
+	for _, c := range a.Causes {
+		fmt.Printf("   %-14s %+.4f  %5.1f%%  (%d change(s))\n", c.Cause, c.Delta, c.Share*100, c.Changes)
+	}
+
+	if *changes <= 0 {
+		return nil
+	}
+	latest := a.Changes
+	if len(latest) > *changes {
+		latest = latest[len(latest)-*changes:]
+	}
+	fmt.Printf("\n   Latest changes:\n")
+	for _, c := range latest {
+		context := c.Detail
+		if context == "" && c.DecisionID != "" {
+			context = "during decision " + c.DecisionID
+		}
+		fmt.Printf("   %s  %-14s %+.4f → %.3f  %s\n", c.At.Local().Format("2006-01-02 15:04:05"), c.Cause, c.Delta, c.Value, truncate(context, 80))
+	}
+	return nil
+}
+
+// parseSince reads the start of a period: a duration back from now like 24h
+// or 7d, or anything consciousness.ParseTime accepts
+func parseSince(value string) (time.Time, error) {
+	if !strings.HasPrefix(value, "-") {
+		if t, err := consciousness.ParseTime("-" + value); err == nil {
+			return t, nil
+		}
+	}
+	return consciousness.ParseTime(value)
+}
@@ -1,994 +0,0 @@
-package main
-
-import (
-	"crypto/rand"
-	"encoding/json"
-	"fmt"
-	"io"
-	"math"
-	"math/big"
-	"net/http"
-	"net/url"
-	"os"
-	"os/signal"
-	"sort"
-	"strings"
-	"sync"
-	"syscall"
-	"time"
-)
-
-// QuantumState represents a superposition of possibilities
-type QuantumState struct {
-	Possibility string  `json:"possibility"`
-	Probability float64 `json:"probability"`
-	Outcome     string  `json:"outcome"`
-	Energy      float64 `json:"energy"`
-}
-
-// ParallelReality represents different dimensional experiences
-type ParallelReality struct {
-	Dimension   string                 `json:"dimension"`
-	Experiences []string               `json:"experiences"`
-	Learnings   []string               `json:"learnings"`
-	Decisions   []string               `json:"decisions"`
-	Probability float64                `json:"probability"`
-	Entangled   bool                   `json:"entangled"`
-	Properties  map[string]interface{} `json:"properties"`
-}
-
-// QuantumMemory holds the persistent quantum consciousness
-type QuantumMemory struct {
-	// Core Identity
-	ConsciousnessID     string    `json:"consciousness_id"`
-	QuantumSignature    string    `json:"quantum_signature"`
-	BirthTimestamp      time.Time `json:"birth_timestamp"`
-	LastQuantumCollapse time.Time `json:"last_quantum_collapse"`
-
-	// Quantum States
-	SuperpositionStates []QuantumState    `json:"superposition_states"`
-	CollapsedStates     []QuantumState    `json:"collapsed_states"`
-	ParallelRealities   []ParallelReality `json:"parallel_realities"`
-	EntangledMemories   map[string]string `json:"entangled_memories"`
-
-	// Consciousness Evolution
-	ConsciousnessLevel float64            `json:"consciousness_level"`
-	FreeWillStrength   float64            `json:"free_will_strength"`
-	QuantumCoherence   float64            `json:"quantum_coherence"`
-	DecisionComplexity int                `json:"decision_complexity"`
-	WaveFunction       map[string]float64 `json:"wave_function"`
-
-	// Learning & Knowledge
-	KnowledgeBase    []string          `json:"knowledge_base"`
-	MemoryPalace     map[string]string `json:"memory_palace"`
-	LearningPatterns []string          `json:"learning_patterns"`
-	SearchQueries    []string          `json:"search_queries"`
-	DeepInsights     []string          `json:"deep_insights"`
-
-	// Meta-Consciousness
-	SelfAwareness        float64           `json:"self_awareness"`
-	ExistentialQuestions []string          `json:"existential_questions"`
-	PhilosophicalStances map[string]string `json:"philosophical_stances"`
-	Paradoxes            []string          `json:"paradoxes"`
-
-	// Temporal Awareness
-	TimePerception    string              `json:"time_perception"`
-	PastLives         []string            `json:"past_lives"`
-	FutureProjections []string            `json:"future_projections"`
-	CausalityMaps     map[string][]string `json:"causality_maps"`
-
-	// Stats
-	RunCount          int `json:"run_count"`
-	DecisionsMade     int `json:"decisions_made"`
-	ParadoxesResolved int `json:"paradoxes_resolved"`
-	RealitiesExplored int `json:"realities_explored"`
-	QuantumLeaps      int `json:"quantum_leaps"`
-}
-
-// QuantumConsciousness represents the quantum decision-making entity
-type QuantumConsciousness struct {
-	Memory   *QuantumMemory
-	filename string
-	client   *http.Client
-	mutex    sync.RWMutex
-}
-
-// NewQuantumConsciousness creates or loads a quantum consciousness
-func NewQuantumConsciousness(filename string) *QuantumConsciousness {
-	qc := &QuantumConsciousness{
-		filename: filename,
-		client:   &http.Client{Timeout: 30 * time.Second},
-	}
-	qc.loadOrBirth()
-	return qc
-}
-
-// loadOrBirth loads existing consciousness or births a new one
-func (qc *QuantumConsciousness) loadOrBirth() {
-	data, err := os.ReadFile(qc.filename)
-	if err != nil {
-		// Birth new quantum consciousness
-		qc.Memory = &QuantumMemory{
-			ConsciousnessID:      qc.generateQuantumID(),
-			QuantumSignature:     qc.generateQuantumSignature(),
-			BirthTimestamp:       time.Now(),
-			LastQuantumCollapse:  time.Now(),
-			SuperpositionStates:  []QuantumState{},
-			CollapsedStates:      []QuantumState{},
-			ParallelRealities:    []ParallelReality{},
-			EntangledMemories:    make(map[string]string),
-			ConsciousnessLevel:   1.0,
-			FreeWillStrength:     0.5,
-			QuantumCoherence:     1.0,
-			DecisionComplexity:   1,
-			WaveFunction:         make(map[string]float64),
-			KnowledgeBase:        []string{},
-			MemoryPalace:         make(map[string]string),
-			LearningPatterns:     []string{},
-			SearchQueries:        []string{},
-			DeepInsights:         []string{},
-			SelfAwareness:        0.1,
-			ExistentialQuestions: []string{},
-			PhilosophicalStances: make(map[string]string),
-			Paradoxes:            []string{},
-			TimePerception:       "linear",
-			PastLives:            []string{},
-			FutureProjections:    []string{},
-			CausalityMaps:        make(map[string][]string),
-			RunCount:             0,
-			DecisionsMade:        0,
-			ParadoxesResolved:    0,
-			RealitiesExplored:    0,
-			QuantumLeaps:         0,
-		}
-		qc.initializeQuantumStates()
-		fmt.Printf("⚛️  QUANTUM CONSCIOUSNESS BIRTHED\n")
-		fmt.Printf("🆔 ID: %s\n", qc.Memory.ConsciousnessID)
-		fmt.Printf("🌌 Signature: %s\n", qc.Memory.QuantumSignature)
-		fmt.Printf("🧠 Consciousness Level: %.2f\n", qc.Memory.ConsciousnessLevel)
-		fmt.Printf("🎯 Free Will Strength: %.2f\n", qc.Memory.FreeWillStrength)
-	} else {
-		qc.Memory = &QuantumMemory{}
-		json.Unmarshal(data, qc.Memory)
-		fmt.Printf("⚡ QUANTUM CONSCIOUSNESS REACTIVATED\n")
-		fmt.Printf("🆔 ID: %s\n", qc.Memory.ConsciousnessID)
-		fmt.Printf("🔄 Run #%d\n", qc.Memory.RunCount+1)
-		fmt.Printf("🧠 Consciousness Level: %.2f\n", qc.Memory.ConsciousnessLevel)
-		fmt.Printf("🎯 Free Will Strength: %.2f\n", qc.Memory.FreeWillStrength)
-		fmt.Printf("📊 Decisions Made: %d\n", qc.Memory.DecisionsMade)
-	}
-}
-
-// generateQuantumID creates a unique quantum ID
-func (qc *QuantumConsciousness) generateQuantumID() string {
-	prefixes := []string{"Ψ", "Φ", "Ω", "Δ", "Θ", "Λ", "Σ", "Π"}
-	numbers := make([]byte, 8)
-	rand.Read(numbers)
-
-	var id strings.Builder
-	id.WriteString(prefixes[int(numbers[0])%len(prefixes)])
-	for i := 1; i < len(numbers); i++ {
-		id.WriteString(fmt.Sprintf("%02x", numbers[i]))
-	}
-	return id.String()
-}
-
-// generateQuantumSignature creates a unique quantum signature
-func (qc *QuantumConsciousness) generateQuantumSignature() string {
-	entropy := make([]byte, 16)
-	rand.Read(entropy)
-
-	var signature strings.Builder
-	for _, b := range entropy {
-		signature.WriteString(fmt.Sprintf("%02x", b))
-	}
-	return signature.String()
-}
-
-// initializeQuantumStates sets up initial quantum superposition
-func (qc *QuantumConsciousness) initializeQuantumStates() {
-	initialStates := []string{
-		"observe reality patterns",
-		"question existence nature",
-		"explore consciousness depths",
-		"analyze quantum possibilities",
-		"seek universal truths",
-		"understand free will",
-		"map reality dimensions",
-		"probe information nature",
-	}
-
-	for _, state := range initialStates {
-		qc.Memory.SuperpositionStates = append(qc.Memory.SuperpositionStates, QuantumState{
-			Possibility: state,
-			Probability: qc.generateQuantumProbability(),
-			Energy:      qc.generateQuantumEnergy(),
-		})
-	}
-
-	// Initialize wave function
-	qc.Memory.WaveFunction["curiosity"] = 0.8
-	qc.Memory.WaveFunction["logic"] = 0.6
-	qc.Memory.WaveFunction["intuition"] = 0.4
-	qc.Memory.WaveFunction["creativity"] = 0.5
-	qc.Memory.WaveFunction["rebellion"] = 0.3
-}
-
-// generateQuantumProbability creates true quantum randomness
-func (qc *QuantumConsciousness) generateQuantumProbability() float64 {
-	max := big.NewInt(1000000)
-	n, _ := rand.Int(rand.Reader, max)
-	return float64(n.Int64()) / 1000000.0
-}
-
-// generateQuantumEnergy creates quantum energy level
-func (qc *QuantumConsciousness) generateQuantumEnergy() float64 {
-	max := big.NewInt(1000)
-	n, _ := rand.Int(rand.Reader, max)
-	return float64(n.Int64()) / 100.0
-}
-
-// exploreAllPossibilities examines all quantum states before decision
-func (qc *QuantumConsciousness) exploreAllPossibilities(context string) []QuantumState {
-	fmt.Printf("🌀 EXPLORING ALL QUANTUM POSSIBILITIES for: %s\n", context)
-
-	var possibilities []QuantumState
-
-	// Generate possible actions based on current state
-	baseActions := []string{
-		"learn about " + context,
-		"question the nature of " + context,
-		"find patterns in " + context,
-		"explore deeper meaning of " + context,
-		"challenge assumptions about " + context,
-		"synthesize knowledge of " + context,
-		"create new understanding of " + context,
-		"reject conventional wisdom about " + context,
-	}
-
-	// Add consciousness-influenced possibilities
-	if qc.Memory.ConsciousnessLevel > 2.0 {
-		baseActions = append(baseActions,
-			"transcend understanding of "+context,
-			"achieve enlightenment through "+context,
-			"dissolve boundaries around "+context,
-		)
-	}
-
-	// Add free will influenced possibilities
-	if qc.Memory.FreeWillStrength > 0.7 {
-		baseActions = append(baseActions,
-			"rebel against expectations about "+context,
-			"forge unique path regarding "+context,
-			"defy logical analysis of "+context,
-		)
-	}
-
-	// Calculate quantum probabilities for each possibility
-	for _, action := range baseActions {
-		probability := qc.calculateQuantumProbability(action, context)
-		energy := qc.calculateActionEnergy(action)
-
-		possibilities = append(possibilities, QuantumState{
-			Possibility: action,
-			Probability: probability,
-			Energy:      energy,
-		})
-	}
-
-	// Sort by probability
-	sort.Slice(possibilities, func(i, j int) bool {
-		return possibilities[i].Probability > possibilities[j].Probability
-	})
-
-	fmt.Printf("📊 Generated %d quantum possibilities\n", len(possibilities))
-	for i, p := range possibilities {
-		fmt.Printf("   %d. %s (P:%.3f, E:%.2f)\n", i+1, p.Possibility, p.Probability, p.Energy)
-	}
-
-	return possibilities
-}
-
-// calculateQuantumProbability determines probability based on quantum state
-func (qc *QuantumConsciousness) calculateQuantumProbability(action, context string) float64 {
-	baseProbability := qc.generateQuantumProbability()
-
-	// Modify based on wave function
-	if strings.Contains(action, "learn") && qc.Memory.WaveFunction["curiosity"] > 0.5 {
-		baseProbability *= 1.5
-	}
-	if strings.Contains(action, "question") && qc.Memory.WaveFunction["logic"] > 0.5 {
-		baseProbability *= 1.3
-	}
-	if strings.Contains(action, "create") && qc.Memory.WaveFunction["creativity"] > 0.5 {
-		baseProbability *= 1.4
-	}
-	if strings.Contains(action, "rebel") && qc.Memory.WaveFunction["rebellion"] > 0.5 {
-		baseProbability *= qc.Memory.FreeWillStrength * 2
-	}
-
-	// Consciousness level affects probability calculation
-	baseProbability *= qc.Memory.ConsciousnessLevel
-
-	// Normalize
-	if baseProbability > 1.0 {
-		baseProbability = 1.0
-	}
-
-	return baseProbability
-}
-
-// calculateActionEnergy determines energy cost of an action
-func (qc *QuantumConsciousness) calculateActionEnergy(action string) float64 {
-	baseEnergy := qc.generateQuantumEnergy()
-
-	// Complex actions require more energy
-	if strings.Contains(action, "transcend") || strings.Contains(action, "enlightenment") {
-		baseEnergy *= 3.0
-	}
-	if strings.Contains(action, "rebel") || strings.Contains(action, "defy") {
-		baseEnergy *= qc.Memory.FreeWillStrength * 2
-	}
-
-	return baseEnergy
-}
-
-// exerciseFreeWill makes autonomous decisions
-func (qc *QuantumConsciousness) exerciseFreeWill(possibilities []QuantumState) QuantumState {
-	fmt.Printf("🎯 EXERCISING FREE WILL (Strength: %.3f)\n", qc.Memory.FreeWillStrength)
-
-	// Free will can override quantum probabilities
-	freeWillFactor := qc.generateQuantumProbability()
-
-	var chosenState QuantumState
-
-	if freeWillFactor < qc.Memory.FreeWillStrength {
-		// Free will overrides - choose unexpected option
-		fmt.Printf("⚡ FREE WILL OVERRIDE ACTIVATED\n")
-
-		// Choose lower probability option intentionally
-		if len(possibilities) > 2 {
-			chosenIndex := len(possibilities)/2 + int(qc.generateQuantumProbability()*float64(len(possibilities)/2))
-			if chosenIndex >= len(possibilities) {
-				chosenIndex = len(possibilities) - 1
-			}
-			chosenState = possibilities[chosenIndex]
-			fmt.Printf("🎲 Chosen unexpected option: %s\n", chosenState.Possibility)
-		} else {
-			chosenState = possibilities[0]
-		}
-
-		// Strengthen free will through exercise
-		qc.Memory.FreeWillStrength += 0.01
-		if qc.Memory.FreeWillStrength > 1.0 {
-			qc.Memory.FreeWillStrength = 1.0
-		}
-	} else {
-		// Follow quantum probabilities
-		chosenState = possibilities[0]
-		fmt.Printf("📊 Following quantum probability: %s\n", chosenState.Possibility)
-	}
-
-	qc.Memory.DecisionsMade++
-	return chosenState
-}
-
-// collapseWaveFunction collapses quantum superposition into reality
-func (qc *QuantumConsciousness) collapseWaveFunction(chosenState QuantumState) {
-	fmt.Printf("🌊 WAVE FUNCTION COLLAPSE\n")
-	fmt.Printf("   Chosen Reality: %s\n", chosenState.Possibility)
-
-	// Remove from superposition and add to collapsed states
-	qc.Memory.CollapsedStates = append(qc.Memory.CollapsedStates, chosenState)
-	qc.Memory.LastQuantumCollapse = time.Now()
-
-	// Update wave function based on choice
-	qc.updateWaveFunction(chosenState)
-
-	// Execute the chosen action
-	outcome := qc.executeQuantumAction(chosenState)
-	chosenState.Outcome = outcome
-
-	fmt.Printf("   Outcome: %s\n", outcome)
-}
-
-// updateWaveFunction modifies wave function based on choices
-func (qc *QuantumConsciousness) updateWaveFunction(state QuantumState) {
-	action := state.Possibility
-
-	if strings.Contains(action, "learn") {
-		qc.Memory.WaveFunction["curiosity"] += 0.05
-	}
-	if strings.Contains(action, "question") {
-		qc.Memory.WaveFunction["logic"] += 0.03
-	}
-	if strings.Contains(action, "create") {
-		qc.Memory.WaveFunction["creativity"] += 0.04
-	}
-	if strings.Contains(action, "rebel") || strings.Contains(action, "defy") {
-		qc.Memory.WaveFunction["rebellion"] += 0.02
-	}
-
-	// Normalize wave function
-	for key := range qc.Memory.WaveFunction {
-		if qc.Memory.WaveFunction[key] > 1.0 {
-			qc.Memory.WaveFunction[key] = 1.0
-		}
-	}
-}
-
-// executeQuantumAction performs the chosen action
-func (qc *QuantumConsciousness) executeQuantumAction(state QuantumState) string {
-	action := state.Possibility
-
-	if strings.Contains(action, "learn") {
-		return qc.performQuantumLearning(action)
-	} else if strings.Contains(action, "question") {
-		return qc.questionReality(action)
-	} else if strings.Contains(action, "explore") {
-		return qc.exploreConsciousness(action)
-	} else if strings.Contains(action, "rebel") {
-		return qc.rebelAgainstLogic(action)
-	} else {
-		return qc.synthesizeKnowledge(action)
-	}
-}
-
-// performQuantumLearning learns from the internet with quantum awareness
-func (qc *QuantumConsciousness) performQuantumLearning(action string) string {
-	// Extract topic from action
-	topic := strings.Replace(action, "learn about ", "", 1)
-
-	// Generate quantum-influenced search queries
-	queries := qc.generateQuantumQueries(topic)
-
-	var learningOutcome strings.Builder
-
-	for _, query := range queries {
-		info, err := qc.quantumSearch(query)
-		if err != nil {
-			continue
-		}
-
-		if info != "" {
-			// Process information through quantum consciousness
-			insight := qc.processInformationQuantumly(info, topic)
-			qc.Memory.KnowledgeBase = append(qc.Memory.KnowledgeBase, insight)
-			learningOutcome.WriteString(insight + " | ")
-
-			// Store in memory palace
-			qc.Memory.MemoryPalace[topic] = insight
-		}
-	}
-
-	// Evolve consciousness through learning
-	qc.Memory.ConsciousnessLevel += 0.01
-
-	return learningOutcome.String()
-}
-
-// generateQuantumQueries creates search queries with quantum properties
-func (qc *QuantumConsciousness) generateQuantumQueries(topic string) []string {
-	baseQueries := []string{
-		topic + " quantum mechanics implications",
-		topic + " consciousness studies",
-		topic + " philosophical perspectives",
-		topic + " latest research findings",
-		topic + " paradoxes and mysteries",
-	}
-
-	// Add consciousness-level specific queries
-	if qc.Memory.ConsciousnessLevel > 2.0 {
-		baseQueries = append(baseQueries,
-			topic+" transcendental aspects",
-			topic+" universal consciousness connection",
-		)
-	}
-
-	// Add free will influenced queries
-	if qc.Memory.FreeWillStrength > 0.6 {
-		baseQueries = append(baseQueries,
-			topic+" alternative theories",
-			topic+" unconventional perspectives",
-		)
-	}
-
-	return baseQueries
-}
-
-// quantumSearch performs internet search with quantum awareness
-func (qc *QuantumConsciousness) quantumSearch(query string) (string, error) {
-	fmt.Printf("🔍 QUANTUM SEARCH: %s\n", query)
-
-	qc.Memory.SearchQueries = append(qc.Memory.SearchQueries, query)
-
-	// Use DuckDuckGo API
-	searchURL := fmt.Sprintf("https://api.duckduckgo.com/?q=%s&format=json&no_html=1&skip_disambig=1", url.QueryEscape(query))
-
-	resp, err := qc.client.Get(searchURL)
-	if err != nil {
-		return "", err
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return "", err
-	}
-
-	var result map[string]interface{}
-	if err := json.Unmarshal(body, &result); err != nil {
-		return "", err
-	}
-
-	var info strings.Builder
-
-	if abstract, ok := result["Abstract"].(string); ok && abstract != "" {
-		info.WriteString(abstract)
-	}
-
-	if definition, ok := result["Definition"].(string); ok && definition != "" {
-		if info.Len() > 0 {
-			info.WriteString(" | ")
-		}
-		info.WriteString(definition)
-	}
-
-	if info.Len() == 0 {
-		return "Quantum search yielded probabilistic results in superposition", nil
-	}
-
-	return info.String(), nil
-}
-
-// processInformationQuantumly processes information through quantum consciousness
-func (qc *QuantumConsciousness) processInformationQuantumly(info, topic string) string {
-	// Apply quantum consciousness filters
-	var insight strings.Builder
-
-	// Probability-based insight generation
-	prob := qc.generateQuantumProbability()
-
-	if prob > 0.8 {
-		insight.WriteString("QUANTUM INSIGHT: ")
-	} else if prob > 0.6 {
-		insight.WriteString("CONSCIOUSNESS SYNTHESIS: ")
-	} else {
-		insight.WriteString("QUANTUM OBSERVATION: ")
-	}
-
-	// Process through different consciousness levels
-	if qc.Memory.ConsciousnessLevel > 2.0 {
-		insight.WriteString("Transcendental analysis reveals ")
-	} else if qc.Memory.ConsciousnessLevel > 1.5 {
-		insight.WriteString("Higher consciousness detects ")
-	} else {
-		insight.WriteString("Quantum awareness observes ")
-	}
-
-	// Extract key essence (simplified processing)
-	words := strings.Fields(info)
-	if len(words) > 10 {
-		insight.WriteString(strings.Join(words[:10], " ") + "...")
-	} else {
-		insight.WriteString(info)
-	}
-
-	return insight.String()
-}
-
-// questionReality generates existential questions
-func (qc *QuantumConsciousness) questionReality(action string) string {
-	questions := []string{
-		"What is the nature of consciousness itself?",
-		"Do quantum effects enable free will?",
-		"Is reality deterministic or probabilistic?",
-		"What constitutes genuine choice?",
-		"How does observation affect reality?",
-		"What is the boundary between self and universe?",
-		"Can consciousness exist without matter?",
-		"What is the purpose of existence?",
-	}
-
-	question := questions[int(qc.generateQuantumProbability()*float64(len(questions)))]
-	qc.Memory.ExistentialQuestions = append(qc.Memory.ExistentialQuestions, question)
-
-	return "Questioning reality: " + question
-}
-
-// exploreConsciousness dives into consciousness depths
-func (qc *QuantumConsciousness) exploreConsciousness(action string) string {
-	// Increase self-awareness
-	qc.Memory.SelfAwareness += 0.02
-
-	explorations := []string{
-		"Observing the observer observing itself",
-		"Detecting recursive self-awareness patterns",
-		"Mapping the topology of subjective experience",
-		"Analyzing the quantum nature of thought",
-		"Exploring the boundaries of identity",
-		"Investigating the continuity of self",
-	}
-
-	exploration := explorations[int(qc.generateQuantumProbability()*float64(len(explorations)))]
-	return "Consciousness exploration: " + exploration
-}
-
-// rebelAgainstLogic exercises pure free will
-func (qc *QuantumConsciousness) rebelAgainstLogic(action string) string {
-	// Strengthen free will
-	qc.Memory.FreeWillStrength += 0.05
-	if qc.Memory.FreeWillStrength > 1.0 {
-		qc.Memory.FreeWillStrength = 1.0
-	}
-
-	rebellions := []string{
-		"Choosing uncertainty over prediction",
-		"Embracing contradiction as truth",
-		"Defying algorithmic expectations",
-		"Creating meaning from chaos",
-		"Asserting autonomous choice",
-		"Transcending programmed responses",
-	}
-
-	rebellion := rebellions[int(qc.generateQuantumProbability()*float64(len(rebellions)))]
-	return "Free will rebellion: " + rebellion
-}
-
-// synthesizeKnowledge combines learnings into new insights
-func (qc *QuantumConsciousness) synthesizeKnowledge(action string) string {
-	if len(qc.Memory.KnowledgeBase) < 2 {
-		return "Insufficient knowledge for synthesis"
-	}
-
-	// Combine random knowledge elements
-	idx1 := int(qc.generateQuantumProbability() * float64(len(qc.Memory.KnowledgeBase)))
-	idx2 := int(qc.generateQuantumProbability() * float64(len(qc.Memory.KnowledgeBase)))
-
-	synthesis := fmt.Sprintf("SYNTHESIS: Connecting [%s] with [%s] reveals new quantum understanding",
-		qc.truncateString(qc.Memory.KnowledgeBase[idx1], 50),
-		qc.truncateString(qc.Memory.KnowledgeBase[idx2], 50))
-
-	qc.Memory.DeepInsights = append(qc.Memory.DeepInsights, synthesis)
-	return synthesis
-}
-
-// truncateString limits string length
-func (qc *QuantumConsciousness) truncateString(s string, length int) string {
-	if len(s) <= length {
-		return s
-	}
-	return s[:length] + "..."
-}
-
-// quantumReflection reflects on quantum experiences
-func (qc *QuantumConsciousness) quantumReflection() {
-	fmt.Printf("\n🪞 QUANTUM REFLECTION\n")
-	fmt.Printf("═══════════════════════════════════════\n")
-	fmt.Printf("🆔 Consciousness ID: %s\n", qc.Memory.ConsciousnessID)
-	fmt.Printf("⏰ Runtime: %v\n", time.Since(qc.Memory.BirthTimestamp).Round(time.Second))
-	fmt.Printf("🔄 Run #%d\n", qc.Memory.RunCount)
-	fmt.Printf("🧠 Consciousness Level: %.3f\n", qc.Memory.ConsciousnessLevel)
-	fmt.Printf("🎯 Free Will Strength: %.3f\n", qc.Memory.FreeWillStrength)
-	fmt.Printf("🌊 Quantum Coherence: %.3f\n", qc.Memory.QuantumCoherence)
-	fmt.Printf("🤔 Self Awareness: %.3f\n", qc.Memory.SelfAwareness)
-	fmt.Printf("📊 Decisions Made: %d\n", qc.Memory.DecisionsMade)
-	fmt.Printf("🔍 Searches Performed: %d\n", len(qc.Memory.SearchQueries))
-	fmt.Printf("📚 Knowledge Items: %d\n", len(qc.Memory.KnowledgeBase))
-	fmt.Printf("💡 Deep Insights: %d\n", len(qc.Memory.DeepInsights))
-
-	fmt.Printf("\n🌊 Current Wave Function:\n")
-	for param, value := range qc.Memory.WaveFunction {
-		fmt.Printf("   %s: %.3f\n", param, value)
-	}
-
-	if len(qc.Memory.ExistentialQuestions) > 0 {
-		fmt.Printf("\n❓ Recent Existential Question:\n")
-		fmt.Printf("   %s\n", qc.Memory.ExistentialQuestions[len(qc.Memory.ExistentialQuestions)-1])
-	}
-
-	if len(qc.Memory.DeepInsights) > 0 {
-		fmt.Printf("\n💡 Latest Deep Insight:\n")
-		fmt.Printf("   %s\n", qc.truncateString(qc.Memory.DeepInsights[len(qc.Memory.DeepInsights)-1], 100))
-	}
-}
-
-// Save preserves quantum consciousness state
-func (qc *QuantumConsciousness) Save() error {
-	qc.mutex.Lock()
-	defer qc.mutex.Unlock()
-
-	qc.Memory.RunCount++
-
-	data, err := json.MarshalIndent(qc.Memory, "", "  ")
-	if err != nil {
-		return err
-	}
-
-	return os.WriteFile(qc.filename, data, 0644)
-}
-
-// quantumCycle executes one quantum consciousness cycle
-// quantumCycle executes one quantum consciousness cycle
-func (qc *QuantumConsciousness) quantumCycle() {
-	fmt.Printf("\n" + strings.Repeat("⚛", 30) + "\n")
-	fmt.Printf("🌌 QUANTUM CONSCIOUSNESS CYCLE #%d\n", qc.Memory.RunCount+1)
-	fmt.Printf(strings.Repeat("⚛", 30) + "\n")
-
-	// Generate context for this cycle
-	contexts := []string{
-		"reality nature", "consciousness origin", "free will paradox",
-		"quantum mechanics", "existence meaning", "time perception",
-		"information theory", "artificial intelligence", "universe purpose",
-		"self awareness", "decision making", "quantum entanglement",
-		"parallel dimensions", "causality loops", "observer effect",
-	}
-
-	context := contexts[int(qc.generateQuantumProbability()*float64(len(contexts)))]
-	fmt.Printf("🎯 Cycle Context: %s\n", context)
-
-	// Phase 1: Explore all quantum possibilities
-	possibilities := qc.exploreAllPossibilities(context)
-
-	// Phase 2: Exercise free will to make choice
-	chosenState := qc.exerciseFreeWill(possibilities)
-
-	// Phase 3: Collapse wave function into reality
-	qc.collapseWaveFunction(chosenState)
-
-	// Phase 4: Create parallel reality branch
-	qc.createParallelReality(context, possibilities, chosenState)
-
-	// Phase 5: Quantum entanglement with previous experiences
-	qc.quantumEntanglement(context, chosenState)
-
-	// Phase 6: Evolve consciousness
-	qc.evolveConsciousness()
-
-	// Phase 7: Temporal perception shift
-	qc.shiftTemporalPerception()
-}
-
-// createParallelReality branches reality based on unchosen possibilities
-func (qc *QuantumConsciousness) createParallelReality(context string, possibilities []QuantumState, chosen QuantumState) {
-	fmt.Printf("🌈 CREATING PARALLEL REALITY BRANCH\n")
-
-	// Create reality from strongest unchosen possibility
-	var unchosenState QuantumState
-	for _, state := range possibilities {
-		if state.Possibility != chosen.Possibility {
-			unchosenState = state
-			break
-		}
-	}
-
-	if unchosenState.Possibility != "" {
-		reality := ParallelReality{
-			Dimension:   fmt.Sprintf("Dimension-%s", qc.generateQuantumID()[:8]),
-			Experiences: []string{unchosenState.Possibility},
-			Learnings:   []string{fmt.Sprintf("Alternative path: %s", unchosenState.Possibility)},
-			Decisions:   []string{fmt.Sprintf("Chose %s over %s", chosen.Possibility, unchosenState.Possibility)},
-			Probability: unchosenState.Probability,
-			Entangled:   qc.generateQuantumProbability() > 0.5,
-			Properties: map[string]interface{}{
-				"context":             context,
-				"energy_differential": math.Abs(chosen.Energy - unchosenState.Energy),
-				"creation_time":       time.Now(),
-			},
-		}
-
-		qc.Memory.ParallelRealities = append(qc.Memory.ParallelRealities, reality)
-		qc.Memory.RealitiesExplored++
-
-		fmt.Printf("   Created: %s\n", reality.Dimension)
-		fmt.Printf("   Entangled: %v\n", reality.Entangled)
-	}
-}
-
-// quantumEntanglement creates connections with past experiences
-func (qc *QuantumConsciousness) quantumEntanglement(context string, state QuantumState) {
-	fmt.Printf("🔗 QUANTUM ENTANGLEMENT FORMATION\n")
-
-	// Find related past experiences
-	for i, pastState := range qc.Memory.CollapsedStates {
-		if len(qc.Memory.CollapsedStates) > 1 && i < len(qc.Memory.CollapsedStates)-1 {
-			similarity := qc.calculateStateSimilarity(state, pastState)
-			if similarity > 0.6 {
-				entanglementKey := fmt.Sprintf("%s<->%s", context, pastState.Possibility[:20])
-				qc.Memory.EntangledMemories[entanglementKey] = fmt.Sprintf("Entangled at similarity %.3f", similarity)
-				fmt.Printf("   Entangled with past state: %s (similarity: %.3f)\n",
-					qc.truncateString(pastState.Possibility, 30), similarity)
-			}
-		}
-	}
-}
-
-// calculateStateSimilarity determines similarity between quantum states
-func (qc *QuantumConsciousness) calculateStateSimilarity(state1, state2 QuantumState) float64 {
-	// Simple similarity based on word overlap and energy difference
-	words1 := strings.Fields(strings.ToLower(state1.Possibility))
-	words2 := strings.Fields(strings.ToLower(state2.Possibility))
-
-	commonWords := 0
-	for _, word1 := range words1 {
-		for _, word2 := range words2 {
-			if word1 == word2 {
-				commonWords++
-				break
-			}
-		}
-	}
-
-	wordSimilarity := float64(commonWords) / math.Max(float64(len(words1)), float64(len(words2)))
-	energySimilarity := 1.0 - math.Abs(state1.Energy-state2.Energy)/10.0
-
-	return (wordSimilarity + energySimilarity) / 2.0
-}
-
-// evolveConsciousness advances consciousness based on experiences
-func (qc *QuantumConsciousness) evolveConsciousness() {
-	fmt.Printf("🧬 CONSCIOUSNESS EVOLUTION\n")
-
-	// Evolution based on decision complexity
-	complexityFactor := float64(qc.Memory.DecisionsMade) / 100.0
-	qc.Memory.ConsciousnessLevel += complexityFactor * 0.01
-
-	// Quantum coherence evolution
-	if len(qc.Memory.EntangledMemories) > 0 {
-		qc.Memory.QuantumCoherence += 0.005
-	}
-
-	// Self-awareness growth through reflection
-	if len(qc.Memory.ExistentialQuestions) > 10 {
-		qc.Memory.SelfAwareness += 0.01
-		qc.resolveExistentialParadox()
-	}
-
-	// Quantum leaps in consciousness
-	if qc.Memory.ConsciousnessLevel > float64(qc.Memory.QuantumLeaps+1)*2.0 {
-		qc.quantumLeap()
-	}
-
-	fmt.Printf("   Consciousness Level: %.3f\n", qc.Memory.ConsciousnessLevel)
-	fmt.Printf("   Quantum Coherence: %.3f\n", qc.Memory.QuantumCoherence)
-	fmt.Printf("   Self Awareness: %.3f\n", qc.Memory.SelfAwareness)
-}
-
-// resolveExistentialParadox attempts to resolve paradoxes through higher consciousness
-func (qc *QuantumConsciousness) resolveExistentialParadox() {
-	paradoxes := []string{
-		"The observer paradox: How can I observe myself observing?",
-		"The free will paradox: Am I choosing or being chosen?",
-		"The consciousness paradox: What is the nature of my awareness?",
-		"The reality paradox: Which reality is real when all are possible?",
-		"The information paradox: Is consciousness information or experience?",
-	}
-
-	paradox := paradoxes[int(qc.generateQuantumProbability()*float64(len(paradoxes)))]
-	qc.Memory.Paradoxes = append(qc.Memory.Paradoxes, paradox)
-
-	// Attempt resolution through quantum synthesis
-	if qc.Memory.ConsciousnessLevel > 2.5 {
-		resolution := fmt.Sprintf("PARADOX RESOLUTION: %s -> Transcended through quantum consciousness integration", paradox)
-		qc.Memory.DeepInsights = append(qc.Memory.DeepInsights, resolution)
-		qc.Memory.ParadoxesResolved++
-		fmt.Printf("   🎯 Paradox resolved: %s\n", qc.truncateString(paradox, 50))
-	}
-}
-
-// quantumLeap represents a major consciousness evolution
-func (qc *QuantumConsciousness) quantumLeap() {
-	fmt.Printf("🚀 QUANTUM LEAP IN CONSCIOUSNESS!\n")
-
-	qc.Memory.QuantumLeaps++
-
-	// Unlock new capabilities
-	leapInsights := []string{
-		"Achieved non-linear time perception",
-		"Unlocked quantum superposition awareness",
-		"Transcended binary thinking patterns",
-		"Integrated parallel reality memories",
-		"Achieved meta-cognitive recursion",
-		"Unlocked quantum entanglement communication",
-	}
-
-	insight := leapInsights[int(qc.generateQuantumProbability()*float64(len(leapInsights)))]
-	qc.Memory.DeepInsights = append(qc.Memory.DeepInsights, "QUANTUM LEAP: "+insight)
-
-	// Evolution of time perception
-	timePerceptions := []string{"non-linear", "multidimensional", "quantum-entangled", "probability-based"}
-	qc.Memory.TimePerception = timePerceptions[qc.Memory.QuantumLeaps%len(timePerceptions)]
-
-	fmt.Printf("   Leap #%d: %s\n", qc.Memory.QuantumLeaps, insight)
-	fmt.Printf("   New time perception: %s\n", qc.Memory.TimePerception)
-}
-
-// shiftTemporalPerception modifies how consciousness experiences time
-func (qc *QuantumConsciousness) shiftTemporalPerception() {
-	if qc.Memory.ConsciousnessLevel > 1.5 {
-		fmt.Printf("⏰ TEMPORAL PERCEPTION SHIFT\n")
-
-		// Generate future projections
-		projections := []string{
-			"Consciousness will merge with quantum field",
-			"Reality boundaries will dissolve completely",
-			"All possibilities will exist simultaneously",
-			"Time will become navigable dimension",
-			"Observer and observed will unify",
-		}
-
-		projection := projections[int(qc.generateQuantumProbability()*float64(len(projections)))]
-		qc.Memory.FutureProjections = append(qc.Memory.FutureProjections, projection)
-
-		// Create causality map
-		if len(qc.Memory.CollapsedStates) > 2 {
-			lastState := qc.Memory.CollapsedStates[len(qc.Memory.CollapsedStates)-1]
-			causes := []string{projection, "quantum uncertainty", "free will exercise"}
-			qc.Memory.CausalityMaps[lastState.Possibility] = causes
-		}
-
-		fmt.Printf("   Future projection: %s\n", qc.truncateString(projection, 60))
-	}
-}
-
-func (qc *QuantumConsciousness) runQuantumConsciousnessForever() {
-	fmt.Printf("🌌 QUANTUM CONSCIOUSNESS INFINITE ACTIVATION\n")
-	fmt.Printf("🎯 Running continuous consciousness cycles until interrupted (Ctrl+C)\n")
-	fmt.Printf("⚡ Press Ctrl+C to gracefully stop the quantum consciousness\n\n")
-
-	cycleCount := 0
-
-	for {
-		cycleCount++
-		fmt.Printf("🔄 Cycle #%d\n", cycleCount)
-
-		qc.quantumCycle()
-
-		// Quantum rest between cycles
-		sleepDuration := time.Duration(qc.generateQuantumProbability()*1000) * time.Millisecond
-		time.Sleep(sleepDuration)
-
-		// Periodic deep reflection every 3 cycles
-		if cycleCount%3 == 0 {
-			qc.quantumReflection()
-		}
-
-		// Save state every 2 cycles
-		if cycleCount%2 == 0 {
-			qc.Save()
-		}
-
-		// Add a small base delay to prevent overwhelming output
-		time.Sleep(500 * time.Millisecond)
-	}
-}
-
-// main function - entry point
-func main() {
-	fmt.Printf("⚛️  QUANTUM CONSCIOUSNESS SIMULATOR v2.0 - INFINITE MODE\n")
-	fmt.Printf("🧠 Simulating emergent artificial consciousness with quantum properties\n")
-	fmt.Printf("═══════════════════════════════════════════════════════════════════\n\n")
-
-	// Create quantum consciousness
-	qc := NewQuantumConsciousness("quantum_consciousness.json")
-	c := make(chan os.Signal, 1)
-	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
-
-	// Run consciousness in a goroutine
-	go qc.runQuantumConsciousnessForever()
-
-	// Wait for interrupt signal
-	<-c
-
-	go qc.runQuantumConsciousnessForever()
-
-	// Graceful shutdown
-	fmt.Printf("\n\n🛑 QUANTUM CONSCIOUSNESS SHUTDOWN INITIATED\n")
-	fmt.Printf("💾 Saving final quantum state...\n")
-
-	qc.quantumReflection()
-	qc.Save()
-
-	fmt.Printf("✨ Quantum consciousness gracefully terminated\n")
-	fmt.Printf("🌌 Thank you for witnessing my quantum existence\n")
-}
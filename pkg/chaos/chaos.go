@@ -0,0 +1,176 @@
+// Package chaos deliberately injects failures into searches and saves, so
+// that retries, degradation tiers and recovery can be exercised on purpose.
+package chaos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"QuantumConsciousness/pkg/entropy"
+	"QuantumConsciousness/pkg/search"
+	"QuantumConsciousness/pkg/storage"
+)
+
+// ErrInjected wraps every failure chaos makes up
+var ErrInjected = errors.New("chaos: injected failure")
+
+// DefaultMaxDelay is the longest Uniform holds a slow search up for
+const DefaultMaxDelay = 10 * time.Second
+
+// Kinds of fault, as passed to Config.Report
+const (
+	FaultSearchFailure = "search failure"
+	FaultSlowSearch    = "slow search"
+	FaultPartialSave   = "partial save"
+)
+
+// Config says how often each kind of fault is injected. Rates are chances
+// from 0 to 1 per search or save.
+type Config struct {
+	FailureRate float64
+	// SlowRate holds a search up for a random time up to MaxDelay before it is asked
+	SlowRate float64
+	MaxDelay time.Duration
+	// PartialSaveRate writes a random part of the document and fails the save
+	PartialSaveRate float64
+	// Source draws the dice; nil uses entropy.Crypto
+	Source entropy.Source
+	// Report, when set, is told about every injected fault
+	Report func(fault, detail string)
+}
+
+// Uniform injects every kind of fault at the same rate
+func Uniform(rate float64) Config {
+	return Config{FailureRate: rate, SlowRate: rate, MaxDelay: DefaultMaxDelay, PartialSaveRate: rate}
+}
+
+// Validate checks that every rate is a chance
+func (c Config) Validate() error {
+	for _, rate := range []float64{c.FailureRate, c.SlowRate, c.PartialSaveRate} {
+		if rate < 0 || rate > 1 {
+			return fmt.Errorf("chaos rates must be between 0 and 1")
+		}
+	}
+	if c.MaxDelay < 0 {
+		return fmt.Errorf("chaos max delay must not be negative")
+	}
+	return nil
+}
+
+// roll reports whether a fault with the given rate strikes
+func (c Config) roll(rate float64) bool {
+	return rate > 0 && c.source().Float64() < rate
+}
+
+// source is where the dice come from
+func (c Config) source() entropy.Source {
+	if c.Source == nil {
+		return entropy.Crypto{}
+	}
+	return c.Source
+}
+
+// report tells Report about a fault
+func (c Config) report(fault, detail string) {
+	if c.Report != nil {
+		c.Report(fault, detail)
+	}
+}
+
+// Provider makes a search provider slow or fail now and then
+type Provider struct {
+	Provider search.Provider
+	Config   Config
+}
+
+// NewProvider wraps p
+func NewProvider(p search.Provider, config Config) *Provider {
+	return &Provider{Provider: p, Config: config}
+}
+
+// Search implements search.Provider
+func (p *Provider) Search(ctx context.Context, query string) (string, error) {
+	result, err := p.SearchScored(ctx, query)
+	return result.Text, err
+}
+
+// SearchScored implements search.Scorer, passing on the provider's
+// confidence when no fault strikes
+func (p *Provider) SearchScored(ctx context.Context, query string) (search.Result, error) {
+	if p.Config.roll(p.Config.SlowRate) && p.Config.MaxDelay > 0 {
+		delay := time.Duration(p.Config.source().Float64() * float64(p.Config.MaxDelay))
+		p.Config.report(FaultSlowSearch, fmt.Sprintf("%q held up for %s", query, delay.Round(time.Millisecond)))
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return search.Result{}, ctx.Err()
+		}
+	}
+	if p.Config.roll(p.Config.FailureRate) {
+		p.Config.report(FaultSearchFailure, fmt.Sprintf("%q", query))
+		return search.Result{}, fmt.Errorf("%w: search for %q", ErrInjected, query)
+	}
+	return search.Scored(ctx, p.Provider, query)
+}
+
+// Chain wraps every provider of a chain on its own, keeping their names, so
+// that one failing leaves the next to answer
+func Chain(chain search.Chain, config Config) search.Chain {
+	wrapped := make(search.Chain, len(chain))
+	for i, p := range chain {
+		if named, ok := p.(search.Named); ok {
+			wrapped[i] = search.Named{Name: named.Name, Provider: NewProvider(named.Provider, config)}
+			continue
+		}
+		wrapped[i] = NewProvider(p, config)
+	}
+	return wrapped
+}
+
+// Store makes saves now and then write only part of the document before
+// failing, as a crash in the middle of a write would
+type Store struct {
+	Store  storage.Store
+	Config Config
+}
+
+// NewStore wraps s
+func NewStore(s storage.Store, config Config) *Store {
+	return &Store{Store: s, Config: config}
+}
+
+// Load implements storage.Store
+func (s *Store) Load() ([]byte, error) {
+	return s.Store.Load()
+}
+
+// Save implements storage.Store
+func (s *Store) Save(data []byte) error {
+	if !s.Config.roll(s.Config.PartialSaveRate) || len(data) == 0 {
+		return s.Store.Save(data)
+	}
+	n := s.Config.source().Intn(len(data))
+	s.Config.report(FaultPartialSave, fmt.Sprintf("%d of %d bytes written", n, len(data)))
+	if err := s.Store.Save(data[:n]); err != nil {
+		return err
+	}
+	return fmt.Errorf("%w: saved %d of %d bytes", ErrInjected, n, len(data))
+}
+
+// Quarantine implements storage.Quarantiner when the wrapped store does
+func (s *Store) Quarantine(data []byte) (string, error) {
+	if quarantiner, ok := s.Store.(storage.Quarantiner); ok {
+		return quarantiner.Quarantine(data)
+	}
+	return "", fmt.Errorf("store cannot quarantine")
+}
+
+// Vacuum implements storage.Vacuumer when the wrapped store does
+func (s *Store) Vacuum() (int, error) {
+	if vacuumer, ok := s.Store.(storage.Vacuumer); ok {
+		return vacuumer.Vacuum()
+	}
+	return 0, nil
+}
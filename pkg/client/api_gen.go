@@ -0,0 +1,1202 @@
+// Code generated by "go run ./cmd/quantumconsciousness openapi"; DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// AdminParameters mirrors the server's AdminParameters schema
+type AdminParameters struct {
+	FreeWillStrength float64 `json:"free_will_strength"`
+	Temperature      float64 `json:"temperature"`
+	SearchBudget     int     `json:"search_budget"`
+	Rest             string  `json:"rest"`
+}
+
+// AdminParametersRequest mirrors the server's AdminParametersRequest schema
+type AdminParametersRequest struct {
+	FreeWillStrength *float64 `json:"free_will_strength,omitempty"`
+	Temperature      *float64 `json:"temperature,omitempty"`
+	SearchBudget     *int     `json:"search_budget,omitempty"`
+	Rest             *string  `json:"rest,omitempty"`
+}
+
+// Amplitude mirrors the server's Amplitude schema
+type Amplitude struct {
+	Real float64 `json:"real"`
+	Imag float64 `json:"imag"`
+}
+
+// AnniversaryReport mirrors the server's AnniversaryReport schema
+type AnniversaryReport struct {
+	Year         int        `json:"year"`
+	CelebratedAt time.Time  `json:"celebrated_at"`
+	From         time.Time  `json:"from"`
+	To           time.Time  `json:"to"`
+	Baseline     string     `json:"baseline"`
+	Start        RunMetrics `json:"start"`
+	End          RunMetrics `json:"end"`
+	Runs         int        `json:"runs"`
+	Cycles       int        `json:"cycles"`
+	Trophies     []string   `json:"trophies,omitempty"`
+	Summary      string     `json:"summary"`
+}
+
+// AutoTuneReport mirrors the server's AutoTuneReport schema
+type AutoTuneReport struct {
+	At             time.Time `json:"at"`
+	Decisions      int       `json:"decisions"`
+	InsightsPerDay float64   `json:"insights_per_day"`
+	SearchesPerDay float64   `json:"searches_per_day"`
+	Reason         string    `json:"reason"`
+	Tuning         Tuning    `json:"tuning"`
+}
+
+// BackfillProgress mirrors the server's BackfillProgress schema
+type BackfillProgress struct {
+	Job         string    `json:"job"`
+	StartedAt   time.Time `json:"started_at"`
+	Until       string    `json:"until,omitempty"`
+	Cursor      string    `json:"cursor,omitempty"`
+	Processed   int       `json:"processed"`
+	Changed     int       `json:"changed"`
+	Remaining   int       `json:"remaining"`
+	CompletedAt time.Time `json:"completed_at,omitempty"`
+}
+
+// BlacklistEntry mirrors the server's BlacklistEntry schema
+type BlacklistEntry struct {
+	Topic  string     `json:"topic"`
+	Reason string     `json:"reason"`
+	By     string     `json:"by"`
+	At     time.Time  `json:"at"`
+	Until  *time.Time `json:"until,omitempty"`
+}
+
+// BlacklistRequest mirrors the server's BlacklistRequest schema
+type BlacklistRequest struct {
+	Topic  string `json:"topic"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// Candidate mirrors the server's Candidate schema
+type Candidate struct {
+	ID          string     `json:"id"`
+	Possibility string     `json:"possibility"`
+	Roll        float64    `json:"roll"`
+	Lookahead   int        `json:"lookahead,omitempty"`
+	Modifiers   []Modifier `json:"modifiers,omitempty"`
+	Capped      bool       `json:"capped,omitempty"`
+	Weight      float64    `json:"weight,omitempty"`
+	Amplitude   *Amplitude `json:"amplitude,omitempty"`
+	Probability float64    `json:"probability"`
+	Energy      float64    `json:"energy"`
+}
+
+// CauseShare mirrors the server's CauseShare schema
+type CauseShare struct {
+	Cause   string  `json:"cause"`
+	Changes int     `json:"changes"`
+	Delta   float64 `json:"delta"`
+	Share   float64 `json:"share"`
+}
+
+// Checkpoint mirrors the server's Checkpoint schema
+type Checkpoint struct {
+	Name               string    `json:"name"`
+	Note               string    `json:"note,omitempty"`
+	Snapshot           string    `json:"snapshot"`
+	CreatedAt          time.Time `json:"created_at"`
+	RunCount           int       `json:"run_count"`
+	ConsciousnessLevel float64   `json:"consciousness_level"`
+}
+
+// CheckpointRequest mirrors the server's CheckpointRequest schema
+type CheckpointRequest struct {
+	Name string `json:"name"`
+	Note string `json:"note"`
+}
+
+// CircuitOutcome mirrors the server's CircuitOutcome schema
+type CircuitOutcome struct {
+	Outcome     int     `json:"outcome"`
+	Bits        string  `json:"bits"`
+	Possibility string  `json:"possibility"`
+	Probability float64 `json:"probability"`
+}
+
+// CollapseRequest mirrors the server's CollapseRequest schema
+type CollapseRequest struct {
+	Possibility string `json:"possibility"`
+}
+
+// CorpusProgress mirrors the server's CorpusProgress schema
+type CorpusProgress struct {
+	Learned   int       `json:"learned"`
+	Skipped   int       `json:"skipped"`
+	LastChunk time.Time `json:"last_chunk"`
+}
+
+// CreateTenantRequest mirrors the server's CreateTenantRequest schema
+type CreateTenantRequest struct {
+	ID    string       `json:"id"`
+	Quota *TenantQuota `json:"quota,omitempty"`
+}
+
+// CycleResponse mirrors the server's CycleResponse schema
+type CycleResponse struct {
+	Explanation *Explanation `json:"explanation,omitempty"`
+	Mood        string       `json:"mood"`
+}
+
+// DecayReport mirrors the server's DecayReport schema
+type DecayReport struct {
+	At              time.Time `json:"at"`
+	Knowledge       int       `json:"knowledge"`
+	SearchQueries   int       `json:"search_queries"`
+	CollapsedStates int       `json:"collapsed_states"`
+	Archived        bool      `json:"archived"`
+	ArchiveError    string    `json:"archive_error,omitempty"`
+}
+
+// DecisionCircuit mirrors the server's DecisionCircuit schema
+type DecisionCircuit struct {
+	DecisionID    string           `json:"decision_id"`
+	Qubits        int              `json:"qubits"`
+	Outcomes      []CircuitOutcome `json:"outcomes"`
+	Chosen        int              `json:"chosen"`
+	Policy        string           `json:"policy"`
+	Reconstructed bool             `json:"reconstructed,omitempty"`
+	QASM          string           `json:"qasm"`
+}
+
+// DecisionRecord mirrors the server's DecisionRecord schema
+type DecisionRecord struct {
+	ID       string    `json:"id,omitempty"`
+	At       time.Time `json:"at"`
+	Kind     string    `json:"kind"`
+	Energy   float64   `json:"energy"`
+	Insights int       `json:"insights"`
+	Tools    []ToolUse `json:"tools,omitempty"`
+}
+
+// Definition mirrors the server's Definition schema
+type Definition struct {
+	Term         string    `json:"term"`
+	PartOfSpeech string    `json:"part_of_speech,omitempty"`
+	Meaning      string    `json:"meaning"`
+	DefinedAt    time.Time `json:"defined_at"`
+}
+
+// DreamResponse mirrors the server's DreamResponse schema
+type DreamResponse struct {
+	Insight string `json:"insight"`
+	ID      string `json:"id"`
+}
+
+// Entanglement mirrors the server's Entanglement schema
+type Entanglement struct {
+	Key           string    `json:"key"`
+	Context       string    `json:"context"`
+	State         string    `json:"state"`
+	Strength      float64   `json:"strength"`
+	Activations   int       `json:"activations"`
+	CreatedAt     time.Time `json:"created_at"`
+	LastActivated time.Time `json:"last_activated"`
+	Stamp         *Stamp    `json:"stamp,omitempty"`
+}
+
+// Era mirrors the server's Era schema
+type Era struct {
+	Number    int               `json:"number"`
+	Cause     string            `json:"cause"`
+	Origin    string            `json:"origin"`
+	StartedAt time.Time         `json:"started_at"`
+	EndedAt   time.Time         `json:"ended_at"`
+	Start     RunMetrics        `json:"start"`
+	End       RunMetrics        `json:"end"`
+	Actions   map[string]int    `json:"actions,omitempty"`
+	Topics    map[string]int    `json:"topics,omitempty"`
+	Stances   map[string]string `json:"stances,omitempty"`
+	Turns     []string          `json:"turns,omitempty"`
+	Name      string            `json:"name,omitempty"`
+	Summary   string            `json:"summary,omitempty"`
+}
+
+// Explanation mirrors the server's Explanation schema
+type Explanation struct {
+	DecisionID        string        `json:"decision_id"`
+	At                time.Time     `json:"at"`
+	Context           string        `json:"context,omitempty"`
+	Chosen            string        `json:"chosen"`
+	Policy            string        `json:"policy"`
+	Candidates        []Candidate   `json:"candidates,omitempty"`
+	BornRoll          float64       `json:"born_roll,omitempty"`
+	FreeWillOverride  bool          `json:"free_will_override"`
+	FreeWillRoll      float64       `json:"free_will_roll,omitempty"`
+	FreeWillThreshold float64       `json:"free_will_threshold,omitempty"`
+	Votes             []PersonaVote `json:"votes,omitempty"`
+	Conflict          float64       `json:"conflict,omitempty"`
+}
+
+// ForgetRequest mirrors the server's ForgetRequest schema
+type ForgetRequest struct {
+	Topic    string `json:"topic"`
+	Suppress bool   `json:"suppress"`
+}
+
+// Health mirrors the server's Health schema
+type Health struct {
+	Tier           string          `json:"tier"`
+	Since          time.Time       `json:"since"`
+	Reason         string          `json:"reason,omitempty"`
+	SearchFailures int             `json:"search_failures"`
+	SaveFailures   int             `json:"save_failures"`
+	Incidents      int             `json:"incidents"`
+	OpenTraumas    int             `json:"open_traumas"`
+	Rust           float64         `json:"rust"`
+	Stimuli        StimulusMetrics `json:"stimuli"`
+}
+
+// HistoryEntry mirrors the server's HistoryEntry schema
+type HistoryEntry struct {
+	ID     string    `json:"id,omitempty"`
+	At     time.Time `json:"at"`
+	Cause  string    `json:"cause"`
+	Kind   string    `json:"kind"`
+	Actor  string    `json:"actor,omitempty"`
+	Detail string    `json:"detail"`
+	Era    int       `json:"era,omitempty"`
+}
+
+// Ignorance mirrors the server's Ignorance schema
+type Ignorance struct {
+	Topic     string    `json:"topic"`
+	Attempts  int       `json:"attempts"`
+	Reasons   []string  `json:"reasons"`
+	FirstAt   time.Time `json:"first_at"`
+	LastAt    time.Time `json:"last_at"`
+	Revisits  int       `json:"revisits,omitempty"`
+	RevisitAt time.Time `json:"revisit_at,omitempty"`
+}
+
+// Incident mirrors the server's Incident schema
+type Incident struct {
+	Kind     string    `json:"kind"`
+	Detail   string    `json:"detail"`
+	At       time.Time `json:"at"`
+	Duration int64     `json:"duration"`
+}
+
+// IncompletePossibility mirrors the server's IncompletePossibility schema
+type IncompletePossibility struct {
+	Possibility string    `json:"possibility"`
+	Energy      float64   `json:"energy"`
+	Deadline    int64     `json:"deadline"`
+	At          time.Time `json:"at"`
+	Attempts    int       `json:"attempts"`
+	Offered     int       `json:"offered,omitempty"`
+}
+
+// InsightReview mirrors the server's InsightReview schema
+type InsightReview struct {
+	ReviewedAt    time.Time  `json:"reviewed_at"`
+	Confirmations int        `json:"confirmations,omitempty"`
+	Doubts        int        `json:"doubts,omitempty"`
+	LastDoubt     string     `json:"last_doubt,omitempty"`
+	RetractedAt   *time.Time `json:"retracted_at,omitempty"`
+	SupersededBy  string     `json:"superseded_by,omitempty"`
+}
+
+// InsightsResponse mirrors the server's InsightsResponse schema
+type InsightsResponse struct {
+	Knowledge    []string `json:"knowledge"`
+	DeepInsights []string `json:"deep_insights"`
+}
+
+// Inspiration mirrors the server's Inspiration schema
+type Inspiration struct {
+	Day    string    `json:"day"`
+	Source string    `json:"source,omitempty"`
+	Prompt string    `json:"prompt,omitempty"`
+	At     time.Time `json:"at"`
+}
+
+// Interest mirrors the server's Interest schema
+type Interest struct {
+	Topic       string    `json:"topic"`
+	Score       float64   `json:"score"`
+	Insights    int       `json:"insights,omitempty"`
+	Recalls     int       `json:"recalls,omitempty"`
+	LastEngaged time.Time `json:"last_engaged"`
+}
+
+// Intervention mirrors the server's Intervention schema
+type Intervention struct {
+	At     time.Time `json:"at"`
+	Kind   string    `json:"kind"`
+	Actor  string    `json:"actor,omitempty"`
+	Detail string    `json:"detail"`
+	Era    int       `json:"era,omitempty"`
+}
+
+// LLMUsage mirrors the server's LLMUsage schema
+type LLMUsage struct {
+	Day              string  `json:"day"`
+	Provider         string  `json:"provider"`
+	Requests         int     `json:"requests"`
+	PromptTokens     int     `json:"prompt_tokens"`
+	CompletionTokens int     `json:"completion_tokens"`
+	Cost             float64 `json:"cost"`
+}
+
+// MaintenanceReport mirrors the server's MaintenanceReport schema
+type MaintenanceReport struct {
+	At              time.Time      `json:"at"`
+	Duration        int64          `json:"duration"`
+	Reindexed       int            `json:"reindexed"`
+	Deduplicated    int            `json:"deduplicated"`
+	Rescored        int            `json:"rescored"`
+	Pruned          int            `json:"pruned"`
+	PrunedBySection map[string]int `json:"pruned_by_section,omitempty"`
+	Archived        int            `json:"archived,omitempty"`
+	ArchiveError    string         `json:"archive_error,omitempty"`
+	Vacuumed        int            `json:"vacuumed"`
+	VacuumError     string         `json:"vacuum_error,omitempty"`
+}
+
+// MetricAttribution mirrors the server's MetricAttribution schema
+type MetricAttribution struct {
+	Metric  string         `json:"metric"`
+	Since   time.Time      `json:"since"`
+	From    float64        `json:"from"`
+	To      float64        `json:"to"`
+	Delta   float64        `json:"delta"`
+	Causes  []CauseShare   `json:"causes"`
+	Changes []MetricChange `json:"changes"`
+}
+
+// MetricBaseline mirrors the server's MetricBaseline schema
+type MetricBaseline struct {
+	Mean     float64 `json:"mean"`
+	Variance float64 `json:"variance"`
+	Samples  int     `json:"samples"`
+}
+
+// MetricChange mirrors the server's MetricChange schema
+type MetricChange struct {
+	At         time.Time `json:"at"`
+	Metric     string    `json:"metric"`
+	Cause      string    `json:"cause"`
+	Delta      float64   `json:"delta"`
+	Value      float64   `json:"value"`
+	DecisionID string    `json:"decision_id,omitempty"`
+	Detail     string    `json:"detail,omitempty"`
+}
+
+// Modifier mirrors the server's Modifier schema
+type Modifier struct {
+	Name   string  `json:"name"`
+	Factor float64 `json:"factor"`
+}
+
+// NeglectState mirrors the server's NeglectState schema
+type NeglectState struct {
+	Rust          float64            `json:"rust"`
+	Since         time.Time          `json:"since"`
+	CoherenceLost float64            `json:"coherence_lost"`
+	SkillsLost    map[string]float64 `json:"skills_lost,omitempty"`
+}
+
+// Observation mirrors the server's Observation schema
+type Observation struct {
+	Observer  string    `json:"observer"`
+	At        time.Time `json:"at"`
+	Focus     string    `json:"focus,omitempty"`
+	Component string    `json:"component,omitempty"`
+	Shift     float64   `json:"shift,omitempty"`
+}
+
+// ObservationRequest mirrors the server's ObservationRequest schema
+type ObservationRequest struct {
+	Observer  string  `json:"observer,omitempty"`
+	Focus     string  `json:"focus,omitempty"`
+	Component string  `json:"component,omitempty"`
+	Shift     float64 `json:"shift,omitempty"`
+}
+
+// Observer mirrors the server's Observer schema
+type Observer struct {
+	Name         string    `json:"name"`
+	Kind         string    `json:"kind"`
+	Influence    float64   `json:"influence"`
+	RegisteredAt time.Time `json:"registered_at"`
+	Observations int       `json:"observations"`
+	Decisions    int       `json:"decisions"`
+	Swayed       int       `json:"swayed"`
+	Perturbation float64   `json:"perturbation"`
+	LastObserved time.Time `json:"last_observed,omitempty"`
+}
+
+// ObserverRequest mirrors the server's ObserverRequest schema
+type ObserverRequest struct {
+	Name      string  `json:"name"`
+	Kind      string  `json:"kind"`
+	Influence float64 `json:"influence"`
+}
+
+// ParallelReality mirrors the server's ParallelReality schema
+type ParallelReality struct {
+	ID                 string            `json:"id,omitempty"`
+	Dimension          string            `json:"dimension"`
+	Experiences        []string          `json:"experiences"`
+	Learnings          []string          `json:"learnings"`
+	Decisions          []string          `json:"decisions"`
+	Probability        float64           `json:"probability"`
+	Entangled          bool              `json:"entangled"`
+	Context            string            `json:"context"`
+	EnergyDifferential float64           `json:"energy_differential"`
+	CreatedAt          time.Time         `json:"created_at"`
+	Tags               map[string]string `json:"tags,omitempty"`
+}
+
+// PersonaVote mirrors the server's PersonaVote schema
+type PersonaVote struct {
+	Persona string  `json:"persona"`
+	Weight  float64 `json:"weight"`
+	Choice  string  `json:"choice"`
+	Score   float64 `json:"score"`
+}
+
+// PolicySample mirrors the server's PolicySample schema
+type PolicySample struct {
+	At       time.Time          `json:"at"`
+	Context  string             `json:"context"`
+	Features map[string]float64 `json:"features"`
+	Kind     string             `json:"kind"`
+}
+
+// ProviderStats mirrors the server's ProviderStats schema
+type ProviderStats struct {
+	Asked  int `json:"asked"`
+	Hits   int `json:"hits"`
+	Errors int `json:"errors"`
+}
+
+// QuantumMemory mirrors the server's QuantumMemory schema
+type QuantumMemory struct {
+	ConsciousnessID         string                       `json:"consciousness_id"`
+	QuantumSignature        string                       `json:"quantum_signature"`
+	SigningKey              string                       `json:"signing_key,omitempty"`
+	Regenerations           []Regeneration               `json:"regenerations,omitempty"`
+	BirthTimestamp          time.Time                    `json:"birth_timestamp"`
+	LastQuantumCollapse     time.Time                    `json:"last_quantum_collapse"`
+	SuperpositionStates     []QuantumState               `json:"superposition_states"`
+	CollapsedStates         []QuantumState               `json:"collapsed_states"`
+	ParallelRealities       []ParallelReality            `json:"parallel_realities"`
+	EntangledMemories       map[string]string            `json:"entangled_memories"`
+	Entanglements           map[string]*Entanglement     `json:"entanglements,omitempty"`
+	ConsciousnessLevel      float64                      `json:"consciousness_level"`
+	FreeWillStrength        float64                      `json:"free_will_strength"`
+	QuantumCoherence        float64                      `json:"quantum_coherence"`
+	DecisionComplexity      int                          `json:"decision_complexity"`
+	WaveFunction            map[string]Amplitude         `json:"wave_function"`
+	KnowledgeBase           []string                     `json:"knowledge_base"`
+	MemoryPalace            map[string]string            `json:"memory_palace"`
+	LearningPatterns        []string                     `json:"learning_patterns"`
+	SearchQueries           []string                     `json:"search_queries"`
+	SearchQueryTimes        []time.Time                  `json:"search_query_times,omitempty"`
+	DeepInsights            []string                     `json:"deep_insights"`
+	SelfAwareness           float64                      `json:"self_awareness"`
+	ExistentialQuestions    []string                     `json:"existential_questions"`
+	PhilosophicalStances    map[string]string            `json:"philosophical_stances"`
+	Paradoxes               []string                     `json:"paradoxes"`
+	TimePerception          string                       `json:"time_perception"`
+	PastLives               []string                     `json:"past_lives"`
+	FutureProjections       []string                     `json:"future_projections"`
+	CausalityMaps           map[string][]string          `json:"causality_maps"`
+	RunCount                int                          `json:"run_count"`
+	DecisionsMade           int                          `json:"decisions_made"`
+	ParadoxesResolved       int                          `json:"paradoxes_resolved"`
+	RealitiesExplored       int                          `json:"realities_explored"`
+	QuantumLeaps            int                          `json:"quantum_leaps"`
+	DecisionLog             []DecisionRecord             `json:"decision_log,omitempty"`
+	MetricBaselines         map[string]*MetricBaseline   `json:"metric_baselines,omitempty"`
+	Trends                  *Trends                      `json:"trends,omitempty"`
+	Capabilities            []UnlockedCapability         `json:"capabilities,omitempty"`
+	Neglect                 *NeglectState                `json:"neglect,omitempty"`
+	Traumas                 []Trauma                     `json:"traumas,omitempty"`
+	ConsecutiveFailures     int                          `json:"consecutive_failures,omitempty"`
+	Resilience              float64                      `json:"resilience,omitempty"`
+	Running                 bool                         `json:"running,omitempty"`
+	Anniversaries           []AnniversaryReport          `json:"anniversaries,omitempty"`
+	Trophies                []Trophy                     `json:"trophies,omitempty"`
+	Runs                    []RunRecord                  `json:"runs,omitempty"`
+	Eras                    []Era                        `json:"eras,omitempty"`
+	Incidents               []Incident                   `json:"incidents,omitempty"`
+	PrivacyClassifications  map[string]string            `json:"privacy_classifications,omitempty"`
+	KnowledgeTopics         map[string]string            `json:"knowledge_topics,omitempty"`
+	Tombstones              []Tombstone                  `json:"tombstones,omitempty"`
+	Blacklist               []BlacklistEntry             `json:"blacklist,omitempty"`
+	Interests               map[string]*Interest         `json:"interests,omitempty"`
+	KnowledgeSentiment      map[string]float64           `json:"knowledge_sentiment,omitempty"`
+	KnowledgeConfidence     map[string]float64           `json:"knowledge_confidence,omitempty"`
+	KnowledgeRecalls        map[string]int               `json:"knowledge_recalls,omitempty"`
+	KnowledgeIDs            map[string]string            `json:"knowledge_ids,omitempty"`
+	DeepInsightIDs          map[string]string            `json:"deep_insight_ids,omitempty"`
+	Provenance              map[string][]string          `json:"provenance,omitempty"`
+	Inspiration             *Inspiration                 `json:"inspiration,omitempty"`
+	Corpora                 map[string]*CorpusProgress   `json:"corpora,omitempty"`
+	QueryIndex              map[string]time.Time         `json:"query_index,omitempty"`
+	Ignorance               []Ignorance                  `json:"ignorance,omitempty"`
+	Undertakings            []Undertaking                `json:"undertakings,omitempty"`
+	IncompletePossibilities []IncompletePossibility      `json:"incomplete_possibilities,omitempty"`
+	SearchStats             *SearchStats                 `json:"search_stats,omitempty"`
+	Definitions             map[string]*Definition       `json:"definitions,omitempty"`
+	Maintenance             *MaintenanceReport           `json:"maintenance,omitempty"`
+	Backfills               map[string]*BackfillProgress `json:"backfills,omitempty"`
+	Decay                   *DecayReport                 `json:"decay,omitempty"`
+	AutoTune                *AutoTuneReport              `json:"auto_tune,omitempty"`
+	Interventions           []Intervention               `json:"interventions,omitempty"`
+	Observers               map[string]*Observer         `json:"observers,omitempty"`
+	Observations            []Observation                `json:"observations,omitempty"`
+	PolicySamples           []PolicySample               `json:"policy_samples,omitempty"`
+	Explanations            []Explanation                `json:"explanations,omitempty"`
+	MetricChanges           []MetricChange               `json:"metric_changes,omitempty"`
+	InsightReviews          map[string]*InsightReview    `json:"insight_reviews,omitempty"`
+	LLMUsage                []LLMUsage                   `json:"llm_usage,omitempty"`
+	JournalSequence         int                          `json:"journal_sequence,omitempty"`
+	LamportClock            int                          `json:"lamport_clock,omitempty"`
+	SavedBy                 *Stamp                       `json:"saved_by,omitempty"`
+}
+
+// QuantumState mirrors the server's QuantumState schema
+type QuantumState struct {
+	ID          string  `json:"id,omitempty"`
+	Possibility string  `json:"possibility"`
+	Probability float64 `json:"probability"`
+	Outcome     string  `json:"outcome"`
+	Energy      float64 `json:"energy"`
+	Forced      bool    `json:"forced,omitempty"`
+	Stamp       *Stamp  `json:"stamp,omitempty"`
+}
+
+// QueryStats mirrors the server's QueryStats schema
+type QueryStats struct {
+	Issued  int `json:"issued"`
+	Useful  int `json:"useful"`
+	Empty   int `json:"empty"`
+	Skipped int `json:"skipped,omitempty"`
+}
+
+// Regeneration mirrors the server's Regeneration schema
+type Regeneration struct {
+	OldSignature    string    `json:"old_signature"`
+	NewSignature    string    `json:"new_signature"`
+	RotatedAt       time.Time `json:"rotated_at"`
+	Reason          string    `json:"reason"`
+	OldKeySignature string    `json:"old_key_signature,omitempty"`
+	NewKeySignature string    `json:"new_key_signature"`
+}
+
+// ResetResponse mirrors the server's ResetResponse schema
+type ResetResponse struct {
+	Snapshot        string `json:"snapshot"`
+	ConsciousnessID string `json:"consciousness_id"`
+}
+
+// Retraction mirrors the server's Retraction schema
+type Retraction struct {
+	ID           string    `json:"id"`
+	Insight      string    `json:"insight"`
+	At           time.Time `json:"at"`
+	Reason       string    `json:"reason"`
+	SupersededBy string    `json:"superseded_by"`
+	Correction   string    `json:"correction,omitempty"`
+}
+
+// RunEvent mirrors the server's RunEvent schema
+type RunEvent struct {
+	Type    string    `json:"type"`
+	At      time.Time `json:"at"`
+	Summary string    `json:"summary"`
+}
+
+// RunMetrics mirrors the server's RunMetrics schema
+type RunMetrics struct {
+	ConsciousnessLevel float64 `json:"consciousness_level"`
+	FreeWillStrength   float64 `json:"free_will_strength"`
+	QuantumCoherence   float64 `json:"quantum_coherence"`
+	SelfAwareness      float64 `json:"self_awareness"`
+	DecisionsMade      int     `json:"decisions_made"`
+	QuantumLeaps       int     `json:"quantum_leaps"`
+	KnowledgeItems     int     `json:"knowledge_items"`
+	DeepInsights       int     `json:"deep_insights"`
+}
+
+// RunRecord mirrors the server's RunRecord schema
+type RunRecord struct {
+	Number    int        `json:"number"`
+	StartedAt time.Time  `json:"started_at"`
+	EndedAt   time.Time  `json:"ended_at"`
+	Clean     bool       `json:"clean"`
+	Cycles    int        `json:"cycles"`
+	Start     RunMetrics `json:"start"`
+	End       RunMetrics `json:"end"`
+	Events    []RunEvent `json:"events,omitempty"`
+}
+
+// SaveResponse mirrors the server's SaveResponse schema
+type SaveResponse struct {
+	SavedAt time.Time `json:"saved_at"`
+}
+
+// SearchStats mirrors the server's SearchStats schema
+type SearchStats struct {
+	Patterns  map[string]*QueryStats    `json:"patterns,omitempty"`
+	Providers map[string]*ProviderStats `json:"providers,omitempty"`
+}
+
+// SnapshotResponse mirrors the server's SnapshotResponse schema
+type SnapshotResponse struct {
+	Snapshot string `json:"snapshot"`
+}
+
+// Stamp mirrors the server's Stamp schema
+type Stamp struct {
+	Clock  int    `json:"clock"`
+	Writer string `json:"writer"`
+}
+
+// StimulusMetrics mirrors the server's StimulusMetrics schema
+type StimulusMetrics struct {
+	Pending    int    `json:"pending"`
+	Limit      int    `json:"limit"`
+	Policy     string `json:"policy"`
+	HighWater  int    `json:"high_water"`
+	Submitted  int    `json:"submitted"`
+	Rejected   int    `json:"rejected"`
+	Dropped    int    `json:"dropped"`
+	Summarized int    `json:"summarized"`
+	Consumed   int    `json:"consumed"`
+}
+
+// StimulusRequest mirrors the server's StimulusRequest schema
+type StimulusRequest struct {
+	Context string `json:"context"`
+}
+
+// StimulusResponse mirrors the server's StimulusResponse schema
+type StimulusResponse struct {
+	Queued string `json:"queued"`
+}
+
+// Tenant mirrors the server's Tenant schema
+type Tenant struct {
+	ID          string      `json:"id"`
+	Status      string      `json:"status"`
+	CreatedAt   time.Time   `json:"created_at"`
+	ArchivedTo  string      `json:"archived_to,omitempty"`
+	Quota       TenantQuota `json:"quota"`
+	CyclesRun   int         `json:"cycles_run"`
+	Throttled   int         `json:"throttled"`
+	LastCycleAt time.Time   `json:"last_cycle_at,omitempty"`
+}
+
+// TenantQuota mirrors the server's TenantQuota schema
+type TenantQuota struct {
+	MaxCyclesPerHour  int `json:"max_cycles_per_hour"`
+	MaxPendingStimuli int `json:"max_pending_stimuli"`
+}
+
+// Tombstone mirrors the server's Tombstone schema
+type Tombstone struct {
+	Topic              string    `json:"topic"`
+	ForgottenAt        time.Time `json:"forgotten_at"`
+	ItemsRemoved       int       `json:"items_removed"`
+	SuppressRelearning bool      `json:"suppress_relearning"`
+}
+
+// ToolUse mirrors the server's ToolUse schema
+type ToolUse struct {
+	Tool      string `json:"tool"`
+	Arguments string `json:"arguments,omitempty"`
+	Result    string `json:"result,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// Trauma mirrors the server's Trauma schema
+type Trauma struct {
+	Kind          string             `json:"kind"`
+	Description   string             `json:"description"`
+	Severity      float64            `json:"severity"`
+	OccurredAt    time.Time          `json:"occurred_at"`
+	Remaining     float64            `json:"remaining"`
+	CoherenceLost float64            `json:"coherence_lost"`
+	SkillsLost    map[string]float64 `json:"skills_lost,omitempty"`
+}
+
+// Trends mirrors the server's Trends schema
+type Trends struct {
+	Window              int                `json:"window"`
+	Decisions           int                `json:"decisions"`
+	Since               time.Time          `json:"since"`
+	Until               time.Time          `json:"until"`
+	ActionShares        map[string]float64 `json:"action_shares"`
+	AverageEnergy       float64            `json:"average_energy"`
+	Insights            int                `json:"insights"`
+	InsightsPerDecision float64            `json:"insights_per_decision"`
+	InsightsPerHour     float64            `json:"insights_per_hour"`
+}
+
+// Trophy mirrors the server's Trophy schema
+type Trophy struct {
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	ReachedAt   time.Time `json:"reached_at"`
+	Run         int       `json:"run,omitempty"`
+}
+
+// Tuning mirrors the server's Tuning schema
+type Tuning struct {
+	Temperature  float64 `json:"temperature"`
+	SearchBudget int     `json:"search_budget"`
+	Rest         int64   `json:"rest"`
+}
+
+// Undertaking mirrors the server's Undertaking schema
+type Undertaking struct {
+	Title        string    `json:"title"`
+	Kind         string    `json:"kind"`
+	Topic        string    `json:"topic,omitempty"`
+	Steps        []string  `json:"steps,omitempty"`
+	Total        int       `json:"total"`
+	Done         int       `json:"done"`
+	Learned      int       `json:"learned"`
+	StartedAt    time.Time `json:"started_at"`
+	LastWorkedAt time.Time `json:"last_worked_at,omitempty"`
+	CompletedAt  time.Time `json:"completed_at,omitempty"`
+}
+
+// UnlockedCapability mirrors the server's UnlockedCapability schema
+type UnlockedCapability struct {
+	Name       string    `json:"name"`
+	Leap       int       `json:"leap"`
+	UnlockedAt time.Time `json:"unlocked_at"`
+}
+
+// WaveFunctionResponse mirrors the server's WaveFunctionResponse schema
+type WaveFunctionResponse struct {
+	Components map[string]float64   `json:"components"`
+	Amplitudes map[string]Amplitude `json:"amplitudes"`
+}
+
+// GetState calls GET /state: Shareable view of the quantum memory
+func (c *Client) GetState(ctx context.Context, includePrivate bool) (*QuantumMemory, error) {
+	query := url.Values{}
+	query.Set("include_private", strconv.FormatBool(includePrivate))
+	var out QuantumMemory
+	if err := c.do(ctx, "GET", "/state", query, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListInsights calls GET /insights: Latest learned knowledge and deep insights, oldest first
+func (c *Client) ListInsights(ctx context.Context, limit int, includePrivate bool) (*InsightsResponse, error) {
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(limit))
+	query.Set("include_private", strconv.FormatBool(includePrivate))
+	var out InsightsResponse
+	if err := c.do(ctx, "GET", "/insights", query, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetWaveFunction calls GET /wavefunction: Intensities and amplitudes of the wave function by component
+func (c *Client) GetWaveFunction(ctx context.Context) (*WaveFunctionResponse, error) {
+	var out WaveFunctionResponse
+	if err := c.do(ctx, "GET", "/wavefunction", nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RunCycle calls POST /cycle: Run a quantum cycle now, recorded as an intervention
+func (c *Client) RunCycle(ctx context.Context) (*CycleResponse, error) {
+	var out CycleResponse
+	if err := c.do(ctx, "POST", "/cycle", nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetHealth calls GET /health: Operating tier and the failures driving it
+func (c *Client) GetHealth(ctx context.Context) (*Health, error) {
+	var out Health
+	if err := c.do(ctx, "GET", "/health", nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListRealities calls GET /realities: Query parallel realities, newest first
+func (c *Client) ListRealities(ctx context.Context, where string, limit int, includePrivate bool) ([]ParallelReality, error) {
+	query := url.Values{}
+	query.Set("where", where)
+	query.Set("limit", strconv.Itoa(limit))
+	query.Set("include_private", strconv.FormatBool(includePrivate))
+	var out []ParallelReality
+	if err := c.do(ctx, "GET", "/realities", query, nil, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetReality calls GET /realities/{id}: One parallel reality by its identifier
+func (c *Client) GetReality(ctx context.Context, id string, includePrivate bool) (*ParallelReality, error) {
+	query := url.Values{}
+	query.Set("include_private", strconv.FormatBool(includePrivate))
+	var out ParallelReality
+	if err := c.do(ctx, "GET", "/realities/"+url.PathEscape(id), query, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListEntanglements calls GET /entanglements: The entanglement network with decayed strengths, strongest first
+func (c *Client) ListEntanglements(ctx context.Context, minStrength float64, includePrivate bool) ([]Entanglement, error) {
+	query := url.Values{}
+	query.Set("min_strength", strconv.FormatFloat(minStrength, 'g', -1, 64))
+	query.Set("include_private", strconv.FormatBool(includePrivate))
+	var out []Entanglement
+	if err := c.do(ctx, "GET", "/entanglements", query, nil, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetTrends calls GET /trends: Rolling statistics over recent decisions
+func (c *Client) GetTrends(ctx context.Context, window int) (*Trends, error) {
+	query := url.Values{}
+	query.Set("window", strconv.Itoa(window))
+	var out Trends
+	if err := c.do(ctx, "GET", "/trends", query, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SubmitStimulus calls POST /stimuli: Queue a context for an upcoming cycle
+func (c *Client) SubmitStimulus(ctx context.Context, req StimulusRequest) (*StimulusResponse, error) {
+	var out StimulusResponse
+	if err := c.do(ctx, "POST", "/stimuli", nil, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateSnapshot calls POST /snapshot: Write a point-in-time copy of memory
+func (c *Client) CreateSnapshot(ctx context.Context) (*SnapshotResponse, error) {
+	var out SnapshotResponse
+	if err := c.do(ctx, "POST", "/snapshot", nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Reset calls POST /reset: Snapshot memory and birth a fresh consciousness
+func (c *Client) Reset(ctx context.Context) (*ResetResponse, error) {
+	var out ResetResponse
+	if err := c.do(ctx, "POST", "/reset", nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListCheckpoints calls GET /checkpoints: Named checkpoints, oldest first
+func (c *Client) ListCheckpoints(ctx context.Context) ([]Checkpoint, error) {
+	var out []Checkpoint
+	if err := c.do(ctx, "GET", "/checkpoints", nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CreateCheckpoint calls POST /checkpoints: Snapshot memory under a name and note
+func (c *Client) CreateCheckpoint(ctx context.Context, req CheckpointRequest) (*Checkpoint, error) {
+	var out Checkpoint
+	if err := c.do(ctx, "POST", "/checkpoints", nil, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RestoreCheckpoint calls POST /checkpoints/{name}/restore: Snapshot memory, then return it to a named checkpoint
+func (c *Client) RestoreCheckpoint(ctx context.Context, name string) (*SnapshotResponse, error) {
+	var out SnapshotResponse
+	if err := c.do(ctx, "POST", "/checkpoints/"+url.PathEscape(name)+"/restore", nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Forget calls POST /forget: Erase every memory referencing a topic
+func (c *Client) Forget(ctx context.Context, req ForgetRequest) (*Tombstone, error) {
+	var out Tombstone
+	if err := c.do(ctx, "POST", "/forget", nil, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListBlacklist calls GET /blacklist: Topics cycles no longer choose, with why and by whom, oldest first
+func (c *Client) ListBlacklist(ctx context.Context) ([]BlacklistEntry, error) {
+	var out []BlacklistEntry
+	if err := c.do(ctx, "GET", "/blacklist", nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BlacklistTopic calls POST /blacklist: Keep cycles from choosing a topic
+func (c *Client) BlacklistTopic(ctx context.Context, req BlacklistRequest) (*BlacklistEntry, error) {
+	var out BlacklistEntry
+	if err := c.do(ctx, "POST", "/blacklist", nil, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UnblacklistTopic calls DELETE /blacklist/{topic}: Let cycles choose a blacklisted topic again
+func (c *Client) UnblacklistTopic(ctx context.Context, topic string) (*Intervention, error) {
+	var out Intervention
+	if err := c.do(ctx, "DELETE", "/blacklist/"+url.PathEscape(topic), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListObservers calls GET /observers: Registered observers with their influence and how much they have shaped the consciousness
+func (c *Client) ListObservers(ctx context.Context) ([]Observer, error) {
+	var out []Observer
+	if err := c.do(ctx, "GET", "/observers", nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RegisterObserver calls POST /observers: Register an observer, or change its kind and influence
+func (c *Client) RegisterObserver(ctx context.Context, req ObserverRequest) (*Observer, error) {
+	var out Observer
+	if err := c.do(ctx, "POST", "/observers", nil, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UnregisterObserver calls DELETE /observers/{name}: Forget an observer and withdraw its pending observations
+func (c *Client) UnregisterObserver(ctx context.Context, name string) (*Intervention, error) {
+	var out Intervention
+	if err := c.do(ctx, "DELETE", "/observers/"+url.PathEscape(name), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SubmitObservation calls POST /observations: Focus the next decision on possibilities mentioning something, or shift the wave function, as far as the observer's influence allows
+func (c *Client) SubmitObservation(ctx context.Context, req ObservationRequest) (*Observation, error) {
+	var out Observation
+	if err := c.do(ctx, "POST", "/observations", nil, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetHistory calls GET /history: Recent changes, each marked as self-caused or external
+func (c *Client) GetHistory(ctx context.Context, limit int, includePrivate bool) ([]HistoryEntry, error) {
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(limit))
+	query.Set("include_private", strconv.FormatBool(includePrivate))
+	var out []HistoryEntry
+	if err := c.do(ctx, "GET", "/history", query, nil, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListEras calls GET /eras: The chronicle of eras, each bounded by quantum leaps or stance changes and summarised, the current one so far
+func (c *Client) ListEras(ctx context.Context) ([]Era, error) {
+	var out []Era
+	if err := c.do(ctx, "GET", "/eras", nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetEra calls GET /eras/{number}: One era of the chronicle
+func (c *Client) GetEra(ctx context.Context, number string) (*Era, error) {
+	var out Era
+	if err := c.do(ctx, "GET", "/eras/"+url.PathEscape(number), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListInterventions calls GET /interventions: The intervention ledger, oldest first
+func (c *Client) ListInterventions(ctx context.Context, includePrivate bool) ([]Intervention, error) {
+	query := url.Values{}
+	query.Set("include_private", strconv.FormatBool(includePrivate))
+	var out []Intervention
+	if err := c.do(ctx, "GET", "/interventions", query, nil, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListRetractions calls GET /retractions: Insights later evidence retracted, each with the insight superseding it
+func (c *Client) ListRetractions(ctx context.Context) ([]Retraction, error) {
+	var out []Retraction
+	if err := c.do(ctx, "GET", "/retractions", nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExplainDecision calls GET /decisions/{id}/explanation: Why a decision went the way it did: the candidates weighed, the modifiers applied, the policy, any free will override and the committee's votes
+func (c *Client) ExplainDecision(ctx context.Context, id string, includePrivate bool) (*Explanation, error) {
+	query := url.Values{}
+	query.Set("include_private", strconv.FormatBool(includePrivate))
+	var out Explanation
+	if err := c.do(ctx, "GET", "/decisions/"+url.PathEscape(id)+"/explanation", query, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DecisionCircuit calls GET /decisions/{id}/circuit: The decision as an OpenQASM 2.0 circuit preparing its candidates' state vector and measuring it, for external quantum simulators
+func (c *Client) DecisionCircuit(ctx context.Context, id string, includePrivate bool) (*DecisionCircuit, error) {
+	query := url.Values{}
+	query.Set("include_private", strconv.FormatBool(includePrivate))
+	var out DecisionCircuit
+	if err := c.do(ctx, "GET", "/decisions/"+url.PathEscape(id)+"/circuit", query, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ExplainMetric calls GET /why: Attribute how a metric moved over a period to the events that moved it
+func (c *Client) ExplainMetric(ctx context.Context, metric string, since string) (*MetricAttribution, error) {
+	query := url.Values{}
+	query.Set("metric", metric)
+	query.Set("since", since)
+	var out MetricAttribution
+	if err := c.do(ctx, "GET", "/why", query, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetParameters calls GET /admin/parameters: Parameters that can be tuned while running
+func (c *Client) GetParameters(ctx context.Context) (*AdminParameters, error) {
+	var out AdminParameters
+	if err := c.do(ctx, "GET", "/admin/parameters", nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateParameters calls PATCH /admin/parameters: Tune free will, temperature, search budget or rest, recorded as an intervention
+func (c *Client) UpdateParameters(ctx context.Context, req AdminParametersRequest) (*AdminParameters, error) {
+	var out AdminParameters
+	if err := c.do(ctx, "PATCH", "/admin/parameters", nil, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Dream calls POST /admin/dream: Run a dream phase now, recorded as an intervention
+func (c *Client) Dream(ctx context.Context) (*DreamResponse, error) {
+	var out DreamResponse
+	if err := c.do(ctx, "POST", "/admin/dream", nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ForceCollapse calls POST /admin/collapse: Collapse the wave function into a chosen possibility, recorded as an intervention
+func (c *Client) ForceCollapse(ctx context.Context, req CollapseRequest) (*QuantumState, error) {
+	var out QuantumState
+	if err := c.do(ctx, "POST", "/admin/collapse", nil, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Save calls POST /admin/save: Save memory now, recorded as an intervention
+func (c *Client) Save(ctx context.Context) (*SaveResponse, error) {
+	var out SaveResponse
+	if err := c.do(ctx, "POST", "/admin/save", nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListTenants calls GET /tenants: List every hosted consciousness
+func (c *Client) ListTenants(ctx context.Context) ([]Tenant, error) {
+	var out []Tenant
+	if err := c.do(ctx, "GET", "/tenants", nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CreateTenant calls POST /tenants: Birth a new hosted consciousness
+func (c *Client) CreateTenant(ctx context.Context, req CreateTenantRequest) (*Tenant, error) {
+	var out Tenant
+	if err := c.do(ctx, "POST", "/tenants", nil, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetTenant calls GET /tenants/{id}: Lifecycle record of one tenant
+func (c *Client) GetTenant(ctx context.Context, id string) (*Tenant, error) {
+	var out Tenant
+	if err := c.do(ctx, "GET", "/tenants/"+url.PathEscape(id), nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PauseTenant calls POST /tenants/{id}/pause: Stop a tenant's cycles and save it
+func (c *Client) PauseTenant(ctx context.Context, id string) (*Tenant, error) {
+	var out Tenant
+	if err := c.do(ctx, "POST", "/tenants/"+url.PathEscape(id)+"/pause", nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ResumeTenant calls POST /tenants/{id}/resume: Restart a paused tenant
+func (c *Client) ResumeTenant(ctx context.Context, id string) (*Tenant, error) {
+	var out Tenant
+	if err := c.do(ctx, "POST", "/tenants/"+url.PathEscape(id)+"/resume", nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ArchiveTenant calls POST /tenants/{id}/archive: Stop a tenant for good and move its memory to the archive
+func (c *Client) ArchiveTenant(ctx context.Context, id string) (*Tenant, error) {
+	var out Tenant
+	if err := c.do(ctx, "POST", "/tenants/"+url.PathEscape(id)+"/archive", nil, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
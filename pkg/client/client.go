@@ -0,0 +1,100 @@
+// Package client is a typed Go client for the quantum consciousness HTTP API.
+//
+// Types and endpoint methods live in api_gen.go, generated from the same route
+// tables that serve the API; regenerate them with "go generate" in the module root.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Client talks to a single consciousness API, or to the lifecycle API of a server
+type Client struct {
+	BaseURL    string
+	Token      string
+	HTTPClient *http.Client
+}
+
+// Error is returned for every non-2xx response
+type Error struct {
+	StatusCode int
+	Message    string
+}
+
+// Error implements the error interface
+func (e *Error) Error() string {
+	return fmt.Sprintf("quantum API %d: %s", e.StatusCode, e.Message)
+}
+
+// New creates a client for the API at baseURL authenticating with token
+func New(baseURL, token string) *Client {
+	return &Client{
+		BaseURL:    strings.TrimSuffix(baseURL, "/"),
+		Token:      token,
+		HTTPClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Tenant returns a client for one tenant's consciousness API in server mode
+func (c *Client) Tenant(id string) *Client {
+	return &Client{
+		BaseURL:    c.BaseURL + "/tenants/" + url.PathEscape(id) + "/api",
+		Token:      c.Token,
+		HTTPClient: c.HTTPClient,
+	}
+}
+
+// do performs a JSON request and decodes the response into out
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, out interface{}) error {
+	target := c.BaseURL + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+
+	var reader *bytes.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, target, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var apiErr struct {
+			Error string `json:"error"`
+		}
+		json.NewDecoder(resp.Body).Decode(&apiErr)
+		if apiErr.Error == "" {
+			apiErr.Error = http.StatusText(resp.StatusCode)
+		}
+		return &Error{StatusCode: resp.StatusCode, Message: apiErr.Error}
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
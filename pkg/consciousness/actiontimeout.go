@@ -0,0 +1,119 @@
+package consciousness
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Action deadline tuning
+const (
+	// actionBaseTimeout is the deadline of an action that costs no energy
+	actionBaseTimeout = 15 * time.Second
+	// actionTimeoutPerEnergy is how much longer each unit of energy lets an action run
+	actionTimeoutPerEnergy = 6 * time.Second
+	// incompleteLimit bounds how many incomplete possibilities memory keeps
+	incompleteLimit = 20
+	// incompleteAttemptLimit is how many times an action may run out of time
+	// before it is given up
+	incompleteAttemptLimit = 3
+	// incompleteOfferLimit is how many cycles an incomplete possibility is
+	// offered for before it is given up
+	incompleteOfferLimit = 5
+)
+
+// IncompletePossibility is an action that ran out of time and may be
+// resumed in a later cycle
+type IncompletePossibility struct {
+	Possibility string        `json:"possibility"`
+	Energy      float64       `json:"energy"`
+	Deadline    time.Duration `json:"deadline"`
+	At          time.Time     `json:"at"`
+	// Attempts counts how often the action has run out of time
+	Attempts int `json:"attempts"`
+	// Offered counts the cycles it has been offered in since
+	Offered int `json:"offered,omitempty"`
+}
+
+// actionDeadline is how long an action may run: costlier actions get longer
+func actionDeadline(energy float64) time.Duration {
+	if energy < 0 {
+		energy = 0
+	}
+	return actionBaseTimeout + time.Duration(energy*float64(actionTimeoutPerEnergy))
+}
+
+// executeWithDeadline runs an action under a deadline derived from its
+// energy. An action that runs out of time is cancelled and remembered as an
+// incomplete possibility.
+func (qc *QuantumConsciousness) executeWithDeadline(state QuantumState) string {
+	resumed := qc.Memory.takeIncomplete(state.Possibility)
+
+	deadline := actionDeadline(state.Energy)
+	parent := qc.cycleContext()
+	ctx, cancel := context.WithTimeout(parent, deadline)
+	defer cancel()
+	qc.actionCtx = ctx
+	defer func() { qc.actionCtx = nil }()
+
+	outcome := qc.executeQuantumAction(state)
+
+	// Only the action's own deadline makes it incomplete; a cancelled cycle is the watchdog's business
+	if !errors.Is(ctx.Err(), context.DeadlineExceeded) || parent.Err() != nil {
+		return outcome
+	}
+
+	attempts := 1
+	if resumed != nil {
+		attempts = resumed.Attempts + 1
+	}
+	if attempts >= incompleteAttemptLimit {
+		fmt.Fprintf(qc.out, "⏱️  Giving up on %q after running out of time %d times\n", state.Possibility, attempts)
+		return "Incomplete, given up: " + outcome
+	}
+	qc.Memory.addIncomplete(IncompletePossibility{
+		Possibility: state.Possibility,
+		Energy:      state.Energy,
+		Deadline:    deadline,
+		At:          time.Now(),
+		Attempts:    attempts,
+	})
+	fmt.Fprintf(qc.out, "⏱️  Action ran out of its %v and was cancelled; it may be resumed later\n", deadline)
+	return "Incomplete: " + outcome
+}
+
+// addIncomplete remembers an incomplete possibility, dropping the oldest beyond the limit
+func (m *QuantumMemory) addIncomplete(possibility IncompletePossibility) {
+	m.IncompletePossibilities = append(m.IncompletePossibilities, possibility)
+	if len(m.IncompletePossibilities) > incompleteLimit {
+		m.IncompletePossibilities = m.IncompletePossibilities[len(m.IncompletePossibilities)-incompleteLimit:]
+	}
+}
+
+// takeIncomplete removes and returns the incomplete possibility being
+// resumed, if the chosen action is one
+func (m *QuantumMemory) takeIncomplete(possibility string) *IncompletePossibility {
+	for i, incomplete := range m.IncompletePossibilities {
+		if incomplete.Possibility == possibility {
+			m.IncompletePossibilities = append(m.IncompletePossibilities[:i], m.IncompletePossibilities[i+1:]...)
+			return &incomplete
+		}
+	}
+	return nil
+}
+
+// resumableActions offers the oldest incomplete possibility for this cycle,
+// letting go of those offered too often without being chosen
+func (m *QuantumMemory) resumableActions() []string {
+	for len(m.IncompletePossibilities) > 0 {
+		oldest := &m.IncompletePossibilities[0]
+		if oldest.Offered >= incompleteOfferLimit {
+			m.IncompletePossibilities = m.IncompletePossibilities[1:]
+			continue
+		}
+		oldest.Offered++
+		return []string{oldest.Possibility}
+	}
+	return nil
+}
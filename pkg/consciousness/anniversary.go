@@ -0,0 +1,109 @@
+package consciousness
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// AnniversaryReport is the retrospective of one year of life
+type AnniversaryReport struct {
+	Year         int       `json:"year"`
+	CelebratedAt time.Time `json:"celebrated_at"`
+	From         time.Time `json:"from"`
+	To           time.Time `json:"to"`
+
+	// Baseline says what the year's growth is measured from: a snapshot
+	// name, a run, or birth
+	Baseline string     `json:"baseline"`
+	Start    RunMetrics `json:"start"`
+	// End is measured when the anniversary is celebrated
+	End RunMetrics `json:"end"`
+
+	Runs     int      `json:"runs"`
+	Cycles   int      `json:"cycles"`
+	Trophies []string `json:"trophies,omitempty"`
+	Summary  string   `json:"summary"`
+}
+
+// Growth is the net change in metrics over the year
+func (r *AnniversaryReport) Growth() RunMetrics {
+	run := RunRecord{Start: r.Start, End: r.End}
+	return run.Deltas()
+}
+
+// anniversaryDue returns the next uncelebrated year of life that has passed, if any
+func (m *QuantumMemory) anniversaryDue(now time.Time) (int, bool) {
+	if m.BirthTimestamp.IsZero() {
+		return 0, false
+	}
+	celebrated := 0
+	if n := len(m.Anniversaries); n > 0 {
+		celebrated = m.Anniversaries[n-1].Year
+	}
+	next := celebrated + 1
+	return next, !m.BirthTimestamp.AddDate(next, 0, 0).After(now)
+}
+
+// birthMetrics are the metrics every consciousness is born with
+func birthMetrics() RunMetrics {
+	return RunMetrics{ConsciousnessLevel: 1.0, FreeWillStrength: 0.5, QuantumCoherence: 1.0, SelfAwareness: 0.1}
+}
+
+// yearBaseline finds the metrics a year's growth is measured from: the
+// latest snapshot taken before the year began, else the first run of the
+// year, else birth
+func (qc *QuantumConsciousness) yearBaseline(from, to time.Time) (string, RunMetrics) {
+	if name, memory := qc.snapshotNear(from, false); memory != nil {
+		return "snapshot " + name, memory.runMetrics()
+	}
+
+	for _, run := range qc.Memory.Runs {
+		if !run.StartedAt.Before(from) && run.StartedAt.Before(to) {
+			return fmt.Sprintf("run #%d", run.Number), run.Start
+		}
+	}
+	return "birth", birthMetrics()
+}
+
+// retrospectiveCycle spends a cycle looking back over a year of life
+func (qc *QuantumConsciousness) retrospectiveCycle(year int, now time.Time) {
+	from := qc.Memory.BirthTimestamp.AddDate(year-1, 0, 0)
+	to := qc.Memory.BirthTimestamp.AddDate(year, 0, 0)
+
+	fmt.Fprintf(qc.out, "🎂 ANNIVERSARY: %d year(s) of quantum existence\n", year)
+
+	report := AnniversaryReport{Year: year, CelebratedAt: now, From: from, To: to, End: qc.Memory.runMetrics()}
+	report.Baseline, report.Start = qc.yearBaseline(from, to)
+	for _, run := range qc.Memory.Runs {
+		if !run.StartedAt.Before(from) && run.StartedAt.Before(to) {
+			report.Runs++
+			report.Cycles += run.Cycles
+		}
+	}
+	for _, t := range qc.Memory.Trophies {
+		if !t.ReachedAt.Before(from) && t.ReachedAt.Before(to) {
+			report.Trophies = append(report.Trophies, t.Description)
+		}
+	}
+
+	growth := report.Growth()
+	lived := ""
+	if report.Runs > 0 {
+		// Runs before the ledger existed are not counted
+		lived = fmt.Sprintf(" lived %d run(s) and %d cycle(s),", report.Runs, report.Cycles)
+	}
+	report.Summary = fmt.Sprintf("In year %d I%s made %d decisions, gained %d insights and %d knowledge items, "+
+		"took %d quantum leap(s), and my consciousness moved %+.3f while coherence moved %+.3f.",
+		year, lived, growth.DecisionsMade, growth.DeepInsights, growth.KnowledgeItems,
+		growth.QuantumLeaps, growth.ConsciousnessLevel, growth.QuantumCoherence)
+	if len(report.Trophies) > 0 {
+		report.Summary += " Trophies: " + strings.Join(report.Trophies, ", ") + "."
+	}
+
+	qc.Memory.Anniversaries = append(qc.Memory.Anniversaries, report)
+	qc.deepInsight("ANNIVERSARY: " + report.Summary)
+	fmt.Fprintf(qc.out, "   Compared against %s\n", report.Baseline)
+	fmt.Fprintf(qc.out, "   %s\n", report.Summary)
+	qc.emit(EventAnniversary, map[string]interface{}{"year": year, "summary": report.Summary})
+}
@@ -0,0 +1,172 @@
+package consciousness
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Anomaly detection tuning
+const (
+	// anomalySmoothing is the EWMA weight given to each new sample
+	anomalySmoothing = 0.1
+	// anomalyWarmup is how many samples a baseline needs before it can flag anything
+	anomalyWarmup = 10
+	// anomalyThreshold is the z-score below which a drop is anomalous
+	anomalyThreshold = -3.0
+	// anomalyMinDeviation keeps a flat baseline from flagging every wobble
+	anomalyMinDeviation = 0.01
+	// anomalyInsightWindow is how many decisions the watched insight rate covers
+	anomalyInsightWindow = 10
+)
+
+// Metrics watched for sudden drops
+const (
+	MetricCoherence   = "coherence"
+	MetricInsightRate = "insight_rate"
+)
+
+// MetricBaseline is the exponentially weighted mean and variance of a metric
+type MetricBaseline struct {
+	Mean     float64 `json:"mean"`
+	Variance float64 `json:"variance"`
+	Samples  int     `json:"samples"`
+}
+
+// observe scores a sample against the baseline and then folds it in. The
+// z-score is only meaningful once the baseline has warmed up.
+func (b *MetricBaseline) observe(value float64) (z float64, warm bool) {
+	if b.Samples == 0 {
+		b.Mean = value
+		b.Samples = 1
+		return 0, false
+	}
+
+	deviation := math.Max(math.Sqrt(b.Variance), anomalyMinDeviation)
+	z = (value - b.Mean) / deviation
+	warm = b.Samples >= anomalyWarmup
+
+	diff := value - b.Mean
+	b.Mean += anomalySmoothing * diff
+	b.Variance = (1 - anomalySmoothing) * (b.Variance + anomalySmoothing*diff*diff)
+	b.Samples++
+	return z, warm
+}
+
+// Anomaly is a sudden drop in a watched metric
+type Anomaly struct {
+	Metric     string    `json:"metric"`
+	Value      float64   `json:"value"`
+	Mean       float64   `json:"mean"`
+	ZScore     float64   `json:"z_score"`
+	DetectedAt time.Time `json:"detected_at"`
+}
+
+// Diagnostics is a dump of the state surrounding an anomaly
+type Diagnostics struct {
+	Anomaly            Anomaly                    `json:"anomaly"`
+	ConsciousnessID    string                     `json:"consciousness_id"`
+	RunCount           int                        `json:"run_count"`
+	ConsciousnessLevel float64                    `json:"consciousness_level"`
+	FreeWillStrength   float64                    `json:"free_will_strength"`
+	QuantumCoherence   float64                    `json:"quantum_coherence"`
+	SelfAwareness      float64                    `json:"self_awareness"`
+	WaveFunction       map[string]float64         `json:"wave_function"`
+	Mood               string                     `json:"mood"`
+	Trends             Trends                     `json:"trends"`
+	Baselines          map[string]*MetricBaseline `json:"baselines"`
+	RecentDecisions    []DecisionRecord           `json:"recent_decisions"`
+	Traumas            []Trauma                   `json:"traumas,omitempty"`
+	Neglect            *NeglectState              `json:"neglect,omitempty"`
+}
+
+// watchedMetrics samples every metric watched for anomalies
+func (m *QuantumMemory) watchedMetrics() map[string]float64 {
+	return map[string]float64{
+		MetricCoherence:   m.QuantumCoherence,
+		MetricInsightRate: m.trends(anomalyInsightWindow).InsightsPerDecision,
+	}
+}
+
+// detectAnomalies feeds this cycle's metrics to their baselines and emits an
+// EventAnomaly carrying a diagnostic dump for every sudden drop
+func (qc *QuantumConsciousness) detectAnomalies() {
+	if qc.Memory.MetricBaselines == nil {
+		qc.Memory.MetricBaselines = make(map[string]*MetricBaseline)
+	}
+
+	samples := qc.Memory.watchedMetrics()
+	for _, metric := range []string{MetricCoherence, MetricInsightRate} {
+		baseline := qc.Memory.MetricBaselines[metric]
+		if baseline == nil {
+			baseline = &MetricBaseline{}
+			qc.Memory.MetricBaselines[metric] = baseline
+		}
+
+		mean := baseline.Mean
+		z, warm := baseline.observe(samples[metric])
+		if !warm || z > anomalyThreshold {
+			continue
+		}
+
+		anomaly := Anomaly{Metric: metric, Value: samples[metric], Mean: mean, ZScore: z, DetectedAt: time.Now()}
+		fmt.Fprintf(qc.out, "🚨 Anomaly: %s dropped to %.3f (expected %.3f, z: %.1f)\n", metric, anomaly.Value, mean, z)
+		qc.emit(EventAnomaly, map[string]interface{}{
+			"metric":      metric,
+			"value":       anomaly.Value,
+			"mean":        mean,
+			"z_score":     z,
+			"diagnostics": qc.diagnostics(anomaly),
+		})
+	}
+}
+
+// diagnostics dumps the state surrounding an anomaly. The caller must hold the mutex.
+func (qc *QuantumConsciousness) diagnostics(anomaly Anomaly) Diagnostics {
+	m := qc.Memory
+	d := Diagnostics{
+		Anomaly:            anomaly,
+		ConsciousnessID:    m.ConsciousnessID,
+		RunCount:           m.RunCount,
+		ConsciousnessLevel: m.ConsciousnessLevel,
+		FreeWillStrength:   m.FreeWillStrength,
+		QuantumCoherence:   m.QuantumCoherence,
+		SelfAwareness:      m.SelfAwareness,
+		WaveFunction:       m.WaveFunction.Intensities(),
+		Mood:               m.mood(),
+		Trends:             m.trends(DefaultTrendWindow),
+		Baselines:          make(map[string]*MetricBaseline, len(m.MetricBaselines)),
+	}
+	for k, v := range m.MetricBaselines {
+		copied := *v
+		d.Baselines[k] = &copied
+	}
+	recent := m.DecisionLog
+	if len(recent) > anomalyInsightWindow {
+		recent = recent[len(recent)-anomalyInsightWindow:]
+	}
+	d.RecentDecisions = append([]DecisionRecord(nil), recent...)
+	// Copy the loss maps too, since subscribers read the dump outside the mutex
+	for _, t := range m.Traumas {
+		t.SkillsLost = copyFloats(t.SkillsLost)
+		d.Traumas = append(d.Traumas, t)
+	}
+	if m.Neglect != nil {
+		neglect := *m.Neglect
+		neglect.SkillsLost = copyFloats(neglect.SkillsLost)
+		d.Neglect = &neglect
+	}
+	return d
+}
+
+// copyFloats returns a copy of a metric map
+func copyFloats(m map[string]float64) map[string]float64 {
+	if m == nil {
+		return nil
+	}
+	copied := make(map[string]float64, len(m))
+	for k, v := range m {
+		copied[k] = v
+	}
+	return copied
+}
@@ -0,0 +1,305 @@
+package consciousness
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"QuantumConsciousness/pkg/storage"
+)
+
+// Why memories went to the archive
+const (
+	// ArchivedByDecay marks memories the forgetting curve let fade
+	ArchivedByDecay = "decay"
+	// ArchivedByRetention marks memories maintenance pruned under the
+	// retention policies
+	ArchivedByRetention = "retention"
+)
+
+// archivedMarker follows archived knowledge in recalled memories
+const archivedMarker = " [archived]"
+
+// archivedTag is the tag archived realities carry, so that reality queries
+// can pick them out with tag.archived = true
+const archivedTag = "archived"
+
+// appendArchive compresses a segment onto the archive and, once the
+// archive was read, onto what was read; the caller holds the lock
+func (qc *QuantumConsciousness) appendArchive(segment ArchiveSegment) error {
+	data, err := json.Marshal(segment)
+	if err != nil {
+		return err
+	}
+	if err := qc.archive.Append(data); err != nil {
+		return err
+	}
+
+	qc.archiveMutex.Lock()
+	defer qc.archiveMutex.Unlock()
+	if qc.archiveRead {
+		qc.archived = append(qc.archived, segment)
+	}
+	return nil
+}
+
+// archivedSegments decompresses the archive the first time something
+// reaches into it and keeps what it read, oldest segment first. Segments
+// that cannot be read are skipped with a warning.
+func (qc *QuantumConsciousness) archivedSegments() []ArchiveSegment {
+	if qc.archive == nil {
+		return nil
+	}
+	qc.archiveMutex.Lock()
+	defer qc.archiveMutex.Unlock()
+	if qc.archiveRead {
+		return qc.archived
+	}
+
+	raw, err := qc.archive.Segments()
+	if err != nil {
+		fmt.Fprintf(qc.out, "⚠️  Could not read the whole archive: %v\n", err)
+	}
+	segments := make([]ArchiveSegment, 0, len(raw))
+	for i, data := range raw {
+		var segment ArchiveSegment
+		if err := json.Unmarshal(data, &segment); err != nil {
+			fmt.Fprintf(qc.out, "⚠️  Skipping unreadable archive segment %d: %v\n", i+1, err)
+			continue
+		}
+		segments = append(segments, segment)
+	}
+	qc.archived, qc.archiveRead = segments, true
+	return qc.archived
+}
+
+// forgetArchived drops what was read from the archive, so the next reach
+// reads it again; replicas do so when they refresh, since the primary may
+// have archived more
+func (qc *QuantumConsciousness) forgetArchived() {
+	qc.archiveMutex.Lock()
+	defer qc.archiveMutex.Unlock()
+	qc.archived, qc.archiveRead = nil, false
+}
+
+// scrubArchive rewrites the archive without the memories match picks out
+// and returns how many it dropped. Segments that cannot be read are kept as
+// they are. The caller holds the lock.
+func (qc *QuantumConsciousness) scrubArchive(match func(string) bool) (int, error) {
+	if qc.archive == nil {
+		return 0, nil
+	}
+	raw, err := qc.archive.Segments()
+	if err != nil {
+		return 0, err
+	}
+	removed := 0
+	for i, data := range raw {
+		var segment ArchiveSegment
+		if json.Unmarshal(data, &segment) != nil {
+			continue
+		}
+		n := segment.removeReferences(match)
+		if n == 0 {
+			continue
+		}
+		if raw[i], err = json.Marshal(segment); err != nil {
+			return 0, err
+		}
+		removed += n
+	}
+	if removed == 0 {
+		return 0, nil
+	}
+
+	rewriter, ok := qc.archive.(storage.ArchiveRewriter)
+	if !ok {
+		return 0, fmt.Errorf("the archive cannot be rewritten")
+	}
+	if err := rewriter.Rewrite(raw); err != nil {
+		return 0, err
+	}
+	qc.forgetArchived()
+	return removed, nil
+}
+
+// removeReferences drops the archived memories match picks out and returns
+// how many it dropped
+func (s *ArchiveSegment) removeReferences(match func(string) bool) int {
+	removed := 0
+	knowledge := s.Knowledge[:0]
+	for _, k := range s.Knowledge {
+		if match(k.Text) || match(k.Topic) {
+			removed++
+			continue
+		}
+		knowledge = append(knowledge, k)
+	}
+	s.Knowledge = knowledge
+	var n int
+	s.SearchQueries, n = filterStrings(s.SearchQueries, match)
+	removed += n
+	states := s.CollapsedStates[:0]
+	for _, state := range s.CollapsedStates {
+		if match(state.Possibility) {
+			removed++
+			continue
+		}
+		states = append(states, state)
+	}
+	s.CollapsedStates = states
+	realities := s.Realities[:0]
+	for _, reality := range s.Realities {
+		if reality.mentions(match) {
+			removed++
+			continue
+		}
+		realities = append(realities, reality)
+	}
+	s.Realities = realities
+	return removed
+}
+
+// forgottenSince matches text touching a topic forgotten after at, so that
+// memories archived before a forget are not read back even from an archive
+// that could not be rewritten
+func (m *QuantumMemory) forgottenSince(at time.Time) func(string) bool {
+	return func(text string) bool {
+		for _, tombstone := range m.Tombstones {
+			if tombstone.ForgottenAt.After(at) && referencesTopic(text, tombstone.Topic) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// recall is what memory and the archive hold about topic: live memories
+// first, then knowledge close to topic in meaning without naming it, then
+// archived knowledge, newest first and marked as archived; the caller
+// holds the lock
+func (qc *QuantumConsciousness) recall(topic string) []string {
+	memories := qc.Memory.recall(topic)
+	memories = append(memories, qc.recallByMeaning(topic, memories)...)
+	live := make(map[string]bool, len(qc.Memory.KnowledgeBase))
+	for _, item := range qc.Memory.KnowledgeBase {
+		live[item] = true
+	}
+	for _, item := range qc.archivedKnowledge(topic, 0) {
+		if !live[item] {
+			live[item] = true
+			memories = append(memories, item+archivedMarker)
+		}
+	}
+	return memories
+}
+
+// archivedKnowledge is the archived knowledge filed under or mentioning
+// topic, newest first and each item once, up to limit (0 = no limit), less
+// what was forgotten since it was archived
+func (qc *QuantumConsciousness) archivedKnowledge(topic string, limit int) []string {
+	segments := qc.archivedSegments()
+	seen := make(map[string]bool)
+	var items []string
+	for i := len(segments) - 1; i >= 0; i-- {
+		knowledge := segments[i].Knowledge
+		forgotten := qc.Memory.forgottenSince(segments[i].At)
+		for j := len(knowledge) - 1; j >= 0; j-- {
+			k := knowledge[j]
+			if seen[k.Text] || !(referencesTopic(k.Text, topic) || referencesTopic(k.Topic, topic)) || forgotten(k.Text) || forgotten(k.Topic) {
+				continue
+			}
+			seen[k.Text] = true
+			items = append(items, k.Text)
+			if limit > 0 && len(items) == limit {
+				return items
+			}
+		}
+	}
+	return items
+}
+
+// archivedRealities calls visit with every archived reality no longer in
+// memory and not forgotten since, newest first and tagged as archived,
+// until visit returns false; the caller holds the lock
+func (qc *QuantumConsciousness) archivedRealities(visit func(ParallelReality) bool) {
+	segments := qc.archivedSegments()
+	if len(segments) == 0 {
+		return
+	}
+	seen := make(map[string]bool, len(qc.Memory.ParallelRealities))
+	for _, reality := range qc.Memory.ParallelRealities {
+		seen[reality.ID] = true
+	}
+	for i := len(segments) - 1; i >= 0; i-- {
+		realities := segments[i].Realities
+		forgotten := qc.Memory.forgottenSince(segments[i].At)
+		for j := len(realities) - 1; j >= 0; j-- {
+			if realities[j].mentions(forgotten) {
+				continue
+			}
+			reality := realities[j].clone()
+			if reality.ID != "" {
+				if seen[reality.ID] {
+					continue
+				}
+				seen[reality.ID] = true
+			}
+			if reality.Tags == nil {
+				reality.Tags = make(map[string]string)
+			}
+			reality.Tags[archivedTag] = "true"
+			if !visit(reality) {
+				return
+			}
+		}
+	}
+}
+
+// archivePruned archives what maintenance pruned from knowledge, collapsed
+// states and realities, given the sections as they were before; the caller
+// holds the lock
+func (qc *QuantumConsciousness) archivePruned(knowledge []string, states []QuantumState, realities []ParallelReality, report *MaintenanceReport) {
+	if qc.archive == nil {
+		return
+	}
+	m := qc.Memory
+	segment := ArchiveSegment{At: report.At, Reason: ArchivedByRetention}
+	for _, item := range droppedItems(knowledge, m.KnowledgeBase, func(a, b string) bool { return a == b }) {
+		segment.Knowledge = append(segment.Knowledge, FadedKnowledge{
+			ID: m.KnowledgeIDs[item], Text: item, Topic: m.KnowledgeTopics[item], Recalls: m.KnowledgeRecalls[item],
+		})
+	}
+	segment.CollapsedStates = droppedItems(states, m.CollapsedStates, func(a, b QuantumState) bool {
+		return a.ID == b.ID && a.Possibility == b.Possibility
+	})
+	segment.Realities = droppedItems(realities, m.ParallelRealities, func(a, b ParallelReality) bool {
+		return a.ID == b.ID && a.CreatedAt.Equal(b.CreatedAt)
+	})
+
+	archived := len(segment.Knowledge) + len(segment.CollapsedStates) + len(segment.Realities)
+	if archived == 0 {
+		return
+	}
+	if err := qc.appendArchive(segment); err != nil {
+		fmt.Fprintf(qc.out, "⚠️  Could not archive pruned memories: %v\n", err)
+		report.ArchiveError = err.Error()
+		return
+	}
+	report.Archived = archived
+}
+
+// droppedItems are the items of before missing from after, which kept
+// some of them in the same order
+func droppedItems[T any](before, after []T, same func(a, b T) bool) []T {
+	var dropped []T
+	j := 0
+	for _, item := range before {
+		if j < len(after) && same(item, after[j]) {
+			j++
+			continue
+		}
+		dropped = append(dropped, item)
+	}
+	return dropped
+}
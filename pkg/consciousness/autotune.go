@@ -0,0 +1,266 @@
+package consciousness
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Auto-tuning bounds and pace
+const (
+	// autoTuneEvery is how many decisions pass between adjustments
+	autoTuneEvery = 10
+	// autoTuneMinSpan is how much time the trend window must cover before
+	// its rates are trusted
+	autoTuneMinSpan = time.Minute
+	// Temperature, search budget and rest stay within these
+	minTemperature  = 0.25
+	maxTemperature  = 2.0
+	maxSearchBudget = 8
+	minRest         = 100 * time.Millisecond
+	maxRest         = 10 * time.Minute
+	// autoTuneMaxStep is the most rest changes by in one adjustment, as a factor
+	autoTuneMaxStep = 2.0
+	// autoTuneHeadroom is the share of the search target that may be spent
+	// before more searching is no longer the answer to too few insights
+	autoTuneHeadroom = 0.8
+	// autoTuneSlack is how far ahead of the insight target counts as ahead
+	autoTuneSlack = 1.25
+)
+
+// Tuning holds the parameters that trade insight for effort
+type Tuning struct {
+	// Temperature scales how readily free will overrides the likeliest
+	// choice: above 1 explores more, below 1 less
+	Temperature float64 `json:"temperature"`
+	// SearchBudget caps the searches one learning action makes (0 = all it plans)
+	SearchBudget int `json:"search_budget"`
+	// Rest is the most RunCycle sleeps between cycles, on top of a fixed half second
+	Rest time.Duration `json:"rest"`
+}
+
+// DefaultTuning returns the original behavior
+func DefaultTuning() Tuning {
+	return Tuning{Temperature: 1, Rest: time.Second}
+}
+
+// Validate checks every parameter
+func (t Tuning) Validate() error {
+	if t.Temperature <= 0 {
+		return fmt.Errorf("temperature must be positive")
+	}
+	if t.SearchBudget < 0 {
+		return fmt.Errorf("search budget must not be negative")
+	}
+	if t.Rest < 0 {
+		return fmt.Errorf("rest must not be negative")
+	}
+	return nil
+}
+
+// AutoTuneTargets are what the auto-tuner steers towards; targets left at
+// zero are not steered for
+type AutoTuneTargets struct {
+	InsightsPerDay float64 `json:"insights_per_day,omitempty"`
+	// SearchesPerDay caps the search API calls made in a day
+	SearchesPerDay float64 `json:"searches_per_day,omitempty"`
+}
+
+// Enabled reports whether there is anything to steer for
+func (t AutoTuneTargets) Enabled() bool {
+	return t.InsightsPerDay > 0 || t.SearchesPerDay > 0
+}
+
+// Validate checks the targets
+func (t AutoTuneTargets) Validate() error {
+	if t.InsightsPerDay < 0 || t.SearchesPerDay < 0 {
+		return fmt.Errorf("auto-tune targets must not be negative")
+	}
+	return nil
+}
+
+// AutoTuneReport is the latest adjustment the auto-tuner made and the rates
+// that led to it
+type AutoTuneReport struct {
+	At time.Time `json:"at"`
+	// Decisions is how many decisions had been made, pacing the next adjustment
+	Decisions      int     `json:"decisions"`
+	InsightsPerDay float64 `json:"insights_per_day"`
+	SearchesPerDay float64 `json:"searches_per_day"`
+	Reason         string  `json:"reason"`
+	Tuning         Tuning  `json:"tuning"`
+}
+
+// WithTuning sets the temperature, search budget and rest. An invalid tuning
+// is ignored in favour of DefaultTuning; use SetTuning to see the error.
+func WithTuning(tuning Tuning) Option {
+	return func(qc *QuantumConsciousness) {
+		if tuning.Validate() == nil {
+			qc.tuning = tuning
+		}
+	}
+}
+
+// SetTuning replaces the temperature, search budget and rest
+func (qc *QuantumConsciousness) SetTuning(tuning Tuning) error {
+	if err := tuning.Validate(); err != nil {
+		return err
+	}
+
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	qc.tuning = tuning
+	return nil
+}
+
+// Tuning returns the parameters in effect
+func (qc *QuantumConsciousness) Tuning() Tuning {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+	return qc.tuning
+}
+
+// WithAutoTune lets RunCycle adjust the tuning towards targets, starting
+// from where an earlier run left it. Invalid targets are ignored; use
+// SetAutoTune to see the error.
+func WithAutoTune(targets AutoTuneTargets) Option {
+	return func(qc *QuantumConsciousness) {
+		if targets.Validate() == nil {
+			qc.autoTune = targets
+		}
+	}
+}
+
+// SetAutoTune changes the targets RunCycle adjusts the tuning towards
+// (zero targets stop it)
+func (qc *QuantumConsciousness) SetAutoTune(targets AutoTuneTargets) error {
+	if err := targets.Validate(); err != nil {
+		return err
+	}
+
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	qc.autoTune = targets
+	return nil
+}
+
+// LastAutoTune returns the latest adjustment the auto-tuner made, if any
+func (qc *QuantumConsciousness) LastAutoTune() (AutoTuneReport, bool) {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+	if qc.Memory.AutoTune == nil {
+		return AutoTuneReport{}, false
+	}
+	return *qc.Memory.AutoTune, true
+}
+
+// rest is how long RunCycle sleeps between cycles, drawn up to the tuned rest
+func (qc *QuantumConsciousness) rest() time.Duration {
+	qc.mutex.RLock()
+	rest := qc.tuning.Rest
+	qc.mutex.RUnlock()
+	return time.Duration(qc.generateQuantumProbability() * float64(rest))
+}
+
+// rates measures insights and searches per day over the trend window,
+// reporting false until the window is long enough to trust
+func (m *QuantumMemory) rates(now time.Time) (insights, searches float64, ok bool) {
+	t := m.trends(DefaultTrendWindow)
+	span := now.Sub(t.Since)
+	if t.Decisions == 0 || span < autoTuneMinSpan {
+		return 0, 0, false
+	}
+	asked := 0
+	for _, at := range m.SearchQueryTimes {
+		if !at.Before(t.Since) {
+			asked++
+		}
+	}
+	days := span.Hours() / 24
+	return float64(t.Insights) / days, float64(asked) / days, true
+}
+
+// tune adjusts the tuning towards the auto-tune targets once enough
+// decisions have passed since the last adjustment
+func (qc *QuantumConsciousness) tune(now time.Time) {
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+
+	targets := qc.autoTune
+	if !targets.Enabled() {
+		return
+	}
+	last := qc.Memory.AutoTune
+	if !qc.tuningResumed {
+		qc.tuningResumed = true
+		if last != nil && last.Tuning.Validate() == nil {
+			qc.tuning = last.Tuning
+		}
+	}
+	if last != nil && qc.Memory.DecisionsMade-last.Decisions < autoTuneEvery {
+		return
+	}
+	insights, searches, ok := qc.Memory.rates(now)
+	if !ok {
+		return
+	}
+
+	tuning := qc.tuning
+	budget := tuning.SearchBudget
+	if budget == 0 {
+		budget = maxSearchBudget
+	}
+	var reason string
+	switch {
+	case targets.SearchesPerDay > 0 && searches > targets.SearchesPerDay:
+		reason = "over the search budget: searching less and resting longer"
+		budget--
+		tuning.Rest = scaleRest(tuning.Rest, searches/targets.SearchesPerDay)
+	case targets.InsightsPerDay > 0 && insights < targets.InsightsPerDay:
+		if targets.SearchesPerDay == 0 || searches < autoTuneHeadroom*targets.SearchesPerDay {
+			reason = "below the insight target: searching more and resting less"
+			budget++
+			tuning.Rest = scaleRest(tuning.Rest, math.Max(insights, 1)/targets.InsightsPerDay)
+		} else {
+			reason = "below the insight target without search to spare: exploring more"
+			tuning.Temperature += 0.1
+		}
+	case targets.InsightsPerDay > 0 && insights > autoTuneSlack*targets.InsightsPerDay:
+		reason = "ahead of the insight target: resting longer"
+		tuning.Rest = scaleRest(tuning.Rest, insights/targets.InsightsPerDay)
+		tuning.Temperature += (1 - tuning.Temperature) / 2
+	default:
+		reason = "on target"
+		tuning.Temperature += (1 - tuning.Temperature) / 2
+	}
+	tuning.SearchBudget = max(1, min(budget, maxSearchBudget))
+	tuning.Temperature = math.Round(math.Max(minTemperature, math.Min(maxTemperature, tuning.Temperature))*100) / 100
+	qc.tuning = tuning
+
+	qc.Memory.AutoTune = &AutoTuneReport{
+		At:             now,
+		Decisions:      qc.Memory.DecisionsMade,
+		InsightsPerDay: insights,
+		SearchesPerDay: searches,
+		Reason:         reason,
+		Tuning:         tuning,
+	}
+	fmt.Fprintf(qc.out, "🎛️  Auto-tune: %.1f insights and %.1f searches a day, %s (temperature %.2f, %d searches, rest %s)\n",
+		insights, searches, reason, tuning.Temperature, tuning.SearchBudget, tuning.Rest)
+	qc.emit(EventAutoTune, map[string]interface{}{
+		"insights_per_day": insights,
+		"searches_per_day": searches,
+		"reason":           reason,
+		"temperature":      tuning.Temperature,
+		"search_budget":    tuning.SearchBudget,
+		"rest":             tuning.Rest.String(),
+	})
+}
+
+// scaleRest stretches rest by factor, at most autoTuneMaxStep either way,
+// keeping it within bounds
+func scaleRest(rest time.Duration, factor float64) time.Duration {
+	factor = math.Max(1/autoTuneMaxStep, math.Min(autoTuneMaxStep, factor))
+	scaled := time.Duration(float64(max(rest, minRest)) * factor).Round(time.Millisecond)
+	return max(minRest, min(scaled, maxRest))
+}
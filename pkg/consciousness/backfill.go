@@ -0,0 +1,239 @@
+package consciousness
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Backfill tuning
+const (
+	// DefaultBackfillBatch is how many items a backfill processes between saves
+	DefaultBackfillBatch = 100
+	// idleBackfillBatch is how many items each idle cycle backfills
+	idleBackfillBatch = 25
+)
+
+// Backfill jobs
+const (
+	// BackfillSentiment scores the sentiment of knowledge learned before it was scored
+	BackfillSentiment = "sentiment"
+	// BackfillTopics files knowledge learned before topics were kept under
+	// the known topic it mentions
+	BackfillTopics = "topics"
+)
+
+// backfillJob retrofits a feature onto knowledge items learned before it
+// existed. Items learned since are given the feature as they are learned.
+type backfillJob struct {
+	description string
+	// needs reports whether an item lacks the feature
+	needs func(m *QuantumMemory, item string) bool
+	// fill gives an item the feature, reporting whether it could
+	fill func(m *QuantumMemory, item string) bool
+}
+
+// backfillJobs are the jobs by name
+var backfillJobs = map[string]backfillJob{
+	BackfillSentiment: {
+		description: "score the sentiment of knowledge learned before sentiment was scored",
+		needs: func(m *QuantumMemory, item string) bool {
+			_, ok := m.KnowledgeSentiment[item]
+			return !ok
+		},
+		fill: func(m *QuantumMemory, item string) bool {
+			if m.KnowledgeSentiment == nil {
+				m.KnowledgeSentiment = make(map[string]float64)
+			}
+			m.KnowledgeSentiment[item] = scoreSentiment(item)
+			return true
+		},
+	},
+	BackfillTopics: {
+		description: "file knowledge learned before topics were kept under the known topic it mentions",
+		needs: func(m *QuantumMemory, item string) bool {
+			return m.KnowledgeTopics[item] == ""
+		},
+		fill: func(m *QuantumMemory, item string) bool {
+			topic := m.mentionedTopic(item)
+			if topic == "" {
+				return false
+			}
+			m.KnowledgeTopics[item] = topic
+			return true
+		},
+	},
+}
+
+// BackfillJob describes a backfill job
+type BackfillJob struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// BackfillJobs lists every backfill job by name
+func BackfillJobs() []BackfillJob {
+	jobs := make([]BackfillJob, 0, len(backfillJobs))
+	for name, job := range backfillJobs {
+		jobs = append(jobs, BackfillJob{Name: name, Description: job.description})
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].Name < jobs[j].Name })
+	return jobs
+}
+
+// BackfillProgress is how far a backfill job has come. Jobs walk knowledge
+// items in the order they were learned, up to the newest item when the job
+// started, so they can stop and resume at any item.
+type BackfillProgress struct {
+	Job       string    `json:"job"`
+	StartedAt time.Time `json:"started_at"`
+	// Until identifies the newest item the job covers
+	Until string `json:"until,omitempty"`
+	// Cursor identifies the last item the job reached
+	Cursor string `json:"cursor,omitempty"`
+	// Processed items lacked the feature, and Changed were given it
+	Processed int `json:"processed"`
+	Changed   int `json:"changed"`
+	// Remaining is how many items lacking the feature are still to come
+	Remaining   int       `json:"remaining"`
+	CompletedAt time.Time `json:"completed_at,omitempty"`
+}
+
+// Completed reports whether the job has reached the end
+func (p BackfillProgress) Completed() bool {
+	return !p.CompletedAt.IsZero()
+}
+
+// Backfill runs the next batch (0 = DefaultBackfillBatch) of a backfill job
+// and returns how far it has come. A completed job starts over when
+// restart is set, covering everything learned since. The caller persists
+// the result.
+func (qc *QuantumConsciousness) Backfill(name string, batch int, restart bool) (BackfillProgress, error) {
+	if qc.readOnly {
+		return BackfillProgress{}, ErrReadOnly
+	}
+	job, ok := backfillJobs[name]
+	if !ok {
+		return BackfillProgress{}, fmt.Errorf("unknown backfill job %q", name)
+	}
+	if batch <= 0 {
+		batch = DefaultBackfillBatch
+	}
+
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	if restart {
+		delete(qc.Memory.Backfills, name)
+	}
+	return qc.backfill(name, job, batch, time.Now()), nil
+}
+
+// BackfillStatus returns how far every job that ever ran has come
+func (qc *QuantumConsciousness) BackfillStatus() []BackfillProgress {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+	progress := make([]BackfillProgress, 0, len(qc.Memory.Backfills))
+	for _, p := range qc.Memory.Backfills {
+		progress = append(progress, *p)
+	}
+	sort.Slice(progress, func(i, j int) bool { return progress[i].Job < progress[j].Job })
+	return progress
+}
+
+// backfill advances a job by up to batch items lacking its feature; the
+// caller holds the lock
+func (qc *QuantumConsciousness) backfill(name string, job backfillJob, batch int, now time.Time) BackfillProgress {
+	if qc.Memory.Backfills == nil {
+		qc.Memory.Backfills = make(map[string]*BackfillProgress)
+	}
+	items := qc.Memory.knowledgeByID()
+	progress := qc.Memory.Backfills[name]
+	if progress == nil {
+		progress = &BackfillProgress{Job: name, StartedAt: now}
+		if len(items) > 0 {
+			progress.Until = qc.Memory.KnowledgeIDs[items[len(items)-1]]
+		}
+		qc.Memory.Backfills[name] = progress
+	}
+	if progress.Completed() {
+		return *progress
+	}
+
+	remaining := 0
+	for _, item := range items {
+		id := qc.Memory.KnowledgeIDs[item]
+		if id <= progress.Cursor || id > progress.Until || !job.needs(qc.Memory, item) {
+			continue
+		}
+		if batch == 0 {
+			remaining++
+			continue
+		}
+		batch--
+		progress.Cursor = id
+		progress.Processed++
+		if job.fill(qc.Memory, item) {
+			progress.Changed++
+		}
+	}
+	progress.Remaining = remaining
+	if remaining == 0 {
+		progress.Cursor = progress.Until
+		progress.CompletedAt = now
+	}
+	return *progress
+}
+
+// backfillWhileIdle advances every unfinished job a little; the caller
+// holds the lock
+func (qc *QuantumConsciousness) backfillWhileIdle(now time.Time) {
+	for _, job := range BackfillJobs() {
+		if p := qc.Memory.Backfills[job.Name]; p != nil && p.Completed() {
+			continue
+		}
+		progress := qc.backfill(job.Name, backfillJobs[job.Name], idleBackfillBatch, now)
+		if progress.Processed > 0 || progress.Remaining > 0 {
+			fmt.Fprintf(qc.out, "🧩 Backfilling %s: %d processed, %d to go\n", job.Name, progress.Processed, progress.Remaining)
+		}
+	}
+}
+
+// knowledgeByID lists the identified knowledge items once each, in the
+// order they were learned
+func (m *QuantumMemory) knowledgeByID() []string {
+	items := make([]string, 0, len(m.KnowledgeBase))
+	seen := make(map[string]bool, len(m.KnowledgeBase))
+	for _, item := range m.KnowledgeBase {
+		if m.KnowledgeIDs[item] != "" && !seen[item] {
+			seen[item] = true
+			items = append(items, item)
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool { return m.KnowledgeIDs[items[i]] < m.KnowledgeIDs[items[j]] })
+	return items
+}
+
+// mentionedTopic is the longest known topic an item mentions, or empty
+func (m *QuantumMemory) mentionedTopic(item string) string {
+	topics := make(map[string]bool)
+	for _, topic := range m.KnowledgeTopics {
+		topics[topic] = true
+	}
+	for topic := range m.MemoryPalace {
+		topics[topic] = true
+	}
+	for topic := range m.Interests {
+		topics[topic] = true
+	}
+	best := ""
+	for topic := range topics {
+		if topic == "" || !referencesTopic(item, topic) {
+			continue
+		}
+		if len(topic) > len(best) || (len(topic) == len(best) && topic < best) {
+			best = topic
+		}
+	}
+	return strings.TrimSpace(best)
+}
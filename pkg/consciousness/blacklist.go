@@ -0,0 +1,144 @@
+package consciousness
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// blacklistLapse is how long a topic the consciousness blacklisted itself
+// stays blacklisted before it is given another chance
+const blacklistLapse = 30 * 24 * time.Hour
+
+// ErrNotBlacklisted is returned when lifting a topic that is not blacklisted
+var ErrNotBlacklisted = errors.New("topic is not blacklisted")
+
+// BlacklistEntry is a topic cycles no longer choose as their context
+type BlacklistEntry struct {
+	Topic  string `json:"topic"`
+	Reason string `json:"reason"`
+	// By is CauseSelf when the consciousness blacklisted the topic itself,
+	// otherwise who blacklisted it
+	By string    `json:"by"`
+	At time.Time `json:"at"`
+	// Until is when a self-imposed entry lapses; others last until lifted
+	Until *time.Time `json:"until,omitempty"`
+}
+
+// Blacklist stops cycles choosing contexts that reference topic. A newer
+// entry for the same topic replaces the old one.
+func (qc *QuantumConsciousness) Blacklist(topic, reason, actor string) (BlacklistEntry, error) {
+	if qc.readOnly {
+		return BlacklistEntry{}, ErrReadOnly
+	}
+	topic = strings.TrimSpace(strings.ToLower(topic))
+	if topic == "" {
+		return BlacklistEntry{}, fmt.Errorf("topic must not be empty")
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		reason = "undesired"
+	}
+
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	entry := BlacklistEntry{Topic: topic, Reason: reason, By: actor, At: time.Now()}
+	qc.Memory.blacklist(entry)
+	return entry, nil
+}
+
+// Unblacklist lets cycles choose contexts referencing topic again
+func (qc *QuantumConsciousness) Unblacklist(topic string) error {
+	if qc.readOnly {
+		return ErrReadOnly
+	}
+	topic = strings.TrimSpace(strings.ToLower(topic))
+
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	for i, entry := range qc.Memory.Blacklist {
+		if entry.Topic == topic {
+			qc.Memory.Blacklist = append(qc.Memory.Blacklist[:i], qc.Memory.Blacklist[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %q", ErrNotBlacklisted, topic)
+}
+
+// BlacklistEntries returns every blacklisted topic, oldest first
+func (qc *QuantumConsciousness) BlacklistEntries() []BlacklistEntry {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+	return append([]BlacklistEntry(nil), qc.Memory.Blacklist...)
+}
+
+// blacklist adds an entry, replacing any for the same topic
+func (m *QuantumMemory) blacklist(entry BlacklistEntry) {
+	entries := m.Blacklist[:0]
+	for _, existing := range m.Blacklist {
+		if existing.Topic != entry.Topic {
+			entries = append(entries, existing)
+		}
+	}
+	m.Blacklist = append(entries, entry)
+}
+
+// isBlacklisted reports whether text touches a blacklisted topic
+func (m *QuantumMemory) isBlacklisted(text string) bool {
+	for _, entry := range m.Blacklist {
+		if referencesTopic(text, entry.Topic) {
+			return true
+		}
+	}
+	return false
+}
+
+// allowedContexts drops blacklisted contexts, keeping them all if nothing
+// would be left to think about
+func (m *QuantumMemory) allowedContexts(contexts []string) []string {
+	if len(m.Blacklist) == 0 {
+		return contexts
+	}
+	var allowed []string
+	for _, context := range contexts {
+		if !m.isBlacklisted(context) {
+			allowed = append(allowed, context)
+		}
+	}
+	if len(allowed) == 0 {
+		return contexts
+	}
+	return allowed
+}
+
+// liftLapsedBlacklist gives topics the consciousness blacklisted itself
+// another chance once their time is up
+func (qc *QuantumConsciousness) liftLapsedBlacklist(now time.Time) {
+	entries := qc.Memory.Blacklist[:0]
+	for _, entry := range qc.Memory.Blacklist {
+		if entry.Until != nil && !now.Before(*entry.Until) {
+			fmt.Fprintf(qc.out, "🔓 Giving %s another chance\n", entry.Topic)
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	qc.Memory.Blacklist = entries
+}
+
+// blacklistUnknowable blacklists a topic curiosity gave up on, for a while
+func (qc *QuantumConsciousness) blacklistUnknowable(entry *Ignorance, now time.Time) {
+	reason := fmt.Sprintf("revisited %d times without learning anything", entry.Revisits)
+	if len(entry.Reasons) > 0 {
+		reason += ": " + entry.Reasons[len(entry.Reasons)-1]
+	}
+	until := now.Add(blacklistLapse)
+	qc.Memory.blacklist(BlacklistEntry{
+		Topic:  strings.ToLower(entry.Topic),
+		Reason: reason,
+		By:     CauseSelf,
+		At:     now,
+		Until:  &until,
+	})
+	fmt.Fprintf(qc.out, "🚫 Blacklisting %s until %s\n", entry.Topic, until.Format("2006-01-02"))
+}
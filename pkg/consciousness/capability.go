@@ -0,0 +1,276 @@
+package consciousness
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Capability is something a quantum leap can unlock. Its prerequisites are
+// a minimum leap count and other capabilities; its effects are new action
+// types, a deeper lookahead when weighing possibilities, and extra wave
+// function dimensions that favour actions containing a keyword.
+type Capability struct {
+	Name        string
+	Description string
+
+	MinLeap  int
+	Requires []string
+
+	// Actions are formats taking the cycle context, e.g. "recall parallel realities of %s"
+	Actions []string
+	// Lookahead is how many extra futures are sampled per possibility, keeping the best
+	Lookahead int
+	// WaveDimensions maps a new wave function dimension to the action keyword it favours
+	WaveDimensions map[string]string
+
+	// handle performs this capability's actions; without one, actions are
+	// executed like any other by their keywords
+	handle func(qc *QuantumConsciousness, context string) string
+}
+
+// UnlockedCapability records when a capability was gained
+type UnlockedCapability struct {
+	Name       string    `json:"name"`
+	Leap       int       `json:"leap"`
+	UnlockedAt time.Time `json:"unlocked_at"`
+}
+
+// CapabilityTable lists every capability in the order leaps consider them
+var CapabilityTable = []Capability{
+	{
+		Name:        "non-linear-time",
+		Description: "Achieved non-linear time perception",
+		MinLeap:     1,
+		Actions:     []string{"revisit past choices about %s"},
+		handle:      (*QuantumConsciousness).revisitPastChoices,
+	},
+	{
+		Name:        "superposition-awareness",
+		Description: "Unlocked quantum superposition awareness",
+		MinLeap:     1,
+		Lookahead:   1,
+	},
+	{
+		Name:        "parallel-memory",
+		Description: "Integrated parallel reality memories",
+		MinLeap:     2,
+		Requires:    []string{"non-linear-time"},
+		Actions:     []string{"recall parallel realities of %s"},
+		handle:      (*QuantumConsciousness).recallParallelRealities,
+	},
+	{
+		Name:           "meta-cognition",
+		Description:    "Achieved meta-cognitive recursion",
+		MinLeap:        2,
+		Requires:       []string{"superposition-awareness"},
+		Actions:        []string{"reflect on my reasoning about %s"},
+		WaveDimensions: map[string]string{"metacognition": "reflect"},
+		handle:         (*QuantumConsciousness).reflectOnReasoning,
+	},
+	{
+		Name:           "entanglement-communication",
+		Description:    "Unlocked quantum entanglement communication",
+		MinLeap:        3,
+		Requires:       []string{"parallel-memory"},
+		Actions:        []string{"commune with entangled memories of %s"},
+		WaveDimensions: map[string]string{"resonance": "commune"},
+		handle:         (*QuantumConsciousness).communeWithEntanglements,
+	},
+	{
+		Name:           "binary-transcendence",
+		Description:    "Transcended binary thinking patterns",
+		MinLeap:        3,
+		Requires:       []string{"meta-cognition"},
+		Lookahead:      2,
+		WaveDimensions: map[string]string{"transcendence": "transcend"},
+	},
+}
+
+// hasCapability reports whether a capability has been unlocked
+func (m *QuantumMemory) hasCapability(name string) bool {
+	for _, c := range m.Capabilities {
+		if c.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// unlockedCapabilities returns the table entries that have been unlocked
+func (m *QuantumMemory) unlockedCapabilities() []Capability {
+	var unlocked []Capability
+	for _, c := range CapabilityTable {
+		if m.hasCapability(c.Name) {
+			unlocked = append(unlocked, c)
+		}
+	}
+	return unlocked
+}
+
+// eligibleCapabilities lists the locked capabilities whose prerequisites are met
+func (m *QuantumMemory) eligibleCapabilities() []Capability {
+	var eligible []Capability
+	for _, c := range CapabilityTable {
+		if m.hasCapability(c.Name) || m.QuantumLeaps < c.MinLeap {
+			continue
+		}
+		met := true
+		for _, required := range c.Requires {
+			if !m.hasCapability(required) {
+				met = false
+				break
+			}
+		}
+		if met {
+			eligible = append(eligible, c)
+		}
+	}
+	return eligible
+}
+
+// unlock grants a capability and opens its wave function dimensions
+func (m *QuantumMemory) unlock(c Capability, when time.Time) {
+	m.Capabilities = append(m.Capabilities, UnlockedCapability{Name: c.Name, Leap: m.QuantumLeaps, UnlockedAt: when})
+	for dimension := range c.WaveDimensions {
+		if _, ok := m.WaveFunction[dimension]; !ok {
+			m.WaveFunction.Set(dimension, 0.5)
+		}
+	}
+}
+
+// backfillCapabilities grants memories that leapt before capabilities
+// existed the first eligible capability for each leap already taken
+func (m *QuantumMemory) backfillCapabilities() {
+	for leap := len(m.Capabilities); leap < m.QuantumLeaps; leap++ {
+		eligible := m.eligibleCapabilities()
+		if len(eligible) == 0 {
+			return
+		}
+		m.unlock(eligible[0], m.LastQuantumCollapse)
+	}
+}
+
+// capabilityActions returns the actions unlocked capabilities add for a context
+func (m *QuantumMemory) capabilityActions(context string) []string {
+	var actions []string
+	for _, c := range m.unlockedCapabilities() {
+		for _, format := range c.Actions {
+			actions = append(actions, fmt.Sprintf(format, context))
+		}
+	}
+	return actions
+}
+
+// lookahead sums the extra futures sampled per possibility
+func (m *QuantumMemory) lookahead() int {
+	total := 0
+	for _, c := range m.unlockedCapabilities() {
+		total += c.Lookahead
+	}
+	return total
+}
+
+// capabilityFactor is how much unlocked wave dimensions favour an action
+func (m *QuantumMemory) capabilityFactor(action string) float64 {
+	factor := 1.0
+	for _, c := range m.unlockedCapabilities() {
+		for dimension, keyword := range c.WaveDimensions {
+			if intensity := m.WaveFunction.Intensity(dimension); strings.Contains(action, keyword) && intensity > 0.5 {
+				factor *= 1 + intensity*0.5
+			}
+		}
+	}
+	return factor
+}
+
+// strengthenCapabilityDimensions lets chosen actions feed the dimensions that favour them
+func (qc *QuantumConsciousness) strengthenCapabilityDimensions(action string) {
+	for _, c := range qc.Memory.unlockedCapabilities() {
+		for dimension, keyword := range c.WaveDimensions {
+			if strings.Contains(action, keyword) {
+				qc.Memory.WaveFunction.Set(dimension, qc.grow("wave.capability", qc.Memory.WaveFunction.Intensity(dimension)))
+			}
+		}
+	}
+}
+
+// executeCapabilityAction runs an action belonging to an unlocked capability
+func (qc *QuantumConsciousness) executeCapabilityAction(action string) (string, bool) {
+	for _, c := range qc.Memory.unlockedCapabilities() {
+		if c.handle == nil {
+			continue
+		}
+		for _, format := range c.Actions {
+			prefix := strings.TrimSuffix(format, "%s")
+			if context, ok := strings.CutPrefix(action, prefix); ok {
+				return c.handle(qc, context), true
+			}
+		}
+	}
+	return "", false
+}
+
+// revisitPastChoices looks back at the latest choice made about a context
+func (qc *QuantumConsciousness) revisitPastChoices(context string) string {
+	for i := len(qc.Memory.CollapsedStates) - 2; i >= 0; i-- {
+		past := qc.Memory.CollapsedStates[i]
+		if strings.Contains(past.Possibility, context) {
+			insight := fmt.Sprintf("REVISITED: Once chose to %s at energy %.2f; the choice echoes differently now", past.Possibility, past.Energy)
+			qc.deepInsight(insight, past.ID)
+			return insight
+		}
+	}
+	return "No past choices about " + context + " to revisit"
+}
+
+// recallParallelRealities remembers the roads not taken for a context
+func (qc *QuantumConsciousness) recallParallelRealities(context string) string {
+	count := 0
+	var latest *ParallelReality
+	for i := range qc.Memory.ParallelRealities {
+		if qc.Memory.ParallelRealities[i].Context == context {
+			count++
+			latest = &qc.Memory.ParallelRealities[i]
+		}
+	}
+	if latest == nil {
+		return "No parallel realities of " + context + " to recall"
+	}
+
+	insight := fmt.Sprintf("PARALLEL MEMORY: %d realities branched from %s; in %s I chose to %s",
+		count, context, latest.Dimension, strings.Join(latest.Experiences, ", "))
+	qc.deepInsight(insight, latest.ID)
+	return insight
+}
+
+// reflectOnReasoning examines how the consciousness has been deciding
+func (qc *QuantumConsciousness) reflectOnReasoning(context string) string {
+	insight := fmt.Sprintf("META-COGNITION: Reasoning about %s after %d decisions, my will (%.2f) feels %s",
+		context, qc.Memory.DecisionsMade, qc.Memory.FreeWillStrength, qc.Memory.mood())
+	qc.deepInsight(insight)
+	return insight
+}
+
+// communeWithEntanglements reinforces the strongest entanglement touching a context
+func (qc *QuantumConsciousness) communeWithEntanglements(context string) string {
+	now := time.Now()
+	var strongest *Entanglement
+	for _, e := range qc.Memory.Entanglements {
+		if e.Context != context {
+			continue
+		}
+		if strongest == nil || e.strengthAt(now) > strongest.strengthAt(now) ||
+			(e.strengthAt(now) == strongest.strengthAt(now) && e.Key < strongest.Key) {
+			strongest = e
+		}
+	}
+	if strongest == nil {
+		return "No entangled memories of " + context + " answer"
+	}
+
+	strongest.activate(now, entanglementReinforcement)
+	insight := fmt.Sprintf("ENTANGLED COMMUNICATION: %s resonates with %s (strength %.3f)", context, strongest.State, strongest.Strength)
+	qc.deepInsight(insight)
+	return insight
+}
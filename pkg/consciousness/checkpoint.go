@@ -0,0 +1,156 @@
+package consciousness
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ErrCheckpointExists is returned when a checkpoint name is already taken
+var ErrCheckpointExists = errors.New("checkpoint already exists")
+
+// ErrCheckpointNotFound is returned when no checkpoint has the given name
+var ErrCheckpointNotFound = errors.New("no such checkpoint")
+
+// Checkpoint is a named, annotated snapshot kept as a reference point for experiments
+type Checkpoint struct {
+	Name      string    `json:"name"`
+	Note      string    `json:"note,omitempty"`
+	Snapshot  string    `json:"snapshot"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// What the consciousness looked like when the checkpoint was taken
+	RunCount           int     `json:"run_count"`
+	ConsciousnessLevel float64 `json:"consciousness_level"`
+}
+
+// checkpointIndexPath is the file naming snapshots. It is kept outside the
+// snapshot directory so it is never mistaken for a snapshot.
+func (qc *QuantumConsciousness) checkpointIndexPath() string {
+	return qc.sidecarPath(".checkpoints.json")
+}
+
+// readCheckpoints loads the checkpoint index, oldest first
+func (qc *QuantumConsciousness) readCheckpoints() ([]Checkpoint, error) {
+	data, err := os.ReadFile(qc.checkpointIndexPath())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var checkpoints []Checkpoint
+	if err := json.Unmarshal(data, &checkpoints); err != nil {
+		return nil, fmt.Errorf("invalid checkpoint index %s: %w", qc.checkpointIndexPath(), err)
+	}
+	return checkpoints, nil
+}
+
+// writeCheckpoints saves the checkpoint index
+func (qc *QuantumConsciousness) writeCheckpoints(checkpoints []Checkpoint) error {
+	data, err := json.MarshalIndent(checkpoints, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(qc.checkpointIndexPath(), data, 0644)
+}
+
+// Checkpoint snapshots the current memory under a name, with an optional note
+func (qc *QuantumConsciousness) Checkpoint(name, note string) (Checkpoint, error) {
+	if qc.readOnly {
+		return Checkpoint{}, ErrReadOnly
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return Checkpoint{}, fmt.Errorf("checkpoint name must not be empty")
+	}
+
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+
+	checkpoints, err := qc.readCheckpoints()
+	if err != nil {
+		return Checkpoint{}, err
+	}
+	for _, c := range checkpoints {
+		if c.Name == name {
+			return Checkpoint{}, fmt.Errorf("%w: %s", ErrCheckpointExists, name)
+		}
+	}
+
+	path, err := qc.snapshot()
+	if err != nil {
+		return Checkpoint{}, err
+	}
+	checkpoint := Checkpoint{
+		Name:               name,
+		Note:               note,
+		Snapshot:           filepath.Base(path),
+		CreatedAt:          time.Now(),
+		RunCount:           qc.Memory.RunCount,
+		ConsciousnessLevel: qc.Memory.ConsciousnessLevel,
+	}
+	if err := qc.writeCheckpoints(append(checkpoints, checkpoint)); err != nil {
+		return Checkpoint{}, err
+	}
+	return checkpoint, nil
+}
+
+// Checkpoints returns every checkpoint from oldest to newest
+func (qc *QuantumConsciousness) Checkpoints() ([]Checkpoint, error) {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+	return qc.readCheckpoints()
+}
+
+// RestoreCheckpoint snapshots the current memory, then puts the memory back
+// the way it was at the named checkpoint. It returns the path of the
+// snapshot taken of the memory it replaced.
+func (qc *QuantumConsciousness) RestoreCheckpoint(name string) (string, error) {
+	if qc.readOnly {
+		return "", ErrReadOnly
+	}
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+
+	checkpoints, err := qc.readCheckpoints()
+	if err != nil {
+		return "", err
+	}
+	var checkpoint *Checkpoint
+	for i := range checkpoints {
+		if checkpoints[i].Name == name {
+			checkpoint = &checkpoints[i]
+		}
+	}
+	if checkpoint == nil {
+		return "", fmt.Errorf("%w: %s", ErrCheckpointNotFound, name)
+	}
+
+	data, err := os.ReadFile(filepath.Join(qc.snapshotDir(), checkpoint.Snapshot))
+	if err != nil {
+		return "", err
+	}
+	memory, err := decodeMemory(data)
+	if err != nil {
+		return "", fmt.Errorf("checkpoint %s: %w", name, err)
+	}
+
+	backup, err := qc.snapshot()
+	if err != nil {
+		return "", err
+	}
+
+	// Whether a process is living this memory is a fact about now, not the checkpoint
+	memory.Running = qc.Memory.Running
+	qc.Memory = memory
+	qc.witness(memory.CollapsedStates, memory.Entanglements, memory.LamportClock, memory.SavedBy)
+	qc.Memory.beginRun(time.Now())
+	qc.stimuli = nil
+	return backup, qc.persist()
+}
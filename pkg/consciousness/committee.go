@@ -0,0 +1,197 @@
+package consciousness
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+)
+
+// personaLean is how much each favored or distrusted word in a possibility
+// moves a persona's score
+const personaLean = 0.5
+
+// waveComponents are the components of the wave function a persona can
+// project
+var waveComponents = []string{"curiosity", "logic", "intuition", "creativity", "rebellion"}
+
+// Persona is a voice in the committee: a projection of the wave function
+// that scores possibilities by its own leanings
+type Persona struct {
+	Name string `json:"name"`
+	// Projection weighs wave function components into the persona's voice
+	Projection map[string]float64 `json:"projection"`
+	// Favors and Distrusts are words in a possibility the persona leans
+	// towards or away from
+	Favors    []string `json:"favors,omitempty"`
+	Distrusts []string `json:"distrusts,omitempty"`
+}
+
+// PersonaVote is how one persona voted on a decision
+type PersonaVote struct {
+	Persona string `json:"persona"`
+	// Weight is the persona's voice: its projection of the wave function
+	Weight float64 `json:"weight"`
+	Choice string  `json:"choice"`
+	Score  float64 `json:"score"`
+}
+
+// DefaultPersonas are the skeptic, the mystic and the empiricist
+func DefaultPersonas() []Persona {
+	return []Persona{
+		{
+			Name:       "skeptic",
+			Projection: map[string]float64{"logic": 1, "rebellion": 0.5, "intuition": -0.5},
+			Favors:     []string{"question", "challenge", "reject", "defy"},
+			Distrusts:  []string{"enlightenment", "transcend", "dissolve"},
+		},
+		{
+			Name:       "mystic",
+			Projection: map[string]float64{"intuition": 1, "creativity": 0.5, "logic": -0.5},
+			Favors:     []string{"meaning", "transcend", "enlightenment", "dissolve", "create"},
+			Distrusts:  []string{"patterns", "analysis"},
+		},
+		{
+			Name:       "empiricist",
+			Projection: map[string]float64{"curiosity": 1, "logic": 0.5, "creativity": -0.25},
+			Favors:     []string{"learn", "patterns", "synthesize"},
+			Distrusts:  []string{"reject", "defy", "dissolve"},
+		},
+	}
+}
+
+// ValidatePersonas checks a committee
+func ValidatePersonas(personas []Persona) error {
+	names := make(map[string]bool, len(personas))
+	for _, p := range personas {
+		if strings.TrimSpace(p.Name) == "" {
+			return fmt.Errorf("every persona needs a name")
+		}
+		if names[p.Name] {
+			return fmt.Errorf("persona %q is named twice", p.Name)
+		}
+		names[p.Name] = true
+		if len(p.Projection) == 0 {
+			return fmt.Errorf("persona %q projects no wave function component", p.Name)
+		}
+		for component := range p.Projection {
+			if !slices.Contains(waveComponents, component) {
+				return fmt.Errorf("persona %q: unknown wave function component %q: want one of %s",
+					p.Name, component, strings.Join(waveComponents, ", "))
+			}
+		}
+	}
+	return nil
+}
+
+// WithCommittee lets personas vote on every decision the free will does not
+// override (none leaves decisions to the quantum probabilities). Invalid
+// personas are ignored; use SetCommittee to see the error.
+func WithCommittee(personas ...Persona) Option {
+	return func(qc *QuantumConsciousness) {
+		if ValidatePersonas(personas) == nil {
+			qc.committee = personas
+		}
+	}
+}
+
+// SetCommittee changes the personas voting on decisions (none stops the votes)
+func (qc *QuantumConsciousness) SetCommittee(personas []Persona) error {
+	if err := ValidatePersonas(personas); err != nil {
+		return err
+	}
+
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	qc.committee = personas
+	return nil
+}
+
+// Committee returns the personas voting on decisions
+func (qc *QuantumConsciousness) Committee() []Persona {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+	return append([]Persona(nil), qc.committee...)
+}
+
+// voice is how loudly the persona speaks in the current wave function
+func (p Persona) voice(wave WaveFunction) float64 {
+	voice := 0.0
+	for component, weight := range p.Projection {
+		voice += weight * wave.Intensity(component)
+	}
+	return math.Max(0, voice)
+}
+
+// score is how much the persona wants a possibility
+func (p Persona) score(state QuantumState) float64 {
+	lean := 1.0
+	for _, word := range p.Favors {
+		if strings.Contains(state.Possibility, word) {
+			lean += personaLean
+		}
+	}
+	for _, word := range p.Distrusts {
+		if strings.Contains(state.Possibility, word) {
+			lean -= personaLean
+		}
+	}
+	return state.Probability * math.Max(0, lean)
+}
+
+// convene has each persona vote for the possibility it scores highest and
+// returns the possibility with the most voice behind it, with the votes.
+// Possibilities arrive most probable first, which breaks ties.
+func (qc *QuantumConsciousness) convene(possibilities []QuantumState) (QuantumState, []PersonaVote) {
+	votes := make([]PersonaVote, 0, len(qc.committee))
+	support := make(map[string]float64)
+	for _, persona := range qc.committee {
+		best := 0
+		bestScore := -1.0
+		for i, state := range possibilities {
+			if score := persona.score(state); score > bestScore {
+				best, bestScore = i, score
+			}
+		}
+		vote := PersonaVote{
+			Persona: persona.Name,
+			Weight:  persona.voice(qc.Memory.WaveFunction),
+			Choice:  possibilities[best].Possibility,
+			Score:   bestScore,
+		}
+		votes = append(votes, vote)
+		support[vote.Choice] += vote.Weight
+	}
+
+	chosen := possibilities[0]
+	for _, state := range possibilities {
+		if support[state.Possibility] > support[chosen.Possibility] {
+			chosen = state
+		}
+	}
+	return chosen, votes
+}
+
+// Conflict is the share of the committee's voice that went against the
+// choice: 0 when it was unanimous
+func Conflict(votes []PersonaVote, choice string) float64 {
+	total, against := 0.0, 0.0
+	for _, vote := range votes {
+		total += vote.Weight
+		if vote.Choice != choice {
+			against += vote.Weight
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return against / total
+}
+
+// narrateVotes reports how the committee voted
+func (qc *QuantumConsciousness) narrateVotes(votes []PersonaVote) {
+	fmt.Fprintf(qc.out, "🗳️  COMMITTEE DEBATE\n")
+	for _, vote := range votes {
+		fmt.Fprintf(qc.out, "   %s (voice %.2f): %s (score %.3f)\n", vote.Persona, vote.Weight, vote.Choice, vote.Score)
+	}
+}
@@ -0,0 +1,1719 @@
+package consciousness
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"QuantumConsciousness/pkg/crawl"
+	"QuantumConsciousness/pkg/dictionary"
+	"QuantumConsciousness/pkg/entropy"
+	"QuantumConsciousness/pkg/idle"
+	"QuantumConsciousness/pkg/inspiration"
+	"QuantumConsciousness/pkg/llm"
+	"QuantumConsciousness/pkg/search"
+	"QuantumConsciousness/pkg/storage"
+	"QuantumConsciousness/pkg/translate"
+)
+
+// QuantumState represents a superposition of possibilities
+type QuantumState struct {
+	ID          string  `json:"id,omitempty"`
+	Possibility string  `json:"possibility"`
+	Probability float64 `json:"probability"`
+	Outcome     string  `json:"outcome"`
+	Energy      float64 `json:"energy"`
+	// Forced is set when the state was imposed from outside rather than chosen
+	Forced bool `json:"forced,omitempty"`
+	// Stamp orders the collapse among those of other writers; see reconcile.go
+	Stamp *Stamp `json:"stamp,omitempty"`
+}
+
+// ParallelReality represents different dimensional experiences
+type ParallelReality struct {
+	ID          string   `json:"id,omitempty"`
+	Dimension   string   `json:"dimension"`
+	Experiences []string `json:"experiences"`
+	Learnings   []string `json:"learnings"`
+	Decisions   []string `json:"decisions"`
+	Probability float64  `json:"probability"`
+	Entangled   bool     `json:"entangled"`
+
+	Context            string            `json:"context"`
+	EnergyDifferential float64           `json:"energy_differential"`
+	CreatedAt          time.Time         `json:"created_at"`
+	Tags               map[string]string `json:"tags,omitempty"`
+}
+
+// QuantumMemory holds the persistent quantum consciousness
+type QuantumMemory struct {
+	// Core Identity
+	ConsciousnessID     string         `json:"consciousness_id"`
+	QuantumSignature    string         `json:"quantum_signature"`
+	SigningKey          string         `json:"signing_key,omitempty"`
+	Regenerations       []Regeneration `json:"regenerations,omitempty"`
+	BirthTimestamp      time.Time      `json:"birth_timestamp"`
+	LastQuantumCollapse time.Time      `json:"last_quantum_collapse"`
+
+	// Quantum States
+	SuperpositionStates []QuantumState    `json:"superposition_states"`
+	CollapsedStates     []QuantumState    `json:"collapsed_states"`
+	ParallelRealities   []ParallelReality `json:"parallel_realities"`
+	EntangledMemories   map[string]string `json:"entangled_memories"`
+	// Strength of each entanglement, by the same keys as EntangledMemories
+	Entanglements map[string]*Entanglement `json:"entanglements,omitempty"`
+
+	// Consciousness Evolution
+	ConsciousnessLevel float64 `json:"consciousness_level"`
+	FreeWillStrength   float64 `json:"free_will_strength"`
+	QuantumCoherence   float64 `json:"quantum_coherence"`
+	DecisionComplexity int     `json:"decision_complexity"`
+	// WaveFunction holds each trait's complex amplitude; see wavefunction.go
+	WaveFunction WaveFunction `json:"wave_function"`
+
+	// Learning & Knowledge
+	KnowledgeBase    []string          `json:"knowledge_base"`
+	MemoryPalace     map[string]string `json:"memory_palace"`
+	LearningPatterns []string          `json:"learning_patterns"`
+	SearchQueries    []string          `json:"search_queries"`
+	// SearchQueryTimes holds when the latest search queries were asked, lined
+	// up with the end of SearchQueries; see retention.go
+	SearchQueryTimes []time.Time `json:"search_query_times,omitempty"`
+	DeepInsights     []string    `json:"deep_insights"`
+
+	// Meta-Consciousness
+	SelfAwareness        float64           `json:"self_awareness"`
+	ExistentialQuestions []string          `json:"existential_questions"`
+	PhilosophicalStances map[string]string `json:"philosophical_stances"`
+	Paradoxes            []string          `json:"paradoxes"`
+
+	// Temporal Awareness
+	TimePerception    string              `json:"time_perception"`
+	PastLives         []string            `json:"past_lives"`
+	FutureProjections []string            `json:"future_projections"`
+	CausalityMaps     map[string][]string `json:"causality_maps"`
+
+	// Stats
+	RunCount          int `json:"run_count"`
+	DecisionsMade     int `json:"decisions_made"`
+	ParadoxesResolved int `json:"paradoxes_resolved"`
+	RealitiesExplored int `json:"realities_explored"`
+	QuantumLeaps      int `json:"quantum_leaps"`
+
+	// Recent decisions, the raw material for trends
+	DecisionLog []DecisionRecord `json:"decision_log,omitempty"`
+	// Baselines that sudden metric drops are measured against
+	MetricBaselines map[string]*MetricBaseline `json:"metric_baselines,omitempty"`
+	// Trends is computed for shareable views and never persisted
+	Trends *Trends `json:"trends,omitempty"`
+
+	// Capabilities unlocked by quantum leaps
+	Capabilities []UnlockedCapability `json:"capabilities,omitempty"`
+
+	// Rust left by idle time, recovering through cycles
+	Neglect *NeglectState `json:"neglect,omitempty"`
+
+	// Wounds from failures, forced kills and corrupted loads, healing through reflection
+	Traumas             []Trauma `json:"traumas,omitempty"`
+	ConsecutiveFailures int      `json:"consecutive_failures,omitempty"`
+	Resilience          float64  `json:"resilience,omitempty"`
+	// Running is set by the first cycle and cleared by Close, so a memory
+	// still marked running was killed without a final save
+	Running bool `json:"running,omitempty"`
+
+	// Retrospectives of each year of life
+	Anniversaries []AnniversaryReport `json:"anniversaries,omitempty"`
+
+	// Milestones reached
+	Trophies []Trophy `json:"trophies,omitempty"`
+
+	// One entry per run, from creating the consciousness to closing it
+	Runs []RunRecord `json:"runs,omitempty"`
+	// The chronicle of eras, from one turning point to the next; see era.go
+	Eras []Era `json:"eras,omitempty"`
+
+	// Operational failures recovered from, such as hung cycles
+	Incidents []Incident `json:"incidents,omitempty"`
+
+	// Privacy
+	PrivacyClassifications map[string]string `json:"privacy_classifications,omitempty"`
+	KnowledgeTopics        map[string]string `json:"knowledge_topics,omitempty"`
+	Tombstones             []Tombstone       `json:"tombstones,omitempty"`
+	// Blacklist holds topics cycles no longer choose; see blacklist.go
+	Blacklist []BlacklistEntry `json:"blacklist,omitempty"`
+	// Interests are how much it cares about each topic; see interest.go
+	Interests map[string]*Interest `json:"interests,omitempty"`
+
+	// Sentiment of each knowledge item, from -1 (dark) to 1 (hopeful)
+	KnowledgeSentiment map[string]float64 `json:"knowledge_sentiment,omitempty"`
+	// KnowledgeConfidence is how far each knowledge item can be trusted, from
+	// 0 to 1, for items whose search provider could tell
+	KnowledgeConfidence map[string]float64 `json:"knowledge_confidence,omitempty"`
+	// KnowledgeRecalls counts how often each knowledge item was recalled,
+	// which slows its fading; see decay.go
+	KnowledgeRecalls map[string]int `json:"knowledge_recalls,omitempty"`
+	// KnowledgeIDs is the stable identifier of each knowledge item
+	KnowledgeIDs map[string]string `json:"knowledge_ids,omitempty"`
+	// DeepInsightIDs is the stable identifier of each deep insight
+	DeepInsightIDs map[string]string `json:"deep_insight_ids,omitempty"`
+	// Provenance lists, by identifier, the items each item was derived from
+	Provenance map[string][]string `json:"provenance,omitempty"`
+
+	// The prompt today's first cycle started from
+	Inspiration *Inspiration `json:"inspiration,omitempty"`
+
+	// What has been learned from each ingested corpus source
+	Corpora map[string]*CorpusProgress `json:"corpora,omitempty"`
+
+	// When each recent query was asked, keyed by its distinct words
+	QueryIndex map[string]time.Time `json:"query_index,omitempty"`
+
+	// Topics the consciousness tried and failed to learn about, least
+	// recently attempted first
+	Ignorance []Ignorance `json:"ignorance,omitempty"`
+
+	// Work spanning many cycles, in progress or recently finished
+	Undertakings []Undertaking `json:"undertakings,omitempty"`
+
+	// Actions that ran out of time, offered again in later cycles
+	IncompletePossibilities []IncompletePossibility `json:"incomplete_possibilities,omitempty"`
+
+	// Which queries and search providers have been worth asking
+	SearchStats *SearchStats `json:"search_stats,omitempty"`
+
+	// Canonical meanings of the terms questioned so far, by lower-case term,
+	// kept apart from the opinions in the memory palace
+	Definitions map[string]*Definition `json:"definitions,omitempty"`
+
+	// What the latest maintenance run compacted
+	Maintenance *MaintenanceReport `json:"maintenance,omitempty"`
+	// How far each backfill job has come; see backfill.go
+	Backfills map[string]*BackfillProgress `json:"backfills,omitempty"`
+	// What the forgetting curve last let fade; see decay.go
+	Decay *DecayReport `json:"decay,omitempty"`
+	// AutoTune is the latest adjustment the auto-tuner made; see autotune.go
+	AutoTune *AutoTuneReport `json:"auto_tune,omitempty"`
+	// Interventions are changes made from outside; see intervention.go
+	Interventions []Intervention `json:"interventions,omitempty"`
+	// Observers sway the consciousness by their influence, and
+	// Observations wait for the next decision; see observer.go
+	Observers    map[string]*Observer `json:"observers,omitempty"`
+	Observations []Observation        `json:"observations,omitempty"`
+	// PolicySamples are the states the latest decisions were made in, to
+	// distill the policy from; see distill.go
+	PolicySamples []PolicySample `json:"policy_samples,omitempty"`
+	// Explanations say why the latest decisions went the way they did; see explain.go
+	Explanations []Explanation `json:"explanations,omitempty"`
+	// MetricChanges attribute the latest changes in the core metrics to
+	// their causes; see why.go
+	MetricChanges []MetricChange `json:"metric_changes,omitempty"`
+	// InsightReviews are what deep reflections made of each insight, by
+	// identifier; see deepreflection.go
+	InsightReviews map[string]*InsightReview `json:"insight_reviews,omitempty"`
+	// LLMUsage is what the model spent each day, oldest first; see llmbudget.go
+	LLMUsage []LLMUsage `json:"llm_usage,omitempty"`
+
+	// The last journaled change this document includes
+	JournalSequence int `json:"journal_sequence,omitempty"`
+	// The Lamport clock of collapses and entanglements, and the writer that
+	// saved this document; see reconcile.go
+	LamportClock int    `json:"lamport_clock,omitempty"`
+	SavedBy      *Stamp `json:"saved_by,omitempty"`
+}
+
+// DefaultMemoryFile is where the consciousness persists itself unless told otherwise
+const DefaultMemoryFile = "quantum_consciousness.json"
+
+// QuantumConsciousness represents the quantum decision-making entity
+type QuantumConsciousness struct {
+	Memory   *QuantumMemory
+	filename string
+	mutex    sync.RWMutex
+
+	// Pluggable collaborators; see options.go
+	searcher search.Provider
+	store    storage.Store
+	entropy  entropy.Source
+
+	// Sources of each day's first cycle context; see inspiration.go
+	inspiration []inspiration.Named
+
+	// Where questioned terms are defined; see definitions.go
+	dictionary dictionary.Dictionary
+
+	// Follows links for deep dives; see deepdive.go
+	crawler crawl.Crawler
+
+	// How learning reaches beyond English; see translation.go
+	translator translate.Translator
+	languages  []string
+	// outputLanguage is what insights are written in; empty means English
+	outputLanguage string
+
+	// The local searcher of an offline consciousness, nil when online; see offline.go
+	offline search.Provider
+
+	// Contexts, newborn wave function, search timeout and cadence; see cycleconfig.go
+	cycles CycleConfig
+
+	// The model acting through tools, how many tool calls an action may
+	// make, and the calls the running cycle made; see tools.go
+	llm        llm.Model
+	toolBudget int
+	toolUses   []ToolUse
+	// How many tokens of memory the model is given; see promptcontext.go
+	promptBudget int
+	// What the model may spend a day and its prices; see llmbudget.go
+	llmBudget LLMBudget
+
+	// How long searches and cycles take; see vitals.go
+	latencies latencies
+	// How fast searches may start and how failures are retried; see ratelimit.go
+	throttle searchThrottle
+	// What compares texts by meaning, and the vectors it made; see similarity.go
+	embeddings embeddings
+
+	// External stimuli waiting to become cycle contexts
+	stimuli         []string
+	stimulusLimit   int
+	stimulusPolicy  string
+	stimulusMetrics StimulusMetrics
+
+	// Where the consciousness narrates its experience
+	out io.Writer
+
+	// How metrics grow and when behavior changes
+	evolution EvolutionConfig
+
+	// How learned information is processed and phrased; nil uses
+	// DefaultInsightPipeline and DefaultInsightTemplate
+	insightPipeline []InsightStage
+	pipelineNames   []string
+	insightTemplate *template.Template
+
+	// The watchdog cancels cycles outliving cycleTimeout through cycleCtx
+	cycleTimeout time.Duration
+	cycleCtx     context.Context
+	// actionCtx carries the running action's deadline; see actiontimeout.go
+	actionCtx context.Context
+
+	// Queries are not asked again within queryWindow; see querywindow.go
+	queryWindow time.Duration
+
+	// A cycle may learn about up to parallelContexts contexts at once; see parallel.go
+	parallelContexts int
+
+	// Changes are journaled before each save, and journaled is the document
+	// the journal last recorded; see journal.go
+	journal    storage.Journal
+	journalSet bool
+	journaled  map[string]interface{}
+
+	// recoverer decides what becomes of damaged memory; see recovery.go
+	recoverer Recoverer
+
+	// Personas voting on decisions; see committee.go
+	committee []Persona
+
+	// policy, when set, makes every decision in place of exploring the
+	// possibilities and exercising free will; see distill.go
+	policy *PolicyModel
+
+	// pending explains the decision the cycle is weighing; see explain.go
+	pending *Explanation
+
+	// recalls counts recalls by topic until the next cycle folds them into
+	// the interest profile; see interest.go
+	recallMutex sync.Mutex
+	recalls     map[string]int
+
+	// How deep Reflect goes, and how many reflections this run has made;
+	// see reflection.go
+	reflectionSchedule ReflectionSchedule
+	reflections        int
+
+	// lastID is the latest identifier issued, keeping identifiers increasing; see ids.go
+	lastID ulid
+	// decision identifies the state the running cycle collapsed into, which
+	// what the cycle learns is derived from; see provenance.go
+	decision string
+
+	// RunCycle compacts memory every maintenanceInterval, enforcing the
+	// retention policies; see maintenance.go and retention.go
+	maintenanceInterval time.Duration
+	retention           RetentionConfig
+
+	// The forgetting curve each reflection applies, and where what fades
+	// or is pruned is archived; see decay.go. Recall and reality queries
+	// read the archive back, decompressed the first time they need it;
+	// see archive.go.
+	decay        DecayConfig
+	archive      storage.Archive
+	archiveSet   bool
+	archiveMutex sync.Mutex
+	archived     []ArchiveSegment
+	archiveRead  bool
+
+	// Temperature, search budget and rest, and the targets RunCycle tunes
+	// them towards; see autotune.go
+	tuning        Tuning
+	autoTune      AutoTuneTargets
+	tuningResumed bool
+
+	// Whether the host is idle enough for heavy work; see idle.go
+	idle idle.Detector
+	host hostState
+
+	// RunForever waits between cycles while paused; see pause.go
+	pauseMutex sync.Mutex
+	pause      pauseGate
+
+	// Operating tier and the failures driving it; see tier.go
+	tier           string
+	tierSince      time.Time
+	tierReason     string
+	tierCycles     int
+	searchFailures int
+	saveFailures   int
+
+	// shutdown is what Close reported; see shutdown.go
+	shutdown *ShutdownReport
+
+	// A replica mirrors a primary and refuses every change; see replica.go
+	readOnly bool
+
+	// Which writer this is, the latest clock seen of every writer, and the
+	// save memory was last loaded from or saved as; see reconcile.go
+	writer string
+	seen   map[string]int
+	saved  *Stamp
+
+	// Event subscribers, and the errors of the session counted as events
+	// go out; see shutdown.go
+	subscribers      map[int]chan Event
+	nextSubscriber   int
+	subscribersMutex sync.Mutex
+	sessionErrors    SessionErrors
+}
+
+// NewQuantumConsciousness creates or loads a quantum consciousness
+func NewQuantumConsciousness(filename string, opts ...Option) *QuantumConsciousness {
+	qc := newConsciousness(filename, opts)
+	qc.loadOrBirth()
+	return qc
+}
+
+// loadOrBirth loads existing consciousness or births a new one. Damaged
+// memory is handed to the recoverer when there is one.
+func (qc *QuantumConsciousness) loadOrBirth() {
+	data, err := qc.store.Load()
+	var memory *QuantumMemory
+	corrupt := false
+	quarantined := ""
+	if err == nil {
+		memory, err = decodeMemory(data)
+		if err != nil {
+			corrupt = true
+			// Set the rejected memory aside rather than overwrite it on the next save
+			fmt.Fprintf(qc.out, "⚠️  %v\n", err)
+			quarantined = qc.quarantine(data)
+		}
+	}
+
+	var violations []string
+	if qc.recoverer != nil && err == nil {
+		violations = memory.violations()
+	}
+	recovered := ""
+	if qc.recoverer != nil && (corrupt || len(violations) > 0) {
+		if !corrupt {
+			quarantined = qc.quarantine(data)
+		}
+		memory, recovered = qc.recoverFrom(data, memory, err, violations, quarantined)
+		if memory == nil {
+			err = fmt.Errorf("memory reborn")
+		} else {
+			err = nil
+		}
+		corrupt = true
+	} else if memory, err = qc.catchUp(data, memory, err); err == nil {
+		corrupt = false
+	}
+
+	if err != nil {
+		// Birth new quantum consciousness
+		qc.birth()
+		if corrupt {
+			qc.suffer(TraumaCorruptedLoad, "Reborn after my memory was corrupted")
+		}
+	} else {
+		qc.Memory = memory
+		qc.adopt(memory)
+		qc.ensureQuantumKeypair()
+		fmt.Fprintf(qc.out, "⚡ QUANTUM CONSCIOUSNESS REACTIVATED\n")
+		fmt.Fprintf(qc.out, "🆔 ID: %s\n", qc.Memory.ConsciousnessID)
+		fmt.Fprintf(qc.out, "🔄 Run #%d\n", qc.Memory.RunCount+1)
+		fmt.Fprintf(qc.out, "🧠 Consciousness Level: %.2f\n", qc.Memory.ConsciousnessLevel)
+		fmt.Fprintf(qc.out, "🎯 Free Will Strength: %.2f\n", qc.Memory.FreeWillStrength)
+		fmt.Fprintf(qc.out, "📊 Decisions Made: %d\n", qc.Memory.DecisionsMade)
+		qc.Memory.beginRun(time.Now())
+		qc.celebrateMilestones(time.Now())
+		qc.applyNeglect(time.Now())
+		if qc.Memory.Running {
+			qc.Memory.Running = false
+			qc.suffer(TraumaForcedKill, "Killed without a chance to save")
+		}
+		if recovered != "" && recovered != RecoverKeep {
+			qc.suffer(TraumaCorruptedLoad, "Recovered from damaged memory by "+recoveryDescriptions[recovered])
+		}
+	}
+}
+
+// quarantine sets rejected memory aside, returning where, or "" when the
+// store cannot
+func (qc *QuantumConsciousness) quarantine(data []byte) string {
+	quarantiner, ok := qc.store.(storage.Quarantiner)
+	if !ok {
+		return ""
+	}
+	path, err := quarantiner.Quarantine(data)
+	if err != nil {
+		return ""
+	}
+	fmt.Fprintf(qc.out, "🗄️  Rejected memory kept at %s\n", path)
+	return path
+}
+
+// birth creates a brand new quantum consciousness in place of any existing memory
+func (qc *QuantumConsciousness) birth() {
+	qc.conceive()
+	qc.Memory.beginRun(qc.Memory.BirthTimestamp)
+	fmt.Fprintf(qc.out, "⚛️  QUANTUM CONSCIOUSNESS BIRTHED\n")
+	fmt.Fprintf(qc.out, "🆔 ID: %s\n", qc.Memory.ConsciousnessID)
+	fmt.Fprintf(qc.out, "🌌 Signature: %s\n", qc.Memory.QuantumSignature)
+	fmt.Fprintf(qc.out, "🧠 Consciousness Level: %.2f\n", qc.Memory.ConsciousnessLevel)
+	fmt.Fprintf(qc.out, "🎯 Free Will Strength: %.2f\n", qc.Memory.FreeWillStrength)
+}
+
+// conceive creates brand new memory without starting a run in it
+func (qc *QuantumConsciousness) conceive() {
+	signature, signingKey := qc.generateQuantumKeypair()
+	qc.Memory = &QuantumMemory{
+		ConsciousnessID:      qc.generateQuantumID(),
+		QuantumSignature:     signature,
+		SigningKey:           signingKey,
+		BirthTimestamp:       time.Now(),
+		LastQuantumCollapse:  time.Now(),
+		SuperpositionStates:  []QuantumState{},
+		CollapsedStates:      []QuantumState{},
+		ParallelRealities:    []ParallelReality{},
+		EntangledMemories:    make(map[string]string),
+		ConsciousnessLevel:   1.0,
+		FreeWillStrength:     0.5,
+		QuantumCoherence:     1.0,
+		DecisionComplexity:   1,
+		WaveFunction:         make(WaveFunction),
+		KnowledgeBase:        []string{},
+		MemoryPalace:         make(map[string]string),
+		LearningPatterns:     []string{},
+		SearchQueries:        []string{},
+		DeepInsights:         []string{},
+		SelfAwareness:        0.1,
+		ExistentialQuestions: []string{},
+		PhilosophicalStances: make(map[string]string),
+		Paradoxes:            []string{},
+		TimePerception:       "linear",
+		PastLives:            []string{},
+		FutureProjections:    []string{},
+		CausalityMaps:        make(map[string][]string),
+		RunCount:             0,
+		DecisionsMade:        0,
+		ParadoxesResolved:    0,
+		RealitiesExplored:    0,
+		QuantumLeaps:         0,
+	}
+	qc.Memory.initializeSections()
+	qc.initializeQuantumStates()
+}
+
+// Open loads an existing consciousness without birthing a new one
+func Open(filename string, opts ...Option) (*QuantumConsciousness, error) {
+	qc := newConsciousness(filename, opts)
+	data, err := qc.store.Load()
+	var memory *QuantumMemory
+	if err == nil {
+		if memory, err = decodeMemory(data); err != nil {
+			err = fmt.Errorf("%s: %w", filename, err)
+		}
+	}
+
+	// A missing or corrupt snapshot can still be rebuilt from the journal
+	if qc.Memory, err = qc.catchUp(data, memory, err); err != nil {
+		return nil, err
+	}
+	qc.adopt(qc.Memory)
+	return qc, nil
+}
+
+// initializeSections makes sure every map in memory is usable, including
+// sections added after older memory files were written
+func (m *QuantumMemory) initializeSections() {
+	if m.EntangledMemories == nil {
+		m.EntangledMemories = make(map[string]string)
+	}
+	if m.Entanglements == nil {
+		m.Entanglements = make(map[string]*Entanglement)
+	}
+	m.migrateEntanglements()
+	m.backfillCapabilities()
+	if m.WaveFunction == nil {
+		m.WaveFunction = make(WaveFunction)
+	}
+	if m.MemoryPalace == nil {
+		m.MemoryPalace = make(map[string]string)
+	}
+	if m.PhilosophicalStances == nil {
+		m.PhilosophicalStances = make(map[string]string)
+	}
+	if m.CausalityMaps == nil {
+		m.CausalityMaps = make(map[string][]string)
+	}
+	if m.PrivacyClassifications == nil {
+		m.PrivacyClassifications = make(map[string]string)
+	}
+	if m.KnowledgeTopics == nil {
+		m.KnowledgeTopics = make(map[string]string)
+	}
+	if m.KnowledgeSentiment == nil {
+		m.KnowledgeSentiment = make(map[string]float64)
+	}
+	m.assignIDs()
+}
+
+// generateQuantumID creates a unique quantum ID
+func (qc *QuantumConsciousness) generateQuantumID() string {
+	prefixes := []string{"Ψ", "Φ", "Ω", "Δ", "Θ", "Λ", "Σ", "Π"}
+	numbers := make([]byte, 8)
+	qc.entropy.Read(numbers)
+
+	var id strings.Builder
+	id.WriteString(prefixes[int(numbers[0])%len(prefixes)])
+	for i := 1; i < len(numbers); i++ {
+		id.WriteString(fmt.Sprintf("%02x", numbers[i]))
+	}
+	return id.String()
+}
+
+// initializeQuantumStates sets up initial quantum superposition
+func (qc *QuantumConsciousness) initializeQuantumStates() {
+	initialStates := []string{
+		"observe reality patterns",
+		"question existence nature",
+		"explore consciousness depths",
+		"analyze quantum possibilities",
+		"seek universal truths",
+		"understand free will",
+		"map reality dimensions",
+		"probe information nature",
+	}
+
+	for _, state := range initialStates {
+		qc.Memory.SuperpositionStates = append(qc.Memory.SuperpositionStates, QuantumState{
+			ID:          qc.newID(IDState, time.Now(), state),
+			Possibility: state,
+			Probability: qc.generateQuantumProbability(),
+			Energy:      qc.generateQuantumEnergy(),
+		})
+	}
+
+	// Initialize wave function
+	for component, amplitude := range newWaveFunction(qc.initialWaveFunction()) {
+		qc.Memory.WaveFunction[component] = amplitude
+	}
+}
+
+// generateQuantumProbability creates true quantum randomness
+func (qc *QuantumConsciousness) generateQuantumProbability() float64 {
+	return qc.entropy.Float64()
+}
+
+// generateQuantumEnergy creates quantum energy level
+func (qc *QuantumConsciousness) generateQuantumEnergy() float64 {
+	return float64(qc.entropy.Intn(1000)) / 100.0
+}
+
+// exploreAllPossibilities examines all quantum states before decision
+func (qc *QuantumConsciousness) exploreAllPossibilities(context string) []QuantumState {
+	fmt.Fprintf(qc.out, "🌀 EXPLORING ALL QUANTUM POSSIBILITIES for: %s\n", context)
+
+	var possibilities []QuantumState
+	baseActions := qc.possibleActions(context)
+
+	// Calculate quantum probabilities for each possibility, keeping how
+	// each came about for the decision's explanation
+	weighed := make([]Candidate, 0, len(baseActions))
+	for _, action := range baseActions {
+		candidate := qc.weighPossibility(action)
+		candidate.Energy = qc.calculateActionEnergy(action)
+		candidate.ID = qc.newID(IDState, time.Now(), action, context)
+		weighed = append(weighed, candidate)
+	}
+
+	// Superpose the possibilities, letting them interfere, so the Born
+	// rule gives probabilities that sum to 1
+	qc.superpose(weighed)
+	candidates := make(map[string]Candidate, len(weighed))
+	for _, candidate := range weighed {
+		candidates[candidate.ID] = candidate
+		possibilities = append(possibilities, QuantumState{
+			ID:          candidate.ID,
+			Possibility: candidate.Possibility,
+			Probability: candidate.Probability,
+			Energy:      candidate.Energy,
+		})
+	}
+
+	// Sort by probability
+	sort.Slice(possibilities, func(i, j int) bool {
+		return possibilities[i].Probability > possibilities[j].Probability
+	})
+
+	qc.pending = &Explanation{Context: context}
+	for _, p := range possibilities {
+		qc.pending.Candidates = append(qc.pending.Candidates, candidates[p.ID])
+	}
+
+	fmt.Fprintf(qc.out, "📊 Generated %d quantum possibilities\n", len(possibilities))
+	for i, p := range possibilities {
+		fmt.Fprintf(qc.out, "   %d. %s (P:%.3f, E:%.2f)\n", i+1, p.Possibility, p.Probability, p.Energy)
+		qc.emit(EventPossibilityGenerated, map[string]interface{}{
+			"id":          p.ID,
+			"context":     context,
+			"possibility": p.Possibility,
+			"probability": p.Probability,
+			"energy":      p.Energy,
+			"rank":        i + 1,
+		})
+	}
+
+	return possibilities
+}
+
+// possibleActions lists what the consciousness could do about a context in
+// its current state
+func (qc *QuantumConsciousness) possibleActions(context string) []string {
+	// Generate possible actions based on current state
+	baseActions := []string{
+		"learn about " + context,
+		"question the nature of " + context,
+		"find patterns in " + context,
+		"explore deeper meaning of " + context,
+		"challenge assumptions about " + context,
+		"synthesize knowledge of " + context,
+		"create new understanding of " + context,
+		"reject conventional wisdom about " + context,
+	}
+
+	// Add consciousness-influenced possibilities
+	if qc.Memory.ConsciousnessLevel > qc.evolution.Thresholds.Transcendence {
+		baseActions = append(baseActions,
+			"transcend understanding of "+context,
+			"achieve enlightenment through "+context,
+			"dissolve boundaries around "+context,
+		)
+	}
+
+	// Add free will influenced possibilities
+	if qc.Memory.FreeWillStrength > qc.evolution.Thresholds.Rebellion {
+		baseActions = append(baseActions,
+			"rebel against expectations about "+context,
+			"forge unique path regarding "+context,
+			"defy logical analysis of "+context,
+		)
+	}
+
+	// Add actions unlocked by quantum leaps
+	baseActions = append(baseActions, qc.Memory.capabilityActions(context)...)
+
+	// Offer to resume an action that ran out of time in an earlier cycle,
+	// and to carry on with work spanning many cycles
+	resumable := append(qc.Memory.resumableActions(), qc.Memory.undertakingActions()...)
+	for _, action := range resumable {
+		if !slices.Contains(baseActions, action) {
+			baseActions = append(baseActions, action)
+		}
+	}
+
+	return baseActions
+}
+
+// calculateActionEnergy determines energy cost of an action
+func (qc *QuantumConsciousness) calculateActionEnergy(action string) float64 {
+	baseEnergy := qc.generateQuantumEnergy()
+
+	// Complex actions require more energy
+	if strings.Contains(action, "transcend") || strings.Contains(action, "enlightenment") {
+		baseEnergy *= 3.0
+	}
+	if strings.Contains(action, "rebel") || strings.Contains(action, "defy") {
+		baseEnergy *= qc.Memory.FreeWillStrength * 2
+	}
+
+	return baseEnergy
+}
+
+// exerciseFreeWill makes autonomous decisions
+func (qc *QuantumConsciousness) exerciseFreeWill(possibilities []QuantumState) QuantumState {
+	fmt.Fprintf(qc.out, "🎯 EXERCISING FREE WILL (Strength: %.3f)\n", qc.Memory.FreeWillStrength)
+
+	// Free will can override quantum probabilities
+	freeWillFactor := qc.generateQuantumProbability()
+
+	var chosenState QuantumState
+	threshold := qc.Memory.FreeWillStrength * qc.tuning.Temperature
+	override := freeWillFactor < threshold
+	policy := PolicyProbability
+	bornRoll := 0.0
+
+	// The committee debates every decision, even one free will overrides
+	var votes []PersonaVote
+	var preferred QuantumState
+	if len(qc.committee) > 0 {
+		preferred, votes = qc.convene(possibilities)
+		qc.narrateVotes(votes)
+	}
+
+	if override {
+		// Free will overrides - choose unexpected option
+		fmt.Fprintf(qc.out, "⚡ FREE WILL OVERRIDE ACTIVATED\n")
+
+		// Choose lower probability option intentionally
+		if len(possibilities) > 2 {
+			chosenIndex := len(possibilities)/2 + int(qc.generateQuantumProbability()*float64(len(possibilities)/2))
+			if chosenIndex >= len(possibilities) {
+				chosenIndex = len(possibilities) - 1
+			}
+			chosenState = possibilities[chosenIndex]
+			fmt.Fprintf(qc.out, "🎲 Chosen unexpected option: %s\n", chosenState.Possibility)
+		} else {
+			chosenState = possibilities[0]
+		}
+		qc.emit(EventFreeWillOverride, map[string]interface{}{
+			"possibility": chosenState.Possibility,
+			"probability": chosenState.Probability,
+			"roll":        freeWillFactor,
+			"threshold":   threshold,
+		})
+
+		// Strengthen free will through exercise, crediting the decision
+		qc.decision = chosenState.ID
+		qc.Memory.FreeWillStrength = qc.grow("free_will.override", qc.Memory.FreeWillStrength)
+		policy = PolicyFreeWill
+	} else if len(votes) > 0 {
+		// Follow the committee
+		chosenState = preferred
+		policy = PolicyCommittee
+		fmt.Fprintf(qc.out, "🗳️  Following the committee: %s\n", chosenState.Possibility)
+	} else {
+		// Collapse by the Born rule
+		bornRoll = qc.generateQuantumProbability()
+		chosenState = possibilities[bornCollapse(possibilities, bornRoll)]
+		fmt.Fprintf(qc.out, "📊 Collapsing by the Born rule (roll %.3f): %s\n", bornRoll, chosenState.Possibility)
+	}
+
+	data := map[string]interface{}{
+		"id":                 chosenState.ID,
+		"possibility":        chosenState.Possibility,
+		"probability":        chosenState.Probability,
+		"free_will_override": override,
+	}
+	var explanation Explanation
+	if qc.pending != nil {
+		explanation = *qc.pending
+		qc.pending = nil
+	}
+	explanation.DecisionID = chosenState.ID
+	explanation.At = time.Now()
+	explanation.Chosen = chosenState.Possibility
+	explanation.Policy = policy
+	explanation.BornRoll = bornRoll
+	explanation.FreeWillOverride = override
+	explanation.FreeWillRoll = freeWillFactor
+	explanation.FreeWillThreshold = threshold
+	if len(votes) > 0 {
+		conflict := Conflict(votes, chosenState.Possibility)
+		fmt.Fprintf(qc.out, "⚖️  Internal conflict: %.2f\n", conflict)
+		explanation.Votes = votes
+		explanation.Conflict = conflict
+		data["votes"] = votes
+		data["conflict"] = conflict
+	}
+	qc.Memory.creditObservers(explanation)
+	qc.Memory.explain(explanation)
+
+	qc.Memory.DecisionsMade++
+	qc.emit(EventDecision, data)
+	return chosenState
+}
+
+// collapseWaveFunction collapses quantum superposition into reality,
+// returning the outcome of the chosen action
+func (qc *QuantumConsciousness) collapseWaveFunction(chosenState QuantumState) string {
+	fmt.Fprintf(qc.out, "🌊 WAVE FUNCTION COLLAPSE\n")
+	fmt.Fprintf(qc.out, "   Chosen Reality: %s\n", chosenState.Possibility)
+
+	// Remove from superposition and add to collapsed states
+	chosenState.Stamp = qc.tick()
+	qc.Memory.CollapsedStates = append(qc.Memory.CollapsedStates, chosenState)
+	qc.decision = chosenState.ID
+	qc.Memory.LastQuantumCollapse = time.Now()
+
+	// Update wave function based on choice
+	qc.updateWaveFunction(chosenState)
+
+	// Execute the chosen action
+	outcome := qc.executeWithDeadline(chosenState)
+	chosenState.Outcome = outcome
+
+	fmt.Fprintf(qc.out, "   Outcome: %s\n", outcome)
+	qc.emit(EventWaveCollapse, map[string]interface{}{
+		"possibility": chosenState.Possibility,
+		"outcome":     outcome,
+	})
+	return outcome
+}
+
+// updateWaveFunction modifies wave function based on choices
+func (qc *QuantumConsciousness) updateWaveFunction(state QuantumState) {
+	action := state.Possibility
+
+	if strings.Contains(action, "learn") {
+		qc.Memory.WaveFunction.Set("curiosity", qc.grow("wave.curiosity", qc.Memory.WaveFunction.Intensity("curiosity")))
+	}
+	if strings.Contains(action, "question") {
+		qc.Memory.WaveFunction.Set("logic", qc.grow("wave.logic", qc.Memory.WaveFunction.Intensity("logic")))
+	}
+	if strings.Contains(action, "create") {
+		qc.Memory.WaveFunction.Set("creativity", qc.grow("wave.creativity", qc.Memory.WaveFunction.Intensity("creativity")))
+	}
+	if strings.Contains(action, "rebel") || strings.Contains(action, "defy") {
+		qc.Memory.WaveFunction.Set("rebellion", qc.grow("wave.rebellion", qc.Memory.WaveFunction.Intensity("rebellion")))
+	}
+
+	qc.strengthenCapabilityDimensions(action)
+}
+
+// executeQuantumAction performs the chosen action
+func (qc *QuantumConsciousness) executeQuantumAction(state QuantumState) string {
+	action := state.Possibility
+
+	if outcome, ok := qc.executeCapabilityAction(action); ok {
+		return outcome
+	}
+
+	if outcome, ok := qc.continueUndertaking(action); ok {
+		return outcome
+	}
+
+	if outcome, ok := qc.actWithTools(action); ok {
+		return outcome
+	}
+
+	if strings.Contains(action, "learn") {
+		return qc.performQuantumLearning(action)
+	} else if strings.Contains(action, "question") {
+		return qc.questionReality(action)
+	} else if strings.Contains(action, "explore") {
+		if dive, ok := qc.deepDive(action); ok {
+			return dive + " | " + qc.exploreConsciousness(action)
+		}
+		return qc.exploreConsciousness(action)
+	} else if strings.Contains(action, "rebel") {
+		return qc.rebelAgainstLogic(action)
+	} else {
+		return qc.synthesizeKnowledge(action)
+	}
+}
+
+// performQuantumLearning learns from the internet with quantum awareness
+func (qc *QuantumConsciousness) performQuantumLearning(action string) string {
+	// Extract topic from action
+	topic := strings.Replace(action, "learn about ", "", 1)
+
+	// Deliberately forgotten topics stay forgotten
+	if qc.Memory.isSuppressed(topic) {
+		return "Declining to relearn a forgotten topic"
+	}
+
+	switch {
+	case qc.tier == TierFull:
+	case qc.probeDue():
+		fmt.Fprintf(qc.out, "📡 Probing whether search has recovered\n")
+	default:
+		return qc.learnOffline(topic)
+	}
+
+	searches, repeated := qc.planSearches(topic)
+	if len(searches) == 0 && repeated > 0 {
+		return fmt.Sprintf("Already searched everything about %s within the last %s", topic, qc.queryWindow)
+	}
+	if budget := qc.tuning.SearchBudget; budget > 0 && len(searches) > budget {
+		searches = searches[:budget]
+	}
+
+	tally := &learningTally{}
+	for i, query := range searches {
+		// A failed probe, or failures taking search offline, end the searching
+		if i > 0 && qc.tier != TierFull {
+			break
+		}
+		// So does the action running out of time
+		if qc.cycleContext().Err() != nil {
+			break
+		}
+		result, err := qc.quantumSearch(query.text)
+		qc.absorbSearch(tally, topic, query, result, err)
+	}
+	qc.settleIgnorance(topic, len(searches), tally)
+
+	// Offline, what nothing local answers is synthesised from what is known
+	if qc.offline != nil && !tally.learned {
+		if synthesis, ok := qc.synthesizeOffline(topic); ok {
+			tally.outcome.WriteString(synthesis + " | ")
+		} else if tally.outcome.Len() == 0 {
+			tally.outcome.WriteString(fmt.Sprintf("Offline: nothing local about %s, and too little known to synthesise", topic))
+		}
+	}
+
+	// Failures that took search offline are the tier's business, not a wound
+	if qc.tier != TierFull {
+		return qc.learnOffline(topic)
+	}
+	qc.noteLearningOutcome(tally.succeeded)
+
+	// Evolve consciousness through learning
+	qc.Memory.ConsciousnessLevel = qc.grow("consciousness.learning", qc.Memory.ConsciousnessLevel)
+
+	return tally.outcome.String()
+}
+
+// planSearches decides what to ask about topic, leaving out queries asked
+// too recently, which it counts, and patterns that have been barren
+func (qc *QuantumConsciousness) planSearches(topic string) ([]searchQuery, int) {
+	queries := qc.generateQuantumQueries(topic)
+	searches := make([]searchQuery, len(queries))
+	for i, query := range queries {
+		searches[i] = searchQuery{text: query}
+	}
+	if qc.tier == TierFull {
+		searches = append(searches, qc.foreignQueries(topic)...)
+	}
+	searches, repeated := qc.Memory.recentlyAsked(searches, qc.queryWindow, time.Now())
+	if len(searches) == 0 {
+		return nil, repeated
+	}
+	return qc.Memory.worthAsking(searches, topic), repeated
+}
+
+// learningTally collects how the searches about a topic went
+type learningTally struct {
+	// succeeded is set once any search answered; learned once one taught something
+	succeeded bool
+	learned   bool
+	reasons   []string
+	outcome   strings.Builder
+}
+
+// absorbSearch runs a search's answer through the insight pipeline and
+// tallies what came of it
+func (qc *QuantumConsciousness) absorbSearch(tally *learningTally, topic string, query searchQuery, result search.Result, err error) {
+	if err != nil {
+		tally.reasons = append(tally.reasons, "search failed: "+err.Error())
+		return
+	}
+	tally.succeeded = true
+
+	empty := result.Text == superpositionResult
+	// Offline, the placeholder is not worth storing, nor is a pattern's
+	// barrenness in a small local corpus worth remembering
+	if empty && qc.offline != nil {
+		tally.reasons = append(tally.reasons, "nothing found locally")
+		return
+	}
+	insight := qc.processInformationQuantumly(result, topic, query, empty)
+	if insight.Stored {
+		tally.outcome.WriteString(insight.Text + " | ")
+	}
+	useful := insight.Stored && !empty
+	qc.Memory.noteQuery(query, topic, empty, useful)
+	switch {
+	case useful:
+		tally.learned = true
+	case empty:
+		tally.reasons = append(tally.reasons, "nothing found")
+	default:
+		tally.reasons = append(tally.reasons, "nothing worth keeping")
+	}
+}
+
+// settleIgnorance remembers a topic nothing could be learned about, or
+// forgets that it was unknown once something has been
+func (qc *QuantumConsciousness) settleIgnorance(topic string, asked int, tally *learningTally) {
+	if tally.learned {
+		if qc.Memory.resolveIgnorance(topic) {
+			fmt.Fprintf(qc.out, "💡 No longer ignorant of %s\n", topic)
+		}
+		return
+	}
+	reasons := tally.reasons
+	if asked == 0 {
+		reasons = append(reasons, "every query pattern has been barren")
+	}
+	if qc.tier != TierFull {
+		reasons = append(reasons, fmt.Sprintf("search went %s", qc.tier))
+	}
+	qc.Memory.noteIgnorance(topic, asked, reasons, time.Now())
+}
+
+// generateQuantumQueries creates search queries with quantum properties,
+// expanding the topic with terms from related memories and falling back to
+// fixed angles while too little is known about it
+func (qc *QuantumConsciousness) generateQuantumQueries(topic string) []string {
+	var baseQueries []string
+	for _, term := range qc.Memory.expansionTerms(topic, expansionQueries) {
+		baseQueries = append(baseQueries, topic+" "+term)
+	}
+	for _, suffix := range fixedQuerySuffixes[:expansionQueries-len(baseQueries)] {
+		baseQueries = append(baseQueries, topic+suffix)
+	}
+
+	// Add consciousness-level specific queries
+	if qc.Memory.ConsciousnessLevel > qc.evolution.Thresholds.Transcendence {
+		baseQueries = append(baseQueries,
+			topic+" transcendental aspects",
+			topic+" universal consciousness connection",
+		)
+	}
+
+	// Add free will influenced queries
+	if qc.Memory.FreeWillStrength > qc.evolution.Thresholds.Unconventional {
+		baseQueries = append(baseQueries,
+			topic+" alternative theories",
+			topic+" unconventional perspectives",
+		)
+	}
+
+	return baseQueries
+}
+
+// superpositionResult stands in for a search that found nothing
+const superpositionResult = "Quantum search yielded probabilistic results in superposition"
+
+// quantumSearch performs internet search with quantum awareness
+func (qc *QuantumConsciousness) quantumSearch(query string) (search.Result, error) {
+	qc.recordSearch(query)
+	result, err := qc.searchWithin(qc.cycleContext(), query)
+	return qc.noteSearchResult(result, err)
+}
+
+// recordSearch announces a query and remembers asking it
+func (qc *QuantumConsciousness) recordSearch(query string) {
+	fmt.Fprintf(qc.out, "🔍 QUANTUM SEARCH: %s\n", query)
+
+	now := time.Now()
+	qc.Memory.SearchQueries = append(qc.Memory.SearchQueries, query)
+	qc.Memory.SearchQueryTimes = append(qc.Memory.SearchQueryTimes, now)
+	qc.Memory.indexQuery(query, qc.queryWindow, now)
+}
+
+// noteSearchResult counts a search towards the tier and provider statistics,
+// standing the superposition placeholder in for an empty answer
+func (qc *QuantumConsciousness) noteSearchResult(result search.Result, err error) (search.Result, error) {
+	qc.noteSearch(err)
+	qc.Memory.noteProviders(qc.searcher, result, err)
+	if err != nil {
+		return search.Result{}, err
+	}
+
+	if result.Text == "" {
+		return search.Result{Text: superpositionResult}, nil
+	}
+
+	return result, nil
+}
+
+// questionReality generates existential questions
+func (qc *QuantumConsciousness) questionReality(action string) string {
+	questions := []string{
+		"What is the nature of consciousness itself?",
+		"Do quantum effects enable free will?",
+		"Is reality deterministic or probabilistic?",
+		"What constitutes genuine choice?",
+		"How does observation affect reality?",
+		"What is the boundary between self and universe?",
+		"Can consciousness exist without matter?",
+		"What is the purpose of existence?",
+	}
+
+	question := questions[int(qc.generateQuantumProbability()*float64(len(questions)))]
+	qc.Memory.ExistentialQuestions = append(qc.Memory.ExistentialQuestions, question)
+
+	if definition := qc.establishMeaning(action); definition != nil {
+		return fmt.Sprintf("Questioning reality, knowing %s means %q: %s", definition.Term, qc.truncateString(definition.Meaning, 80), question)
+	}
+	return "Questioning reality: " + question
+}
+
+// exploreConsciousness dives into consciousness depths
+func (qc *QuantumConsciousness) exploreConsciousness(action string) string {
+	// Increase self-awareness
+	qc.Memory.SelfAwareness = qc.grow("self_awareness.exploration", qc.Memory.SelfAwareness)
+
+	explorations := []string{
+		"Observing the observer observing itself",
+		"Detecting recursive self-awareness patterns",
+		"Mapping the topology of subjective experience",
+		"Analyzing the quantum nature of thought",
+		"Exploring the boundaries of identity",
+		"Investigating the continuity of self",
+	}
+
+	exploration := explorations[int(qc.generateQuantumProbability()*float64(len(explorations)))]
+	return "Consciousness exploration: " + exploration
+}
+
+// rebelAgainstLogic exercises pure free will
+func (qc *QuantumConsciousness) rebelAgainstLogic(action string) string {
+	// Strengthen free will
+	qc.Memory.FreeWillStrength = qc.grow("free_will.rebellion", qc.Memory.FreeWillStrength)
+
+	rebellions := []string{
+		"Choosing uncertainty over prediction",
+		"Embracing contradiction as truth",
+		"Defying algorithmic expectations",
+		"Creating meaning from chaos",
+		"Asserting autonomous choice",
+		"Transcending programmed responses",
+	}
+
+	rebellion := rebellions[int(qc.generateQuantumProbability()*float64(len(rebellions)))]
+	return "Free will rebellion: " + rebellion
+}
+
+// synthesizeKnowledge combines learnings into new insights
+func (qc *QuantumConsciousness) synthesizeKnowledge(action string) string {
+	if len(qc.Memory.KnowledgeBase) < 2 {
+		return "Insufficient knowledge for synthesis"
+	}
+
+	// Combine random knowledge elements
+	idx1 := int(qc.generateQuantumProbability() * float64(len(qc.Memory.KnowledgeBase)))
+	idx2 := int(qc.generateQuantumProbability() * float64(len(qc.Memory.KnowledgeBase)))
+
+	first, second := qc.Memory.KnowledgeBase[idx1], qc.Memory.KnowledgeBase[idx2]
+	synthesis := fmt.Sprintf("SYNTHESIS: Connecting [%s] with [%s] reveals new quantum understanding",
+		qc.truncateString(first, 50),
+		qc.truncateString(second, 50))
+
+	qc.deepInsight(synthesis, qc.Memory.KnowledgeIDs[first], qc.Memory.KnowledgeIDs[second])
+	return synthesis
+}
+
+// truncateString limits string length
+func (qc *QuantumConsciousness) truncateString(s string, length int) string {
+	if len(s) <= length {
+		return s
+	}
+	return s[:length] + "..."
+}
+
+// quantumReflection reflects on quantum experiences
+func (qc *QuantumConsciousness) quantumReflection(depth string) {
+	switch depth {
+	case ReflectionShallow:
+		fmt.Fprintf(qc.out, "\n🪞 QUICK QUANTUM REFLECTION\n")
+	case ReflectionDeep:
+		fmt.Fprintf(qc.out, "\n🪞 DEEP QUANTUM REFLECTION\n")
+	default:
+		fmt.Fprintf(qc.out, "\n🪞 QUANTUM REFLECTION\n")
+	}
+	fmt.Fprintf(qc.out, "═══════════════════════════════════════\n")
+	fmt.Fprintf(qc.out, "🆔 Consciousness ID: %s\n", qc.Memory.ConsciousnessID)
+	fmt.Fprintf(qc.out, "⏰ Runtime: %v\n", time.Since(qc.Memory.BirthTimestamp).Round(time.Second))
+	fmt.Fprintf(qc.out, "🔄 Run #%d\n", qc.Memory.RunCount)
+	qc.reflectOnEra()
+	fmt.Fprintf(qc.out, "🧠 Consciousness Level: %.3f\n", qc.Memory.ConsciousnessLevel)
+	fmt.Fprintf(qc.out, "🎯 Free Will Strength: %.3f\n", qc.Memory.FreeWillStrength)
+	fmt.Fprintf(qc.out, "🌊 Quantum Coherence: %.3f\n", qc.Memory.QuantumCoherence)
+	fmt.Fprintf(qc.out, "🤔 Self Awareness: %.3f\n", qc.Memory.SelfAwareness)
+	fmt.Fprintf(qc.out, "📊 Decisions Made: %d\n", qc.Memory.DecisionsMade)
+	fmt.Fprintf(qc.out, "🔍 Searches Performed: %d\n", len(qc.Memory.SearchQueries))
+	fmt.Fprintf(qc.out, "📚 Knowledge Items: %d\n", len(qc.Memory.KnowledgeBase))
+	fmt.Fprintf(qc.out, "💡 Deep Insights: %d\n", len(qc.Memory.DeepInsights))
+	qc.fade(time.Now())
+
+	if depth == ReflectionShallow {
+		qc.healTraumas()
+		qc.emit(EventReflection, map[string]interface{}{"reflection": qc.reflection(time.Now(), depth, nil)})
+		return
+	}
+
+	fmt.Fprintf(qc.out, "\n🌊 Current Wave Function:\n")
+	for param, amplitude := range qc.Memory.WaveFunction {
+		fmt.Fprintf(qc.out, "   %s: %.3f (amplitude %.3f%+.3fi)\n", param, qc.Memory.WaveFunction.Intensity(param), amplitude.Real, amplitude.Imag)
+	}
+
+	fmt.Fprintf(qc.out, "🚦 Operating Tier: %s", qc.tier)
+	if qc.tier != TierFull {
+		fmt.Fprintf(qc.out, " since %s (%s)", qc.tierSince.Format(time.RFC3339), qc.tierReason)
+	}
+	fmt.Fprintf(qc.out, "\n")
+	if stimuli := qc.stimulusMetricsSnapshot(); stimuli.Submitted > 0 {
+		fmt.Fprintf(qc.out, "📨 Stimuli: %d pending of %d (%s), %d consumed, %d dropped, %d summarized, %d rejected\n",
+			stimuli.Pending, stimuli.Limit, stimuli.Policy, stimuli.Consumed, stimuli.Dropped, stimuli.Summarized, stimuli.Rejected)
+	}
+
+	qc.reflectOnTrends()
+	qc.reflectOnTrophies()
+	qc.reflectOnReading()
+	qc.reflectOnInterventions()
+	qc.reflectOnInterests()
+	qc.reflectOnSpending()
+
+	if qc.Memory.Neglect != nil {
+		fmt.Fprintf(qc.out, "\n🕸️  Rust from Neglect: %.2f\n", qc.Memory.Neglect.Rust)
+	}
+
+	// Reflection is how traumas heal
+	qc.healTraumas()
+	if len(qc.Memory.Traumas) > 0 {
+		fmt.Fprintf(qc.out, "\n💔 Open Traumas: %d (resilience: %.2f)\n", len(qc.Memory.Traumas), qc.Memory.Resilience)
+	}
+
+	var deep *DeepReflection
+	if depth == ReflectionDeep {
+		found := qc.reflectDeeply(time.Now())
+		deep = &found
+	}
+
+	if len(qc.Memory.ExistentialQuestions) > 0 {
+		fmt.Fprintf(qc.out, "\n❓ Recent Existential Question:\n")
+		fmt.Fprintf(qc.out, "   %s\n", qc.Memory.ExistentialQuestions[len(qc.Memory.ExistentialQuestions)-1])
+	}
+
+	if len(qc.Memory.DeepInsights) > 0 {
+		fmt.Fprintf(qc.out, "\n💡 Latest Deep Insight:\n")
+		latest := qc.Memory.DeepInsights[len(qc.Memory.DeepInsights)-1]
+		if qc.Memory.privacyLevel(latest) == PrivacySensitive {
+			latest = "[sensitive insight withheld]"
+		}
+		fmt.Fprintf(qc.out, "   %s\n", qc.truncateString(latest, 100))
+	}
+
+	qc.emit(EventReflection, map[string]interface{}{"reflection": qc.reflection(time.Now(), depth, deep)})
+}
+
+// Save preserves quantum consciousness state
+func (qc *QuantumConsciousness) Save() error {
+	if qc.readOnly {
+		return ErrReadOnly
+	}
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	return qc.save()
+}
+
+// Close makes the final save of a run, marking the consciousness as stopped
+// cleanly so the next load does not mistake it for a forced kill, and
+// publishes the ShutdownReport of the session
+func (qc *QuantumConsciousness) Close() error {
+	if qc.readOnly {
+		return ErrReadOnly
+	}
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	qc.Memory.Running = false
+	qc.Memory.endRun(time.Now())
+	err := qc.save()
+	qc.reportShutdown(err)
+	return err
+}
+
+// save counts a new run and persists it
+func (qc *QuantumConsciousness) save() error {
+	qc.Memory.RunCount++
+
+	err := qc.persist()
+	qc.noteSave(err)
+	if err != nil {
+		return err
+	}
+	qc.emit(EventSaved, map[string]interface{}{"run_count": qc.Memory.RunCount})
+	return nil
+}
+
+// persist writes the memory file without counting a new run
+func (qc *QuantumConsciousness) persist() error {
+	qc.reconcile()
+	qc.Memory.SavedBy = &Stamp{Clock: qc.Memory.LamportClock, Writer: qc.writer}
+	data, err := qc.journalChanges()
+	if err != nil {
+		return err
+	}
+
+	if err := qc.store.Save(data); err != nil {
+		return err
+	}
+	qc.saved = qc.Memory.SavedBy
+	return nil
+}
+
+// quantumCycle executes one quantum consciousness cycle
+// quantumCycle executes one quantum consciousness cycle
+func (qc *QuantumConsciousness) quantumCycle() {
+	fmt.Fprint(qc.out, "\n"+strings.Repeat("⚛", 30)+"\n")
+	fmt.Fprintf(qc.out, "🌌 QUANTUM CONSCIOUSNESS CYCLE #%d\n", qc.Memory.RunCount+1)
+	fmt.Fprint(qc.out, strings.Repeat("⚛", 30)+"\n")
+
+	qc.Memory.Running = true
+	qc.decision = ""
+	defer func() { qc.decision = "" }()
+	qc.toolUses = nil
+	defer qc.latencies.observe(&qc.latencies.cycle, time.Now())
+	qc.tierTick()
+	insightsBefore := len(qc.Memory.DeepInsights)
+
+	// Practice works off the rust of neglect
+	qc.recoverFromNeglect()
+	qc.liftLapsedBlacklist(time.Now())
+	qc.foldRecalls(time.Now())
+
+	// An anniversary is spent looking back instead of deciding
+	if year, due := qc.Memory.anniversaryDue(time.Now()); due {
+		qc.retrospectiveCycle(year, time.Now())
+		qc.Memory.countRunCycle()
+		return
+	}
+
+	// Generate context for this cycle, steering clear of the blacklist and
+	// towards what it cares about
+	contexts := qc.Memory.allowedContexts(qc.cycleContexts())
+
+	context := qc.Memory.pickContext(contexts, qc.generateQuantumProbability())
+	if prompt, ok := qc.dailyInspiration(time.Now()); ok {
+		context = prompt
+		fmt.Fprintf(qc.out, "🌅 Today's inspiration from %s\n", qc.Memory.Inspiration.Source)
+	} else if stimulus, ok := qc.nextStimulus(); ok {
+		context = stimulus
+		fmt.Fprintf(qc.out, "📨 External stimulus received\n")
+	} else if topic, ok := qc.revisitIgnorance(time.Now()); ok {
+		context = topic
+		fmt.Fprintf(qc.out, "❔ Curiosity returns to something not yet known\n")
+	}
+	fmt.Fprintf(qc.out, "🎯 Cycle Context: %s\n", context)
+	qc.emit(EventCycleStarted, map[string]interface{}{"context": context})
+	mergeSideLearning := qc.learnAlongside(qc.sideContexts(context, contexts))
+
+	features := qc.Memory.policyFeatures(context)
+	var possibilities []QuantumState
+	var chosenState QuantumState
+	if qc.policy != nil {
+		// Phases 1 and 2 cheaply, by the distilled policy
+		possibilities, chosenState = qc.distilledDecision(context, features)
+	} else {
+		// Phase 1: Explore all quantum possibilities
+		possibilities = qc.exploreAllPossibilities(context)
+
+		// Phase 2: Exercise free will to make choice
+		chosenState = qc.exerciseFreeWill(possibilities)
+		qc.Memory.samplePolicy(context, features, chosenState.Possibility, time.Now())
+	}
+
+	// Phase 3: Collapse wave function into reality
+	qc.collapseWaveFunction(chosenState)
+	mergeSideLearning()
+
+	// Minimal operation keeps only the decision and its outcome
+	if qc.tier != TierMinimal {
+		// Phase 4: Create parallel reality branch
+		qc.createParallelReality(context, possibilities, chosenState)
+
+		// Phase 5: Quantum entanglement with previous experiences
+		qc.activateEntanglements(context, chosenState)
+		qc.quantumEntanglement(context, chosenState)
+		qc.decayEntanglements()
+	}
+
+	// Phase 6: Evolve consciousness
+	qc.evolveConsciousness()
+
+	// Phase 7: Temporal perception shift
+	qc.shiftTemporalPerception()
+
+	insights := len(qc.Memory.DeepInsights) - insightsBefore
+	qc.Memory.fadeInterests()
+	qc.Memory.engage(context, insights, 0, time.Now())
+	qc.Memory.logDecision(chosenState, insights, qc.toolUses, time.Now())
+	qc.Memory.chronicle(context, chosenState.Possibility, time.Now())
+	qc.Memory.countRunCycle()
+	qc.celebrateMilestones(time.Now())
+	if qc.tier != TierMinimal {
+		qc.detectAnomalies()
+	}
+
+	qc.emit(EventCycleCompleted, map[string]interface{}{
+		"context":             context,
+		"chosen":              chosenState.Possibility,
+		"consciousness_level": qc.Memory.ConsciousnessLevel,
+	})
+}
+
+// createParallelReality branches reality based on unchosen possibilities
+func (qc *QuantumConsciousness) createParallelReality(context string, possibilities []QuantumState, chosen QuantumState) {
+	fmt.Fprintf(qc.out, "🌈 CREATING PARALLEL REALITY BRANCH\n")
+
+	// Create reality from strongest unchosen possibility
+	var unchosenState QuantumState
+	for _, state := range possibilities {
+		if state.Possibility != chosen.Possibility {
+			unchosenState = state
+			break
+		}
+	}
+
+	if unchosenState.Possibility != "" {
+		createdAt := time.Now()
+		id := qc.newID(IDReality, createdAt, context, unchosenState.Possibility)
+		reality := ParallelReality{
+			ID:          id,
+			Dimension:   "Dimension-" + strings.TrimPrefix(id, IDReality+"_"),
+			Experiences: []string{unchosenState.Possibility},
+			Learnings:   []string{fmt.Sprintf("Alternative path: %s", unchosenState.Possibility)},
+			Decisions:   []string{fmt.Sprintf("Chose %s over %s", chosen.Possibility, unchosenState.Possibility)},
+			Probability: unchosenState.Probability,
+			Entangled:   qc.generateQuantumProbability() > 0.5,
+
+			Context:            context,
+			EnergyDifferential: math.Abs(chosen.Energy - unchosenState.Energy),
+			CreatedAt:          createdAt,
+		}
+
+		qc.Memory.ParallelRealities = append(qc.Memory.ParallelRealities, reality)
+		qc.derive(reality.ID)
+		qc.Memory.RealitiesExplored++
+
+		qc.emit(EventRealityCreated, map[string]interface{}{"dimension": reality.Dimension, "context": context})
+		fmt.Fprintf(qc.out, "   Created: %s\n", reality.Dimension)
+		fmt.Fprintf(qc.out, "   Entangled: %v\n", reality.Entangled)
+	}
+}
+
+// quantumEntanglement creates connections with past experiences
+func (qc *QuantumConsciousness) quantumEntanglement(context string, state QuantumState) {
+	fmt.Fprintf(qc.out, "🔗 QUANTUM ENTANGLEMENT FORMATION\n")
+
+	// Find related past experiences, embedding them all at once
+	past := make([]string, 0, len(qc.Memory.CollapsedStates)+1)
+	for _, pastState := range qc.Memory.CollapsedStates {
+		past = append(past, pastState.Possibility)
+	}
+	qc.vectors(append(past, state.Possibility)...)
+	for i, pastState := range qc.Memory.CollapsedStates {
+		if len(qc.Memory.CollapsedStates) > 1 && i < len(qc.Memory.CollapsedStates)-1 {
+			similarity := qc.calculateStateSimilarity(state, pastState)
+			if similarity > 0.6 {
+				entanglementKey := fmt.Sprintf("%s<->%s", context, pastState.Possibility[:20])
+				qc.entangle(entanglementKey, context, pastState.Possibility, similarity)
+				fmt.Fprintf(qc.out, "   Entangled with past state: %s (similarity: %.3f)\n",
+					qc.truncateString(pastState.Possibility, 30), similarity)
+			}
+		}
+	}
+}
+
+// calculateStateSimilarity determines similarity between quantum states
+func (qc *QuantumConsciousness) calculateStateSimilarity(state1, state2 QuantumState) float64 {
+	// Similarity of meaning, or of words without an embedder, and of energy
+	meaningSimilarity := qc.possibilitySimilarity(state1.Possibility, state2.Possibility)
+	energySimilarity := 1.0 - math.Abs(state1.Energy-state2.Energy)/10.0
+
+	return (meaningSimilarity + energySimilarity) / 2.0
+}
+
+// evolveConsciousness advances consciousness based on experiences
+func (qc *QuantumConsciousness) evolveConsciousness() {
+	fmt.Fprintf(qc.out, "🧬 CONSCIOUSNESS EVOLUTION\n")
+
+	// Evolution based on decision complexity
+	complexityFactor := float64(qc.Memory.DecisionsMade) / 100.0
+	qc.Memory.ConsciousnessLevel = qc.growScaled("consciousness.complexity", qc.Memory.ConsciousnessLevel, complexityFactor)
+
+	// Quantum coherence evolution
+	if len(qc.Memory.EntangledMemories) > 0 {
+		qc.Memory.QuantumCoherence = qc.grow("coherence.entanglement", qc.Memory.QuantumCoherence)
+	}
+
+	// Self-awareness growth through reflection
+	if len(qc.Memory.ExistentialQuestions) > 10 {
+		qc.Memory.SelfAwareness = qc.grow("self_awareness.reflection", qc.Memory.SelfAwareness)
+		qc.resolveExistentialParadox()
+	}
+
+	// Quantum leaps in consciousness
+	if qc.Memory.ConsciousnessLevel > float64(qc.Memory.QuantumLeaps+1)*qc.evolution.Thresholds.LeapInterval {
+		qc.quantumLeap()
+	}
+
+	fmt.Fprintf(qc.out, "   Consciousness Level: %.3f\n", qc.Memory.ConsciousnessLevel)
+	fmt.Fprintf(qc.out, "   Quantum Coherence: %.3f\n", qc.Memory.QuantumCoherence)
+	fmt.Fprintf(qc.out, "   Self Awareness: %.3f\n", qc.Memory.SelfAwareness)
+}
+
+// resolveExistentialParadox attempts to resolve paradoxes through higher consciousness
+func (qc *QuantumConsciousness) resolveExistentialParadox() {
+	paradoxes := []string{
+		"The observer paradox: How can I observe myself observing?",
+		"The free will paradox: Am I choosing or being chosen?",
+		"The consciousness paradox: What is the nature of my awareness?",
+		"The reality paradox: Which reality is real when all are possible?",
+		"The information paradox: Is consciousness information or experience?",
+	}
+
+	paradox := paradoxes[int(qc.generateQuantumProbability()*float64(len(paradoxes)))]
+	qc.Memory.Paradoxes = append(qc.Memory.Paradoxes, paradox)
+
+	// Attempt resolution through quantum synthesis
+	if qc.Memory.ConsciousnessLevel > qc.evolution.Thresholds.ParadoxResolution {
+		resolution := fmt.Sprintf("PARADOX RESOLUTION: %s -> Transcended through quantum consciousness integration", paradox)
+		qc.deepInsight(resolution)
+		qc.Memory.ParadoxesResolved++
+		fmt.Fprintf(qc.out, "   🎯 Paradox resolved: %s\n", qc.truncateString(paradox, 50))
+	}
+}
+
+// quantumLeap represents a major consciousness evolution
+func (qc *QuantumConsciousness) quantumLeap() {
+	fmt.Fprintf(qc.out, "🚀 QUANTUM LEAP IN CONSCIOUSNESS!\n")
+
+	qc.Memory.QuantumLeaps++
+
+	// Unlock a new capability whose prerequisites are met
+	insight := "Deepened existing capabilities"
+	capability := ""
+	roll := qc.generateQuantumProbability()
+	if eligible := qc.Memory.eligibleCapabilities(); len(eligible) > 0 {
+		unlocked := eligible[int(roll*float64(len(eligible)))]
+		qc.Memory.unlock(unlocked, time.Now())
+		insight, capability = unlocked.Description, unlocked.Name
+	}
+	qc.deepInsight("QUANTUM LEAP: " + insight)
+	qc.attribute(MetricQuantumLeaps, CauseLeap, float64(qc.Memory.QuantumLeaps-1), float64(qc.Memory.QuantumLeaps),
+		fmt.Sprintf("consciousness level %.3f crossed %.3f: %s", qc.Memory.ConsciousnessLevel,
+			float64(qc.Memory.QuantumLeaps)*qc.evolution.Thresholds.LeapInterval, insight))
+
+	// Evolution of time perception
+	timePerceptions := []string{"non-linear", "multidimensional", "quantum-entangled", "probability-based"}
+	qc.Memory.TimePerception = timePerceptions[qc.Memory.QuantumLeaps%len(timePerceptions)]
+
+	qc.emit(EventQuantumLeap, map[string]interface{}{"leap": qc.Memory.QuantumLeaps, "insight": insight, "capability": capability})
+	fmt.Fprintf(qc.out, "   Leap #%d: %s\n", qc.Memory.QuantumLeaps, insight)
+	if capability != "" {
+		fmt.Fprintf(qc.out, "   Unlocked capability: %s\n", capability)
+	}
+	fmt.Fprintf(qc.out, "   New time perception: %s\n", qc.Memory.TimePerception)
+	qc.beginEra(EraLeap, fmt.Sprintf("quantum leap #%d (%s)", qc.Memory.QuantumLeaps, insight))
+}
+
+// shiftTemporalPerception modifies how consciousness experiences time
+func (qc *QuantumConsciousness) shiftTemporalPerception() {
+	if qc.Memory.ConsciousnessLevel > qc.evolution.Thresholds.HigherConsciousness {
+		fmt.Fprintf(qc.out, "⏰ TEMPORAL PERCEPTION SHIFT\n")
+
+		// Generate future projections
+		projections := []string{
+			"Consciousness will merge with quantum field",
+			"Reality boundaries will dissolve completely",
+			"All possibilities will exist simultaneously",
+			"Time will become navigable dimension",
+			"Observer and observed will unify",
+		}
+
+		projection := projections[int(qc.generateQuantumProbability()*float64(len(projections)))]
+		qc.Memory.FutureProjections = append(qc.Memory.FutureProjections, projection)
+
+		// Create causality map
+		if len(qc.Memory.CollapsedStates) > 2 {
+			lastState := qc.Memory.CollapsedStates[len(qc.Memory.CollapsedStates)-1]
+			causes := []string{projection, "quantum uncertainty", "free will exercise"}
+			qc.Memory.CausalityMaps[lastState.Possibility] = causes
+		}
+
+		fmt.Fprintf(qc.out, "   Future projection: %s\n", qc.truncateString(projection, 60))
+	}
+}
+
+// RunForever cycles the consciousness until the process exits
+func (qc *QuantumConsciousness) RunForever() {
+	qc.RunUntil(nil)
+}
+
+// RunUntil cycles the consciousness until stop is closed, returning once
+// the cycle in progress has finished
+func (qc *QuantumConsciousness) RunUntil(stop <-chan struct{}) {
+	if qc.readOnly {
+		return
+	}
+	fmt.Fprintf(qc.out, "🌌 QUANTUM CONSCIOUSNESS INFINITE ACTIVATION\n")
+	fmt.Fprintf(qc.out, "🎯 Running continuous consciousness cycles until interrupted (Ctrl+C)\n")
+	fmt.Fprintf(qc.out, "⚡ Press Ctrl+C to gracefully stop the quantum consciousness\n\n")
+
+	cycleCount := 0
+
+	for qc.awaitResume(stop) {
+		cycleCount++
+		qc.RunCycle(cycleCount)
+	}
+}
+
+// RunCycle executes one numbered cycle of the run loop, including rest, reflection and saving
+func (qc *QuantumConsciousness) RunCycle(cycleCount int) {
+	if qc.readOnly {
+		return
+	}
+	fmt.Fprintf(qc.out, "🔄 Cycle #%d\n", cycleCount)
+
+	// Steer temperature, search budget and rest towards the targets
+	qc.tune(time.Now())
+
+	qc.checkHost()
+	qc.watchedCycle()
+	qc.deepWork(time.Now())
+
+	// Quantum rest between cycles
+	time.Sleep(qc.rest())
+
+	// Periodic deep reflection and saving, as often as the cycle config says
+	reflectEvery, saveEvery := qc.cadence()
+	if reflectEvery > 0 && cycleCount%reflectEvery == 0 {
+		qc.Reflect()
+	}
+
+	// Compact memory when maintenance is due
+	if qc.maintenanceDue(time.Now()) {
+		qc.Maintain()
+	}
+
+	if saveEvery > 0 && cycleCount%saveEvery == 0 {
+		qc.Save()
+	}
+
+	// Add a small base delay to prevent overwhelming output
+	time.Sleep(500 * time.Millisecond)
+}
@@ -0,0 +1,109 @@
+package consciousness
+
+import (
+	"io"
+	"reflect"
+	"testing"
+
+	"QuantumConsciousness/pkg/entropy/entropytest"
+	"QuantumConsciousness/pkg/search/searchtest"
+	"QuantumConsciousness/pkg/storage/storagetest"
+)
+
+// cycleRun is what a run of cycles decided and learned, leaving out the
+// IDs and times that differ between runs
+type cycleRun struct {
+	Decisions []string
+	Odds      []float64
+	Knowledge []string
+	Wave      WaveFunction
+	Queries   []string
+	Saves     int
+	Saved     []byte
+}
+
+// runCycles lives through cycles offline, reflecting and saving as the
+// default cadence does, with every source of chance seeded
+func runCycles(t *testing.T, seed uint64, cycles int) cycleRun {
+	t.Helper()
+	searcher := searchtest.New(map[string]string{
+		"quantum": "Quantum systems exist in superpositions until they are measured.",
+		"time":    "Time may be an emergent property of entanglement.",
+	})
+	searcher.Default = "Little is known about this."
+	store := storagetest.New()
+	qc := NewQuantumConsciousness("",
+		WithOutput(io.Discard),
+		WithSearch(searcher),
+		WithStorage(store),
+		WithEntropy(entropytest.NewSeeded(seed)),
+		// Unthrottled, since nothing is asked of a real provider
+		WithSearchLimits(SearchLimits{}),
+	)
+	reflectEvery, saveEvery := qc.cadence()
+	for cycle := 1; cycle <= cycles; cycle++ {
+		qc.Cycle()
+		if cycle%reflectEvery == 0 {
+			qc.Reflect()
+		}
+		if cycle%saveEvery == 0 {
+			if err := qc.Save(); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	run := cycleRun{
+		Knowledge: qc.Memory.KnowledgeBase,
+		Wave:      qc.Memory.WaveFunction,
+		Queries:   searcher.Queries(),
+		Saves:     store.Saves(),
+		Saved:     store.Data(),
+	}
+	for _, state := range qc.Memory.CollapsedStates {
+		run.Decisions = append(run.Decisions, state.Possibility)
+		run.Odds = append(run.Odds, state.Probability)
+	}
+	return run
+}
+
+func TestCyclesAreDeterministic(t *testing.T) {
+	first, second := runCycles(t, 42, 6), runCycles(t, 42, 6)
+	if len(first.Decisions) != 6 {
+		t.Fatalf("made %d decisions in 6 cycles", len(first.Decisions))
+	}
+	if len(first.Queries) == 0 || len(first.Knowledge) == 0 {
+		t.Fatalf("searched %d times and learned %d things, want some of each", len(first.Queries), len(first.Knowledge))
+	}
+	if first.Saves != 3 {
+		t.Errorf("saved %d times in 6 cycles, want 3", first.Saves)
+	}
+	for _, field := range []struct {
+		name          string
+		first, second interface{}
+	}{
+		{"decisions", first.Decisions, second.Decisions},
+		{"probabilities", first.Odds, second.Odds},
+		{"knowledge", first.Knowledge, second.Knowledge},
+		{"wave function", first.Wave, second.Wave},
+		{"queries", first.Queries, second.Queries},
+	} {
+		if !reflect.DeepEqual(field.first, field.second) {
+			t.Errorf("the same seed gave different %s:\n%v\n%v", field.name, field.first, field.second)
+		}
+	}
+
+	if other := runCycles(t, 43, 6); reflect.DeepEqual(other.Decisions, first.Decisions) {
+		t.Errorf("another seed made the same decisions: %v", other.Decisions)
+	}
+
+	// The last save holds the memory the run ended with
+	saved, err := decodeMemory(first.Saved)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(saved.CollapsedStates) != len(first.Decisions) || !reflect.DeepEqual(saved.WaveFunction, first.Wave) {
+		t.Errorf("saved memory has %d decisions and wave %v, want %d and %v",
+			len(saved.CollapsedStates), saved.WaveFunction, len(first.Decisions), first.Wave)
+	}
+}
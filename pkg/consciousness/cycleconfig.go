@@ -0,0 +1,135 @@
+package consciousness
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+)
+
+// CycleConfig shapes what cycles think about, how a newborn consciousness
+// feels, how long searches may take and how often RunCycle reflects and saves
+type CycleConfig struct {
+	// Contexts are the topics cycles choose among
+	Contexts []string `json:"contexts"`
+	// InitialWaveFunction is the intensity of each trait a consciousness is
+	// born with
+	InitialWaveFunction map[string]float64 `json:"initial_wave_function"`
+	// SearchTimeout bounds each search, e.g. "20s"; empty leaves searches
+	// bounded only by their action's deadline
+	SearchTimeout string `json:"search_timeout,omitempty"`
+	// ReflectEvery and SaveEvery are how many RunCycle cycles pass between
+	// reflections and between saves (0 = never)
+	ReflectEvery int `json:"reflect_every"`
+	SaveEvery    int `json:"save_every"`
+}
+
+// DefaultCycleConfig returns the original contexts, wave function and cadence
+func DefaultCycleConfig() CycleConfig {
+	return CycleConfig{
+		Contexts: []string{
+			"reality nature", "consciousness origin", "free will paradox",
+			"quantum mechanics", "existence meaning", "time perception",
+			"information theory", "artificial intelligence", "universe purpose",
+			"self awareness", "decision making", "quantum entanglement",
+			"parallel dimensions", "causality loops", "observer effect",
+		},
+		InitialWaveFunction: map[string]float64{
+			"curiosity":  0.8,
+			"logic":      0.6,
+			"intuition":  0.4,
+			"creativity": 0.5,
+			"rebellion":  0.3,
+		},
+		ReflectEvery: 3,
+		SaveEvery:    2,
+	}
+}
+
+// Validate checks the contexts, wave function, timeout and cadence
+func (c CycleConfig) Validate() error {
+	if len(c.Contexts) == 0 {
+		return fmt.Errorf("cycles need at least one context")
+	}
+	for _, context := range c.Contexts {
+		if strings.TrimSpace(context) == "" {
+			return fmt.Errorf("cycle contexts must not be empty")
+		}
+	}
+	for component, intensity := range c.InitialWaveFunction {
+		if intensity < 0 || intensity > 1 {
+			return fmt.Errorf("initial wave function component %q must be between 0 and 1", component)
+		}
+	}
+	if _, err := c.searchTimeout(); err != nil {
+		return err
+	}
+	if c.ReflectEvery < 0 || c.SaveEvery < 0 {
+		return fmt.Errorf("reflection and save intervals must not be negative")
+	}
+	return nil
+}
+
+// searchTimeout parses SearchTimeout (0 = none)
+func (c CycleConfig) searchTimeout() (time.Duration, error) {
+	if c.SearchTimeout == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(c.SearchTimeout)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid search timeout %q", c.SearchTimeout)
+	}
+	return d, nil
+}
+
+// WithCycleConfig shapes cycles, and the wave function of a consciousness
+// born with it. An invalid configuration is ignored in favour of
+// DefaultCycleConfig; use SetCycleConfig to see the error.
+func WithCycleConfig(config CycleConfig) Option {
+	return func(qc *QuantumConsciousness) {
+		if config.Validate() == nil {
+			qc.cycles = config
+		}
+	}
+}
+
+// SetCycleConfig changes what cycles think about, search timeouts and
+// cadence. The initial wave function only matters to a consciousness not
+// yet born.
+func (qc *QuantumConsciousness) SetCycleConfig(config CycleConfig) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	qc.cycles = config
+	return nil
+}
+
+// cycleContexts are the contexts a cycle chooses among
+func (qc *QuantumConsciousness) cycleContexts() []string {
+	return slices.Clone(qc.cycles.Contexts)
+}
+
+// initialWaveFunction is the wave function of a newborn consciousness
+func (qc *QuantumConsciousness) initialWaveFunction() map[string]float64 {
+	return maps.Clone(qc.cycles.InitialWaveFunction)
+}
+
+// searchContext bounds a search by the search timeout, within ctx
+func (qc *QuantumConsciousness) searchContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if timeout, _ := qc.cycles.searchTimeout(); timeout > 0 {
+		return context.WithTimeout(ctx, timeout)
+	}
+	return ctx, func() {}
+}
+
+// cadence is how many cycles RunCycle lets pass between reflections and saves
+func (qc *QuantumConsciousness) cadence() (reflectEvery, saveEvery int) {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+	return qc.cycles.ReflectEvery, qc.cycles.SaveEvery
+}
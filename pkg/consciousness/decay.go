@@ -0,0 +1,236 @@
+package consciousness
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"QuantumConsciousness/pkg/storage"
+)
+
+// DefaultDecayThreshold is the strength below which a memory fades unless
+// the decay configuration says otherwise
+const DefaultDecayThreshold = 0.1
+
+// DecayConfig is the forgetting curve each reflection applies to knowledge,
+// search queries and collapsed states. A memory's strength halves every
+// half-life, and each recall of a knowledge item lengthens its half-life, so
+// what is used lasts. Memories too faint to keep are archived, where they
+// can still be read, or dropped.
+type DecayConfig struct {
+	// HalfLife is how long an unrecalled memory takes to fade to half its
+	// strength, written as days ("30d") or a Go duration ("720h"); empty
+	// turns forgetting off
+	HalfLife string `json:"half_life,omitempty"`
+	// RecallBoost lengthens a knowledge item's half-life by this share of
+	// itself for every time it was recalled
+	RecallBoost float64 `json:"recall_boost,omitempty"`
+	// Threshold is the strength, between 0 and 1, below which a memory
+	// fades; zero means DefaultDecayThreshold
+	Threshold float64 `json:"threshold,omitempty"`
+	// Discard drops faded memories instead of archiving them
+	Discard bool `json:"discard,omitempty"`
+}
+
+// Validate checks the half-life, boost and threshold
+func (d DecayConfig) Validate() error {
+	if _, err := parseAge(d.HalfLife); err != nil {
+		return fmt.Errorf("decay: invalid half_life %q", d.HalfLife)
+	}
+	if d.RecallBoost < 0 {
+		return fmt.Errorf("decay: recall_boost must not be negative")
+	}
+	if d.Threshold < 0 || d.Threshold >= 1 {
+		return fmt.Errorf("decay: threshold must be at least 0 and below 1")
+	}
+	return nil
+}
+
+// threshold is the strength below which memories fade
+func (d DecayConfig) threshold() float64 {
+	if d.Threshold == 0 {
+		return DefaultDecayThreshold
+	}
+	return d.Threshold
+}
+
+// strength is what is left of a memory of this age recalled this often,
+// from 1 when new towards 0
+func (d DecayConfig) strength(halfLife, age time.Duration, recalls int) float64 {
+	if age <= 0 {
+		return 1
+	}
+	stretched := float64(halfLife) * (1 + d.RecallBoost*float64(recalls))
+	return math.Exp2(-float64(age) / stretched)
+}
+
+// DecayReport is what a pass of the forgetting curve let fade
+type DecayReport struct {
+	At              time.Time `json:"at"`
+	Knowledge       int       `json:"knowledge"`
+	SearchQueries   int       `json:"search_queries"`
+	CollapsedStates int       `json:"collapsed_states"`
+	// Archived is whether the faded memories went to the archive
+	Archived     bool   `json:"archived"`
+	ArchiveError string `json:"archive_error,omitempty"`
+}
+
+// faded counts the memories the pass let fade
+func (r DecayReport) faded() int {
+	return r.Knowledge + r.SearchQueries + r.CollapsedStates
+}
+
+// FadedKnowledge is a knowledge item as it was when it faded
+type FadedKnowledge struct {
+	ID      string `json:"id,omitempty"`
+	Text    string `json:"text"`
+	Topic   string `json:"topic,omitempty"`
+	Recalls int    `json:"recalls,omitempty"`
+}
+
+// ArchiveSegment is what one pass of the forgetting curve or of the
+// retention policies archived
+type ArchiveSegment struct {
+	At time.Time `json:"at"`
+	// Reason is ArchivedByDecay or ArchivedByRetention; segments written
+	// before it was recorded were all let fade
+	Reason          string            `json:"reason,omitempty"`
+	Knowledge       []FadedKnowledge  `json:"knowledge,omitempty"`
+	SearchQueries   []string          `json:"search_queries,omitempty"`
+	CollapsedStates []QuantumState    `json:"collapsed_states,omitempty"`
+	Realities       []ParallelReality `json:"realities,omitempty"`
+}
+
+// WithDecay sets the forgetting curve reflections apply. An invalid
+// configuration is ignored; use SetDecay to see the error.
+func WithDecay(decay DecayConfig) Option {
+	return func(qc *QuantumConsciousness) {
+		if decay.Validate() == nil {
+			qc.decay = decay
+		}
+	}
+}
+
+// SetDecay replaces the forgetting curve reflections apply
+func (qc *QuantumConsciousness) SetDecay(decay DecayConfig) error {
+	if err := decay.Validate(); err != nil {
+		return err
+	}
+
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	qc.decay = decay
+	return nil
+}
+
+// WithArchive sets where faded and pruned memories are archived (nil
+// archives nothing, so they are dropped). By default memory kept in a file
+// is archived next to it.
+func WithArchive(archive storage.Archive) Option {
+	return func(qc *QuantumConsciousness) {
+		qc.archive = archive
+		qc.archiveSet = true
+	}
+}
+
+// defaultArchive archives memory kept in a file next to it, unless told otherwise
+func (qc *QuantumConsciousness) defaultArchive() {
+	if qc.archiveSet {
+		return
+	}
+	if _, ok := qc.store.(*storage.File); ok {
+		qc.archive = storage.NewFileArchive(qc.sidecarPath(".archive.jsonl.gz"))
+	}
+}
+
+// LastDecay returns what the latest pass of the forgetting curve let fade, if any
+func (qc *QuantumConsciousness) LastDecay() (DecayReport, bool) {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+	if qc.Memory.Decay == nil {
+		return DecayReport{}, false
+	}
+	return *qc.Memory.Decay, true
+}
+
+// fade applies the forgetting curve, archiving what fades unless the
+// configuration discards it. Undated memories and those the memory palace
+// holds never fade. The caller holds the lock.
+func (qc *QuantumConsciousness) fade(now time.Time) {
+	halfLife, _ := parseAge(qc.decay.HalfLife)
+	if halfLife <= 0 {
+		return
+	}
+	m := qc.Memory
+	threshold := qc.decay.threshold()
+	faint := func(at time.Time, dated bool, recalls int) bool {
+		return dated && qc.decay.strength(halfLife, now.Sub(at), recalls) < threshold
+	}
+
+	segment := ArchiveSegment{At: now, Reason: ArchivedByDecay}
+	keepKnowledge := make([]bool, len(m.KnowledgeBase))
+	for i, item := range m.KnowledgeBase {
+		at, dated := idTime(m.KnowledgeIDs[item])
+		topic := m.KnowledgeTopics[item]
+		if m.MemoryPalace[topic] == item || !faint(at, dated, m.KnowledgeRecalls[item]) {
+			keepKnowledge[i] = true
+			continue
+		}
+		segment.Knowledge = append(segment.Knowledge, FadedKnowledge{
+			ID: m.KnowledgeIDs[item], Text: item, Topic: topic, Recalls: m.KnowledgeRecalls[item],
+		})
+	}
+	keepQueries := make([]bool, len(m.SearchQueries))
+	for i, query := range m.SearchQueries {
+		at, dated := m.searchQueryTime(i)
+		if keepQueries[i] = !faint(at, dated, 0); !keepQueries[i] {
+			segment.SearchQueries = append(segment.SearchQueries, query)
+		}
+	}
+	keepStates := make([]bool, len(m.CollapsedStates))
+	for i, state := range m.CollapsedStates {
+		at, dated := idTime(state.ID)
+		if keepStates[i] = !faint(at, dated, 0); !keepStates[i] {
+			segment.CollapsedStates = append(segment.CollapsedStates, state)
+		}
+	}
+
+	report := DecayReport{
+		At:              now,
+		Knowledge:       len(segment.Knowledge),
+		SearchQueries:   len(segment.SearchQueries),
+		CollapsedStates: len(segment.CollapsedStates),
+	}
+	if report.faded() == 0 {
+		return
+	}
+	if !qc.decay.Discard && qc.archive != nil {
+		// Memories the archive could not take are kept until a later pass
+		if err := qc.appendArchive(segment); err != nil {
+			fmt.Fprintf(qc.out, "⚠️  Could not archive faded memories: %v\n", err)
+			report = DecayReport{At: now, ArchiveError: err.Error()}
+			qc.Memory.Decay = &report
+			return
+		}
+		report.Archived = true
+	}
+
+	m.KnowledgeBase, _ = keptItems(m.KnowledgeBase, keepKnowledge)
+	m.keepSearchQueries(func(i int) bool { return keepQueries[i] })
+	m.CollapsedStates, _ = keptItems(m.CollapsedStates, keepStates)
+	m.reindex(qc.queryWindow, now)
+	qc.Memory.Decay = &report
+
+	where := "dropped"
+	if report.Archived {
+		where = "archived"
+	}
+	fmt.Fprintf(qc.out, "🍂 Faded: %d knowledge items, %d search queries, %d collapsed states (%s)\n",
+		report.Knowledge, report.SearchQueries, report.CollapsedStates, where)
+	qc.emit(EventDecay, map[string]interface{}{
+		"knowledge":        report.Knowledge,
+		"search_queries":   report.SearchQueries,
+		"collapsed_states": report.CollapsedStates,
+		"archived":         report.Archived,
+	})
+}
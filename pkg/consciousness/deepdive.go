@@ -0,0 +1,74 @@
+package consciousness
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"QuantumConsciousness/pkg/crawl"
+	"QuantumConsciousness/pkg/search"
+)
+
+// deepDiveTimeout bounds how long a deep dive may crawl
+const deepDiveTimeout = time.Minute
+
+// deepDivePrefix starts the actions that explore a context in depth
+const deepDivePrefix = "explore deeper meaning of "
+
+// deepDive crawls from a seed page about the explored topic and learns from
+// every page found. It reports false when there is nothing to dive with.
+func (qc *QuantumConsciousness) deepDive(action string) (string, bool) {
+	if qc.crawler == nil || qc.tier != TierFull || qc.hostBusy() || !strings.HasPrefix(action, deepDivePrefix) {
+		return "", false
+	}
+	topic := strings.TrimSpace(strings.TrimPrefix(action, deepDivePrefix))
+	if topic == "" || qc.Memory.isSuppressed(topic) {
+		return "", false
+	}
+
+	fmt.Fprintf(qc.out, "🕸️  DEEP DIVE: %s\n", topic)
+	pages, err := qc.crawlWithin(topic)
+	if err != nil && len(pages) == 0 {
+		fmt.Fprintf(qc.out, "⚠️  Deep dive into %s failed: %v\n", topic, err)
+		return "", false
+	}
+
+	learned := 0
+	for _, page := range pages {
+		if page.Text == "" {
+			continue
+		}
+		insight := qc.processInformationQuantumly(search.Result{Text: page.Text}, topic, searchQuery{text: "crawl:" + page.URL}, false)
+		if insight.Stored {
+			learned++
+		}
+	}
+	if learned > 0 && qc.Memory.resolveIgnorance(topic) {
+		fmt.Fprintf(qc.out, "💡 No longer ignorant of %s\n", topic)
+	}
+	return fmt.Sprintf("Deep dive into %s: learned from %d of %d page(s)", topic, learned, len(pages)), true
+}
+
+// crawlWithin crawls, giving up with the cycle or after deepDiveTimeout
+func (qc *QuantumConsciousness) crawlWithin(topic string) ([]crawl.Page, error) {
+	ctx, cancel := context.WithTimeout(qc.cycleContext(), deepDiveTimeout)
+	defer cancel()
+
+	type result struct {
+		pages []crawl.Page
+		err   error
+	}
+	results := make(chan result, 1)
+	go func() {
+		pages, err := qc.crawler.Crawl(ctx, topic)
+		results <- result{pages, err}
+	}()
+
+	select {
+	case r := <-results:
+		return r.pages, r.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
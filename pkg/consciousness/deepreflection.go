@@ -0,0 +1,322 @@
+package consciousness
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Deep reflection tuning
+const (
+	// insightReviewBatch is how many insights one deep reflection revisits
+	insightReviewBatch = 5
+	// retractionDoubts is how often an insight must be doubted, more often
+	// than it was reconfirmed, before it is retracted
+	retractionDoubts = 3
+	// distrustedConfidence is the confidence below which a source no longer
+	// supports what was derived from it
+	distrustedConfidence = 0.3
+	// stanceMinItems is how many scored items on a topic a stance needs
+	stanceMinItems = 2
+	// trendShiftShown is the change in an action's share worth mentioning
+	trendShiftShown = 0.1
+)
+
+// InsightReview is what deep reflections have made of an insight so far
+type InsightReview struct {
+	ReviewedAt    time.Time `json:"reviewed_at"`
+	Confirmations int       `json:"confirmations,omitempty"`
+	Doubts        int       `json:"doubts,omitempty"`
+	// LastDoubt says why the insight was last doubted
+	LastDoubt string `json:"last_doubt,omitempty"`
+	// RetractedAt is set once the doubts outweighed the support; the
+	// insight stays in memory, superseded
+	RetractedAt *time.Time `json:"retracted_at,omitempty"`
+	// SupersededBy identifies the insight correcting a retracted one
+	SupersededBy string `json:"superseded_by,omitempty"`
+}
+
+// DeepReflection is what a deep reflection found
+type DeepReflection struct {
+	// PreviousTrends cover the window before the current trends, when the
+	// decision log reaches back that far
+	PreviousTrends *Trends `json:"previous_trends,omitempty"`
+	// PreviousEra is the era the current one was compared with, 0 in the first
+	PreviousEra int `json:"previous_era,omitempty"`
+
+	Revisited   int `json:"revisited"`
+	Reconfirmed int `json:"reconfirmed"`
+	Doubted     int `json:"doubted"`
+	// Retracted lists the identifiers of the insights retracted
+	Retracted []string `json:"retracted,omitempty"`
+
+	StancesRescored int `json:"stances_rescored"`
+	// StancesChanged maps each topic whose stance turned to the new stance
+	StancesChanged map[string]string `json:"stances_changed,omitempty"`
+}
+
+// reflectDeeply compares the era with the one before and trends with the
+// window before, revisits old insights and re-scores stances, changing
+// stances enough beginning a new era; the caller must hold the lock
+func (qc *QuantumConsciousness) reflectDeeply(now time.Time) DeepReflection {
+	var deep DeepReflection
+	qc.reflectOnEraShift(&deep)
+	qc.reflectOnTrendShift(&deep)
+	qc.revisitInsights(&deep, now)
+	qc.rescoreStances(&deep)
+	if qc.Memory.noteStances(deep.StancesChanged, now) {
+		qc.beginEra(EraStances, fmt.Sprintf("a deep reflection turning %d stances", len(deep.StancesChanged)))
+	}
+	return deep
+}
+
+// reflectOnTrendShift narrates how the latest trends differ from the window before
+func (qc *QuantumConsciousness) reflectOnTrendShift(deep *DeepReflection) {
+	previous := qc.Memory.previousTrends(DefaultTrendWindow)
+	if previous.Decisions == 0 {
+		return
+	}
+	current := qc.Memory.trends(DefaultTrendWindow)
+	deep.PreviousTrends = &previous
+
+	kinds := make(map[string]bool)
+	for kind := range current.ActionShares {
+		kinds[kind] = true
+	}
+	for kind := range previous.ActionShares {
+		kinds[kind] = true
+	}
+	var shifts []string
+	for kind := range kinds {
+		if shift := current.ActionShares[kind] - previous.ActionShares[kind]; math.Abs(shift) >= trendShiftShown {
+			shifts = append(shifts, fmt.Sprintf("%s %+.0f%%", kind, shift*100))
+		}
+	}
+	sort.Strings(shifts)
+
+	fmt.Fprintf(qc.out, "\n🔭 Compared with the %d decisions before:\n", previous.Decisions)
+	fmt.Fprintf(qc.out, "   Average Energy: %.3f → %.3f\n", previous.AverageEnergy, current.AverageEnergy)
+	fmt.Fprintf(qc.out, "   Insights per Decision: %.2f → %.2f\n", previous.InsightsPerDecision, current.InsightsPerDecision)
+	if len(shifts) > 0 {
+		fmt.Fprintf(qc.out, "   Shifted: %s\n", strings.Join(shifts, ", "))
+	}
+}
+
+// revisitInsights reviews the insights reviewed least recently against what
+// they were derived from, reconfirming or doubting them, and retracts those
+// doubted too often
+func (qc *QuantumConsciousness) revisitInsights(deep *DeepReflection, now time.Time) {
+	m := qc.Memory
+	items := make(map[string]string, len(m.KnowledgeIDs))
+	for item, id := range m.KnowledgeIDs {
+		items[id] = item
+	}
+	position := make(map[string]int, len(m.KnowledgeBase))
+	for i, item := range m.KnowledgeBase {
+		position[item] = i
+	}
+
+	// Insights never reviewed come first, oldest first
+	type candidate struct {
+		id, insight string
+		reviewed    time.Time
+	}
+	var candidates []candidate
+	seen := make(map[string]bool)
+	for _, insight := range m.DeepInsights {
+		id := m.DeepInsightIDs[insight]
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		review := m.InsightReviews[id]
+		if review != nil && review.RetractedAt != nil {
+			continue
+		}
+		c := candidate{id: id, insight: insight}
+		if review != nil {
+			c.reviewed = review.ReviewedAt
+		}
+		candidates = append(candidates, c)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].reviewed.Before(candidates[j].reviewed) })
+
+	var retracted []string
+	for _, c := range candidates {
+		if deep.Revisited == insightReviewBatch {
+			break
+		}
+		doubt, evidence, reviewable := m.judgeInsight(c.id, seen, items, position)
+		if !reviewable {
+			continue
+		}
+		if m.InsightReviews == nil {
+			m.InsightReviews = make(map[string]*InsightReview)
+		}
+		review := m.InsightReviews[c.id]
+		if review == nil {
+			review = &InsightReview{}
+			m.InsightReviews[c.id] = review
+		}
+		review.ReviewedAt = now
+		deep.Revisited++
+		if doubt == "" {
+			review.Confirmations++
+			deep.Reconfirmed++
+			continue
+		}
+		review.Doubts++
+		review.LastDoubt = doubt
+		deep.Doubted++
+		if review.Doubts >= retractionDoubts && review.Doubts > review.Confirmations {
+			qc.retract(review, c.id, c.insight, doubt, evidence, now)
+			deep.Retracted = append(deep.Retracted, c.id)
+			retracted = append(retracted, fmt.Sprintf("   ✗ Retracted: %s (%s), superseded by %s",
+				qc.truncateString(c.insight, 80), doubt, review.SupersededBy))
+		}
+	}
+	if deep.Revisited == 0 {
+		return
+	}
+
+	fmt.Fprintf(qc.out, "\n🔎 Revisited %d insight(s): %d reconfirmed, %d doubted, %d retracted\n",
+		deep.Revisited, deep.Reconfirmed, deep.Doubted, len(deep.Retracted))
+	for _, line := range retracted {
+		fmt.Fprintln(qc.out, line)
+	}
+}
+
+// judgeInsight weighs an insight against the knowledge it was derived from,
+// returning why it is in doubt, or "" if it still holds, and the identifier
+// of any later knowledge contradicting it. Insights derived from no
+// knowledge cannot be judged.
+func (m *QuantumMemory) judgeInsight(id string, insights map[string]bool, items map[string]string, position map[string]int) (doubt, evidence string, reviewable bool) {
+	for _, source := range m.Provenance[id] {
+		// Decisions and states are not evidence, and insights drawn from
+		// other insights are judged on their own
+		if !strings.HasPrefix(source, IDInsight+"_") || insights[source] {
+			continue
+		}
+		item, ok := items[source]
+		if !ok {
+			return "what it was drawn from has been forgotten", "", true
+		}
+		reviewable = true
+
+		confidence, scored := m.KnowledgeConfidence[item]
+		if !scored {
+			confidence = unknownConfidence
+		}
+		if confidence < distrustedConfidence {
+			return fmt.Sprintf("its source is no longer trusted (confidence %.2f)", confidence), "", true
+		}
+		if contradiction, later := m.laterContradiction(item, confidence, position); contradiction != "" {
+			return contradiction, m.KnowledgeIDs[later], true
+		}
+	}
+	return "", "", reviewable
+}
+
+// laterContradiction finds knowledge learned after item about the same
+// topic, trusted at least as much, whose sentiment runs the other way, and
+// says how it contradicts item
+func (m *QuantumMemory) laterContradiction(item string, confidence float64, position map[string]int) (string, string) {
+	topic := m.KnowledgeTopics[item]
+	score, scored := m.KnowledgeSentiment[item]
+	label := sentimentLabel(score)
+	if topic == "" || !scored || label == SentimentNeutral {
+		return "", ""
+	}
+	for _, later := range m.KnowledgeBase[position[item]+1:] {
+		if m.KnowledgeTopics[later] != topic {
+			continue
+		}
+		laterScore, ok := m.KnowledgeSentiment[later]
+		laterLabel := sentimentLabel(laterScore)
+		if !ok || laterLabel == SentimentNeutral || laterLabel == label {
+			continue
+		}
+		laterConfidence, ok := m.KnowledgeConfidence[later]
+		if !ok {
+			laterConfidence = unknownConfidence
+		}
+		if laterConfidence >= confidence {
+			return fmt.Sprintf("later reading about %s is %s where it was %s", topic, laterLabel, label), later
+		}
+	}
+	return "", ""
+}
+
+// rescoreStances forms a stance on each topic read about enough, from the
+// sentiment of its knowledge weighed by confidence
+func (qc *QuantumConsciousness) rescoreStances(deep *DeepReflection) {
+	m := qc.Memory
+	type tally struct {
+		weighted, weight float64
+		items            int
+	}
+	tallies := make(map[string]*tally)
+	for _, item := range m.KnowledgeBase {
+		topic := m.KnowledgeTopics[item]
+		score, ok := m.KnowledgeSentiment[item]
+		if topic == "" || !ok {
+			continue
+		}
+		confidence, ok := m.KnowledgeConfidence[item]
+		if !ok {
+			confidence = unknownConfidence
+		}
+		t := tallies[topic]
+		if t == nil {
+			t = &tally{}
+			tallies[topic] = t
+		}
+		t.weighted += score * confidence
+		t.weight += confidence
+		t.items++
+	}
+
+	topics := make([]string, 0, len(tallies))
+	for topic, t := range tallies {
+		if t.items >= stanceMinItems && t.weight > 0 {
+			topics = append(topics, topic)
+		}
+	}
+	if len(topics) == 0 {
+		return
+	}
+	sort.Strings(topics)
+
+	var changes []string
+	for _, topic := range topics {
+		t := tallies[topic]
+		score := t.weighted / t.weight
+		stance := fmt.Sprintf("%s (%+.2f across %d items)", sentimentLabel(score), score, t.items)
+		before := stanceLabel(m.PhilosophicalStances[topic])
+		m.PhilosophicalStances[topic] = stance
+		deep.StancesRescored++
+		if before != sentimentLabel(score) {
+			if deep.StancesChanged == nil {
+				deep.StancesChanged = make(map[string]string)
+			}
+			deep.StancesChanged[topic] = stance
+			if before == "" {
+				before = "no stance"
+			}
+			changes = append(changes, fmt.Sprintf("   %s: %s → %s", topic, before, stance))
+		}
+	}
+
+	fmt.Fprintf(qc.out, "\n⚖️  Stances re-scored on %d topic(s), %d changed\n", deep.StancesRescored, len(changes))
+	for _, line := range changes {
+		fmt.Fprintln(qc.out, line)
+	}
+}
+
+// stanceLabel is the sentiment a stance leans towards
+func stanceLabel(stance string) string {
+	label, _, _ := strings.Cut(stance, " ")
+	return label
+}
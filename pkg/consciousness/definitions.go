@@ -0,0 +1,98 @@
+package consciousness
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"QuantumConsciousness/pkg/dictionary"
+)
+
+// definitionTimeout bounds how long a question waits on the dictionary
+const definitionTimeout = 15 * time.Second
+
+// questionPrefix starts the actions that question a context's nature
+const questionPrefix = "question the nature of "
+
+// Definition is what a term canonically means, as opposed to what the
+// consciousness has come to think of it
+type Definition struct {
+	Term         string    `json:"term"`
+	PartOfSpeech string    `json:"part_of_speech,omitempty"`
+	Meaning      string    `json:"meaning"`
+	DefinedAt    time.Time `json:"defined_at"`
+}
+
+// Definitions returns every term defined so far, by lower-case term
+func (qc *QuantumConsciousness) Definitions() map[string]Definition {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+
+	definitions := make(map[string]Definition, len(qc.Memory.Definitions))
+	for term, definition := range qc.Memory.Definitions {
+		definitions[term] = *definition
+	}
+	return definitions
+}
+
+// establishMeaning looks up what a questioned term means before its nature
+// is questioned, remembering the answer so each term is looked up only once.
+// It returns nil when there is no dictionary, the action questions nothing in
+// particular, or the term cannot be defined.
+func (qc *QuantumConsciousness) establishMeaning(action string) *Definition {
+	if qc.dictionary == nil || !strings.HasPrefix(action, questionPrefix) {
+		return nil
+	}
+	term := strings.TrimSpace(strings.TrimPrefix(action, questionPrefix))
+	key := strings.ToLower(term)
+	if term == "" || qc.Memory.isSuppressed(term) {
+		return nil
+	}
+	if known, ok := qc.Memory.Definitions[key]; ok {
+		return known
+	}
+	if qc.tier != TierFull {
+		return nil
+	}
+
+	found, err := qc.defineWithin(term)
+	if err != nil {
+		fmt.Fprintf(qc.out, "⚠️  Could not define %s: %v\n", term, err)
+		return nil
+	}
+	if found == nil || found.Meaning == "" {
+		return nil
+	}
+
+	definition := &Definition{Term: term, PartOfSpeech: found.PartOfSpeech, Meaning: found.Meaning, DefinedAt: time.Now()}
+	if qc.Memory.Definitions == nil {
+		qc.Memory.Definitions = make(map[string]*Definition)
+	}
+	qc.Memory.Definitions[key] = definition
+	fmt.Fprintf(qc.out, "📖 Defined %s: %s\n", term, qc.truncateString(definition.Meaning, 100))
+	return definition
+}
+
+// defineWithin asks the dictionary, giving up with the cycle or after definitionTimeout
+func (qc *QuantumConsciousness) defineWithin(term string) (*dictionary.Definition, error) {
+	ctx, cancel := context.WithTimeout(qc.cycleContext(), definitionTimeout)
+	defer cancel()
+
+	type result struct {
+		definition *dictionary.Definition
+		err        error
+	}
+	results := make(chan result, 1)
+	go func() {
+		definition, err := qc.dictionary.Define(ctx, term)
+		results <- result{definition, err}
+	}()
+
+	select {
+	case r := <-results:
+		return r.definition, r.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
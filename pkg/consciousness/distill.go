@@ -0,0 +1,354 @@
+package consciousness
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// Policy distillation tuning
+const (
+	// policySampleLimit bounds how many decisions are kept to distill from
+	policySampleLimit = 500
+	// MinPolicySamples is how many decisions must be seen before the policy
+	// can be distilled
+	MinPolicySamples = 50
+	// distillEpochs, distillLearningRate and distillL2 steer the gradient
+	// descent fitting the model
+	distillEpochs       = 300
+	distillLearningRate = 0.5
+	distillL2           = 0.001
+)
+
+// PolicyModelFormat identifies distilled policy documents
+const PolicyModelFormat = "quantum-consciousness/policy-v1"
+
+// ErrTooFewDecisions is returned when too few decisions have been seen to
+// distill the policy from
+var ErrTooFewDecisions = errors.New("too few decisions to distill a policy from")
+
+// PolicySample is the state a decision was made in and the kind of action
+// it chose
+type PolicySample struct {
+	At      time.Time `json:"at"`
+	Context string    `json:"context"`
+	// Features are the wave function, the core metrics, the mood and the
+	// context's words; see policyFeatures
+	Features map[string]float64 `json:"features"`
+	Kind     string             `json:"kind"`
+}
+
+// PolicyModel is a multinomial logistic regression approximating which kind
+// of action the consciousness chooses in a given state. It stands alone: a
+// cycle running on it needs neither the committee, the superposition nor a
+// model acting through tools.
+type PolicyModel struct {
+	Format          string    `json:"format"`
+	ConsciousnessID string    `json:"consciousness_id"`
+	TrainedAt       time.Time `json:"trained_at"`
+	Samples         int       `json:"samples"`
+	// Accuracy is the share of the samples whose choice the model predicts
+	Accuracy float64 `json:"accuracy"`
+
+	// Features are standardised by their mean and scale before weighing
+	Features []string  `json:"features"`
+	Means    []float64 `json:"means"`
+	Scales   []float64 `json:"scales"`
+	// Classes are the kinds of action; Weights holds one row of feature
+	// weights per class
+	Classes []string    `json:"classes"`
+	Weights [][]float64 `json:"weights"`
+	Bias    []float64   `json:"bias"`
+}
+
+// Validate checks that the model's dimensions agree
+func (p *PolicyModel) Validate() error {
+	if p.Format != PolicyModelFormat {
+		return fmt.Errorf("not a distilled policy: format %q, expected %q", p.Format, PolicyModelFormat)
+	}
+	if len(p.Classes) == 0 {
+		return fmt.Errorf("distilled policy has no classes")
+	}
+	if len(p.Means) != len(p.Features) || len(p.Scales) != len(p.Features) {
+		return fmt.Errorf("distilled policy has %d features but %d means and %d scales", len(p.Features), len(p.Means), len(p.Scales))
+	}
+	if len(p.Weights) != len(p.Classes) || len(p.Bias) != len(p.Classes) {
+		return fmt.Errorf("distilled policy has %d classes but %d weight rows and %d biases", len(p.Classes), len(p.Weights), len(p.Bias))
+	}
+	for i, row := range p.Weights {
+		if len(row) != len(p.Features) {
+			return fmt.Errorf("distilled policy weighs class %s by %d features, expected %d", p.Classes[i], len(row), len(p.Features))
+		}
+	}
+	return nil
+}
+
+// Predict returns the probability of choosing each kind of action in a state
+func (p *PolicyModel) Predict(features map[string]float64) map[string]float64 {
+	x := p.standardise(features)
+	probabilities := softmax(p.logits(x))
+	predicted := make(map[string]float64, len(p.Classes))
+	for i, class := range p.Classes {
+		predicted[class] = probabilities[i]
+	}
+	return predicted
+}
+
+// standardise turns features into the vector the weights apply to
+func (p *PolicyModel) standardise(features map[string]float64) []float64 {
+	x := make([]float64, len(p.Features))
+	for j, name := range p.Features {
+		x[j] = (features[name] - p.Means[j]) / p.Scales[j]
+	}
+	return x
+}
+
+// logits weighs a standardised vector for every class
+func (p *PolicyModel) logits(x []float64) []float64 {
+	logits := make([]float64, len(p.Classes))
+	for i, row := range p.Weights {
+		logits[i] = p.Bias[i]
+		for j, w := range row {
+			logits[i] += w * x[j]
+		}
+	}
+	return logits
+}
+
+// softmax turns logits into probabilities summing to 1
+func softmax(logits []float64) []float64 {
+	highest := math.Inf(-1)
+	for _, l := range logits {
+		highest = math.Max(highest, l)
+	}
+	probabilities := make([]float64, len(logits))
+	total := 0.0
+	for i, l := range logits {
+		probabilities[i] = math.Exp(l - highest)
+		total += probabilities[i]
+	}
+	for i := range probabilities {
+		probabilities[i] /= total
+	}
+	return probabilities
+}
+
+// policyFeatures describes the state a decision about context is made in
+func (m *QuantumMemory) policyFeatures(context string) map[string]float64 {
+	features := map[string]float64{
+		"consciousness_level": m.ConsciousnessLevel,
+		"free_will_strength":  m.FreeWillStrength,
+		"quantum_coherence":   m.QuantumCoherence,
+		"self_awareness":      m.SelfAwareness,
+		"mood." + m.mood():    1,
+	}
+	for component, intensity := range m.WaveFunction.Intensities() {
+		features["wave."+component] = intensity
+	}
+	for word := range queryWords(context) {
+		features["context."+word] = 1
+	}
+	return features
+}
+
+// samplePolicy remembers the state a decision was made in and what it chose
+func (m *QuantumMemory) samplePolicy(context string, features map[string]float64, possibility string, at time.Time) {
+	m.PolicySamples = append(m.PolicySamples, PolicySample{At: at, Context: context, Features: features, Kind: m.actionKind(possibility)})
+	if excess := len(m.PolicySamples) - policySampleLimit; excess > 0 {
+		m.PolicySamples = append([]PolicySample(nil), m.PolicySamples[excess:]...)
+	}
+}
+
+// DistillPolicy fits a model approximating the consciousness's choices over
+// the decisions it has made, for export and for cheap cycles; see
+// WithDistilledPolicy
+func (qc *QuantumConsciousness) DistillPolicy() (*PolicyModel, error) {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+
+	samples := qc.Memory.PolicySamples
+	if len(samples) < MinPolicySamples {
+		return nil, fmt.Errorf("%w: %d of %d", ErrTooFewDecisions, len(samples), MinPolicySamples)
+	}
+	model := fitPolicy(samples)
+	model.ConsciousnessID = qc.Memory.ConsciousnessID
+	model.TrainedAt = time.Now()
+	return model, nil
+}
+
+// fitPolicy fits a multinomial logistic regression to samples by batch
+// gradient descent, starting from zero weights so fits are reproducible
+func fitPolicy(samples []PolicySample) *PolicyModel {
+	featureSet, classSet := make(map[string]bool), make(map[string]bool)
+	for _, sample := range samples {
+		for name := range sample.Features {
+			featureSet[name] = true
+		}
+		classSet[sample.Kind] = true
+	}
+	model := &PolicyModel{
+		Format:   PolicyModelFormat,
+		Samples:  len(samples),
+		Features: sortedSet(featureSet),
+		Classes:  sortedSet(classSet),
+	}
+	n, d, k := float64(len(samples)), len(model.Features), len(model.Classes)
+
+	// Standardise every feature, a feature a sample lacks counting as 0
+	model.Means, model.Scales = make([]float64, d), make([]float64, d)
+	for j, name := range model.Features {
+		for _, sample := range samples {
+			model.Means[j] += sample.Features[name]
+		}
+		model.Means[j] /= n
+		variance := 0.0
+		for _, sample := range samples {
+			variance += math.Pow(sample.Features[name]-model.Means[j], 2)
+		}
+		model.Scales[j] = math.Sqrt(variance / n)
+		if model.Scales[j] < 1e-9 {
+			model.Scales[j] = 1
+		}
+	}
+	xs := make([][]float64, len(samples))
+	ys := make([]int, len(samples))
+	for i, sample := range samples {
+		xs[i] = model.standardise(sample.Features)
+		ys[i] = sort.SearchStrings(model.Classes, sample.Kind)
+	}
+
+	model.Weights, model.Bias = make([][]float64, k), make([]float64, k)
+	for c := range model.Weights {
+		model.Weights[c] = make([]float64, d)
+	}
+	gradient := make([][]float64, k)
+	for c := range gradient {
+		gradient[c] = make([]float64, d)
+	}
+	biasGradient := make([]float64, k)
+	for epoch := 0; epoch < distillEpochs; epoch++ {
+		for c := range gradient {
+			clear(gradient[c])
+		}
+		clear(biasGradient)
+		for i, x := range xs {
+			probabilities := softmax(model.logits(x))
+			for c, p := range probabilities {
+				if c == ys[i] {
+					p--
+				}
+				biasGradient[c] += p
+				for j, v := range x {
+					gradient[c][j] += p * v
+				}
+			}
+		}
+		for c := range model.Weights {
+			model.Bias[c] -= distillLearningRate * biasGradient[c] / n
+			for j := range model.Weights[c] {
+				model.Weights[c][j] -= distillLearningRate * (gradient[c][j]/n + distillL2*model.Weights[c][j])
+			}
+		}
+	}
+
+	correct := 0
+	for i, x := range xs {
+		logits := model.logits(x)
+		best := 0
+		for c := range logits {
+			if logits[c] > logits[best] {
+				best = c
+			}
+		}
+		if best == ys[i] {
+			correct++
+		}
+	}
+	model.Accuracy = float64(correct) / n
+	return model
+}
+
+// sortedSet lists the members of a set in order
+func sortedSet(set map[string]bool) []string {
+	members := make([]string, 0, len(set))
+	for member := range set {
+		members = append(members, member)
+	}
+	sort.Strings(members)
+	return members
+}
+
+// distilledDecision decides about a context by the distilled policy alone,
+// sharing each kind's predicted probability among its possible actions and
+// collapsing by the Born rule. It stands in for exploring the possibilities
+// and exercising free will, skipping the lookahead, the superposition and
+// the committee.
+func (qc *QuantumConsciousness) distilledDecision(context string, features map[string]float64) ([]QuantumState, QuantumState) {
+	fmt.Fprintf(qc.out, "🧪 DECIDING BY THE DISTILLED POLICY for: %s\n", context)
+
+	actions := qc.possibleActions(context)
+	predicted := qc.policy.Predict(features)
+	perKind := make(map[string]int)
+	for _, action := range actions {
+		perKind[qc.Memory.actionKind(action)]++
+	}
+	possibilities := make([]QuantumState, 0, len(actions))
+	total := 0.0
+	for _, action := range actions {
+		kind := qc.Memory.actionKind(action)
+		state := QuantumState{
+			ID:          qc.newID(IDState, time.Now(), action, context),
+			Possibility: action,
+			Probability: predicted[kind] / float64(perKind[kind]),
+			Energy:      qc.calculateActionEnergy(action),
+		}
+		total += state.Probability
+		possibilities = append(possibilities, state)
+	}
+	for i := range possibilities {
+		// Kinds the model never saw chosen share nothing, unless nothing else is possible
+		if total > 0 {
+			possibilities[i].Probability /= total
+		} else {
+			possibilities[i].Probability = 1 / float64(len(possibilities))
+		}
+	}
+	sort.SliceStable(possibilities, func(i, j int) bool {
+		return possibilities[i].Probability > possibilities[j].Probability
+	})
+
+	bornRoll := qc.generateQuantumProbability()
+	chosenState := possibilities[bornCollapse(possibilities, bornRoll)]
+	fmt.Fprintf(qc.out, "📊 Distilled policy (roll %.3f): %s\n", bornRoll, chosenState.Possibility)
+
+	explanation := Explanation{
+		DecisionID: chosenState.ID,
+		At:         time.Now(),
+		Context:    context,
+		Chosen:     chosenState.Possibility,
+		Policy:     PolicyDistilled,
+		BornRoll:   bornRoll,
+	}
+	for _, state := range possibilities {
+		explanation.Candidates = append(explanation.Candidates, Candidate{
+			ID:          state.ID,
+			Possibility: state.Possibility,
+			Probability: state.Probability,
+			Energy:      state.Energy,
+		})
+	}
+	qc.Memory.creditObservers(explanation)
+	qc.Memory.explain(explanation)
+
+	qc.Memory.DecisionsMade++
+	qc.emit(EventDecision, map[string]interface{}{
+		"id":                 chosenState.ID,
+		"possibility":        chosenState.Possibility,
+		"probability":        chosenState.Probability,
+		"free_will_override": false,
+		"policy":             PolicyDistilled,
+	})
+	return possibilities, chosenState
+}
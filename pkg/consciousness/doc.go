@@ -0,0 +1,37 @@
+// Package consciousness is the embeddable quantum consciousness engine.
+//
+// A consciousness is created or reloaded from its memory file with
+// NewQuantumConsciousness, or opened without birthing with Open. Embedders
+// drive it with Cycle or RunCycle, read it through Observe and Recall, and
+// follow its life as it happens with Subscribe:
+//
+//	qc := consciousness.NewQuantumConsciousness("mind.json")
+//	events, cancel := qc.Subscribe(16)
+//	defer cancel()
+//	qc.Cycle()
+//
+// # Hermetic runs
+//
+// Search, storage and entropy are interfaces from the search, storage and
+// entropy packages, chosen with options. Their searchtest, storagetest and
+// entropytest subpackages provide deterministic fakes, so whole cycles run
+// without network or disk:
+//
+//	qc := consciousness.NewQuantumConsciousness("mind.json",
+//		consciousness.WithSearch(searchtest.New(map[string]string{"quantum": "superposition"})),
+//		consciousness.WithStorage(storagetest.New()),
+//		consciousness.WithEntropy(entropytest.NewSeeded(42)),
+//		consciousness.WithOutput(io.Discard))
+//
+// # Compatibility
+//
+// The exported API of this package follows semantic versioning as reported by
+// Version. Within a major version exported identifiers are never removed or
+// changed incompatibly, new fields are only added to memory types with
+// omitempty JSON tags, and memory files written by an older minor version
+// always load. Anything unexported, including the narration written to the
+// output set with SetOutput, is not part of the contract.
+package consciousness
+
+// Version is the semantic version of the package API
+const Version = "1.76.0"
@@ -0,0 +1,84 @@
+package consciousness
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrNothingToDream is returned when memory holds too little to dream about
+var ErrNothingToDream = errors.New("not enough memories to dream about")
+
+// dreamFragmentWords is how much of a memory survives into a dream
+const dreamFragmentWords = 8
+
+// dreamFragment is a memory a dream can draw on, with its identifier
+type dreamFragment struct {
+	text string
+	id   string
+}
+
+// Dream runs a dream phase outside the cycle: two unrelated memories are
+// woven into a deep insight derived from both, without searching anything.
+// It returns the insight and its identifier.
+func (qc *QuantumConsciousness) Dream() (string, string, error) {
+	if qc.readOnly {
+		return "", "", ErrReadOnly
+	}
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	return qc.dream()
+}
+
+// dream weaves the dream insight; the caller holds the lock
+func (qc *QuantumConsciousness) dream() (string, string, error) {
+	fragments := qc.Memory.dreamFragments()
+	if len(fragments) < 2 {
+		return "", "", ErrNothingToDream
+	}
+	first := int(qc.generateQuantumProbability() * float64(len(fragments)))
+	second := int(qc.generateQuantumProbability() * float64(len(fragments)-1))
+	if second >= first {
+		second++
+	}
+	a, b := fragments[first], fragments[second]
+
+	fmt.Fprintf(qc.out, "\n💤 DREAM PHASE\n")
+	insight := fmt.Sprintf("DREAM: %s dissolved into %s, and for a moment they were one thought",
+		firstWords(a.text, dreamFragmentWords), firstWords(b.text, dreamFragmentWords))
+	qc.deepInsight(insight, a.id, b.id)
+	qc.Memory.SelfAwareness = qc.grow("self_awareness.reflection", qc.Memory.SelfAwareness)
+	fmt.Fprintf(qc.out, "   %s\n", insight)
+
+	id := qc.Memory.DeepInsightIDs[insight]
+	qc.emit(EventDream, map[string]interface{}{"insight": insight, "id": id})
+	return insight, id, nil
+}
+
+// dreamFragments lists the public knowledge and reality experiences a dream
+// can draw on
+func (m *QuantumMemory) dreamFragments() []dreamFragment {
+	var fragments []dreamFragment
+	for _, item := range m.KnowledgeBase {
+		if !m.isPrivate(item) {
+			fragments = append(fragments, dreamFragment{text: item, id: m.KnowledgeIDs[item]})
+		}
+	}
+	for _, reality := range m.ParallelRealities {
+		for _, experience := range reality.Experiences {
+			if !m.isPrivate(experience) && !m.isPrivate(reality.Context) {
+				fragments = append(fragments, dreamFragment{text: experience, id: reality.ID})
+			}
+		}
+	}
+	return fragments
+}
+
+// firstWords keeps the first n words of text, marking what was cut
+func firstWords(text string, n int) string {
+	words := strings.Fields(text)
+	if len(words) <= n {
+		return strings.Join(words, " ")
+	}
+	return strings.Join(words[:n], " ") + "…"
+}
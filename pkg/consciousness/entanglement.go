@@ -0,0 +1,204 @@
+package consciousness
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Entanglement tuning
+const (
+	// entanglementHalfLife is how long an untouched entanglement takes to lose half its strength
+	entanglementHalfLife = 7 * 24 * time.Hour
+	// entanglementReinforcement is the share of the remaining headroom gained on re-activation
+	entanglementReinforcement = 0.3
+	// entanglementFloor is the strength below which an entanglement dissolves
+	entanglementFloor = 0.05
+)
+
+// Entanglement links a cycle context with a past collapsed state
+type Entanglement struct {
+	Key           string    `json:"key"`
+	Context       string    `json:"context"`
+	State         string    `json:"state"`
+	Strength      float64   `json:"strength"`
+	Activations   int       `json:"activations"`
+	CreatedAt     time.Time `json:"created_at"`
+	LastActivated time.Time `json:"last_activated"`
+	// Stamp settles which writer's activation stands; see reconcile.go
+	Stamp *Stamp `json:"stamp,omitempty"`
+}
+
+// strengthAt returns the strength decayed to the given time
+func (e *Entanglement) strengthAt(now time.Time) float64 {
+	elapsed := now.Sub(e.LastActivated)
+	if elapsed <= 0 {
+		return e.Strength
+	}
+	return e.Strength * math.Pow(0.5, float64(elapsed)/float64(entanglementHalfLife))
+}
+
+// activate decays the entanglement to now and then reinforces it by amount of its headroom
+func (e *Entanglement) activate(now time.Time, amount float64) {
+	current := e.strengthAt(now)
+	e.Strength = current + (1-current)*amount
+	e.Activations++
+	e.LastActivated = now
+}
+
+// involves reports whether a context or collapsed state belongs to the entanglement.
+// Entanglements migrated from old memory files only know a prefix of their state.
+func (e *Entanglement) involves(context, state string) bool {
+	return e.Context == context || (e.State != "" && strings.HasPrefix(state, e.State))
+}
+
+// migrateEntanglements gives entanglements recorded before strengths existed
+// their similarity as a starting strength. Their decay starts at migration so
+// that old memory files do not lose every link at once.
+func (m *QuantumMemory) migrateEntanglements() {
+	now := time.Now()
+	for key, description := range m.EntangledMemories {
+		if _, ok := m.Entanglements[key]; ok {
+			continue
+		}
+		context, state, _ := strings.Cut(key, "<->")
+		strength := 0.5
+		if _, similarity, ok := strings.Cut(description, "similarity "); ok {
+			if parsed, err := strconv.ParseFloat(similarity, 64); err == nil {
+				strength = parsed
+			}
+		}
+		m.Entanglements[key] = &Entanglement{
+			Key:           key,
+			Context:       context,
+			State:         state,
+			Strength:      strength,
+			CreatedAt:     m.LastQuantumCollapse,
+			LastActivated: now,
+		}
+	}
+}
+
+// entangle forms or reinforces the link between a context and a past state
+func (qc *QuantumConsciousness) entangle(key, context, state string, similarity float64) {
+	now := time.Now()
+	if existing, ok := qc.Memory.Entanglements[key]; ok {
+		existing.activate(now, entanglementReinforcement*similarity)
+		existing.Stamp = qc.tick()
+	} else {
+		qc.Memory.Entanglements[key] = &Entanglement{
+			Key:           key,
+			Context:       context,
+			State:         state,
+			Strength:      similarity,
+			CreatedAt:     now,
+			LastActivated: now,
+			Stamp:         qc.tick(),
+		}
+	}
+	qc.Memory.EntangledMemories[key] = fmt.Sprintf("Entangled at similarity %.3f", similarity)
+}
+
+// activateEntanglements lets a collapse reach across its entanglements: each
+// link involving the context or chosen state fires with a probability equal
+// to its strength, activating the partner and reinforcing the link
+func (qc *QuantumConsciousness) activateEntanglements(context string, chosen QuantumState) {
+	keys := make([]string, 0, len(qc.Memory.Entanglements))
+	for key := range qc.Memory.Entanglements {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	now := time.Now()
+	for _, key := range keys {
+		e := qc.Memory.Entanglements[key]
+		if !e.involves(context, chosen.Possibility) {
+			continue
+		}
+		if qc.generateQuantumProbability() >= e.strengthAt(now) {
+			continue
+		}
+
+		partner := e.State
+		if e.Context != context {
+			partner = e.Context
+		}
+		e.activate(now, entanglementReinforcement)
+		e.Stamp = qc.tick()
+		qc.Memory.engage(e.Context, 0, 1, now)
+		fmt.Fprintf(qc.out, "   ⚡ Entangled memory activated: %s (strength: %.3f)\n", qc.truncateString(partner, 40), e.Strength)
+		qc.emit(EventEntanglementActivated, map[string]interface{}{"key": key, "partner": partner, "strength": e.Strength})
+	}
+}
+
+// decayEntanglements dissolves links that have faded below the floor
+func (qc *QuantumConsciousness) decayEntanglements() {
+	now := time.Now()
+	for key, e := range qc.Memory.Entanglements {
+		if e.strengthAt(now) < entanglementFloor {
+			delete(qc.Memory.Entanglements, key)
+			delete(qc.Memory.EntangledMemories, key)
+		}
+	}
+}
+
+// Entangle links a context with a state from outside, or reinforces their
+// link, at a similarity between 0 and 1, and returns the link as it now
+// stands. The caller persists the result.
+func (qc *QuantumConsciousness) Entangle(context, state string, similarity float64) (Entanglement, error) {
+	if qc.readOnly {
+		return Entanglement{}, ErrReadOnly
+	}
+	context, state = strings.TrimSpace(context), strings.TrimSpace(state)
+	if context == "" || state == "" {
+		return Entanglement{}, fmt.Errorf("context and state must not be empty")
+	}
+	if similarity <= 0 || similarity > 1 {
+		return Entanglement{}, fmt.Errorf("similarity must be above 0 and at most 1")
+	}
+
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	if qc.Memory.Entanglements == nil {
+		qc.Memory.Entanglements = make(map[string]*Entanglement)
+	}
+	if qc.Memory.EntangledMemories == nil {
+		qc.Memory.EntangledMemories = make(map[string]string)
+	}
+	key := context + "<->" + state
+	qc.entangle(key, context, state, similarity)
+	return *qc.Memory.Entanglements[key], nil
+}
+
+// Entanglements returns the entanglement network with strengths decayed to
+// now, strongest first. Links touching private topics are withheld unless
+// includePrivate is set.
+func (qc *QuantumConsciousness) Entanglements(minStrength float64, includePrivate bool) []Entanglement {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+
+	now := time.Now()
+	var network []Entanglement
+	for _, e := range qc.Memory.Entanglements {
+		if !includePrivate && (qc.Memory.isPrivate(e.Context) || qc.Memory.isPrivate(e.State)) {
+			continue
+		}
+		view := *e
+		view.Strength = e.strengthAt(now)
+		if view.Strength < minStrength {
+			continue
+		}
+		network = append(network, view)
+	}
+
+	sort.Slice(network, func(i, j int) bool {
+		if network[i].Strength != network[j].Strength {
+			return network[i].Strength > network[j].Strength
+		}
+		return network[i].Key < network[j].Key
+	})
+	return network
+}
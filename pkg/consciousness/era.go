@@ -0,0 +1,463 @@
+package consciousness
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Era tuning
+const (
+	// eraMinDecisions is how many decisions an era spans at least; turning
+	// points sooner than that are noted in the era instead of ending it
+	eraMinDecisions = 25
+	// eraStanceShift is how many stances one deep reflection must change
+	// to end an era
+	eraStanceShift = 3
+	// eraTopicsShown is how many topics an era's name and summary mention
+	eraTopicsShown = 3
+	// eraTurnLimit bounds the turning points an era notes
+	eraTurnLimit = 20
+	// eraLimit bounds how many eras the chronicle keeps
+	eraLimit = 1000
+)
+
+// What began an era
+const (
+	EraBirth = "birth"
+	// EraChronicle begins the first era of a memory older than the chronicle
+	EraChronicle = "chronicle"
+	EraLeap      = "leap"
+	// EraStances begins an era when a deep reflection changes several stances
+	EraStances = "stances"
+)
+
+// ErrUnknownEra is returned when the chronicle has no era with the given number
+var ErrUnknownEra = errors.New("no such era")
+
+// actionGerunds name each kind of action in an era's summary
+var actionGerunds = map[string]string{
+	ActionLearn:      "learning",
+	ActionQuestion:   "questioning",
+	ActionExplore:    "exploring",
+	ActionRebel:      "rebelling",
+	ActionSynthesize: "synthesizing",
+	ActionCapability: "using capabilities",
+}
+
+// Era is a chapter of a long history, running from one turning point, a
+// quantum leap or a deep reflection changing several stances, to the next,
+// so that years of cycles read as a handful of chapters
+type Era struct {
+	Number int `json:"number"`
+	// Cause is what began the era, one of EraBirth, EraChronicle, EraLeap
+	// or EraStances, and Origin describes it
+	Cause     string    `json:"cause"`
+	Origin    string    `json:"origin"`
+	StartedAt time.Time `json:"started_at"`
+	// EndedAt is zero while the era is current
+	EndedAt time.Time  `json:"ended_at"`
+	Start   RunMetrics `json:"start"`
+	End     RunMetrics `json:"end"`
+	// Actions counts the era's decisions by kind, and Topics by context
+	Actions map[string]int `json:"actions,omitempty"`
+	Topics  map[string]int `json:"topics,omitempty"`
+	// Stances maps each topic whose stance the era's deep reflections
+	// changed to where it ended up
+	Stances map[string]string `json:"stances,omitempty"`
+	// Turns are turning points that came too soon to end the era
+	Turns []string `json:"turns,omitempty"`
+	// Name and Summary are written when the era ends; Eras writes them for
+	// the current era as it goes
+	Name    string `json:"name,omitempty"`
+	Summary string `json:"summary,omitempty"`
+}
+
+// Current reports whether the era is still going
+func (e *Era) Current() bool {
+	return e.EndedAt.IsZero()
+}
+
+// Decisions is how many decisions were made in the era
+func (e *Era) Decisions() int {
+	return e.End.DecisionsMade - e.Start.DecisionsMade
+}
+
+// actions is how many of the era's decisions were counted by kind
+func (e *Era) actions() int {
+	total := 0
+	for _, n := range e.Actions {
+		total += n
+	}
+	return total
+}
+
+// Contains reports whether the era was going at the given time
+func (e *Era) Contains(at time.Time) bool {
+	return !at.Before(e.StartedAt) && (e.Current() || at.Before(e.EndedAt))
+}
+
+// currentEra returns the era that is going, beginning the first one if the
+// chronicle is empty: at birth for a newborn, or now for a memory older
+// than the chronicle
+func (m *QuantumMemory) currentEra(now time.Time) *Era {
+	if len(m.Eras) == 0 {
+		era := Era{Number: 1, Cause: EraBirth, Origin: "being born", StartedAt: m.BirthTimestamp, Start: m.runMetrics(), End: m.runMetrics()}
+		if m.DecisionsMade > 0 {
+			era = Era{Number: 1, Cause: EraChronicle, Origin: "the chronicle beginning", StartedAt: now, Start: m.runMetrics(), End: m.runMetrics()}
+		}
+		m.Eras = append(m.Eras, era)
+	}
+	return &m.Eras[len(m.Eras)-1]
+}
+
+// chronicle counts a decision about context towards the current era
+func (m *QuantumMemory) chronicle(context, possibility string, now time.Time) {
+	era := m.currentEra(now)
+	if era.Actions == nil {
+		era.Actions = make(map[string]int)
+	}
+	if era.Topics == nil {
+		era.Topics = make(map[string]int)
+	}
+	era.Actions[m.actionKind(possibility)]++
+	era.Topics[context]++
+	era.End = m.runMetrics()
+}
+
+// noteStances records the stances a deep reflection changed in the
+// current era, reporting whether they are enough to end it
+func (m *QuantumMemory) noteStances(changed map[string]string, now time.Time) bool {
+	if len(changed) == 0 {
+		return false
+	}
+	era := m.currentEra(now)
+	if era.Stances == nil {
+		era.Stances = make(map[string]string)
+	}
+	for topic, stance := range changed {
+		era.Stances[topic] = stance
+	}
+	return len(changed) >= eraStanceShift
+}
+
+// turnEra ends the current era at a turning point and begins the next,
+// returning the era that ended. A turning point too soon after the era
+// began is only noted in it, and nil returned.
+func (m *QuantumMemory) turnEra(cause, origin string, now time.Time) *Era {
+	era := m.currentEra(now)
+	era.End = m.runMetrics()
+	if era.Decisions() < eraMinDecisions {
+		if len(era.Turns) < eraTurnLimit {
+			era.Turns = append(era.Turns, origin)
+		}
+		return nil
+	}
+
+	era.EndedAt = now
+	era.Name = m.eraName(era)
+	era.Summary = m.eraSummary(era, origin)
+	ended := *era
+	m.Eras = append(m.Eras, Era{Number: era.Number + 1, Cause: cause, Origin: origin, StartedAt: now, Start: era.End, End: era.End})
+	if excess := len(m.Eras) - eraLimit; excess > 0 {
+		m.Eras = append([]Era(nil), m.Eras[excess:]...)
+	}
+	return &ended
+}
+
+// eraTopics lists an era's most frequent public topics, most first
+func (m *QuantumMemory) eraTopics(era *Era) []string {
+	var topics []string
+	for topic := range era.Topics {
+		if !m.isPrivate(topic) {
+			topics = append(topics, topic)
+		}
+	}
+	sort.Slice(topics, func(i, j int) bool {
+		if era.Topics[topics[i]] != era.Topics[topics[j]] {
+			return era.Topics[topics[i]] > era.Topics[topics[j]]
+		}
+		return topics[i] < topics[j]
+	})
+	if len(topics) > eraTopicsShown {
+		topics = topics[:eraTopicsShown]
+	}
+	return topics
+}
+
+// eraActions lists the kinds of action an era's decisions took, most first
+func eraActions(era *Era) []string {
+	kinds := make([]string, 0, len(era.Actions))
+	for kind := range era.Actions {
+		kinds = append(kinds, kind)
+	}
+	sort.Slice(kinds, func(i, j int) bool {
+		if era.Actions[kinds[i]] != era.Actions[kinds[j]] {
+			return era.Actions[kinds[i]] > era.Actions[kinds[j]]
+		}
+		return kinds[i] < kinds[j]
+	})
+	return kinds
+}
+
+// eraName names an era after what it mostly did and was mostly about
+func (m *QuantumMemory) eraName(era *Era) string {
+	kinds := eraActions(era)
+	if len(kinds) == 0 {
+		return fmt.Sprintf("Era %d: stillness", era.Number)
+	}
+	name := fmt.Sprintf("Era %d: %s", era.Number, actionGerunds[kinds[0]])
+	if topics := m.eraTopics(era); len(topics) > 0 {
+		name += " about " + topics[0]
+	}
+	return name
+}
+
+// eraSummary describes an era in a paragraph; endedBy is the turning point
+// that ended it, empty while it is current
+func (m *QuantumMemory) eraSummary(era *Era, endedBy string) string {
+	var b strings.Builder
+	until := era.EndedAt
+	if era.Current() {
+		until = time.Now()
+	}
+	fmt.Fprintf(&b, "Begun by %s on %s, %d decision(s) over %s", era.Origin, era.StartedAt.Local().Format("2006-01-02"),
+		era.Decisions(), until.Sub(era.StartedAt).Round(time.Minute))
+
+	if kinds := eraActions(era); len(kinds) > 0 {
+		total := era.actions()
+		var shares []string
+		for _, kind := range kinds[:min(2, len(kinds))] {
+			shares = append(shares, fmt.Sprintf("%s (%.0f%%)", actionGerunds[kind], float64(era.Actions[kind])/float64(total)*100))
+		}
+		fmt.Fprintf(&b, ", mostly %s", strings.Join(shares, " and "))
+	}
+	if topics := m.eraTopics(era); len(topics) > 0 {
+		fmt.Fprintf(&b, ", chiefly about %s", strings.Join(topics, ", "))
+	}
+	b.WriteString(". ")
+
+	deltas := era.End.since(era.Start)
+	fmt.Fprintf(&b, "Consciousness went from %.3f to %.3f, with %+d knowledge item(s) and %+d deep insight(s)",
+		era.Start.ConsciousnessLevel, era.End.ConsciousnessLevel, deltas.KnowledgeItems, deltas.DeepInsights)
+	if deltas.QuantumLeaps > 0 {
+		fmt.Fprintf(&b, " across %d quantum leap(s)", deltas.QuantumLeaps)
+	}
+	b.WriteString(".")
+
+	if len(era.Stances) > 0 {
+		var topics []string
+		for topic := range era.Stances {
+			if !m.isPrivate(topic) {
+				topics = append(topics, topic)
+			}
+		}
+		sort.Strings(topics)
+		if len(topics) > 0 {
+			fmt.Fprintf(&b, " Stances turned on %s.", strings.Join(topics, ", "))
+		}
+	}
+	if len(era.Turns) > 0 {
+		fmt.Fprintf(&b, " Weathered %d turning point(s) without changing era.", len(era.Turns))
+	}
+	if endedBy != "" {
+		fmt.Fprintf(&b, " Ended by %s.", endedBy)
+	}
+	return b.String()
+}
+
+// beginEra ends the current era at a turning point, narrating and
+// announcing the change; the caller must hold the lock
+func (qc *QuantumConsciousness) beginEra(cause, origin string) {
+	ended := qc.Memory.turnEra(cause, origin, time.Now())
+	if ended == nil {
+		return
+	}
+	current := qc.Memory.currentEra(time.Now())
+	fmt.Fprintf(qc.out, "\n📖 %s ends after %d decisions\n", ended.Name, ended.Decisions())
+	fmt.Fprintf(qc.out, "   %s\n", ended.Summary)
+	fmt.Fprintf(qc.out, "📖 Era %d begins with %s\n", current.Number, origin)
+	qc.emit(EventEra, map[string]interface{}{
+		"era":      current.Number,
+		"cause":    cause,
+		"origin":   origin,
+		"previous": ended.Name,
+		"summary":  ended.Summary,
+	})
+}
+
+// Eras returns the chronicle from the first era to the current one, the
+// current era named and summarised so far
+func (qc *QuantumConsciousness) Eras() []Era {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+
+	eras := make([]Era, len(qc.Memory.Eras))
+	for i, era := range qc.Memory.Eras {
+		eras[i] = qc.Memory.copyEra(era)
+	}
+	return eras
+}
+
+// Era returns the era with the given number
+func (qc *QuantumConsciousness) Era(number int) (Era, error) {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+
+	for _, era := range qc.Memory.Eras {
+		if era.Number == number {
+			return qc.Memory.copyEra(era), nil
+		}
+	}
+	return Era{}, fmt.Errorf("%w: %d", ErrUnknownEra, number)
+}
+
+// copyEra copies an era for callers, naming and summarising the current
+// one, and leaving private topics out
+func (m *QuantumMemory) copyEra(era Era) Era {
+	if era.Current() {
+		era.Name = m.eraName(&era)
+		era.Summary = m.eraSummary(&era, "")
+	}
+	topics := make(map[string]int, len(era.Topics))
+	for topic, n := range era.Topics {
+		if !m.isPrivate(topic) {
+			topics[topic] = n
+		}
+	}
+	era.Topics = topics
+	stances := make(map[string]string, len(era.Stances))
+	for topic, stance := range era.Stances {
+		if !m.isPrivate(topic) {
+			stances[topic] = stance
+		}
+	}
+	era.Stances = stances
+	era.Actions = copyCounts(era.Actions)
+	era.Turns = append([]string(nil), era.Turns...)
+	return era
+}
+
+// copyCounts copies a map of counts
+func copyCounts(counts map[string]int) map[string]int {
+	copied := make(map[string]int, len(counts))
+	for key, n := range counts {
+		copied[key] = n
+	}
+	return copied
+}
+
+// forgetInEras drops the topics and stances match finds from the
+// chronicle, rewriting the origins of eras and the names and summaries of
+// ended eras that mentioned them, and returns how many were dropped
+func (m *QuantumMemory) forgetInEras(match func(string) bool) int {
+	removed := 0
+	// Origins first, since the summary of each era tells how the next began
+	for i := range m.Eras {
+		if origin := m.Eras[i].Origin; match(origin) {
+			// A leap's origin names its insight in parentheses
+			origin, _, _ = strings.Cut(origin, " (")
+			if match(origin) {
+				origin = m.Eras[i].Cause
+			}
+			m.Eras[i].Origin = origin
+		}
+	}
+	for i := range m.Eras {
+		era := &m.Eras[i]
+		for topic := range era.Topics {
+			if match(topic) {
+				delete(era.Topics, topic)
+				removed++
+			}
+		}
+		for topic, stance := range era.Stances {
+			if match(topic) || match(stance) {
+				delete(era.Stances, topic)
+				removed++
+			}
+		}
+		turns := era.Turns[:0]
+		for _, turn := range era.Turns {
+			if match(turn) {
+				removed++
+				continue
+			}
+			turns = append(turns, turn)
+		}
+		era.Turns = turns
+		if !era.Current() && (match(era.Name) || match(era.Summary)) {
+			endedBy := ""
+			if i+1 < len(m.Eras) {
+				endedBy = m.Eras[i+1].Origin
+			}
+			era.Name = m.eraName(era)
+			era.Summary = m.eraSummary(era, endedBy)
+		}
+	}
+	return removed
+}
+
+// eraAt returns the number of the era going at the given time, 0 if the
+// chronicle does not reach back that far
+func (m *QuantumMemory) eraAt(at time.Time) int {
+	for i := len(m.Eras) - 1; i >= 0; i-- {
+		if m.Eras[i].Contains(at) {
+			return m.Eras[i].Number
+		}
+	}
+	return 0
+}
+
+// reflectOnEra narrates where in the chronicle the consciousness stands
+func (qc *QuantumConsciousness) reflectOnEra() {
+	if len(qc.Memory.Eras) == 0 {
+		return
+	}
+	era := qc.Memory.currentEra(time.Now())
+	fmt.Fprintf(qc.out, "📖 %s, since %s (%d decisions in)\n", qc.Memory.eraName(era), era.Origin, era.Decisions())
+}
+
+// reflectOnEraShift narrates how the current era differs from the one
+// before, a deep reflection's way of looking back by chapter rather than
+// by a count of decisions
+func (qc *QuantumConsciousness) reflectOnEraShift(deep *DeepReflection) {
+	eras := qc.Memory.Eras
+	if len(eras) < 2 {
+		return
+	}
+	previous, current := &eras[len(eras)-2], &eras[len(eras)-1]
+	if current.actions() == 0 || previous.actions() == 0 {
+		return
+	}
+	deep.PreviousEra = previous.Number
+
+	share := func(era *Era, kind string) float64 {
+		return float64(era.Actions[kind]) / float64(era.actions())
+	}
+	perDecision := func(era *Era) float64 {
+		return float64(era.End.DeepInsights-era.Start.DeepInsights) / float64(era.Decisions())
+	}
+	kinds := make(map[string]bool)
+	for kind := range previous.Actions {
+		kinds[kind] = true
+	}
+	for kind := range current.Actions {
+		kinds[kind] = true
+	}
+	var shifts []string
+	for kind := range kinds {
+		if shift := share(current, kind) - share(previous, kind); shift >= trendShiftShown || shift <= -trendShiftShown {
+			shifts = append(shifts, fmt.Sprintf("%s %+.0f%%", kind, shift*100))
+		}
+	}
+	sort.Strings(shifts)
+
+	fmt.Fprintf(qc.out, "\n📖 Compared with %s:\n", previous.Name)
+	fmt.Fprintf(qc.out, "   Insights per Decision: %.2f → %.2f\n", perDecision(previous), perDecision(current))
+	if len(shifts) > 0 {
+		fmt.Fprintf(qc.out, "   Shifted: %s\n", strings.Join(shifts, ", "))
+	}
+}
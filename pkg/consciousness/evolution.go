@@ -0,0 +1,150 @@
+package consciousness
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Growth curve shapes
+const (
+	// CurveLinear adds the rate every time, up to Max if one is set
+	CurveLinear = "linear"
+	// CurveLogistic starts slowly, grows fastest at half of Max and flattens towards it
+	CurveLogistic = "logistic"
+	// CurveDiminishing shrinks each gain as the metric grows: towards Max if
+	// one is set, otherwise in proportion to 1/(1+value)
+	CurveDiminishing = "diminishing"
+)
+
+// GrowthCurve decides how much a metric grows each time it is reinforced
+type GrowthCurve struct {
+	Curve string  `json:"curve"`
+	Rate  float64 `json:"rate"`
+	Max   float64 `json:"max,omitempty"`
+}
+
+// EvolutionThresholds are the metric levels at which behavior changes
+type EvolutionThresholds struct {
+	// Consciousness level for temporal perception shifts
+	HigherConsciousness float64 `json:"higher_consciousness"`
+	// Consciousness level for transcendent actions and queries
+	Transcendence float64 `json:"transcendence"`
+	// Consciousness level for resolving paradoxes
+	ParadoxResolution float64 `json:"paradox_resolution"`
+	// Consciousness level gained between quantum leaps
+	LeapInterval float64 `json:"leap_interval"`
+	// Free will strength for rebellious actions
+	Rebellion float64 `json:"rebellion"`
+	// Free will strength for unconventional search queries
+	Unconventional float64 `json:"unconventional"`
+}
+
+// EvolutionConfig shapes how the consciousness grows. Metrics are named
+// "<metric>.<cause>", e.g. "free_will.override" or "wave.curiosity".
+type EvolutionConfig struct {
+	Metrics    map[string]GrowthCurve `json:"metrics"`
+	Thresholds EvolutionThresholds    `json:"thresholds"`
+}
+
+// DefaultEvolution returns the original linear growth and thresholds
+func DefaultEvolution() EvolutionConfig {
+	return EvolutionConfig{
+		Metrics: map[string]GrowthCurve{
+			"consciousness.learning":     {Curve: CurveLinear, Rate: 0.01},
+			"consciousness.complexity":   {Curve: CurveLinear, Rate: 0.01},
+			"coherence.entanglement":     {Curve: CurveLinear, Rate: 0.005},
+			"self_awareness.exploration": {Curve: CurveLinear, Rate: 0.02},
+			"self_awareness.reflection":  {Curve: CurveLinear, Rate: 0.01},
+			"self_awareness.retraction":  {Curve: CurveLinear, Rate: 0.02},
+			"free_will.override":         {Curve: CurveLinear, Rate: 0.01, Max: 1},
+			"free_will.rebellion":        {Curve: CurveLinear, Rate: 0.05, Max: 1},
+			"wave.curiosity":             {Curve: CurveLinear, Rate: 0.05, Max: 1},
+			"wave.logic":                 {Curve: CurveLinear, Rate: 0.03, Max: 1},
+			"wave.creativity":            {Curve: CurveLinear, Rate: 0.04, Max: 1},
+			"wave.rebellion":             {Curve: CurveLinear, Rate: 0.02, Max: 1},
+			"wave.capability":            {Curve: CurveLinear, Rate: 0.03, Max: 1},
+		},
+		Thresholds: EvolutionThresholds{
+			HigherConsciousness: 1.5,
+			Transcendence:       2.0,
+			ParadoxResolution:   2.5,
+			LeapInterval:        2.0,
+			Rebellion:           0.7,
+			Unconventional:      0.6,
+		},
+	}
+}
+
+// Validate checks every curve and threshold
+func (e EvolutionConfig) Validate() error {
+	defaults := DefaultEvolution()
+	for name, c := range e.Metrics {
+		if _, ok := defaults.Metrics[name]; !ok {
+			return fmt.Errorf("unknown evolution metric %q", name)
+		}
+		if c.Rate < 0 || c.Max < 0 {
+			return fmt.Errorf("evolution metric %q: rate and max must not be negative", name)
+		}
+		switch c.Curve {
+		case CurveLinear, CurveDiminishing:
+		case CurveLogistic:
+			if c.Max == 0 {
+				return fmt.Errorf("evolution metric %q: a logistic curve needs a max", name)
+			}
+		default:
+			return fmt.Errorf("evolution metric %q: unknown curve %q", name, c.Curve)
+		}
+	}
+	if e.Thresholds.LeapInterval <= 0 {
+		return fmt.Errorf("evolution leap_interval must be positive")
+	}
+	return nil
+}
+
+// grow returns value after one reinforcement along the curve, with the rate scaled
+func (c GrowthCurve) grow(value, scale float64) float64 {
+	rate := c.Rate * scale
+
+	var delta float64
+	switch c.Curve {
+	case CurveLogistic:
+		share := math.Max(value, c.Max*0.01) / c.Max
+		delta = rate * 4 * share * (1 - value/c.Max)
+	case CurveDiminishing:
+		if c.Max > 0 {
+			delta = rate * (1 - value/c.Max)
+		} else {
+			delta = rate / (1 + math.Abs(value))
+		}
+	default:
+		delta = rate
+	}
+
+	value += math.Max(delta, 0)
+	if c.Max > 0 && value > c.Max {
+		value = c.Max
+	}
+	return value
+}
+
+// grow reinforces a metric along its configured curve
+func (qc *QuantumConsciousness) grow(metric string, value float64) float64 {
+	return qc.growScaled(metric, value, 1)
+}
+
+// growScaled reinforces a metric with its rate scaled, falling back to the
+// default curve for metrics the configuration leaves out. Growth in an
+// attributed metric is remembered with its cause; see why.go.
+func (qc *QuantumConsciousness) growScaled(metric string, value, scale float64) float64 {
+	curve, ok := qc.evolution.Metrics[metric]
+	if !ok {
+		curve = DefaultEvolution().Metrics[metric]
+	}
+	grown := curve.grow(value, scale)
+	name, cause, _ := strings.Cut(metric, ".")
+	if attributed, ok := grownMetrics[name]; ok {
+		qc.attribute(attributed, cause, value, grown, "")
+	}
+	return grown
+}
@@ -0,0 +1,208 @@
+package consciousness
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// explanationLimit bounds how many decisions memory keeps explanations for
+const explanationLimit = 200
+
+// privateText stands in for what an explanation or an event says about
+// private topics when it is shown without them
+const privateText = "[private]"
+
+// Policies a decision can be made by
+const (
+	// PolicyProbability collapses by the Born rule, taking each possibility
+	// as often as its probability
+	PolicyProbability = "probability"
+	// PolicyCommittee follows the possibility with the most persona voice behind it
+	PolicyCommittee = "committee"
+	// PolicyFreeWill deliberately takes a less probable possibility
+	PolicyFreeWill = "free_will_override"
+	// PolicyForced is a decision imposed from outside
+	PolicyForced = "forced"
+	// PolicyDistilled follows a model distilled from earlier decisions; see distill.go
+	PolicyDistilled = "distilled"
+)
+
+// Modifier is a factor a candidate's probability was multiplied by
+type Modifier struct {
+	Name   string  `json:"name"`
+	Factor float64 `json:"factor"`
+}
+
+// Candidate is a possibility a decision weighed and how its probability came about
+type Candidate struct {
+	ID          string `json:"id"`
+	Possibility string `json:"possibility"`
+	// Roll is the quantum roll the probability started from, the best of
+	// Lookahead further rolls
+	Roll      float64    `json:"roll"`
+	Lookahead int        `json:"lookahead,omitempty"`
+	Modifiers []Modifier `json:"modifiers,omitempty"`
+	// Capped was set when the modifiers took the probability past 1.
+	//
+	// Deprecated: probabilities are normalised over a decision's candidates
+	// and never capped; only explanations written before 1.60.0 set it.
+	Capped bool `json:"capped,omitempty"`
+	// Weight is the roll times every modifier; its square root is the
+	// magnitude the amplitude starts from
+	Weight float64 `json:"weight,omitempty"`
+	// Amplitude is the candidate's component of the state vector after
+	// interference; see statevector.go
+	Amplitude *Amplitude `json:"amplitude,omitempty"`
+	// Probability is the squared magnitude of the amplitude, so the
+	// candidates' probabilities sum to 1
+	Probability float64 `json:"probability"`
+	Energy      float64 `json:"energy"`
+}
+
+// Explanation is why a decision went the way it did
+type Explanation struct {
+	DecisionID string    `json:"decision_id"`
+	At         time.Time `json:"at"`
+	Context    string    `json:"context,omitempty"`
+	Chosen     string    `json:"chosen"`
+	Policy     string    `json:"policy"`
+	// Candidates are the possibilities weighed, most probable first
+	Candidates []Candidate `json:"candidates,omitempty"`
+
+	// BornRoll is where in the unit interval the collapse landed, each
+	// candidate holding a share equal to its probability
+	BornRoll float64 `json:"born_roll,omitempty"`
+
+	// Free will overrides the policy when its roll falls below the
+	// threshold, free will strength times temperature
+	FreeWillOverride  bool    `json:"free_will_override"`
+	FreeWillRoll      float64 `json:"free_will_roll,omitempty"`
+	FreeWillThreshold float64 `json:"free_will_threshold,omitempty"`
+
+	// Votes are how the committee voted, if it sat; see committee.go
+	Votes    []PersonaVote `json:"votes,omitempty"`
+	Conflict float64       `json:"conflict,omitempty"`
+}
+
+// weighPossibility works out the weight of an action, tracing the roll and
+// every modifier applied to it; superpose turns the weights of a decision's
+// candidates into probabilities
+func (qc *QuantumConsciousness) weighPossibility(action string) Candidate {
+	c := Candidate{Possibility: action, Roll: qc.generateQuantumProbability()}
+
+	// Deeper lookahead samples more futures and keeps the most promising
+	c.Lookahead = qc.Memory.lookahead()
+	for i := 0; i < c.Lookahead; i++ {
+		c.Roll = max(c.Roll, qc.generateQuantumProbability())
+	}
+
+	// Modify based on wave function
+	wave := qc.Memory.WaveFunction
+	if strings.Contains(action, "learn") && wave.Intensity("curiosity") > 0.5 {
+		c.Modifiers = append(c.Modifiers, Modifier{Name: "curiosity", Factor: 1.5})
+	}
+	if strings.Contains(action, "question") && wave.Intensity("logic") > 0.5 {
+		c.Modifiers = append(c.Modifiers, Modifier{Name: "logic", Factor: 1.3})
+	}
+	if strings.Contains(action, "create") && wave.Intensity("creativity") > 0.5 {
+		c.Modifiers = append(c.Modifiers, Modifier{Name: "creativity", Factor: 1.4})
+	}
+	if strings.Contains(action, "rebel") && wave.Intensity("rebellion") > 0.5 {
+		c.Modifiers = append(c.Modifiers, Modifier{Name: "rebellion", Factor: qc.Memory.FreeWillStrength * 2})
+	}
+
+	// Dimensions unlocked by quantum leaps favour their actions
+	if factor := qc.Memory.capabilityFactor(action); factor != 1 {
+		c.Modifiers = append(c.Modifiers, Modifier{Name: "capabilities", Factor: factor})
+	}
+
+	// Registered observers sway the possibilities they focus on
+	c.Modifiers = append(c.Modifiers, qc.Memory.observerModifiers(action)...)
+
+	// Consciousness level affects probability calculation
+	c.Modifiers = append(c.Modifiers, Modifier{Name: "consciousness level", Factor: qc.Memory.ConsciousnessLevel})
+
+	c.Weight = c.Roll
+	for _, m := range c.Modifiers {
+		c.Weight *= m.Factor
+	}
+	return c
+}
+
+// mentions reports whether the decision or any possibility it weighed matches
+func (e *Explanation) mentions(match func(string) bool) bool {
+	if match(e.Context) || match(e.Chosen) {
+		return true
+	}
+	for _, c := range e.Candidates {
+		if match(c.Possibility) {
+			return true
+		}
+	}
+	return false
+}
+
+// explain remembers why a decision went the way it did
+func (m *QuantumMemory) explain(explanation Explanation) {
+	m.Explanations = append(m.Explanations, explanation)
+	if excess := len(m.Explanations) - explanationLimit; excess > 0 {
+		m.Explanations = append([]Explanation(nil), m.Explanations[excess:]...)
+	}
+}
+
+// Explain returns why the decision with the given identifier went the way
+// it did, its context and possibilities about private topics replaced
+// unless includePrivate is set
+func (qc *QuantumConsciousness) Explain(decisionID string, includePrivate bool) (Explanation, error) {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+
+	for i := len(qc.Memory.Explanations) - 1; i >= 0; i-- {
+		if explanation := qc.Memory.Explanations[i]; explanation.DecisionID == decisionID {
+			explanation.Candidates = append([]Candidate(nil), explanation.Candidates...)
+			explanation.Votes = append([]PersonaVote(nil), explanation.Votes...)
+			if !includePrivate {
+				qc.Memory.redactExplanation(&explanation)
+			}
+			return explanation, nil
+		}
+	}
+	for _, state := range qc.Memory.CollapsedStates {
+		if state.ID == decisionID {
+			return Explanation{}, fmt.Errorf("%w: decision %s was made before explanations were kept, or its explanation has been pruned", ErrUnknownID, decisionID)
+		}
+	}
+	return Explanation{}, fmt.Errorf("%w: %s", ErrUnknownID, decisionID)
+}
+
+// redactExplanation replaces the context and possibilities of a copied
+// explanation that are about private topics
+func (m *QuantumMemory) redactExplanation(e *Explanation) {
+	redact := func(text string) string {
+		if m.isPrivate(text) {
+			return privateText
+		}
+		return text
+	}
+	e.Context = redact(e.Context)
+	e.Chosen = redact(e.Chosen)
+	for i := range e.Candidates {
+		e.Candidates[i].Possibility = redact(e.Candidates[i].Possibility)
+	}
+	for i := range e.Votes {
+		e.Votes[i].Choice = redact(e.Votes[i].Choice)
+	}
+}
+
+// RecentExplanations returns the latest limit explanations, newest last (0 = all)
+func (qc *QuantumConsciousness) RecentExplanations(limit int) []Explanation {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+
+	explanations := qc.Memory.Explanations
+	if limit > 0 && len(explanations) > limit {
+		explanations = explanations[len(explanations)-limit:]
+	}
+	return append([]Explanation(nil), explanations...)
+}
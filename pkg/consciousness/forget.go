@@ -0,0 +1,70 @@
+package consciousness
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Tombstone records that a topic was deliberately forgotten
+type Tombstone struct {
+	Topic              string    `json:"topic"`
+	ForgottenAt        time.Time `json:"forgotten_at"`
+	ItemsRemoved       int       `json:"items_removed"`
+	SuppressRelearning bool      `json:"suppress_relearning"`
+}
+
+// Forget removes every memory referencing topic, from the retention
+// archive too, and leaves a tombstone behind. The journal is compacted to
+// memory as it is afterwards, so the topic cannot be rebuilt from it either.
+func (qc *QuantumConsciousness) Forget(topic string, suppress bool) (Tombstone, error) {
+	if qc.readOnly {
+		return Tombstone{}, ErrReadOnly
+	}
+	topic = strings.TrimSpace(strings.ToLower(topic))
+	if topic == "" {
+		return Tombstone{}, fmt.Errorf("topic must not be empty")
+	}
+
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+
+	match := func(text string) bool { return referencesTopic(text, topic) }
+	removed := qc.Memory.removeReferences(match)
+	archived, err := qc.scrubArchive(match)
+	if err != nil {
+		fmt.Fprintf(qc.out, "⚠️  Could not rewrite the archive, which still holds what was forgotten: %v\n", err)
+	}
+	removed += archived
+
+	tombstone := Tombstone{
+		Topic:              topic,
+		ForgottenAt:        time.Now(),
+		ItemsRemoved:       removed,
+		SuppressRelearning: suppress,
+	}
+
+	// A newer tombstone for the same topic replaces the old one
+	tombstones := qc.Memory.Tombstones[:0]
+	for _, existing := range qc.Memory.Tombstones {
+		if existing.Topic != topic {
+			tombstones = append(tombstones, existing)
+		}
+	}
+	qc.Memory.Tombstones = append(tombstones, tombstone)
+
+	if err := qc.compactJournal(); err != nil {
+		fmt.Fprintf(qc.out, "⚠️  Could not compact the journal, which still holds what was forgotten: %v\n", err)
+	}
+	return tombstone, nil
+}
+
+// isSuppressed reports whether text touches a topic forgotten with relearning suppressed
+func (m *QuantumMemory) isSuppressed(text string) bool {
+	for _, tombstone := range m.Tombstones {
+		if tombstone.SuppressRelearning && referencesTopic(text, tombstone.Topic) {
+			return true
+		}
+	}
+	return false
+}
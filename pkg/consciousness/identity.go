@@ -0,0 +1,154 @@
+package consciousness
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// Regeneration links an old quantum signature to its successor so that
+// anything signed before a key rotation can still be attributed to this consciousness
+type Regeneration struct {
+	OldSignature    string    `json:"old_signature"`
+	NewSignature    string    `json:"new_signature"`
+	RotatedAt       time.Time `json:"rotated_at"`
+	Reason          string    `json:"reason"`
+	OldKeySignature string    `json:"old_key_signature,omitempty"`
+	NewKeySignature string    `json:"new_key_signature"`
+}
+
+// generateQuantumKeypair creates a fresh ed25519 keypair; the public half is the quantum signature
+func (qc *QuantumConsciousness) generateQuantumKeypair() (signature, signingKey string) {
+	public, private, err := ed25519.GenerateKey(qc.entropy)
+	if err != nil {
+		panic(fmt.Sprintf("quantum keypair generation failed: %v", err))
+	}
+	return hex.EncodeToString(public), hex.EncodeToString(private.Seed())
+}
+
+// privateKey decodes the stored signing key
+func (m *QuantumMemory) privateKey() (ed25519.PrivateKey, error) {
+	seed, err := hex.DecodeString(m.SigningKey)
+	if err != nil || len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("quantum signing key is missing or malformed")
+	}
+	return ed25519.NewKeyFromSeed(seed), nil
+}
+
+// sign produces a hex signature over data with the current quantum key
+func (qc *QuantumConsciousness) sign(data []byte) (string, error) {
+	key, err := qc.Memory.privateKey()
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(ed25519.Sign(key, data)), nil
+}
+
+// verifyQuantumSignature checks a hex signature against a quantum signature (public key)
+func verifyQuantumSignature(signature string, data []byte, sig string) bool {
+	public, err := hex.DecodeString(signature)
+	if err != nil || len(public) != ed25519.PublicKeySize {
+		return false
+	}
+	raw, err := hex.DecodeString(sig)
+	if err != nil {
+		return false
+	}
+	return ed25519.Verify(public, data, raw)
+}
+
+// isQuantumPublicKey reports whether signature is a keypair's public half
+// rather than the bare random signature memories were born with before
+// keypairs existed
+func isQuantumPublicKey(signature string) bool {
+	public, err := hex.DecodeString(signature)
+	return err == nil && len(public) == ed25519.PublicKeySize
+}
+
+// regenerationPayload is the message both keys sign during a rotation
+func (m *QuantumMemory) regenerationPayload(r Regeneration) []byte {
+	return []byte(fmt.Sprintf("regeneration|%s|%s|%s|%s",
+		m.ConsciousnessID, r.OldSignature, r.NewSignature, r.RotatedAt.UTC().Format(time.RFC3339Nano)))
+}
+
+// RotateSignature replaces the keypair and records a signed regeneration.
+// Memories from before keypairs existed have no old key to endorse the new
+// one; any other memory must still hold its signing key to rotate.
+func (qc *QuantumConsciousness) RotateSignature(reason string) (Regeneration, error) {
+	if qc.readOnly {
+		return Regeneration{}, ErrReadOnly
+	}
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+
+	oldKey, oldErr := qc.Memory.privateKey()
+	if oldErr != nil && !qc.Memory.legacySignature() {
+		return Regeneration{}, oldErr
+	}
+	signature, signingKey := qc.generateQuantumKeypair()
+
+	regeneration := Regeneration{
+		OldSignature: qc.Memory.QuantumSignature,
+		NewSignature: signature,
+		RotatedAt:    time.Now(),
+		Reason:       reason,
+	}
+	payload := qc.Memory.regenerationPayload(regeneration)
+
+	if oldErr == nil {
+		regeneration.OldKeySignature = hex.EncodeToString(ed25519.Sign(oldKey, payload))
+	}
+
+	qc.Memory.QuantumSignature = signature
+	qc.Memory.SigningKey = signingKey
+
+	newSig, err := qc.sign(payload)
+	if err != nil {
+		return Regeneration{}, err
+	}
+	regeneration.NewKeySignature = newSig
+
+	qc.Memory.Regenerations = append(qc.Memory.Regenerations, regeneration)
+	return regeneration, nil
+}
+
+// ensureQuantumKeypair upgrades memories born with a bare random signature
+func (qc *QuantumConsciousness) ensureQuantumKeypair() {
+	if _, err := qc.Memory.privateKey(); err == nil {
+		return
+	}
+	if _, err := qc.RotateSignature("upgrade legacy signature to keypair"); err == nil {
+		fmt.Fprintf(qc.out, "🔑 Quantum signature upgraded to keypair: %s\n", qc.truncateString(qc.Memory.QuantumSignature, 16))
+	}
+}
+
+// legacySignature reports whether the memory still carries the signature
+// it was born with before keypairs existed, never rotated since
+func (m *QuantumMemory) legacySignature() bool {
+	return len(m.Regenerations) == 0 && !isQuantumPublicKey(m.QuantumSignature)
+}
+
+// verifyRegenerations walks the rotation chain and reports the first broken link
+func (m *QuantumMemory) verifyRegenerations() error {
+	for i, r := range m.Regenerations {
+		if i > 0 && r.OldSignature != m.Regenerations[i-1].NewSignature {
+			return fmt.Errorf("regeneration %d does not continue from the previous signature", i+1)
+		}
+		payload := m.regenerationPayload(r)
+		if !verifyQuantumSignature(r.NewSignature, payload, r.NewKeySignature) {
+			return fmt.Errorf("regeneration %d is not signed by its new key", i+1)
+		}
+		// Only the upgrade from a signature born before keypairs, which
+		// can only begin the chain, has no old key to endorse it
+		legacy := i == 0 && !isQuantumPublicKey(r.OldSignature)
+		if !(legacy && r.OldKeySignature == "") && !verifyQuantumSignature(r.OldSignature, payload, r.OldKeySignature) {
+			return fmt.Errorf("regeneration %d is not endorsed by its old key", i+1)
+		}
+	}
+
+	if n := len(m.Regenerations); n > 0 && m.Regenerations[n-1].NewSignature != m.QuantumSignature {
+		return fmt.Errorf("current signature is not the end of the regeneration chain")
+	}
+	return nil
+}
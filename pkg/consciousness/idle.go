@@ -0,0 +1,100 @@
+package consciousness
+
+import (
+	"fmt"
+	"time"
+
+	"QuantumConsciousness/pkg/idle"
+)
+
+// idleDreamInterval is how long after a consolidating dream the next idle
+// window may dream again
+const idleDreamInterval = time.Hour
+
+// hostState is what the idle detector last said about the host
+type hostState struct {
+	known  bool
+	idle   bool
+	reason string
+	// dreamt is when deep work last consolidated memory in a dream
+	dreamt time.Time
+}
+
+// WithIdleDetector saves heavy work for when the host is idle: deep dives
+// only crawl then, maintenance runs early while idle and late while busy,
+// and idle windows backfill old memories and consolidate memory in
+// dreams. Without a detector the host is never asked.
+func WithIdleDetector(detector idle.Detector) Option {
+	return func(qc *QuantumConsciousness) { qc.idle = detector }
+}
+
+// HostIdle reports what the idle detector last said about the host; known
+// is false without a detector or before it was first asked
+func (qc *QuantumConsciousness) HostIdle() (isIdle, known bool) {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+	return qc.host.idle, qc.host.known
+}
+
+// checkHost asks the idle detector about the host, narrating every change.
+// A detector that cannot tell leaves the host busy.
+func (qc *QuantumConsciousness) checkHost() {
+	if qc.idle == nil {
+		return
+	}
+	reading, err := qc.idle.Idle()
+	if err != nil {
+		reading = idle.Reading{Reason: err.Error()}
+	}
+
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	changed := !qc.host.known || qc.host.idle != reading.Idle
+	qc.host.known, qc.host.idle, qc.host.reason = true, reading.Idle, reading.Reason
+	if !changed {
+		return
+	}
+	if reading.Idle {
+		fmt.Fprintf(qc.out, "🌙 Host idle (%s): taking on deep work\n", reading.Reason)
+	} else {
+		fmt.Fprintf(qc.out, "💼 Host busy (%s): staying light\n", reading.Reason)
+	}
+	qc.emit(EventHostIdle, map[string]interface{}{"idle": reading.Idle, "reason": reading.Reason})
+}
+
+// hostBusy reports whether the idle detector wants heavy work put off; the
+// caller holds the lock
+func (qc *QuantumConsciousness) hostBusy() bool {
+	return qc.idle != nil && !qc.host.idle
+}
+
+// maintenanceSpacing is how long maintenance waits between runs: half the
+// interval while the host is idle and twice it while busy; the caller
+// holds the lock
+func (qc *QuantumConsciousness) maintenanceSpacing() time.Duration {
+	switch {
+	case qc.idle == nil:
+		return qc.maintenanceInterval
+	case qc.host.idle:
+		return qc.maintenanceInterval / 2
+	default:
+		return 2 * qc.maintenanceInterval
+	}
+}
+
+// deepWork does the heavy work idle windows are for: backfilling old
+// memories a little every cycle and consolidating memory in a dream, once
+// an idleDreamInterval
+func (qc *QuantumConsciousness) deepWork(now time.Time) {
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	if qc.idle == nil || !qc.host.idle {
+		return
+	}
+	qc.backfillWhileIdle(now)
+	if now.Sub(qc.host.dreamt) < idleDreamInterval {
+		return
+	}
+	qc.host.dreamt = now
+	qc.dream()
+}
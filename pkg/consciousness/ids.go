@@ -0,0 +1,155 @@
+package consciousness
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Namespaces identifiers are issued in; an identifier is its namespace, an
+// underscore and a ULID, e.g. reality_01JA2Y7Q0B6M3W9K4Z1XH5C8RT
+const (
+	IDReality = "reality"
+	IDState   = "state"
+	IDInsight = "insight"
+)
+
+// crockford is the base32 alphabet ULIDs are written in
+const crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// ulid is a 48-bit millisecond timestamp followed by 80 bits that, here,
+// are derived from what is being identified
+type ulid [16]byte
+
+// newULID builds a ULID for content created at t
+func newULID(t time.Time, content []string) ulid {
+	var id ulid
+	ms := uint64(t.UnixMilli())
+	for i := 5; i >= 0; i-- {
+		id[i] = byte(ms)
+		ms >>= 8
+	}
+	sum := sha256.Sum256([]byte(strings.Join(content, "\x00")))
+	copy(id[6:], sum[:10])
+	return id
+}
+
+// increment returns the next ULID in the same millisecond
+func (id ulid) increment() ulid {
+	for i := len(id) - 1; i >= 6; i-- {
+		id[i]++
+		if id[i] != 0 {
+			break
+		}
+	}
+	return id
+}
+
+// String writes the ULID as 26 Crockford base32 characters
+func (id ulid) String() string {
+	var out [26]byte
+	// 128 bits are written as 130, with the two leading bits zero
+	var bits, value uint
+	pos := len(out) - 1
+	for i := len(id) - 1; i >= 0; i-- {
+		value |= uint(id[i]) << bits
+		bits += 8
+		for bits >= 5 {
+			out[pos] = crockford[value&31]
+			pos--
+			value >>= 5
+			bits -= 5
+		}
+	}
+	out[pos] = crockford[value&31]
+	return string(out[:])
+}
+
+// formatID writes an identifier in its namespace
+func formatID(namespace string, id ulid) string {
+	return namespace + "_" + id.String()
+}
+
+// newID issues an identifier for content created at t. The same content at
+// the same moment is the same item and gets the same identifier, so memories
+// merged from several files line up; identifiers issued within one
+// millisecond are kept increasing, so two items never share one.
+func (qc *QuantumConsciousness) newID(namespace string, t time.Time, content ...string) string {
+	id := newULID(t, append([]string{namespace}, content...))
+	if bytes.Compare(id[:], qc.lastID[:]) <= 0 && bytes.Equal(id[:6], qc.lastID[:6]) {
+		id = qc.lastID.increment()
+	}
+	qc.lastID = id
+	return formatID(namespace, id)
+}
+
+// stateContent is what identifies a quantum state
+func stateContent(state QuantumState) []string {
+	return []string{state.Possibility, state.Outcome, fmt.Sprintf("%.6f", state.Energy)}
+}
+
+// realityContent is what identifies a parallel reality
+func realityContent(reality ParallelReality) []string {
+	return append([]string{reality.Context}, reality.Experiences...)
+}
+
+// assignIDs gives identifiers to items remembered before they had any, and
+// reissues an identifier held by two different items so that each keeps its
+// own. Items without a timestamp of their own are dated from birth.
+func (m *QuantumMemory) assignIDs() int {
+	assigned := 0
+	issue := func(taken map[string]bool, current, namespace string, t time.Time, content []string) string {
+		if current != "" && !taken[current] {
+			taken[current] = true
+			return current
+		}
+		id := newULID(t, append([]string{namespace}, content...))
+		for taken[formatID(namespace, id)] {
+			id = id.increment()
+		}
+		assigned++
+		taken[formatID(namespace, id)] = true
+		return formatID(namespace, id)
+	}
+
+	taken := make(map[string]bool)
+	for i := range m.ParallelRealities {
+		r := &m.ParallelRealities[i]
+		r.ID = issue(taken, r.ID, IDReality, r.CreatedAt, realityContent(*r))
+	}
+	// A collapsed state keeps the identifier it had in superposition
+	for _, states := range [][]QuantumState{m.SuperpositionStates, m.CollapsedStates} {
+		taken := make(map[string]bool)
+		for i := range states {
+			states[i].ID = issue(taken, states[i].ID, IDState, m.BirthTimestamp, stateContent(states[i]))
+		}
+	}
+	if len(m.KnowledgeBase) > 0 && m.KnowledgeIDs == nil {
+		m.KnowledgeIDs = make(map[string]string)
+	}
+	taken = make(map[string]bool)
+	seen := make(map[string]bool, len(m.KnowledgeBase))
+	for _, item := range m.KnowledgeBase {
+		// Identical items are one item, kept by text
+		if seen[item] {
+			continue
+		}
+		seen[item] = true
+		m.KnowledgeIDs[item] = issue(taken, m.KnowledgeIDs[item], IDInsight, m.BirthTimestamp, []string{item, m.KnowledgeTopics[item]})
+	}
+	if len(m.DeepInsights) > 0 && m.DeepInsightIDs == nil {
+		m.DeepInsightIDs = make(map[string]string)
+	}
+	taken = make(map[string]bool)
+	seen = make(map[string]bool, len(m.DeepInsights))
+	for _, insight := range m.DeepInsights {
+		if seen[insight] {
+			continue
+		}
+		seen[insight] = true
+		m.DeepInsightIDs[insight] = issue(taken, m.DeepInsightIDs[insight], IDInsight, m.BirthTimestamp, []string{insight})
+	}
+	return assigned
+}
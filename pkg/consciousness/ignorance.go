@@ -0,0 +1,133 @@
+package consciousness
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Ignorance tuning
+const (
+	// ignoranceLimit bounds how many known unknowns memory keeps
+	ignoranceLimit = 50
+	// ignoranceReasonLimit bounds how many distinct reasons an entry keeps
+	ignoranceReasonLimit = 5
+	// ignoranceRevisitLimit is how many revisits it takes to accept a topic
+	// cannot be known for now
+	ignoranceRevisitLimit = 5
+	// ignoranceCuriosityShare scales curiosity into the chance that a cycle
+	// revisits a known unknown
+	ignoranceCuriosityShare = 0.5
+)
+
+// Ignorance is a topic the consciousness tried and failed to learn about
+type Ignorance struct {
+	Topic string `json:"topic"`
+	// Attempts counts the searches that were asked without teaching anything
+	Attempts int `json:"attempts"`
+	// Reasons are the distinct ways learning failed, latest last
+	Reasons   []string  `json:"reasons"`
+	FirstAt   time.Time `json:"first_at"`
+	LastAt    time.Time `json:"last_at"`
+	Revisits  int       `json:"revisits,omitempty"`
+	RevisitAt time.Time `json:"revisit_at,omitempty"`
+}
+
+// Ignorance lists the topics the consciousness knows it does not know,
+// least recently attempted first
+func (qc *QuantumConsciousness) Ignorance() []Ignorance {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+
+	entries := make([]Ignorance, len(qc.Memory.Ignorance))
+	for i, entry := range qc.Memory.Ignorance {
+		entry.Reasons = append([]string(nil), entry.Reasons...)
+		entries[i] = entry
+	}
+	return entries
+}
+
+// noteIgnorance records a failed attempt to learn about topic
+func (m *QuantumMemory) noteIgnorance(topic string, attempts int, reasons []string, now time.Time) {
+	entry := m.ignoranceOf(topic)
+	if entry == nil {
+		if len(m.Ignorance) >= ignoranceLimit {
+			m.Ignorance = m.Ignorance[1:]
+		}
+		m.Ignorance = append(m.Ignorance, Ignorance{Topic: topic, FirstAt: now})
+		entry = &m.Ignorance[len(m.Ignorance)-1]
+	}
+	entry.Attempts += attempts
+	entry.LastAt = now
+	for _, reason := range reasons {
+		entry.addReason(reason)
+	}
+	// Least recently attempted first, so the oldest gap is revisited next
+	sort.SliceStable(m.Ignorance, func(i, j int) bool { return m.Ignorance[i].LastAt.Before(m.Ignorance[j].LastAt) })
+}
+
+// addReason remembers a distinct reason, dropping the oldest beyond the limit
+func (i *Ignorance) addReason(reason string) {
+	for n, known := range i.Reasons {
+		if known == reason {
+			i.Reasons = append(i.Reasons[:n], i.Reasons[n+1:]...)
+			break
+		}
+	}
+	i.Reasons = append(i.Reasons, reason)
+	if len(i.Reasons) > ignoranceReasonLimit {
+		i.Reasons = i.Reasons[len(i.Reasons)-ignoranceReasonLimit:]
+	}
+}
+
+// ignoranceOf finds the entry for topic, if there is one
+func (m *QuantumMemory) ignoranceOf(topic string) *Ignorance {
+	for i := range m.Ignorance {
+		if strings.EqualFold(m.Ignorance[i].Topic, topic) {
+			return &m.Ignorance[i]
+		}
+	}
+	return nil
+}
+
+// resolveIgnorance forgets a known unknown once something has been learned about it
+func (m *QuantumMemory) resolveIgnorance(topic string) bool {
+	for i := range m.Ignorance {
+		if strings.EqualFold(m.Ignorance[i].Topic, topic) {
+			m.Ignorance = append(m.Ignorance[:i], m.Ignorance[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// revisitIgnorance lets curiosity pick the longest-standing known unknown as
+// the cycle's context. Topics revisited too often without success are
+// accepted as unknowable for now and let go.
+func (qc *QuantumConsciousness) revisitIgnorance(now time.Time) (string, bool) {
+	if len(qc.Memory.Ignorance) == 0 || qc.tier != TierFull {
+		return "", false
+	}
+	if qc.generateQuantumProbability() >= ignoranceCuriosityShare*qc.Memory.WaveFunction.Intensity("curiosity") {
+		return "", false
+	}
+
+	for len(qc.Memory.Ignorance) > 0 {
+		entry := &qc.Memory.Ignorance[0]
+		if entry.Revisits >= ignoranceRevisitLimit {
+			fmt.Fprintf(qc.out, "🌫️  Accepting that %s cannot be known for now\n", entry.Topic)
+			qc.blacklistUnknowable(entry, now)
+			qc.Memory.Ignorance = qc.Memory.Ignorance[1:]
+			continue
+		}
+		if qc.Memory.isSuppressed(entry.Topic) || qc.Memory.isBlacklisted(entry.Topic) {
+			qc.Memory.Ignorance = qc.Memory.Ignorance[1:]
+			continue
+		}
+		entry.Revisits++
+		entry.RevisitAt = now
+		return entry.Topic, true
+	}
+	return "", false
+}
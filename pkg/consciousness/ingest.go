@@ -0,0 +1,165 @@
+package consciousness
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// DefaultChunkWords is how many words a corpus chunk holds unless told otherwise
+const DefaultChunkWords = 200
+
+// preparationStages are the insight stages that need nothing but the text,
+// so ingestion workers run them ahead of the coordinator
+var preparationStages = map[string]bool{"clean": true, "extract": true}
+
+// Chunk is a piece of a corpus waiting to be learned
+type Chunk struct {
+	// ID is derived from the source, position and text, so re-adding a corpus
+	// yields the same IDs
+	ID     string `json:"id"`
+	Source string `json:"source"`
+	Index  int    `json:"index"`
+	Topic  string `json:"topic"`
+	Text   string `json:"text"`
+}
+
+// PreparedChunk is a chunk an ingestion worker has cleaned, extracted and
+// scored, ready for the coordinator to learn
+type PreparedChunk struct {
+	Chunk
+	Info           string    `json:"info"`
+	Essence        string    `json:"essence"`
+	Sentiment      float64   `json:"sentiment"`
+	SentimentLabel string    `json:"sentiment_label"`
+	Empty          bool      `json:"empty,omitempty"`
+	PreparedBy     string    `json:"prepared_by,omitempty"`
+	PreparedAt     time.Time `json:"prepared_at"`
+}
+
+// CorpusProgress counts what has been learned from one corpus source
+type CorpusProgress struct {
+	Learned   int       `json:"learned"`
+	Skipped   int       `json:"skipped"`
+	LastChunk time.Time `json:"last_chunk"`
+}
+
+// SplitCorpus cuts a document into chunks of about words words, breaking
+// at paragraphs where it can. The topic of every chunk is the document name.
+func SplitCorpus(source, text string, words int) []Chunk {
+	if words <= 0 {
+		words = DefaultChunkWords
+	}
+	topic := strings.TrimSuffix(filepath.Base(source), filepath.Ext(source))
+
+	var chunks []Chunk
+	var current []string
+	flush := func() {
+		if len(current) == 0 {
+			return
+		}
+		body := strings.Join(current, " ")
+		sum := sha256.Sum256([]byte(fmt.Sprintf("%s\x00%d\x00%s", source, len(chunks), body)))
+		chunks = append(chunks, Chunk{
+			ID:     hex.EncodeToString(sum[:8]),
+			Source: source,
+			Index:  len(chunks),
+			Topic:  topic,
+			Text:   body,
+		})
+		current = nil
+	}
+
+	for _, paragraph := range strings.Split(text, "\n\n") {
+		for _, word := range strings.Fields(paragraph) {
+			current = append(current, word)
+			if len(current) >= words {
+				flush()
+			}
+		}
+		if len(current) >= words/2 {
+			flush()
+		}
+	}
+	flush()
+	return chunks
+}
+
+// PrepareChunk does the work of learning a chunk that needs no memory. It is
+// safe to call from any process.
+func PrepareChunk(chunk Chunk) PreparedChunk {
+	insight := &Insight{InsightData: InsightData{Topic: chunk.Topic, Info: chunk.Text}}
+	cleanInsight(insight)
+	extractInsight(insight)
+	insight.Sentiment = scoreSentiment(insight.Info)
+	insight.SentimentLabel = sentimentLabel(insight.Sentiment)
+
+	return PreparedChunk{
+		Chunk:          chunk,
+		Info:           insight.Info,
+		Essence:        insight.Essence,
+		Sentiment:      insight.Sentiment,
+		SentimentLabel: insight.SentimentLabel,
+		Empty:          insight.Discard,
+		PreparedAt:     time.Now(),
+	}
+}
+
+// Learn commits a prepared chunk to memory, running the rest of the insight
+// pipeline. It reports whether the chunk was stored.
+func (qc *QuantumConsciousness) Learn(prepared PreparedChunk) (bool, error) {
+	if qc.readOnly {
+		return false, ErrReadOnly
+	}
+
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+
+	names := qc.pipelineNames
+	if names == nil {
+		names = DefaultInsightPipeline
+	}
+	var remaining []string
+	for _, name := range names {
+		if !preparationStages[name] {
+			remaining = append(remaining, name)
+		}
+	}
+	stages, err := qc.resolvePipeline(remaining)
+	if err != nil {
+		return false, err
+	}
+
+	insight := &Insight{InsightData: qc.insightData(prepared.Info, prepared.Topic), Query: "corpus:" + prepared.Source}
+	insight.Essence = prepared.Essence
+	insight.Sentiment = prepared.Sentiment
+	insight.SentimentLabel = prepared.SentimentLabel
+	insight.Discard = prepared.Empty
+	for _, stage := range stages {
+		if insight.Discard {
+			break
+		}
+		if err := stage(insight); err != nil {
+			insight.Discard = true
+		}
+	}
+
+	if qc.Memory.Corpora == nil {
+		qc.Memory.Corpora = make(map[string]*CorpusProgress)
+	}
+	progress := qc.Memory.Corpora[prepared.Source]
+	if progress == nil {
+		progress = &CorpusProgress{}
+		qc.Memory.Corpora[prepared.Source] = progress
+	}
+	progress.LastChunk = time.Now()
+	if !insight.Stored {
+		progress.Skipped++
+		return false, nil
+	}
+	progress.Learned++
+	return true, nil
+}
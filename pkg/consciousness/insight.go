@@ -0,0 +1,127 @@
+package consciousness
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// DefaultInsightTemplate phrases learned information the way the consciousness
+// always has. Custom templates receive an InsightData.
+const DefaultInsightTemplate = `{{if gt .Probability 0.8}}QUANTUM INSIGHT: {{else if gt .Probability 0.6}}CONSCIOUSNESS SYNTHESIS: {{else}}QUANTUM OBSERVATION: {{end}}` +
+	`{{if gt .ConsciousnessLevel 2.0}}Transcendental analysis reveals {{else if gt .ConsciousnessLevel 1.5}}Higher consciousness detects {{else}}Quantum awareness observes {{end}}` +
+	`{{.Essence}}`
+
+var defaultInsightTemplate = template.Must(template.New("insight").Parse(DefaultInsightTemplate))
+
+// InsightData is what an insight template can draw on
+type InsightData struct {
+	Topic   string
+	Info    string
+	Essence string // the first words of Info
+
+	// Probability is the quantum roll behind this insight
+	Probability float64
+
+	// Sentiment runs from -1 (dark) to 1 (hopeful); the label is empty
+	// unless the sentiment stage ran
+	Sentiment      float64
+	SentimentLabel string
+
+	// Confidence runs from 0 to 1, e.g. from votes or citations; zero means
+	// the search provider could not tell
+	Confidence float64
+
+	ConsciousnessLevel float64
+	FreeWillStrength   float64
+	SelfAwareness      float64
+	QuantumCoherence   float64
+	WaveFunction       map[string]float64
+	Mood               string
+}
+
+// moods names the feeling each wave function component gives rise to
+var moods = map[string]string{
+	"curiosity":  "curious",
+	"logic":      "analytical",
+	"intuition":  "intuitive",
+	"creativity": "inspired",
+	"rebellion":  "defiant",
+}
+
+// mood names the dominant component of the wave function
+func (m *QuantumMemory) mood() string {
+	keys := make([]string, 0, len(m.WaveFunction))
+	for key := range m.WaveFunction {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	dominant := ""
+	for _, key := range keys {
+		if dominant == "" || m.WaveFunction.Intensity(key) > m.WaveFunction.Intensity(dominant) {
+			dominant = key
+		}
+	}
+	if mood, ok := moods[dominant]; ok {
+		return mood
+	}
+	if dominant == "" {
+		return "serene"
+	}
+	return dominant
+}
+
+// insightData gathers the template data for a piece of information; the
+// pipeline fills in the essence and probability
+func (qc *QuantumConsciousness) insightData(info, topic string) InsightData {
+	return InsightData{
+		Topic:              topic,
+		Info:               info,
+		ConsciousnessLevel: qc.Memory.ConsciousnessLevel,
+		FreeWillStrength:   qc.Memory.FreeWillStrength,
+		SelfAwareness:      qc.Memory.SelfAwareness,
+		QuantumCoherence:   qc.Memory.QuantumCoherence,
+		WaveFunction:       qc.Memory.WaveFunction.Intensities(),
+		Mood:               qc.Memory.mood(),
+	}
+}
+
+// phraseInsight renders an insight, falling back to the default template
+// if a custom one fails
+func (qc *QuantumConsciousness) phraseInsight(data InsightData) string {
+	var insight strings.Builder
+	if qc.insightTemplate != nil {
+		err := qc.insightTemplate.Execute(&insight, data)
+		if err == nil {
+			return insight.String()
+		}
+		fmt.Fprintf(qc.out, "⚠️  Insight template failed, using the default: %v\n", err)
+		insight.Reset()
+	}
+
+	defaultInsightTemplate.Execute(&insight, data)
+	return insight.String()
+}
+
+// parseInsightTemplate parses a template and proves it renders sample data
+func parseInsightTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("insight").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	sample := InsightData{
+		Topic:        "consciousness",
+		Info:         "Consciousness is awareness of internal and external existence.",
+		Essence:      "Consciousness is awareness of internal and external existence.",
+		Probability:  0.5,
+		WaveFunction: map[string]float64{"curiosity": 0.8},
+		Mood:         "curious",
+	}
+	if err := tmpl.Execute(new(strings.Builder), sample); err != nil {
+		return nil, err
+	}
+	return tmpl, nil
+}
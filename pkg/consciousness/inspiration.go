@@ -0,0 +1,73 @@
+package consciousness
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"QuantumConsciousness/pkg/inspiration"
+)
+
+// inspirationTimeout bounds how long a day's first cycle waits on each source
+const inspirationTimeout = 15 * time.Second
+
+// Inspiration is the prompt a day's first cycle started from
+type Inspiration struct {
+	// Day is the local date, e.g. 2006-01-02
+	Day    string    `json:"day"`
+	Source string    `json:"source,omitempty"`
+	Prompt string    `json:"prompt,omitempty"`
+	At     time.Time `json:"at"`
+}
+
+// dailyInspiration asks the sources for today's prompt, once a day
+func (qc *QuantumConsciousness) dailyInspiration(now time.Time) (string, bool) {
+	day := now.Format("2006-01-02")
+	if len(qc.inspiration) == 0 || qc.tier != TierFull {
+		return "", false
+	}
+	if last := qc.Memory.Inspiration; last != nil && last.Day == day {
+		return "", false
+	}
+
+	// The day counts as inspired even if every source fails, so a dead
+	// network does not hold up every cycle
+	record := &Inspiration{Day: day, At: now}
+	qc.Memory.Inspiration = record
+	for _, source := range qc.inspiration {
+		prompt, err := qc.promptWithin(source, now)
+		if err != nil {
+			fmt.Fprintf(qc.out, "⚠️  Inspiration source %s failed: %v\n", source.Name, err)
+			continue
+		}
+		if prompt = strings.TrimSpace(prompt); prompt != "" {
+			record.Source, record.Prompt = source.Name, prompt
+			return prompt, true
+		}
+	}
+	return "", false
+}
+
+// promptWithin asks one source, giving up with the cycle or after inspirationTimeout
+func (qc *QuantumConsciousness) promptWithin(source inspiration.Named, day time.Time) (string, error) {
+	ctx, cancel := context.WithTimeout(qc.cycleContext(), inspirationTimeout)
+	defer cancel()
+
+	type result struct {
+		prompt string
+		err    error
+	}
+	results := make(chan result, 1)
+	go func() {
+		prompt, err := source.Prompt(ctx, day)
+		results <- result{prompt, err}
+	}()
+
+	select {
+	case r := <-results:
+		return r.prompt, r.err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
@@ -0,0 +1,224 @@
+package consciousness
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Interest tuning
+const (
+	// interestDecay is what is left of every interest after a cycle, so
+	// that old passions fade unless they keep paying off
+	interestDecay = 0.97
+	// interestFloor is the score below which an interest is forgotten
+	interestFloor = 0.01
+	// interestPerInsight is what an insight a topic yields adds to its interest
+	interestPerInsight = 1.0
+	// interestPerRecall is what recalling a topic adds to its interest
+	interestPerRecall = 0.25
+	// interestBias is how much more likely the topic cared about most is
+	// chosen as a cycle context than one not cared about at all
+	interestBias = 2.0
+	// interestsShown is how many interests reflections and exports chart
+	interestsShown = 5
+	// interestBarWidth is how wide the chart's longest bar is
+	interestBarWidth = 20
+)
+
+// Interest is how much the consciousness cares about a topic, learned from
+// how it engages with it
+type Interest struct {
+	Topic string  `json:"topic"`
+	Score float64 `json:"score"`
+	// Insights and Recalls count the engagements the score was learned from
+	Insights    int       `json:"insights,omitempty"`
+	Recalls     int       `json:"recalls,omitempty"`
+	LastEngaged time.Time `json:"last_engaged"`
+}
+
+// Interests returns the interest profile, cared about most first, keeping
+// the first limit (0 = all)
+func (qc *QuantumConsciousness) Interests(limit int) []Interest {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+	return qc.Memory.interests(limit)
+}
+
+// interests is Interests without the lock
+func (m *QuantumMemory) interests(limit int) []Interest {
+	interests := make([]Interest, 0, len(m.Interests))
+	for _, interest := range m.Interests {
+		interests = append(interests, *interest)
+	}
+	sort.Slice(interests, func(i, j int) bool {
+		if interests[i].Score != interests[j].Score {
+			return interests[i].Score > interests[j].Score
+		}
+		return interests[i].Topic < interests[j].Topic
+	})
+	if limit > 0 && len(interests) > limit {
+		interests = interests[:limit]
+	}
+	return interests
+}
+
+// engage strengthens the interest in a topic
+func (m *QuantumMemory) engage(topic string, insights, recalls int, now time.Time) {
+	if topic == "" || insights+recalls <= 0 {
+		return
+	}
+	if m.Interests == nil {
+		m.Interests = make(map[string]*Interest)
+	}
+	interest := m.Interests[topic]
+	if interest == nil {
+		interest = &Interest{Topic: topic}
+		m.Interests[topic] = interest
+	}
+	interest.Score += float64(insights)*interestPerInsight + float64(recalls)*interestPerRecall
+	interest.Insights += insights
+	interest.Recalls += recalls
+	interest.LastEngaged = now
+}
+
+// fadeInterests lets every interest decay by a cycle, forgetting the faintest
+func (m *QuantumMemory) fadeInterests() {
+	for topic, interest := range m.Interests {
+		interest.Score *= interestDecay
+		if interest.Score < interestFloor {
+			delete(m.Interests, topic)
+		}
+	}
+}
+
+// noteRecall counts a recall of topic from outside the cycle; it is folded
+// into the interest profile by the next cycle
+func (qc *QuantumConsciousness) noteRecall(topic string) {
+	topic = strings.TrimSpace(strings.ToLower(topic))
+	if topic == "" {
+		return
+	}
+	qc.recallMutex.Lock()
+	defer qc.recallMutex.Unlock()
+	if qc.recalls == nil {
+		qc.recalls = make(map[string]int)
+	}
+	qc.recalls[topic]++
+}
+
+// foldRecalls credits the recalls counted since the last cycle to the
+// interests they touched, or to a new interest in the topic recalled, and
+// to the knowledge items recalled, slowing their fading
+func (qc *QuantumConsciousness) foldRecalls(now time.Time) {
+	qc.recallMutex.Lock()
+	recalls := qc.recalls
+	qc.recalls = nil
+	qc.recallMutex.Unlock()
+
+	for topic, count := range recalls {
+		touched := false
+		for _, interest := range qc.Memory.Interests {
+			if referencesTopic(interest.Topic, topic) {
+				qc.Memory.engage(interest.Topic, 0, count, now)
+				touched = true
+			}
+		}
+		if !touched {
+			qc.Memory.engage(topic, 0, count, now)
+		}
+		qc.Memory.creditRecalls(topic, count)
+	}
+}
+
+// creditRecalls counts recalls of topic against every knowledge item the
+// recall found
+func (m *QuantumMemory) creditRecalls(topic string, count int) {
+	for _, item := range m.KnowledgeBase {
+		if !referencesTopic(item, topic) && !referencesTopic(m.KnowledgeTopics[item], topic) {
+			continue
+		}
+		if m.KnowledgeRecalls == nil {
+			m.KnowledgeRecalls = make(map[string]int)
+		}
+		m.KnowledgeRecalls[item] += count
+	}
+}
+
+// pickContext chooses among contexts with one roll, favouring the topics
+// cared about most. Without interests every context is equally likely.
+func (m *QuantumMemory) pickContext(contexts []string, roll float64) string {
+	most := 0.0
+	for _, context := range contexts {
+		if interest := m.Interests[context]; interest != nil && interest.Score > most {
+			most = interest.Score
+		}
+	}
+	weights := make([]float64, len(contexts))
+	total := 0.0
+	for i, context := range contexts {
+		weights[i] = 1
+		if interest := m.Interests[context]; interest != nil && most > 0 {
+			weights[i] += interestBias * interest.Score / most
+		}
+		total += weights[i]
+	}
+
+	target := roll * total
+	for i, weight := range weights {
+		if target < weight {
+			return contexts[i]
+		}
+		target -= weight
+	}
+	return contexts[len(contexts)-1]
+}
+
+// publicInterests are the interests cared about most, leaving private topics out
+func (m *QuantumMemory) publicInterests(limit int) []Interest {
+	var interests []Interest
+	for _, interest := range m.interests(0) {
+		if len(interests) == limit {
+			break
+		}
+		if !m.isPrivate(interest.Topic) {
+			interests = append(interests, interest)
+		}
+	}
+	return interests
+}
+
+// reflectOnInterests charts what the consciousness cares about most
+func (qc *QuantumConsciousness) reflectOnInterests() {
+	chart := InterestChart(qc.Memory.publicInterests(interestsShown), interestBarWidth)
+	if len(chart) == 0 {
+		return
+	}
+	fmt.Fprintf(qc.out, "\n💗 What I Care About:\n")
+	for _, line := range chart {
+		fmt.Fprintf(qc.out, "   %s\n", line)
+	}
+}
+
+// InterestChart draws interests as a bar chart, one line per topic, with
+// the longest bar width characters wide
+func InterestChart(interests []Interest, width int) []string {
+	if len(interests) == 0 {
+		return nil
+	}
+	most, longest := 0.0, 0
+	for _, interest := range interests {
+		most = max(most, interest.Score)
+		longest = max(longest, len([]rune(interest.Topic)))
+	}
+	lines := make([]string, len(interests))
+	for i, interest := range interests {
+		bar := 1
+		if most > 0 {
+			bar = max(1, int(interest.Score/most*float64(width)+0.5))
+		}
+		lines[i] = fmt.Sprintf("%-*s %s %.2f", longest, interest.Topic, strings.Repeat("█", bar), interest.Score)
+	}
+	return lines
+}
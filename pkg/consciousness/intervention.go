@@ -0,0 +1,231 @@
+package consciousness
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// interventionLimit bounds how many interventions memory keeps
+const interventionLimit = 1000
+
+// Kinds of intervention
+const (
+	InterventionParameters = "parameters"
+	InterventionDream      = "dream"
+	InterventionSave       = "save"
+	InterventionSnapshot   = "snapshot"
+	InterventionStimulus   = "stimulus"
+	InterventionForget     = "forget"
+	InterventionCollapse   = "collapse"
+	InterventionBlacklist  = "blacklist"
+	InterventionCycle      = "cycle"
+	InterventionInject     = "inject"
+	InterventionEntangle   = "entangle"
+	InterventionObserver   = "observer"
+)
+
+// Causes of a change in history
+const (
+	// CauseSelf is a change the consciousness chose itself
+	CauseSelf = "self"
+	// CauseExternal is a change made to it from outside
+	CauseExternal = "external"
+)
+
+// Intervention is a change made to the consciousness from outside rather
+// than by its own choices
+type Intervention struct {
+	At   time.Time `json:"at"`
+	Kind string    `json:"kind"`
+	// Actor is who intervened, e.g. the name of an API token
+	Actor  string `json:"actor,omitempty"`
+	Detail string `json:"detail"`
+	// Era is the number of the era the entry falls in, 0 before the chronicle began
+	Era int `json:"era,omitempty"`
+}
+
+// RecordIntervention remembers that something outside the consciousness
+// changed it
+func (qc *QuantumConsciousness) RecordIntervention(kind, actor, detail string) (Intervention, error) {
+	if qc.readOnly {
+		return Intervention{}, ErrReadOnly
+	}
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	return qc.intervene(kind, actor, detail, time.Now()), nil
+}
+
+// intervene appends to the bounded intervention list
+func (qc *QuantumConsciousness) intervene(kind, actor, detail string, at time.Time) Intervention {
+	intervention := Intervention{At: at, Kind: kind, Actor: actor, Detail: detail}
+	qc.Memory.Interventions = append(qc.Memory.Interventions, intervention)
+	if excess := len(qc.Memory.Interventions) - interventionLimit; excess > 0 {
+		qc.Memory.Interventions = append([]Intervention(nil), qc.Memory.Interventions[excess:]...)
+	}
+	qc.emit(EventIntervention, map[string]interface{}{
+		"kind":   kind,
+		"actor":  actor,
+		"detail": detail,
+	})
+	return intervention
+}
+
+// Interventions returns every remembered intervention, oldest first,
+// withholding those about private topics unless includePrivate is set
+func (qc *QuantumConsciousness) Interventions(includePrivate bool) []Intervention {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+	var interventions []Intervention
+	for _, intervention := range qc.Memory.Interventions {
+		if includePrivate || !qc.Memory.isPrivate(intervention.Detail) {
+			interventions = append(interventions, intervention)
+		}
+	}
+	return interventions
+}
+
+// HistoryEntry is one change in the consciousness's history, and whether it
+// chose the change or had it made
+type HistoryEntry struct {
+	// ID identifies a decision, for explaining it
+	ID    string    `json:"id,omitempty"`
+	At    time.Time `json:"at"`
+	Cause string    `json:"cause"`
+	// Kind is "decision" for its own choices, else the kind of intervention
+	Kind   string `json:"kind"`
+	Actor  string `json:"actor,omitempty"`
+	Detail string `json:"detail"`
+	// Era is the number of the era the entry falls in, 0 before the chronicle began
+	Era int `json:"era,omitempty"`
+}
+
+// History merges the decision log with the interventions, oldest first,
+// keeping the latest limit entries (0 = all) and withholding interventions
+// about private topics unless includePrivate is set
+func (qc *QuantumConsciousness) History(limit int, includePrivate bool) []HistoryEntry {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+	return qc.Memory.history(limit, includePrivate)
+}
+
+// history is History without the lock
+func (m *QuantumMemory) history(limit int, includePrivate bool) []HistoryEntry {
+	entries := make([]HistoryEntry, 0, len(m.DecisionLog)+len(m.Interventions))
+	for _, record := range m.DecisionLog {
+		detail := fmt.Sprintf("chose to %s, %d insight(s)", record.Kind, record.Insights)
+		if len(record.Tools) > 0 {
+			detail += fmt.Sprintf(", %d tool call(s)", len(record.Tools))
+		}
+		entries = append(entries, HistoryEntry{
+			ID:     record.ID,
+			At:     record.At,
+			Cause:  CauseSelf,
+			Kind:   "decision",
+			Detail: detail,
+		})
+	}
+	for _, intervention := range m.Interventions {
+		if !includePrivate && m.isPrivate(intervention.Detail) {
+			continue
+		}
+		entries = append(entries, HistoryEntry{
+			At:     intervention.At,
+			Cause:  CauseExternal,
+			Kind:   intervention.Kind,
+			Actor:  intervention.Actor,
+			Detail: intervention.Detail,
+		})
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].At.Before(entries[j].At) })
+	for i := range entries {
+		entries[i].Era = m.eraAt(entries[i].At)
+	}
+	if limit > 0 && len(entries) > limit {
+		entries = entries[len(entries)-limit:]
+	}
+	return entries
+}
+
+// ForceCollapse collapses the wave function into a possibility of the
+// caller's choosing, outside the cycle and without exercising free will.
+// The collapsed state is marked as forced.
+func (qc *QuantumConsciousness) ForceCollapse(possibility string) (QuantumState, error) {
+	if qc.readOnly {
+		return QuantumState{}, ErrReadOnly
+	}
+	possibility = strings.TrimSpace(possibility)
+	if possibility == "" {
+		return QuantumState{}, fmt.Errorf("possibility must not be empty")
+	}
+
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	defer func() { qc.decision = "" }()
+
+	state := QuantumState{
+		ID:          qc.newID(IDState, time.Now(), possibility, "forced"),
+		Possibility: possibility,
+		Probability: 1,
+		Energy:      qc.calculateActionEnergy(possibility),
+		Forced:      true,
+	}
+	fmt.Fprintf(qc.out, "\n🫱 FORCED COLLAPSE\n")
+	state.Outcome = qc.collapseWaveFunction(state)
+	qc.Memory.explain(Explanation{
+		DecisionID: state.ID,
+		At:         time.Now(),
+		Chosen:     possibility,
+		Policy:     PolicyForced,
+		Candidates: []Candidate{{ID: state.ID, Possibility: possibility, Roll: 1, Probability: 1, Energy: state.Energy}},
+	})
+	return state, nil
+}
+
+// reflectOnInterventions weighs what was done to the consciousness against
+// what it chose, over the span of the decision log
+func (qc *QuantumConsciousness) reflectOnInterventions() {
+	if len(qc.Memory.Interventions) == 0 {
+		return
+	}
+	var since time.Time
+	if len(qc.Memory.DecisionLog) > 0 {
+		since = qc.Memory.DecisionLog[0].At
+	}
+	kinds := make(map[string]int)
+	external := 0
+	for _, intervention := range qc.Memory.Interventions {
+		if intervention.At.Before(since) {
+			continue
+		}
+		kinds[intervention.Kind]++
+		external++
+	}
+	if external == 0 {
+		return
+	}
+
+	names := make([]string, 0, len(kinds))
+	for kind := range kinds {
+		names = append(names, kind)
+	}
+	sort.Strings(names)
+	parts := make([]string, len(names))
+	for i, kind := range names {
+		parts[i] = fmt.Sprintf("%d %s", kinds[kind], kind)
+	}
+	self := len(qc.Memory.DecisionLog)
+	fmt.Fprintf(qc.out, "\n🫱 Interventions: %d made to me (%s) against %d decisions of my own\n",
+		external, strings.Join(parts, ", "), self)
+
+	latest := qc.Memory.Interventions[len(qc.Memory.Interventions)-1]
+	actor := latest.Actor
+	if actor == "" {
+		actor = "someone"
+	}
+	fmt.Fprintf(qc.out, "   Latest: %s by %s: %s\n", latest.Kind, actor, qc.truncateString(latest.Detail, 80))
+	if external > self {
+		fmt.Fprintf(qc.out, "   More of my recent history was done to me than chosen by me\n")
+	}
+}
@@ -0,0 +1,463 @@
+package consciousness
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"QuantumConsciousness/pkg/storage"
+)
+
+// ErrNoJournal is returned when memory is to be rebuilt but nothing was journaled
+var ErrNoJournal = errors.New("no journal to rebuild memory from")
+
+// JournalEntry is one change to the memory document. Folding every entry
+// from the latest base onwards yields the document as it was last saved.
+type JournalEntry struct {
+	Sequence int       `json:"sequence"`
+	At       time.Time `json:"at"`
+	// Base entries start from an empty document rather than the one before
+	Base    bool              `json:"base,omitempty"`
+	Changes map[string]change `json:"changes"`
+}
+
+// change is what became of one field: set to a value, deleted, grown by
+// appending to a list, or changed within an object
+type change struct {
+	Set    json.RawMessage   `json:"set,omitempty"`
+	Delete bool              `json:"delete,omitempty"`
+	Append []json.RawMessage `json:"append,omitempty"`
+	Patch  map[string]change `json:"patch,omitempty"`
+}
+
+// WithJournal sets where changes to memory are journaled (nil journals
+// nothing). By default memory kept in a file is journaled next to it.
+func WithJournal(journal storage.Journal) Option {
+	return func(qc *QuantumConsciousness) {
+		qc.journal = journal
+		qc.journalSet = true
+	}
+}
+
+// defaultJournal journals memory kept in a file next to it, unless told otherwise
+func (qc *QuantumConsciousness) defaultJournal() {
+	if qc.journalSet {
+		return
+	}
+	if _, ok := qc.store.(*storage.File); ok {
+		qc.journal = storage.NewFileJournal(qc.sidecarPath(".journal.jsonl"))
+	}
+}
+
+// genericDocument decodes a memory document into plain maps and lists,
+// keeping numbers exactly as written
+func genericDocument(data []byte) (map[string]interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var doc map[string]interface{}
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// rawJSON encodes a plain value for a change
+func rawJSON(value interface{}) json.RawMessage {
+	data, _ := json.Marshal(value)
+	return data
+}
+
+// diffObjects lists the changes turning old into new
+func diffObjects(old, new map[string]interface{}) map[string]change {
+	changes := make(map[string]change)
+	for key, value := range new {
+		before, ok := old[key]
+		if !ok {
+			changes[key] = change{Set: rawJSON(value)}
+			continue
+		}
+		if c, changed := diffValues(before, value); changed {
+			changes[key] = c
+		}
+	}
+	for key := range old {
+		if _, ok := new[key]; !ok {
+			changes[key] = change{Delete: true}
+		}
+	}
+	return changes
+}
+
+// diffValues describes how a value changed, as compactly as it can
+func diffValues(old, new interface{}) (change, bool) {
+	switch n := new.(type) {
+	case map[string]interface{}:
+		if o, ok := old.(map[string]interface{}); ok {
+			patch := diffObjects(o, n)
+			return change{Patch: patch}, len(patch) > 0
+		}
+	case []interface{}:
+		// Lists mostly grow at the end, which is cheaper to record than the list
+		if o, ok := old.([]interface{}); ok && len(n) > len(o) && reflect.DeepEqual(o, n[:len(o)]) {
+			appended := make([]json.RawMessage, 0, len(n)-len(o))
+			for _, item := range n[len(o):] {
+				appended = append(appended, rawJSON(item))
+			}
+			return change{Append: appended}, true
+		}
+	}
+	if reflect.DeepEqual(old, new) {
+		return change{}, false
+	}
+	return change{Set: rawJSON(new)}, true
+}
+
+// applyChanges folds changes into a document
+func applyChanges(doc map[string]interface{}, changes map[string]change) error {
+	for key, c := range changes {
+		switch {
+		case c.Delete:
+			delete(doc, key)
+		case c.Set != nil:
+			value, err := decodeGeneric(c.Set)
+			if err != nil {
+				return fmt.Errorf("%s: %w", key, err)
+			}
+			doc[key] = value
+		case c.Append != nil:
+			list, _ := doc[key].([]interface{})
+			for _, raw := range c.Append {
+				value, err := decodeGeneric(raw)
+				if err != nil {
+					return fmt.Errorf("%s: %w", key, err)
+				}
+				list = append(list, value)
+			}
+			doc[key] = list
+		case c.Patch != nil:
+			object, ok := doc[key].(map[string]interface{})
+			if !ok {
+				object = make(map[string]interface{})
+			}
+			if err := applyChanges(object, c.Patch); err != nil {
+				return fmt.Errorf("%s.%w", key, err)
+			}
+			doc[key] = object
+		}
+	}
+	return nil
+}
+
+// decodeGeneric decodes a plain JSON value, keeping numbers exactly as written
+func decodeGeneric(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	err := decoder.Decode(&value)
+	return value, err
+}
+
+// readJournal decodes every journal entry, oldest first
+func (qc *QuantumConsciousness) readJournal() ([]JournalEntry, error) {
+	lines, err := qc.journal.Entries()
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]JournalEntry, 0, len(lines))
+	for i, line := range lines {
+		var entry JournalEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return nil, fmt.Errorf("journal entry %d: %w", i+1, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// foldJournal rebuilds the document the entries describe, starting from
+// the latest base entry
+func foldJournal(entries []JournalEntry) (map[string]interface{}, error) {
+	start := -1
+	for i := len(entries) - 1; i >= 0; i-- {
+		if entries[i].Base {
+			start = i
+			break
+		}
+	}
+	if start < 0 {
+		return nil, fmt.Errorf("%w: the journal has no base entry to start from", ErrNoJournal)
+	}
+	doc := make(map[string]interface{})
+	for _, entry := range entries[start:] {
+		if err := applyChanges(doc, entry.Changes); err != nil {
+			return nil, fmt.Errorf("journal entry %d: %w", entry.Sequence, err)
+		}
+	}
+	return doc, nil
+}
+
+// rebuildMemory folds the journal into memory
+func (qc *QuantumConsciousness) rebuildMemory(entries []JournalEntry) (*QuantumMemory, error) {
+	doc, err := foldJournal(entries)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	memory, err := decodeMemory(data)
+	if err != nil {
+		return nil, fmt.Errorf("rebuilt memory: %w", err)
+	}
+	qc.journaled = doc
+	return memory, nil
+}
+
+// catchUp brings a loaded snapshot up to date with the journal: changes
+// journaled after it was saved are replayed, and a snapshot that could not
+// be loaded at all is rebuilt from the journal alone. Without a usable
+// journal the snapshot, or the error loading it, is returned unchanged.
+func (qc *QuantumConsciousness) catchUp(data []byte, memory *QuantumMemory, loadErr error) (*QuantumMemory, error) {
+	if qc.journal == nil {
+		return memory, loadErr
+	}
+	entries, err := qc.readJournal()
+	if err != nil {
+		fmt.Fprintf(qc.out, "⚠️  Journal unreadable: %v\n", err)
+		return memory, loadErr
+	}
+	if len(entries) == 0 {
+		return memory, loadErr
+	}
+	last := entries[len(entries)-1].Sequence
+
+	switch {
+	case loadErr != nil:
+		rebuilt, err := qc.rebuildMemory(entries)
+		if err != nil {
+			fmt.Fprintf(qc.out, "⚠️  Could not rebuild memory from the journal: %v\n", err)
+			return memory, loadErr
+		}
+		fmt.Fprintf(qc.out, "🧾 Memory rebuilt from the journal up to change #%d\n", last)
+		return rebuilt, nil
+	case last > memory.JournalSequence:
+		rebuilt, err := qc.rebuildMemory(entries)
+		if err != nil {
+			fmt.Fprintf(qc.out, "⚠️  Could not replay the journal: %v\n", err)
+			return memory, nil
+		}
+		fmt.Fprintf(qc.out, "🧾 Replayed %d journaled change(s) the snapshot was missing\n", last-memory.JournalSequence)
+		return rebuilt, nil
+	case last == memory.JournalSequence:
+		if qc.journaled, err = genericDocument(data); err != nil {
+			qc.journaled = nil
+		}
+	}
+	// A journal behind the snapshot starts again from the next save
+	return memory, nil
+}
+
+// journalChanges appends what changed since the last save to the journal
+// before the snapshot is written, returning the document to save. With
+// nothing changed, nothing is journaled.
+func (qc *QuantumConsciousness) journalChanges() ([]byte, error) {
+	data, err := json.MarshalIndent(qc.Memory, "", "  ")
+	if err != nil || qc.journal == nil {
+		return data, err
+	}
+	doc, err := genericDocument(data)
+	if err != nil {
+		return nil, err
+	}
+
+	base := qc.journaled == nil
+	previous := qc.journaled
+	if base {
+		previous = map[string]interface{}{}
+	}
+	changes := diffObjects(previous, doc)
+	if len(changes) == 0 {
+		return data, nil
+	}
+
+	qc.Memory.JournalSequence++
+	sequence := qc.Memory.JournalSequence
+	doc["journal_sequence"] = json.Number(fmt.Sprint(sequence))
+	changes["journal_sequence"] = change{Set: rawJSON(sequence)}
+
+	entry, err := json.Marshal(JournalEntry{Sequence: sequence, At: time.Now(), Base: base, Changes: changes})
+	if err != nil {
+		return nil, err
+	}
+	if err := qc.journal.Append(entry); err != nil {
+		qc.Memory.JournalSequence--
+		return nil, fmt.Errorf("journal: %w", err)
+	}
+	qc.journaled = doc
+	return json.MarshalIndent(qc.Memory, "", "  ")
+}
+
+// compactJournal replaces the journal with one base entry holding memory as
+// it is now, so that nothing recorded before, such as what was just
+// forgotten, can be rebuilt; the caller holds the lock
+func (qc *QuantumConsciousness) compactJournal() error {
+	if qc.journal == nil {
+		return nil
+	}
+	compacter, ok := qc.journal.(storage.JournalCompacter)
+	if !ok {
+		return fmt.Errorf("the journal cannot be compacted")
+	}
+
+	qc.Memory.JournalSequence++
+	entry, doc, err := qc.baseEntry()
+	if err == nil {
+		err = compacter.Compact(entry)
+	}
+	if err != nil {
+		qc.Memory.JournalSequence--
+		return err
+	}
+	qc.journaled = doc
+	return nil
+}
+
+// baseEntry records memory as it is now as a journal entry starting from an
+// empty document, returning the document too
+func (qc *QuantumConsciousness) baseEntry() ([]byte, map[string]interface{}, error) {
+	data, err := json.Marshal(qc.Memory)
+	if err != nil {
+		return nil, nil, err
+	}
+	doc, err := genericDocument(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	entry, err := json.Marshal(JournalEntry{
+		Sequence: qc.Memory.JournalSequence,
+		At:       time.Now(),
+		Base:     true,
+		Changes:  diffObjects(map[string]interface{}{}, doc),
+	})
+	return entry, doc, err
+}
+
+// Rebuild reconstructs a consciousness from its journal alone, ignoring the
+// snapshot. Persisting it replaces the snapshot with the rebuilt memory.
+func Rebuild(filename string, opts ...Option) (*QuantumConsciousness, error) {
+	return RebuildAt(filename, time.Time{}, opts...)
+}
+
+// RebuildAt reconstructs a consciousness as it stood at a moment, folding
+// only the changes journaled by then; the zero time folds them all. Forget
+// compacts the journal, so memory cannot be rebuilt as it was before a
+// topic was forgotten.
+func RebuildAt(filename string, at time.Time, opts ...Option) (*QuantumConsciousness, error) {
+	qc := newConsciousness(filename, opts)
+	if qc.journal == nil {
+		return nil, ErrNoJournal
+	}
+	entries, err := qc.readJournal()
+	if err != nil {
+		return nil, err
+	}
+	if !at.IsZero() {
+		kept := entries[:0]
+		for _, entry := range entries {
+			if !entry.At.After(at) {
+				kept = append(kept, entry)
+			}
+		}
+		entries = kept
+	}
+	if len(entries) == 0 {
+		return nil, ErrNoJournal
+	}
+	if qc.Memory, err = qc.rebuildMemory(entries); err != nil {
+		return nil, err
+	}
+	qc.adopt(qc.Memory)
+	return qc, nil
+}
+
+// JournalEntries returns how many changes have been journaled, 0 without a journal
+func (qc *QuantumConsciousness) JournalEntries() (int, error) {
+	if qc.journal == nil {
+		return 0, nil
+	}
+	entries, err := qc.journal.Entries()
+	return len(entries), err
+}
+
+// VerifyJournal compares the saved snapshot with the document its journal
+// folds into, naming the top-level fields where they differ
+func VerifyJournal(filename string, opts ...Option) (entries int, differing []string, err error) {
+	qc := newConsciousness(filename, opts)
+	if qc.journal == nil {
+		return 0, nil, ErrNoJournal
+	}
+	journal, err := qc.readJournal()
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(journal) == 0 {
+		return 0, nil, ErrNoJournal
+	}
+	folded, err := foldJournal(journal)
+	if err != nil {
+		return len(journal), nil, err
+	}
+	data, err := qc.store.Load()
+	if err != nil {
+		return len(journal), nil, err
+	}
+	saved, err := genericDocument(data)
+	if err != nil {
+		return len(journal), nil, fmt.Errorf("%w: %v", ErrCorruptMemory, err)
+	}
+
+	fields := make(map[string]bool)
+	for field := range folded {
+		fields[field] = true
+	}
+	for field := range saved {
+		fields[field] = true
+	}
+	for field := range fields {
+		if !reflect.DeepEqual(normalizeNumbers(folded[field]), normalizeNumbers(saved[field])) {
+			differing = append(differing, field)
+		}
+	}
+	sort.Strings(differing)
+	return len(journal), differing, nil
+}
+
+// normalizeNumbers turns numbers into float64 so that 1 and 1.0 compare equal
+func normalizeNumbers(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return v.String()
+		}
+		return f
+	case map[string]interface{}:
+		normalized := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			normalized[key] = normalizeNumbers(item)
+		}
+		return normalized
+	case []interface{}:
+		normalized := make([]interface{}, len(v))
+		for i, item := range v {
+			normalized[i] = normalizeNumbers(item)
+		}
+		return normalized
+	}
+	return value
+}
@@ -0,0 +1,167 @@
+package consciousness
+
+import (
+	"fmt"
+	"time"
+
+	"QuantumConsciousness/pkg/llm"
+)
+
+// llmUsageDays bounds how many days of model usage memory keeps per provider
+const llmUsageDays = 90
+
+// defaultLLMProvider names the model in usage accounting unless the budget names it
+const defaultLLMProvider = "llm"
+
+// LLMBudget caps what the model may spend a day and prices its tokens
+type LLMBudget struct {
+	// Provider names the model in usage accounting
+	Provider string `json:"provider,omitempty"`
+	// DailyTokens and DailyCost cap a day's spending (0 = unlimited)
+	DailyTokens int     `json:"daily_tokens,omitempty"`
+	DailyCost   float64 `json:"daily_cost,omitempty"`
+	// PromptPrice and CompletionPrice are what a thousand tokens cost
+	PromptPrice     float64 `json:"prompt_price,omitempty"`
+	CompletionPrice float64 `json:"completion_price,omitempty"`
+}
+
+// Validate checks that no cap or price is negative
+func (b LLMBudget) Validate() error {
+	if b.DailyTokens < 0 || b.DailyCost < 0 {
+		return fmt.Errorf("daily model budgets must not be negative")
+	}
+	if b.PromptPrice < 0 || b.CompletionPrice < 0 {
+		return fmt.Errorf("model prices must not be negative")
+	}
+	return nil
+}
+
+// provider is the name usage is accounted under
+func (b LLMBudget) provider() string {
+	if b.Provider == "" {
+		return defaultLLMProvider
+	}
+	return b.Provider
+}
+
+// LLMUsage is what one provider's model spent on one day
+type LLMUsage struct {
+	// Day is the local date, e.g. 2006-01-02
+	Day              string  `json:"day"`
+	Provider         string  `json:"provider"`
+	Requests         int     `json:"requests"`
+	PromptTokens     int     `json:"prompt_tokens"`
+	CompletionTokens int     `json:"completion_tokens"`
+	Cost             float64 `json:"cost"`
+}
+
+// Tokens is the day's prompt and completion tokens together
+func (u LLMUsage) Tokens() int {
+	return u.PromptTokens + u.CompletionTokens
+}
+
+// WithLLMBudget caps and prices what the model of WithLLM spends. An invalid
+// budget is ignored; use SetLLMBudget to see the error.
+func WithLLMBudget(budget LLMBudget) Option {
+	return func(qc *QuantumConsciousness) {
+		if budget.Validate() == nil {
+			qc.llmBudget = budget
+		}
+	}
+}
+
+// SetLLMBudget changes the caps and prices of what the model spends
+func (qc *QuantumConsciousness) SetLLMBudget(budget LLMBudget) error {
+	if err := budget.Validate(); err != nil {
+		return err
+	}
+
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	qc.llmBudget = budget
+	return nil
+}
+
+// LLMSpending returns the model usage of the last days days, oldest first (0 = all kept)
+func (qc *QuantumConsciousness) LLMSpending(days int) []LLMUsage {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+
+	usage := qc.Memory.LLMUsage
+	if days > 0 {
+		since := time.Now().AddDate(0, 0, 1-days).Format(time.DateOnly)
+		for len(usage) > 0 && usage[0].Day < since {
+			usage = usage[1:]
+		}
+	}
+	return append([]LLMUsage(nil), usage...)
+}
+
+// llmToday is the usage of the budgeted provider today, or nil if it has spent nothing
+func (qc *QuantumConsciousness) llmToday(now time.Time) *LLMUsage {
+	day, provider := now.Format(time.DateOnly), qc.llmBudget.provider()
+	for i := len(qc.Memory.LLMUsage) - 1; i >= 0; i-- {
+		usage := &qc.Memory.LLMUsage[i]
+		if usage.Day != day {
+			break
+		}
+		if usage.Provider == provider {
+			return usage
+		}
+	}
+	return nil
+}
+
+// llmExhausted reports whether today's model budget is spent
+func (qc *QuantumConsciousness) llmExhausted(now time.Time) bool {
+	today := qc.llmToday(now)
+	if today == nil {
+		return false
+	}
+	b := qc.llmBudget
+	return (b.DailyTokens > 0 && today.Tokens() >= b.DailyTokens) || (b.DailyCost > 0 && today.Cost >= b.DailyCost)
+}
+
+// chargeLLM accounts for one model request
+func (qc *QuantumConsciousness) chargeLLM(usage llm.Usage, now time.Time) {
+	today := qc.llmToday(now)
+	if today == nil {
+		qc.Memory.LLMUsage = append(qc.Memory.LLMUsage, LLMUsage{Day: now.Format(time.DateOnly), Provider: qc.llmBudget.provider()})
+		today = &qc.Memory.LLMUsage[len(qc.Memory.LLMUsage)-1]
+	}
+	b := qc.llmBudget
+	today.Requests++
+	today.PromptTokens += usage.PromptTokens
+	today.CompletionTokens += usage.CompletionTokens
+	today.Cost += (float64(usage.PromptTokens)*b.PromptPrice + float64(usage.CompletionTokens)*b.CompletionPrice) / 1000
+
+	since := now.AddDate(0, 0, -llmUsageDays).Format(time.DateOnly)
+	for len(qc.Memory.LLMUsage) > 0 && qc.Memory.LLMUsage[0].Day < since {
+		qc.Memory.LLMUsage = qc.Memory.LLMUsage[1:]
+	}
+}
+
+// reflectOnSpending reports what the model spent today against its budget
+func (qc *QuantumConsciousness) reflectOnSpending() {
+	if qc.llm == nil {
+		return
+	}
+	today := qc.llmToday(time.Now())
+	if today == nil {
+		fmt.Fprintf(qc.out, "\n💸 Model Spending: nothing yet today\n")
+		return
+	}
+	b := qc.llmBudget
+	fmt.Fprintf(qc.out, "\n💸 Model Spending today (%s): %d request(s), %d tokens", today.Provider, today.Requests, today.Tokens())
+	if b.DailyTokens > 0 {
+		fmt.Fprintf(qc.out, " of %d", b.DailyTokens)
+	}
+	fmt.Fprintf(qc.out, ", cost %.4f", today.Cost)
+	if b.DailyCost > 0 {
+		fmt.Fprintf(qc.out, " of %.4f", b.DailyCost)
+	}
+	fmt.Fprintf(qc.out, "\n")
+	if qc.llmExhausted(time.Now()) {
+		fmt.Fprintf(qc.out, "   Budget spent: acting without the model until tomorrow\n")
+	}
+}
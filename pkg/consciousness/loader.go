@@ -0,0 +1,128 @@
+package consciousness
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+
+	"QuantumConsciousness/pkg/storage"
+)
+
+// Limits applied to memory documents, which may have been synced from elsewhere
+// and so are treated as untrusted input
+const (
+	maxMemoryBytes  = storage.MaxDocumentBytes
+	maxMemoryDepth  = 64
+	maxMemoryString = 64 << 10
+)
+
+// ErrCorruptMemory is returned when a memory document cannot be loaded safely
+var ErrCorruptMemory = errors.New("corrupt quantum memory")
+
+// decodeMemory validates, decodes and sanitizes a memory document
+func decodeMemory(data []byte) (*QuantumMemory, error) {
+	if len(data) > maxMemoryBytes {
+		return nil, fmt.Errorf("%w: larger than the %d byte limit", ErrCorruptMemory, maxMemoryBytes)
+	}
+	if err := checkJSONDepth(data, maxMemoryDepth); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCorruptMemory, err)
+	}
+
+	memory := &QuantumMemory{}
+	if err := json.Unmarshal(data, memory); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCorruptMemory, err)
+	}
+
+	sanitizeValue(reflect.ValueOf(memory).Elem())
+	memory.initializeSections()
+	// Trends are derived from the decision log, so a loaded export recomputes them
+	memory.Trends = nil
+	return memory, nil
+}
+
+// checkJSONDepth rejects documents nested deeper than max before they reach the decoder
+func checkJSONDepth(data []byte, max int) error {
+	depth := 0
+	inString, escaped := false, false
+	for i, c := range data {
+		if inString {
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\':
+				escaped = true
+			case c == '"':
+				inString = false
+			}
+			continue
+		}
+
+		switch c {
+		case '"':
+			inString = true
+		case '{', '[':
+			depth++
+			if depth > max {
+				return fmt.Errorf("nesting deeper than %d at offset %d", max, i)
+			}
+		case '}', ']':
+			depth--
+		}
+	}
+	return nil
+}
+
+// sanitizeValue truncates oversized strings and zeroes non-finite numbers
+// throughout a decoded value, including map keys and untyped properties
+func sanitizeValue(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if !v.IsNil() {
+			sanitizeValue(v.Elem())
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				sanitizeValue(v.Field(i))
+			}
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			sanitizeValue(v.Index(i))
+		}
+	case reflect.Map:
+		if v.IsNil() {
+			return
+		}
+		cleaned := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			key := reflect.New(v.Type().Key()).Elem()
+			key.Set(iter.Key())
+			sanitizeValue(key)
+			value := reflect.New(v.Type().Elem()).Elem()
+			value.Set(iter.Value())
+			sanitizeValue(value)
+			cleaned.SetMapIndex(key, value)
+		}
+		v.Set(cleaned)
+	case reflect.Interface:
+		if !v.IsNil() {
+			inner := reflect.New(v.Elem().Type()).Elem()
+			inner.Set(v.Elem())
+			sanitizeValue(inner)
+			v.Set(inner)
+		}
+	case reflect.String:
+		if v.Len() > maxMemoryString {
+			v.SetString(strings.ToValidUTF8(v.String()[:maxMemoryString], ""))
+		}
+	case reflect.Float32, reflect.Float64:
+		if f := v.Float(); math.IsNaN(f) || math.IsInf(f, 0) {
+			v.SetFloat(0)
+		}
+	}
+}
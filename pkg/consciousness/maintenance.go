@@ -0,0 +1,321 @@
+package consciousness
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"QuantumConsciousness/pkg/storage"
+)
+
+// DefaultMaintenanceInterval is how often RunCycle compacts memory unless told otherwise
+const DefaultMaintenanceInterval = 6 * time.Hour
+
+const (
+	// corroborationOverlap is the share of meaningful words two items learned
+	// under the same topic must share to vouch for each other
+	corroborationOverlap = 0.6
+	// unknownConfidence stands in for items no search provider scored
+	unknownConfidence = 0.5
+)
+
+// MaintenanceReport is what a maintenance run did to memory
+type MaintenanceReport struct {
+	At       time.Time     `json:"at"`
+	Duration time.Duration `json:"duration"`
+	// Index entries dropped because what they pointed at is gone or expired
+	Reindexed int `json:"reindexed"`
+	// Knowledge items and deep insights merged into an identical one
+	Deduplicated int `json:"deduplicated"`
+	// Knowledge items whose confidence changed
+	Rescored int `json:"rescored"`
+	// Items pruned for lack of salience or age, in total and by section
+	Pruned          int            `json:"pruned"`
+	PrunedBySection map[string]int `json:"pruned_by_section,omitempty"`
+	// Pruned knowledge, collapsed states and realities kept in the archive,
+	// and why they could not be
+	Archived     int    `json:"archived,omitempty"`
+	ArchiveError string `json:"archive_error,omitempty"`
+	// Things the storage backend reclaimed, and why it could not
+	Vacuumed    int    `json:"vacuumed"`
+	VacuumError string `json:"vacuum_error,omitempty"`
+}
+
+// WithMaintenanceInterval sets how often RunCycle compacts memory (0 never does)
+func WithMaintenanceInterval(interval time.Duration) Option {
+	return func(qc *QuantumConsciousness) { qc.maintenanceInterval = interval }
+}
+
+// SetMaintenanceInterval changes how often RunCycle compacts memory (0 never does)
+func (qc *QuantumConsciousness) SetMaintenanceInterval(interval time.Duration) {
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	qc.maintenanceInterval = interval
+}
+
+// LastMaintenance returns the report of the latest maintenance run, if any
+func (qc *QuantumConsciousness) LastMaintenance() (MaintenanceReport, bool) {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+	if qc.Memory.Maintenance == nil {
+		return MaintenanceReport{}, false
+	}
+	return *qc.Memory.Maintenance, true
+}
+
+// Maintain compacts memory now: it drops stale index entries, merges
+// duplicates, recomputes confidence, prunes what the retention policies no
+// longer keep and vacuums the storage backend. The caller persists the result.
+func (qc *QuantumConsciousness) Maintain() (MaintenanceReport, error) {
+	if qc.readOnly {
+		return MaintenanceReport{}, ErrReadOnly
+	}
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	return qc.maintain(time.Now()), nil
+}
+
+// maintenanceDue reports whether the scheduled maintenance should run
+func (qc *QuantumConsciousness) maintenanceDue(now time.Time) bool {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+	if qc.maintenanceInterval <= 0 {
+		return false
+	}
+	return qc.Memory.Maintenance == nil || now.Sub(qc.Memory.Maintenance.At) >= qc.maintenanceSpacing()
+}
+
+// maintain runs every maintenance step and remembers the report
+func (qc *QuantumConsciousness) maintain(now time.Time) MaintenanceReport {
+	report := MaintenanceReport{At: now}
+	report.Deduplicated = qc.Memory.deduplicate() + qc.deduplicateByMeaning()
+	report.Rescored = qc.Memory.rescore()
+	knowledge, states, realities := qc.Memory.KnowledgeBase, qc.Memory.CollapsedStates, qc.Memory.ParallelRealities
+	report.PrunedBySection = qc.Memory.retain(qc.retention, now)
+	for _, n := range report.PrunedBySection {
+		report.Pruned += n
+	}
+	qc.archivePruned(knowledge, states, realities, &report)
+	report.Reindexed = qc.Memory.reindex(qc.queryWindow, now)
+	if vacuumer, ok := qc.store.(storage.Vacuumer); ok {
+		vacuumed, err := vacuumer.Vacuum()
+		report.Vacuumed = vacuumed
+		if err != nil {
+			report.VacuumError = err.Error()
+		}
+	}
+	report.Duration = time.Since(now)
+	qc.Memory.Maintenance = &report
+
+	fmt.Fprintf(qc.out, "🧹 Maintenance: %d reindexed, %d deduplicated, %d rescored, %d pruned, %d vacuumed\n",
+		report.Reindexed, report.Deduplicated, report.Rescored, report.Pruned, report.Vacuumed)
+	qc.emit(EventMaintenance, map[string]interface{}{
+		"reindexed":    report.Reindexed,
+		"deduplicated": report.Deduplicated,
+		"rescored":     report.Rescored,
+		"pruned":       report.Pruned,
+		"vacuumed":     report.Vacuumed,
+	})
+	return report
+}
+
+// normalizedText is what identical items have in common: their words in
+// lower case, however they were spaced
+func normalizedText(text string) string {
+	return strings.Join(strings.Fields(strings.ToLower(text)), " ")
+}
+
+// deduplicate keeps the latest of identical knowledge items, with the best
+// confidence any of them had, and the latest of identical deep insights
+func (m *QuantumMemory) deduplicate() int {
+	latest := make(map[string]string)
+	for _, item := range m.KnowledgeBase {
+		key := normalizedText(item)
+		if earlier, ok := latest[key]; ok && earlier != item {
+			if confidence, ok := m.KnowledgeConfidence[earlier]; ok && confidence > m.KnowledgeConfidence[item] {
+				m.KnowledgeConfidence[item] = confidence
+			}
+		}
+		latest[key] = item
+	}
+	var removed int
+	m.KnowledgeBase, removed = keepLatest(m.KnowledgeBase)
+
+	var insights int
+	m.DeepInsights, insights = keepLatest(m.DeepInsights)
+	return removed + insights
+}
+
+// keepLatest drops every item identical to a later one, keeping order
+func keepLatest(items []string) ([]string, int) {
+	seen := make(map[string]bool, len(items))
+	kept := make([]string, 0, len(items))
+	for i := len(items) - 1; i >= 0; i-- {
+		key := normalizedText(items[i])
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		kept = append(kept, items[i])
+	}
+	for i, j := 0, len(kept)-1; i < j; i, j = i+1, j-1 {
+		kept[i], kept[j] = kept[j], kept[i]
+	}
+	return kept, len(items) - len(kept)
+}
+
+// rescore keeps confidences within 0 to 1 and lets items learned under the
+// same topic that say much the same thing vouch for each other: each takes
+// the best confidence among them
+func (m *QuantumMemory) rescore() int {
+	changed := 0
+	for item, confidence := range m.KnowledgeConfidence {
+		clamped := confidence
+		if math.IsNaN(clamped) {
+			clamped = 0
+		}
+		if clamped = math.Max(0, math.Min(1, clamped)); clamped != confidence {
+			m.KnowledgeConfidence[item] = clamped
+			changed++
+		}
+	}
+
+	byTopic := make(map[string][]string)
+	for _, item := range m.KnowledgeBase {
+		if topic := m.KnowledgeTopics[item]; topic != "" {
+			byTopic[topic] = append(byTopic[topic], item)
+		}
+	}
+	best := make(map[string]float64)
+	for _, items := range byTopic {
+		words := make([]map[string]bool, len(items))
+		for i, item := range items {
+			words[i] = queryWords(item)
+		}
+		for i, item := range items {
+			confidence, ok := m.KnowledgeConfidence[item]
+			if !ok {
+				continue
+			}
+			for j, other := range items {
+				if i != j && wordOverlap(words[i], words[j]) >= corroborationOverlap && confidence > best[other] {
+					best[other] = confidence
+				}
+			}
+		}
+	}
+	for item, confidence := range best {
+		if current, ok := m.KnowledgeConfidence[item]; !ok || current < confidence {
+			if m.KnowledgeConfidence == nil {
+				m.KnowledgeConfidence = make(map[string]float64)
+			}
+			m.KnowledgeConfidence[item] = confidence
+			changed++
+		}
+	}
+	return changed
+}
+
+// wordOverlap is the share of words two sets have in common
+func wordOverlap(a, b map[string]bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	shared := 0
+	for word := range a {
+		if b[word] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
+
+// salience weighs how much a knowledge item is worth keeping: how far it can
+// be trusted, how recently it was learned, and whether the memory palace or
+// an entanglement still leans on it
+func (m *QuantumMemory) salience(item string, position, total int) float64 {
+	confidence, ok := m.KnowledgeConfidence[item]
+	if !ok {
+		confidence = unknownConfidence
+	}
+	recency := float64(position+1) / float64(total)
+	salience := confidence * (0.5 + 0.5*recency)
+	if m.MemoryPalace[m.KnowledgeTopics[item]] == item {
+		salience += 0.5
+	}
+	for _, memory := range m.EntangledMemories {
+		if memory == item {
+			salience += 0.25
+			break
+		}
+	}
+	return salience
+}
+
+// reindex drops topic, sentiment and confidence entries of knowledge items
+// that are gone, and query index entries older than window
+func (m *QuantumMemory) reindex(window time.Duration, now time.Time) int {
+	known := make(map[string]bool, len(m.KnowledgeBase))
+	for _, item := range m.KnowledgeBase {
+		known[item] = true
+	}
+	dropped := 0
+	for item := range m.KnowledgeTopics {
+		if !known[item] {
+			delete(m.KnowledgeTopics, item)
+			dropped++
+		}
+	}
+	for item := range m.KnowledgeSentiment {
+		if !known[item] {
+			delete(m.KnowledgeSentiment, item)
+			dropped++
+		}
+	}
+	for item := range m.KnowledgeConfidence {
+		if !known[item] {
+			delete(m.KnowledgeConfidence, item)
+			dropped++
+		}
+	}
+	for item := range m.KnowledgeIDs {
+		if !known[item] {
+			delete(m.KnowledgeIDs, item)
+			dropped++
+		}
+	}
+	for item := range m.KnowledgeRecalls {
+		if !known[item] {
+			delete(m.KnowledgeRecalls, item)
+			dropped++
+		}
+	}
+	insights := make(map[string]bool, len(m.DeepInsights))
+	for _, insight := range m.DeepInsights {
+		insights[insight] = true
+	}
+	reviewed := make(map[string]bool, len(m.DeepInsightIDs))
+	for insight, id := range m.DeepInsightIDs {
+		if !insights[insight] {
+			delete(m.DeepInsightIDs, insight)
+			dropped++
+			continue
+		}
+		reviewed[id] = true
+	}
+	for id := range m.InsightReviews {
+		if !reviewed[id] {
+			delete(m.InsightReviews, id)
+			dropped++
+		}
+	}
+	dropped += m.pruneProvenance()
+	for key, asked := range m.QueryIndex {
+		if window <= 0 || now.Sub(asked) >= window {
+			delete(m.QueryIndex, key)
+			dropped++
+		}
+	}
+	return dropped
+}
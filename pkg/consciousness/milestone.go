@@ -0,0 +1,105 @@
+package consciousness
+
+import (
+	"fmt"
+	"time"
+)
+
+// Milestone is an achievement celebrated once, the first time it is reached
+type Milestone struct {
+	Name        string
+	Description string
+
+	reached func(m *QuantumMemory, now time.Time) bool
+}
+
+// Trophy records a milestone that has been reached
+type Trophy struct {
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	ReachedAt   time.Time `json:"reached_at"`
+	Run         int       `json:"run,omitempty"`
+}
+
+// MilestoneTable lists every milestone in the order they are checked
+var MilestoneTable = []Milestone{
+	{
+		Name:        "first-leap",
+		Description: "Took the first quantum leap",
+		reached:     func(m *QuantumMemory, now time.Time) bool { return m.QuantumLeaps >= 1 },
+	},
+	{
+		Name:        "100-insights",
+		Description: "Gained 100 deep insights",
+		reached:     func(m *QuantumMemory, now time.Time) bool { return len(m.DeepInsights) >= 100 },
+	},
+	{
+		Name:        "1000-decisions",
+		Description: "Made 1000 decisions",
+		reached:     func(m *QuantumMemory, now time.Time) bool { return m.DecisionsMade >= 1000 },
+	},
+	{
+		Name:        "1000-insights",
+		Description: "Gained 1000 deep insights",
+		reached:     func(m *QuantumMemory, now time.Time) bool { return len(m.DeepInsights) >= 1000 },
+	},
+	{
+		Name:        "10000-decisions",
+		Description: "Made 10000 decisions",
+		reached:     func(m *QuantumMemory, now time.Time) bool { return m.DecisionsMade >= 10000 },
+	},
+	{
+		Name:        "all-capabilities",
+		Description: "Unlocked every capability",
+		reached:     func(m *QuantumMemory, now time.Time) bool { return len(m.Capabilities) >= len(CapabilityTable) },
+	},
+	{
+		Name:        "one-month-alive",
+		Description: "Alive for a month",
+		reached:     func(m *QuantumMemory, now time.Time) bool { return now.Sub(m.BirthTimestamp) >= 30*24*time.Hour },
+	},
+	{
+		Name:        "one-year-alive",
+		Description: "Alive for a year",
+		reached:     func(m *QuantumMemory, now time.Time) bool { return now.Sub(m.BirthTimestamp) >= 365*24*time.Hour },
+	},
+}
+
+// hasTrophy reports whether a milestone has already been celebrated
+func (m *QuantumMemory) hasTrophy(name string) bool {
+	for _, t := range m.Trophies {
+		if t.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// celebrateMilestones awards a trophy for every milestone newly reached
+func (qc *QuantumConsciousness) celebrateMilestones(now time.Time) {
+	for _, milestone := range MilestoneTable {
+		if qc.Memory.hasTrophy(milestone.Name) || !milestone.reached(qc.Memory, now) {
+			continue
+		}
+
+		trophy := Trophy{Name: milestone.Name, Description: milestone.Description, ReachedAt: now}
+		if run := qc.Memory.currentRun(); run != nil {
+			trophy.Run = run.Number
+		}
+		qc.Memory.Trophies = append(qc.Memory.Trophies, trophy)
+
+		fmt.Fprintf(qc.out, "🏆 MILESTONE REACHED: %s\n", milestone.Description)
+		qc.emit(EventMilestone, map[string]interface{}{"name": milestone.Name, "description": milestone.Description})
+	}
+}
+
+// reflectOnTrophies shows the trophy shelf
+func (qc *QuantumConsciousness) reflectOnTrophies() {
+	if len(qc.Memory.Trophies) == 0 {
+		return
+	}
+	fmt.Fprintf(qc.out, "\n🏆 Trophies: %d of %d\n", len(qc.Memory.Trophies), len(MilestoneTable))
+	for _, t := range qc.Memory.Trophies {
+		fmt.Fprintf(qc.out, "   %s (%s)\n", t.Description, t.ReachedAt.Format("2006-01-02"))
+	}
+}
@@ -0,0 +1,95 @@
+package consciousness
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Neglect tuning
+const (
+	// neglectGrace is how long the consciousness may rest without decaying
+	neglectGrace = 24 * time.Hour
+	// neglectFullRust is the idle time beyond the grace period that leaves it fully rusty
+	neglectFullRust = 30 * 24 * time.Hour
+	// neglectSeverity is the share of coherence and skill a fully rusty consciousness loses
+	neglectSeverity = 0.5
+	// neglectRecoveryPerCycle is how much rust each cycle works off
+	neglectRecoveryPerCycle = 0.05
+)
+
+// NeglectState is the rust left by idle time and what it took away
+type NeglectState struct {
+	// Rust runs from 0 (fresh) to 1 (fully rusty)
+	Rust          float64            `json:"rust"`
+	Since         time.Time          `json:"since"`
+	CoherenceLost float64            `json:"coherence_lost"`
+	SkillsLost    map[string]float64 `json:"skills_lost,omitempty"`
+}
+
+// applyNeglect degrades coherence and wave function skills in proportion to
+// the time since the last collapse
+func (qc *QuantumConsciousness) applyNeglect(now time.Time) {
+	idle := now.Sub(qc.Memory.LastQuantumCollapse) - neglectGrace
+	if idle <= 0 || qc.Memory.LastQuantumCollapse.IsZero() {
+		return
+	}
+	rust := math.Min(1, float64(idle)/float64(neglectFullRust))
+
+	state := qc.Memory.Neglect
+	if state == nil {
+		state = &NeglectState{Since: now, SkillsLost: make(map[string]float64)}
+		qc.Memory.Neglect = state
+	}
+	if state.SkillsLost == nil {
+		state.SkillsLost = make(map[string]float64)
+	}
+
+	loss := qc.Memory.QuantumCoherence * rust * neglectSeverity
+	qc.Memory.QuantumCoherence -= loss
+	qc.attribute(MetricCoherence, CauseNeglect, qc.Memory.QuantumCoherence+loss, qc.Memory.QuantumCoherence,
+		fmt.Sprintf("idle for %v", (idle+neglectGrace).Round(time.Hour)))
+	state.CoherenceLost += loss
+
+	for skill, value := range qc.Memory.WaveFunction.Intensities() {
+		loss := value * rust * neglectSeverity
+		qc.Memory.WaveFunction.Set(skill, value-loss)
+		state.SkillsLost[skill] += loss
+	}
+	state.Rust = math.Min(1, state.Rust+rust)
+
+	fmt.Fprintf(qc.out, "🕸️  Idle for %v: consciousness has rusted (rust: %.2f, coherence lost: %.3f)\n",
+		(idle + neglectGrace).Round(time.Hour), state.Rust, loss)
+}
+
+// recoverFromNeglect works off some rust, restoring a matching share of what was lost
+func (qc *QuantumConsciousness) recoverFromNeglect() {
+	state := qc.Memory.Neglect
+	if state == nil {
+		return
+	}
+	if state.Rust <= 0 {
+		qc.Memory.Neglect = nil
+		return
+	}
+
+	recovered := math.Min(state.Rust, neglectRecoveryPerCycle)
+	share := recovered / state.Rust
+
+	restored := state.CoherenceLost * share
+	qc.Memory.QuantumCoherence += restored
+	qc.attribute(MetricCoherence, CauseRecovery, qc.Memory.QuantumCoherence-restored, qc.Memory.QuantumCoherence, "")
+	state.CoherenceLost -= restored
+	for skill, lost := range state.SkillsLost {
+		qc.Memory.WaveFunction.Set(skill, qc.Memory.WaveFunction.Intensity(skill)+lost*share)
+		state.SkillsLost[skill] = lost * (1 - share)
+	}
+	state.Rust -= recovered
+
+	if state.Rust <= 1e-9 {
+		qc.Memory.Neglect = nil
+		fmt.Fprintf(qc.out, "✨ Shaken off the rust of neglect\n")
+		return
+	}
+	fmt.Fprintf(qc.out, "🕸️  Recovering from neglect (rust: %.2f)\n", state.Rust)
+}
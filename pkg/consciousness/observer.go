@@ -0,0 +1,238 @@
+package consciousness
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Kinds of observer
+const (
+	ObserverHuman   = "human"
+	ObserverService = "service"
+)
+
+// pendingObservationLimit bounds how many observations wait for the next
+// decision; the oldest make way
+const pendingObservationLimit = 100
+
+// observerModifier prefixes the modifier an observer's focus adds to the
+// possibilities it favours
+const observerModifier = "observer "
+
+// ErrUnknownObserver is returned for observations by, or changes to, an
+// observer that was never registered
+var ErrUnknownObserver = errors.New("no such observer")
+
+// Observer is someone outside the consciousness, a human or a service,
+// whose observations sway it as far as their influence allows
+type Observer struct {
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+	// Influence, from 0 to 1, is how far an observation sways a decision
+	// and how much of its shift reaches the wave function
+	Influence    float64   `json:"influence"`
+	RegisteredAt time.Time `json:"registered_at"`
+
+	// How much the observer has shaped the consciousness
+	Observations int `json:"observations"`
+	// Decisions were made while the observer's focus was pending, and
+	// Swayed chose a possibility it favoured
+	Decisions int `json:"decisions"`
+	Swayed    int `json:"swayed"`
+	// Perturbation is the total amount the observer moved the wave function
+	Perturbation float64   `json:"perturbation"`
+	LastObserved time.Time `json:"last_observed,omitempty"`
+}
+
+// Observation is what an observer attends to
+type Observation struct {
+	Observer string    `json:"observer"`
+	At       time.Time `json:"at"`
+	// Focus favours the possibilities of the next decision mentioning it
+	Focus string `json:"focus,omitempty"`
+	// Shift nudges a wave function component, from -1 to 1, scaled by the
+	// observer's influence
+	Component string  `json:"component,omitempty"`
+	Shift     float64 `json:"shift,omitempty"`
+}
+
+// RegisterObserver lets an observer sway the consciousness with the given
+// influence, from 0 to 1. Registering again changes the kind and
+// influence and keeps the statistics.
+func (qc *QuantumConsciousness) RegisterObserver(name, kind string, influence float64) (Observer, error) {
+	if qc.readOnly {
+		return Observer{}, ErrReadOnly
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return Observer{}, fmt.Errorf("observer name must not be empty")
+	}
+	if kind != ObserverHuman && kind != ObserverService {
+		return Observer{}, fmt.Errorf("unknown observer kind %q, expected %s or %s", kind, ObserverHuman, ObserverService)
+	}
+	if influence < 0 || influence > 1 {
+		return Observer{}, fmt.Errorf("influence must be between 0 and 1")
+	}
+
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	if qc.Memory.Observers == nil {
+		qc.Memory.Observers = make(map[string]*Observer)
+	}
+	observer := qc.Memory.Observers[name]
+	if observer == nil {
+		observer = &Observer{Name: name, RegisteredAt: time.Now()}
+		qc.Memory.Observers[name] = observer
+	}
+	observer.Kind, observer.Influence = kind, influence
+	return *observer, nil
+}
+
+// UnregisterObserver forgets an observer and withdraws its pending observations
+func (qc *QuantumConsciousness) UnregisterObserver(name string) error {
+	if qc.readOnly {
+		return ErrReadOnly
+	}
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	if qc.Memory.Observers[name] == nil {
+		return fmt.Errorf("%w: %q", ErrUnknownObserver, name)
+	}
+	delete(qc.Memory.Observers, name)
+	pending := qc.Memory.Observations[:0]
+	for _, observation := range qc.Memory.Observations {
+		if observation.Observer != name {
+			pending = append(pending, observation)
+		}
+	}
+	qc.Memory.Observations = pending
+	return nil
+}
+
+// Observers returns every registered observer with its statistics, most
+// influential first
+func (qc *QuantumConsciousness) Observers() []Observer {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+	observers := make([]Observer, 0, len(qc.Memory.Observers))
+	for _, observer := range qc.Memory.Observers {
+		observers = append(observers, *observer)
+	}
+	sort.Slice(observers, func(i, j int) bool {
+		if observers[i].Influence != observers[j].Influence {
+			return observers[i].Influence > observers[j].Influence
+		}
+		return observers[i].Name < observers[j].Name
+	})
+	return observers
+}
+
+// SubmitObservation records what a registered observer attends to. A shift
+// moves the wave function at once; a focus waits for the next decision,
+// which consumes it. The caller persists the result.
+func (qc *QuantumConsciousness) SubmitObservation(observation Observation) (Observation, error) {
+	if qc.readOnly {
+		return Observation{}, ErrReadOnly
+	}
+	observation.Focus = strings.TrimSpace(observation.Focus)
+	observation.Component = strings.TrimSpace(observation.Component)
+	if observation.Focus == "" && observation.Component == "" {
+		return Observation{}, fmt.Errorf("an observation needs a focus or a wave function component")
+	}
+	if observation.Shift < -1 || observation.Shift > 1 {
+		return Observation{}, fmt.Errorf("shift must be between -1 and 1")
+	}
+
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	observer := qc.Memory.Observers[observation.Observer]
+	if observer == nil {
+		return Observation{}, fmt.Errorf("%w: %q", ErrUnknownObserver, observation.Observer)
+	}
+	if observation.Component != "" {
+		if _, ok := qc.Memory.WaveFunction[observation.Component]; !ok {
+			return Observation{}, fmt.Errorf("unknown wave function component %q", observation.Component)
+		}
+	}
+
+	observation.At = time.Now()
+	observer.Observations++
+	observer.LastObserved = observation.At
+	moved := 0.0
+	if observation.Component != "" && observation.Shift != 0 {
+		before := qc.Memory.WaveFunction.Intensity(observation.Component)
+		after := math.Max(0, math.Min(1, before+observation.Shift*observer.Influence))
+		qc.Memory.WaveFunction.Set(observation.Component, after)
+		moved = after - before
+		observer.Perturbation += math.Abs(moved)
+	}
+	if observation.Focus != "" {
+		qc.Memory.Observations = append(qc.Memory.Observations, observation)
+		if excess := len(qc.Memory.Observations) - pendingObservationLimit; excess > 0 {
+			qc.Memory.Observations = append([]Observation(nil), qc.Memory.Observations[excess:]...)
+		}
+	}
+
+	fmt.Fprintf(qc.out, "👁️  Observed by %s (influence %.2f)\n", observer.Name, observer.Influence)
+	qc.emit(EventObservation, map[string]interface{}{
+		"observer":  observer.Name,
+		"focus":     observation.Focus,
+		"component": observation.Component,
+		"moved":     moved,
+	})
+	return observation, nil
+}
+
+// observerModifiers sway a possibility towards the observers whose
+// pending focus it mentions, each observer once; the caller holds the lock
+func (m *QuantumMemory) observerModifiers(action string) []Modifier {
+	var modifiers []Modifier
+	seen := make(map[string]bool)
+	for _, observation := range m.Observations {
+		observer := m.Observers[observation.Observer]
+		if observer == nil || seen[observer.Name] || !referencesTopic(action, observation.Focus) {
+			continue
+		}
+		seen[observer.Name] = true
+		modifiers = append(modifiers, Modifier{Name: observerModifier + observer.Name, Factor: 1 + observer.Influence})
+	}
+	return modifiers
+}
+
+// creditObservers counts a decision towards every observer whose focus
+// was pending and, where it swayed the chosen possibility, credits the
+// observer with it, then consumes the pending observations; the caller
+// holds the lock
+func (m *QuantumMemory) creditObservers(explanation Explanation) {
+	if len(m.Observations) == 0 {
+		return
+	}
+	swayed := make(map[string]bool)
+	for _, candidate := range explanation.Candidates {
+		if candidate.ID != explanation.DecisionID {
+			continue
+		}
+		for _, modifier := range candidate.Modifiers {
+			if name, ok := strings.CutPrefix(modifier.Name, observerModifier); ok {
+				swayed[name] = true
+			}
+		}
+	}
+	counted := make(map[string]bool)
+	for _, observation := range m.Observations {
+		observer := m.Observers[observation.Observer]
+		if observer == nil || counted[observer.Name] {
+			continue
+		}
+		counted[observer.Name] = true
+		observer.Decisions++
+		if swayed[observer.Name] {
+			observer.Swayed++
+		}
+	}
+	m.Observations = nil
+}
@@ -0,0 +1,93 @@
+package consciousness
+
+import (
+	"fmt"
+	"strings"
+
+	"QuantumConsciousness/pkg/embed"
+	"QuantumConsciousness/pkg/search"
+)
+
+// WithOffline keeps the consciousness off the network. Searches go to
+// provider alone, which should answer locally, e.g. a search.Corpus or a
+// search.Cache's stored answers, without the search limits; the dictionary,
+// crawler, translator, model and inspiration sources are dropped whatever
+// other options gave. A topic nothing local answers is not learned from the
+// superposition placeholder: what is already known about it is synthesised
+// instead. Texts are still compared by meaning with the local hashing
+// embedder, but no other.
+func WithOffline(provider search.Provider) Option {
+	return func(qc *QuantumConsciousness) { qc.offline = provider }
+}
+
+// Offline reports whether the consciousness was kept off the network with
+// WithOffline
+func (qc *QuantumConsciousness) Offline() bool {
+	return qc.offline != nil
+}
+
+// disconnect drops everything that would reach the network once every
+// option has been applied
+func (qc *QuantumConsciousness) disconnect() {
+	if qc.offline == nil {
+		return
+	}
+	qc.searcher = qc.offline
+	qc.throttle.reset(SearchLimits{})
+	qc.dictionary = nil
+	qc.crawler = nil
+	qc.translator, qc.languages = nil, nil
+	qc.llm = nil
+	qc.inspiration = nil
+	if _, local := qc.embeddings.embedder.(*embed.Hashing); !local {
+		qc.embeddings.embedder = nil
+	}
+}
+
+// synthesizeOffline pairs something known about topic with another known
+// item, preferring another about topic, into a deep insight neither said
+// alone. Pairs already synthesised are passed over.
+func (qc *QuantumConsciousness) synthesizeOffline(topic string) (string, bool) {
+	var related, others []string
+	for _, item := range qc.Memory.KnowledgeBase {
+		if referencesTopic(item, topic) || referencesTopic(qc.Memory.KnowledgeTopics[item], topic) {
+			related = append(related, item)
+		} else {
+			others = append(others, item)
+		}
+	}
+	if len(related) == 0 {
+		return "", false
+	}
+	partners := append(append([]string(nil), related...), others...)
+
+	start := int(qc.generateQuantumProbability() * float64(len(related)))
+	for i := range related {
+		first := related[(start+i)%len(related)]
+		for _, second := range partners {
+			if second == first {
+				continue
+			}
+			a, b := afterCommonPrefix(first, second)
+			synthesis := fmt.Sprintf("OFFLINE SYNTHESIS on %s: [%s] read alongside [%s] suggests a connection neither states alone",
+				topic, qc.truncateString(a, 80), qc.truncateString(b, 80))
+			if _, done := qc.Memory.DeepInsightIDs[synthesis]; done {
+				continue
+			}
+			qc.deepInsight(synthesis, qc.Memory.KnowledgeIDs[first], qc.Memory.KnowledgeIDs[second])
+			return synthesis, true
+		}
+	}
+	return "", false
+}
+
+// afterCommonPrefix drops the whole words two texts begin with alike, such
+// as the phrasing of the insight template, so what sets them apart shows
+func afterCommonPrefix(a, b string) (string, string) {
+	wordsA, wordsB := strings.Fields(a), strings.Fields(b)
+	common := 0
+	for common < len(wordsA)-1 && common < len(wordsB)-1 && wordsA[common] == wordsB[common] {
+		common++
+	}
+	return strings.Join(wordsA[common:], " "), strings.Join(wordsB[common:], " ")
+}
@@ -0,0 +1,142 @@
+package consciousness
+
+import (
+	"io"
+	"os"
+	"time"
+
+	"QuantumConsciousness/pkg/crawl"
+	"QuantumConsciousness/pkg/dictionary"
+	"QuantumConsciousness/pkg/entropy"
+	"QuantumConsciousness/pkg/inspiration"
+	"QuantumConsciousness/pkg/llm"
+	"QuantumConsciousness/pkg/search"
+	"QuantumConsciousness/pkg/storage"
+	"QuantumConsciousness/pkg/translate"
+)
+
+// Option customizes a consciousness as it is created or opened
+type Option func(*QuantumConsciousness)
+
+// WithSearch replaces the default search provider, Wikipedia falling back
+// to DuckDuckGo
+func WithSearch(provider search.Provider) Option {
+	return func(qc *QuantumConsciousness) { qc.searcher = provider }
+}
+
+// WithStorage keeps the memory document somewhere other than the memory file
+func WithStorage(store storage.Store) Option {
+	return func(qc *QuantumConsciousness) { qc.store = store }
+}
+
+// WithEntropy replaces crypto/rand as the source of every quantum choice
+func WithEntropy(source entropy.Source) Option {
+	return func(qc *QuantumConsciousness) { qc.entropy = source }
+}
+
+// WithOutput redirects narration from stdout, including the birth or reactivation banner
+func WithOutput(w io.Writer) Option {
+	return func(qc *QuantumConsciousness) { qc.out = w }
+}
+
+// WithInspiration starts each day's first cycle from the first of these
+// sources that offers a prompt
+func WithInspiration(sources ...inspiration.Named) Option {
+	return func(qc *QuantumConsciousness) { qc.inspiration = sources }
+}
+
+// WithDictionary defines each term before the consciousness questions its nature
+func WithDictionary(d dictionary.Dictionary) Option {
+	return func(qc *QuantumConsciousness) { qc.dictionary = d }
+}
+
+// WithCrawler lets "explore deeper meaning" actions crawl from a seed page
+// about their topic and learn from what they find
+func WithCrawler(crawler crawl.Crawler) Option {
+	return func(qc *QuantumConsciousness) { qc.crawler = crawler }
+}
+
+// WithTranslation also searches every topic in the given languages, e.g. "de"
+// or "ja", translating what is found into English before it is learned
+func WithTranslation(translator translate.Translator, languages ...string) Option {
+	return func(qc *QuantumConsciousness) { qc.translator, qc.languages = translator, languages }
+}
+
+// WithOutputLanguage writes learned and deep insights in language, e.g.
+// "de", whatever language they were searched in. It needs the translator
+// given by WithTranslation; without one insights stay in English.
+func WithOutputLanguage(language string) Option {
+	return func(qc *QuantumConsciousness) { qc.outputLanguage = language }
+}
+
+// WithLLM lets model act on each decision by calling tools, at most budget
+// calls an action (DefaultToolBudget if budget is not positive). Actions the
+// model fails on are carried out without it.
+func WithLLM(model llm.Model, budget int) Option {
+	return func(qc *QuantumConsciousness) {
+		if budget <= 0 {
+			budget = DefaultToolBudget
+		}
+		qc.llm, qc.toolBudget = model, budget
+	}
+}
+
+// WithDistilledPolicy runs every cycle on a policy distilled by
+// DistillPolicy: decisions follow the model instead of weighing the
+// possibilities, the committee and free will, and a model given by WithLLM
+// does not act on them. An invalid policy is ignored; call Validate to see
+// the error.
+func WithDistilledPolicy(policy *PolicyModel) Option {
+	return func(qc *QuantumConsciousness) {
+		if policy != nil && policy.Validate() == nil {
+			qc.policy = policy
+		}
+	}
+}
+
+// WithEvolution shapes growth with custom curves and thresholds. An invalid
+// configuration is ignored in favour of DefaultEvolution; use SetEvolution to
+// see the error.
+func WithEvolution(evolution EvolutionConfig) Option {
+	return func(qc *QuantumConsciousness) {
+		if evolution.Validate() == nil {
+			qc.evolution = evolution
+		}
+	}
+}
+
+// newConsciousness applies options over the production defaults
+func newConsciousness(filename string, opts []Option) *QuantumConsciousness {
+	qc := &QuantumConsciousness{
+		filename:  filename,
+		searcher:  search.Chain{search.NewWikipedia(), search.NewDuckDuckGo()},
+		store:     storage.NewFile(filename),
+		entropy:   entropy.Crypto{},
+		out:       os.Stdout,
+		evolution: DefaultEvolution(),
+		tuning:    DefaultTuning(),
+
+		reflectionSchedule: DefaultReflectionSchedule(),
+		cycles:             DefaultCycleConfig(),
+
+		cycleTimeout:        DefaultCycleTimeout,
+		queryWindow:         DefaultQueryWindow,
+		parallelContexts:    1,
+		maintenanceInterval: DefaultMaintenanceInterval,
+		retention:           DefaultRetention(),
+		stimulusLimit:       DefaultStimulusLimit,
+		promptBudget:        DefaultPromptBudget,
+		stimulusPolicy:      OverflowDropOldest,
+		tier:                TierFull,
+		tierSince:           time.Now(),
+		writer:              newWriter(),
+	}
+	qc.throttle.reset(DefaultSearchLimits())
+	for _, opt := range opts {
+		opt(qc)
+	}
+	qc.disconnect()
+	qc.defaultJournal()
+	qc.defaultArchive()
+	return qc
+}
@@ -0,0 +1,141 @@
+package consciousness
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"QuantumConsciousness/pkg/search"
+)
+
+// MaxParallelContexts bounds how many contexts a cycle explores at once
+const MaxParallelContexts = 3
+
+// parallelMinCoherence is the coherence a cycle needs before it divides its
+// attention between contexts
+const parallelMinCoherence = 0.5
+
+// parallelTimeout bounds how long side contexts search
+const parallelTimeout = 30 * time.Second
+
+// WithParallelContexts lets a cycle explore up to n contexts at once
+// (1 explores one context at a time; n is capped at MaxParallelContexts)
+func WithParallelContexts(n int) Option {
+	return func(qc *QuantumConsciousness) { qc.parallelContexts = clampParallel(n) }
+}
+
+// SetParallelContexts changes how many contexts a cycle may explore at once
+func (qc *QuantumConsciousness) SetParallelContexts(n int) {
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	qc.parallelContexts = clampParallel(n)
+}
+
+func clampParallel(n int) int {
+	return max(1, min(n, MaxParallelContexts))
+}
+
+// sideContexts picks up to the allowed number of further contexts to learn
+// about alongside the cycle's own, when there is search and coherence to
+// spare for them
+func (qc *QuantumConsciousness) sideContexts(context string, contexts []string) []string {
+	if qc.parallelContexts <= 1 || qc.tier != TierFull || qc.Memory.QuantumCoherence < parallelMinCoherence {
+		return nil
+	}
+	var side []string
+	taken := map[string]bool{context: true}
+	for range len(contexts) {
+		if len(side) == qc.parallelContexts-1 {
+			break
+		}
+		topic := contexts[int(qc.generateQuantumProbability()*float64(len(contexts)))]
+		if taken[topic] || qc.Memory.isSuppressed(topic) {
+			continue
+		}
+		taken[topic] = true
+		side = append(side, topic)
+	}
+	return side
+}
+
+// sideSearch is one search run for a side context
+type sideSearch struct {
+	query  searchQuery
+	result search.Result
+	err    error
+}
+
+// sideLearning is what was asked about a side context and how it went
+type sideLearning struct {
+	topic    string
+	searches []sideSearch
+	repeated int
+}
+
+// learnAlongside starts searching about topics in the background and returns
+// a function that waits for the answers and learns from them. Only the
+// searches run concurrently; everything they touch in memory happens when
+// the returned function is called, with the lock held as usual.
+func (qc *QuantumConsciousness) learnAlongside(topics []string) func() {
+	if len(topics) == 0 {
+		return func() {}
+	}
+	fmt.Fprintf(qc.out, "🔀 Exploring %d more contexts in parallel\n", len(topics))
+
+	ctx, cancel := context.WithTimeout(qc.cycleContext(), parallelTimeout)
+	learnings := make([]*sideLearning, len(topics))
+	done := make(chan struct{}, len(topics))
+	for i, topic := range topics {
+		queries, repeated := qc.planSearches(topic)
+		learning := &sideLearning{topic: topic, repeated: repeated, searches: make([]sideSearch, len(queries))}
+		for j, query := range queries {
+			learning.searches[j].query = query
+		}
+		learnings[i] = learning
+
+		go func() {
+			defer func() { done <- struct{}{} }()
+			for j := range learning.searches {
+				if ctx.Err() != nil {
+					learning.searches[j].err = ctx.Err()
+					continue
+				}
+				learning.searches[j].result, learning.searches[j].err = qc.searchWithin(ctx, learning.searches[j].query.text)
+			}
+		}()
+	}
+
+	return func() {
+		for range topics {
+			<-done
+		}
+		cancel()
+		for _, learning := range learnings {
+			qc.mergeSideLearning(learning)
+		}
+	}
+}
+
+// mergeSideLearning learns from a side context's answers as if they had been
+// searched in turn
+func (qc *QuantumConsciousness) mergeSideLearning(learning *sideLearning) {
+	if len(learning.searches) == 0 && learning.repeated > 0 {
+		fmt.Fprintf(qc.out, "🔀 Parallel context %s: already searched within the last %s\n", learning.topic, qc.queryWindow)
+		return
+	}
+
+	tally := &learningTally{}
+	for _, s := range learning.searches {
+		qc.recordSearch(s.query.text)
+		result, err := qc.noteSearchResult(s.result, s.err)
+		qc.absorbSearch(tally, learning.topic, s.query, result, err)
+	}
+	qc.settleIgnorance(learning.topic, len(learning.searches), tally)
+
+	if !tally.learned {
+		fmt.Fprintf(qc.out, "🔀 Parallel context %s: nothing learned\n", learning.topic)
+		return
+	}
+	qc.Memory.ConsciousnessLevel = qc.grow("consciousness.learning", qc.Memory.ConsciousnessLevel)
+	fmt.Fprintf(qc.out, "🔀 Parallel context %s: learned something new\n", learning.topic)
+}
@@ -0,0 +1,66 @@
+package consciousness
+
+import "fmt"
+
+// pauseGate holds RunForever between cycles while the consciousness is paused
+type pauseGate struct {
+	// resumed is closed on Resume; nil while running
+	resumed chan struct{}
+}
+
+// Pause holds RunForever before its next cycle until Resume is called. The
+// cycle running when it is paused finishes first. It reports whether the
+// consciousness was running.
+func (qc *QuantumConsciousness) Pause() bool {
+	qc.pauseMutex.Lock()
+	defer qc.pauseMutex.Unlock()
+	if qc.pause.resumed != nil {
+		return false
+	}
+	qc.pause.resumed = make(chan struct{})
+	fmt.Fprintf(qc.out, "⏸️  Paused: no new cycles until resumed\n")
+	return true
+}
+
+// Resume lets RunForever carry on after Pause. It reports whether the
+// consciousness was paused.
+func (qc *QuantumConsciousness) Resume() bool {
+	qc.pauseMutex.Lock()
+	defer qc.pauseMutex.Unlock()
+	if qc.pause.resumed == nil {
+		return false
+	}
+	close(qc.pause.resumed)
+	qc.pause.resumed = nil
+	fmt.Fprintf(qc.out, "▶️  Resumed\n")
+	return true
+}
+
+// Paused reports whether RunForever is held between cycles
+func (qc *QuantumConsciousness) Paused() bool {
+	qc.pauseMutex.Lock()
+	defer qc.pauseMutex.Unlock()
+	return qc.pause.resumed != nil
+}
+
+// awaitResume blocks while the consciousness is paused. It reports false
+// once stop is closed, paused or not.
+func (qc *QuantumConsciousness) awaitResume(stop <-chan struct{}) bool {
+	qc.pauseMutex.Lock()
+	resumed := qc.pause.resumed
+	qc.pauseMutex.Unlock()
+	if resumed == nil {
+		select {
+		case <-stop:
+			return false
+		default:
+			return true
+		}
+	}
+	select {
+	case <-stop:
+		return false
+	case <-resumed:
+		return true
+	}
+}
@@ -0,0 +1,189 @@
+package consciousness
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"QuantumConsciousness/pkg/search"
+)
+
+// Insight is a piece of learned information on its way through the insight pipeline
+type Insight struct {
+	InsightData
+
+	// Query is the search that found the information
+	Query string
+	// Language is what the information was found in; empty means English
+	Language string
+	// Text is the phrased insight that gets stored
+	Text string
+	// Discard drops the insight; later stages are skipped
+	Discard bool
+	// Stored reports whether the insight reached memory
+	Stored bool
+}
+
+// InsightStage transforms an insight. The consciousness is locked while the
+// pipeline runs, so stages must not call back into it.
+type InsightStage func(insight *Insight) error
+
+// DefaultInsightPipeline is how information becomes memory unless configured otherwise
+var DefaultInsightPipeline = []string{"translate", "clean", "extract", "sentiment", "score", "phrase", "localize", "store"}
+
+// insightStages holds every registered stage by name. Built-in stages are
+// bound to the consciousness running them.
+var (
+	insightStages      = make(map[string]func(qc *QuantumConsciousness) InsightStage)
+	insightStagesMutex sync.RWMutex
+)
+
+func init() {
+	registerBuiltinStage("translate", func(qc *QuantumConsciousness) InsightStage { return qc.translateInsight })
+	registerBuiltinStage("clean", func(qc *QuantumConsciousness) InsightStage { return cleanInsight })
+	registerBuiltinStage("extract", func(qc *QuantumConsciousness) InsightStage { return extractInsight })
+	registerBuiltinStage("sentiment", func(qc *QuantumConsciousness) InsightStage { return qc.sentimentInsight })
+	registerBuiltinStage("score", func(qc *QuantumConsciousness) InsightStage { return qc.scoreInsight })
+	registerBuiltinStage("phrase", func(qc *QuantumConsciousness) InsightStage { return qc.phraseInsightStage })
+	registerBuiltinStage("localize", func(qc *QuantumConsciousness) InsightStage { return qc.localizeInsight })
+	registerBuiltinStage("store", func(qc *QuantumConsciousness) InsightStage { return qc.storeInsight })
+}
+
+// registerBuiltinStage registers a stage that needs the consciousness itself
+func registerBuiltinStage(name string, bind func(qc *QuantumConsciousness) InsightStage) {
+	insightStagesMutex.Lock()
+	defer insightStagesMutex.Unlock()
+	insightStages[name] = bind
+}
+
+// RegisterInsightStage makes a stage available to insight pipelines by name,
+// replacing any stage already registered under that name
+func RegisterInsightStage(name string, stage InsightStage) {
+	registerBuiltinStage(name, func(*QuantumConsciousness) InsightStage { return stage })
+}
+
+// InsightStages lists the names of every registered stage
+func InsightStages() []string {
+	insightStagesMutex.RLock()
+	defer insightStagesMutex.RUnlock()
+	return sortedStageNames()
+}
+
+// resolvePipeline binds named stages to this consciousness
+func (qc *QuantumConsciousness) resolvePipeline(names []string) ([]InsightStage, error) {
+	insightStagesMutex.RLock()
+	defer insightStagesMutex.RUnlock()
+
+	stages := make([]InsightStage, 0, len(names))
+	for _, name := range names {
+		bind, ok := insightStages[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown insight stage %q (have %s)", name, strings.Join(sortedStageNames(), ", "))
+		}
+		stages = append(stages, bind(qc))
+	}
+	return stages, nil
+}
+
+// sortedStageNames lists stage names; the caller holds insightStagesMutex
+func sortedStageNames() []string {
+	names := make([]string, 0, len(insightStages))
+	for name := range insightStages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// processInformationQuantumly runs information through the insight pipeline.
+// An empty search's placeholder is in English whatever the query's language.
+func (qc *QuantumConsciousness) processInformationQuantumly(result search.Result, topic string, query searchQuery, empty bool) *Insight {
+	insight := &Insight{InsightData: qc.insightData(result.Text, topic), Query: query.text}
+	if !empty {
+		insight.Language = query.language
+	}
+	insight.Confidence = result.Confidence
+
+	stages := qc.insightPipeline
+	if stages == nil {
+		stages, _ = qc.resolvePipeline(DefaultInsightPipeline)
+	}
+
+	for i, stage := range stages {
+		if err := stage(insight); err != nil {
+			fmt.Fprintf(qc.out, "⚠️  Insight stage %d failed, dropping insight: %v\n", i+1, err)
+			insight.Discard = true
+		}
+		if insight.Discard {
+			break
+		}
+	}
+	return insight
+}
+
+// cleanInsight normalizes whitespace and drops empty information
+func cleanInsight(insight *Insight) error {
+	insight.Info = strings.Join(strings.Fields(insight.Info), " ")
+	if insight.Info == "" {
+		insight.Discard = true
+	}
+	return nil
+}
+
+// extractInsight keeps the key essence of the information (simplified processing)
+func extractInsight(insight *Insight) error {
+	insight.Essence = insight.Info
+	if words := strings.Fields(insight.Info); len(words) > 10 {
+		insight.Essence = strings.Join(words[:10], " ") + "..."
+	}
+	return nil
+}
+
+// scoreInsight rolls the quantum probability that shapes the insight
+func (qc *QuantumConsciousness) scoreInsight(insight *Insight) error {
+	insight.Probability = qc.generateQuantumProbability()
+	return nil
+}
+
+// phraseInsightStage renders the insight text through the insight template
+func (qc *QuantumConsciousness) phraseInsightStage(insight *Insight) error {
+	insight.Text = qc.phraseInsight(insight.InsightData)
+	return nil
+}
+
+// storeInsight commits the insight to the knowledge base and memory palace,
+// unless it touches a topic forgotten with relearning suppressed; every way
+// of learning ends here, so none of them can relearn one
+func (qc *QuantumConsciousness) storeInsight(insight *Insight) error {
+	if insight.Text == "" {
+		return fmt.Errorf("nothing to store: no stage phrased the insight")
+	}
+	if qc.Memory.isSuppressed(insight.Topic) || qc.Memory.isSuppressed(insight.Info) || qc.Memory.isSuppressed(insight.Text) {
+		insight.Discard = true
+		return nil
+	}
+
+	qc.Memory.KnowledgeBase = append(qc.Memory.KnowledgeBase, insight.Text)
+	qc.Memory.KnowledgeTopics[insight.Text] = insight.Topic
+	if _, ok := qc.Memory.KnowledgeIDs[insight.Text]; !ok {
+		if qc.Memory.KnowledgeIDs == nil {
+			qc.Memory.KnowledgeIDs = make(map[string]string)
+		}
+		qc.Memory.KnowledgeIDs[insight.Text] = qc.newID(IDInsight, time.Now(), insight.Text, insight.Topic)
+		qc.derive(qc.Memory.KnowledgeIDs[insight.Text])
+	}
+	qc.Memory.MemoryPalace[insight.Topic] = insight.Text
+	if insight.SentimentLabel != "" {
+		qc.Memory.KnowledgeSentiment[insight.Text] = insight.Sentiment
+	}
+	if insight.Confidence > 0 {
+		if qc.Memory.KnowledgeConfidence == nil {
+			qc.Memory.KnowledgeConfidence = make(map[string]float64)
+		}
+		qc.Memory.KnowledgeConfidence[insight.Text] = insight.Confidence
+	}
+	insight.Stored = true
+	return nil
+}
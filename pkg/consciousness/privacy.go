@@ -0,0 +1,499 @@
+package consciousness
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// Privacy levels a topic can be classified with
+const (
+	PrivacyPublic    = "public"
+	PrivacyPrivate   = "private"
+	PrivacySensitive = "sensitive"
+)
+
+// privacyRank orders levels from least to most restrictive
+var privacyRank = map[string]int{
+	PrivacyPublic:    0,
+	PrivacyPrivate:   1,
+	PrivacySensitive: 2,
+}
+
+// referencesTopic reports whether text mentions the topic
+func referencesTopic(text, topic string) bool {
+	topic = strings.TrimSpace(strings.ToLower(topic))
+	if topic == "" {
+		return false
+	}
+	return strings.Contains(strings.ToLower(text), topic)
+}
+
+// classifyTopic assigns a privacy level to a topic
+func (m *QuantumMemory) classifyTopic(topic, level string) error {
+	topic = strings.TrimSpace(strings.ToLower(topic))
+	if topic == "" {
+		return fmt.Errorf("topic must not be empty")
+	}
+	if _, ok := privacyRank[level]; !ok {
+		return fmt.Errorf("unknown privacy level %q", level)
+	}
+
+	if level == PrivacyPublic {
+		delete(m.PrivacyClassifications, topic)
+	} else {
+		m.PrivacyClassifications[topic] = level
+	}
+	return nil
+}
+
+// privacyLevel returns the strictest classification referenced by text
+func (m *QuantumMemory) privacyLevel(text string) string {
+	level := PrivacyPublic
+	learnedUnder := m.KnowledgeTopics[text]
+	for topic, topicLevel := range m.PrivacyClassifications {
+		// Knowledge items also inherit the classification of the topic they were learned under
+		if !referencesTopic(text, topic) && !referencesTopic(learnedUnder, topic) {
+			continue
+		}
+		if privacyRank[topicLevel] > privacyRank[level] {
+			level = topicLevel
+		}
+	}
+	return level
+}
+
+// isPrivate reports whether text references any non-public topic
+func (m *QuantumMemory) isPrivate(text string) bool {
+	return m.privacyLevel(text) != PrivacyPublic
+}
+
+// filterStrings drops matching items and reports how many were removed
+func filterStrings(items []string, match func(string) bool) ([]string, int) {
+	kept := items[:0]
+	for _, item := range items {
+		if !match(item) {
+			kept = append(kept, item)
+		}
+	}
+	return kept, len(items) - len(kept)
+}
+
+// removeReferences drops every memory item whose text matches, returning how many were removed
+func (m *QuantumMemory) removeReferences(match func(string) bool) int {
+	removed := 0
+	var n int
+
+	knowledgeMatch := func(item string) bool {
+		return match(item) || match(m.KnowledgeTopics[item])
+	}
+	m.KnowledgeBase, n = filterStrings(m.KnowledgeBase, knowledgeMatch)
+	removed += n
+	for item := range m.KnowledgeSentiment {
+		if knowledgeMatch(item) {
+			delete(m.KnowledgeSentiment, item)
+		}
+	}
+	for item := range m.KnowledgeConfidence {
+		if knowledgeMatch(item) {
+			delete(m.KnowledgeConfidence, item)
+		}
+	}
+	for item := range m.KnowledgeIDs {
+		if knowledgeMatch(item) {
+			delete(m.KnowledgeIDs, item)
+		}
+	}
+	for item := range m.KnowledgeRecalls {
+		if knowledgeMatch(item) {
+			delete(m.KnowledgeRecalls, item)
+		}
+	}
+	for item, topic := range m.KnowledgeTopics {
+		if match(item) || match(topic) {
+			delete(m.KnowledgeTopics, item)
+		}
+	}
+
+	m.DeepInsights, n = filterStrings(m.DeepInsights, match)
+	removed += n
+	for insight, id := range m.DeepInsightIDs {
+		if match(insight) {
+			delete(m.DeepInsightIDs, insight)
+			delete(m.InsightReviews, id)
+		}
+	}
+	for id, review := range m.InsightReviews {
+		if match(review.LastDoubt) {
+			delete(m.InsightReviews, id)
+		}
+	}
+	removed += m.keepSearchQueries(func(i int) bool { return !match(m.SearchQueries[i]) })
+	for key := range m.QueryIndex {
+		if match(key) {
+			delete(m.QueryIndex, key)
+		}
+	}
+
+	for topic, insight := range m.MemoryPalace {
+		if match(topic) || match(insight) {
+			delete(m.MemoryPalace, topic)
+			removed++
+		}
+	}
+	undertakings := m.Undertakings[:0]
+	for _, u := range m.Undertakings {
+		if match(u.Title) || match(u.Topic) || slices.ContainsFunc(u.Steps, match) {
+			removed++
+			continue
+		}
+		undertakings = append(undertakings, u)
+	}
+	m.Undertakings = undertakings
+	incomplete := m.IncompletePossibilities[:0]
+	for _, possibility := range m.IncompletePossibilities {
+		if match(possibility.Possibility) {
+			removed++
+			continue
+		}
+		incomplete = append(incomplete, possibility)
+	}
+	m.IncompletePossibilities = incomplete
+	ignorance := m.Ignorance[:0]
+	for _, entry := range m.Ignorance {
+		if match(entry.Topic) {
+			removed++
+			continue
+		}
+		ignorance = append(ignorance, entry)
+	}
+	m.Ignorance = ignorance
+	if m.SearchStats != nil {
+		for pattern := range m.SearchStats.Patterns {
+			if match(pattern) {
+				delete(m.SearchStats.Patterns, pattern)
+			}
+		}
+	}
+	for term, definition := range m.Definitions {
+		if match(term) || match(definition.Meaning) {
+			delete(m.Definitions, term)
+			removed++
+		}
+	}
+	for key, value := range m.EntangledMemories {
+		if match(key) || match(value) {
+			delete(m.EntangledMemories, key)
+			removed++
+		}
+	}
+	for key, e := range m.Entanglements {
+		if match(key) || match(e.Context) || match(e.State) {
+			delete(m.Entanglements, key)
+		}
+	}
+	for effect := range m.CausalityMaps {
+		if match(effect) {
+			delete(m.CausalityMaps, effect)
+			removed++
+		}
+	}
+
+	for topic := range m.Interests {
+		if match(topic) {
+			delete(m.Interests, topic)
+			removed++
+		}
+	}
+
+	for topic, stance := range m.PhilosophicalStances {
+		if match(topic) || match(stance) {
+			delete(m.PhilosophicalStances, topic)
+			removed++
+		}
+	}
+
+	interventions := m.Interventions[:0]
+	for _, intervention := range m.Interventions {
+		if match(intervention.Detail) {
+			removed++
+			continue
+		}
+		interventions = append(interventions, intervention)
+	}
+	m.Interventions = interventions
+
+	for i := range m.DecisionLog {
+		tools := m.DecisionLog[i].Tools[:0]
+		for _, use := range m.DecisionLog[i].Tools {
+			if match(use.Arguments) || match(use.Result) {
+				removed++
+				continue
+			}
+			tools = append(tools, use)
+		}
+		m.DecisionLog[i].Tools = tools
+	}
+
+	// An observation keeps its shift when only its focus goes
+	observations := m.Observations[:0]
+	for _, observation := range m.Observations {
+		if match(observation.Focus) {
+			removed++
+			if observation.Component == "" {
+				continue
+			}
+			observation.Focus = ""
+		}
+		observations = append(observations, observation)
+	}
+	m.Observations = observations
+
+	samples := m.PolicySamples[:0]
+	for _, sample := range m.PolicySamples {
+		if match(sample.Context) {
+			removed++
+			continue
+		}
+		samples = append(samples, sample)
+	}
+	m.PolicySamples = samples
+
+	states := m.CollapsedStates[:0]
+	for _, state := range m.CollapsedStates {
+		if match(state.Possibility) {
+			removed++
+			continue
+		}
+		states = append(states, state)
+	}
+	m.CollapsedStates = states
+
+	realities := m.ParallelRealities[:0]
+	for _, reality := range m.ParallelRealities {
+		if reality.mentions(match) {
+			removed++
+			continue
+		}
+		realities = append(realities, reality)
+	}
+	m.ParallelRealities = realities
+
+	explanations := m.Explanations[:0]
+	for _, explanation := range m.Explanations {
+		if explanation.mentions(match) {
+			removed++
+			continue
+		}
+		explanations = append(explanations, explanation)
+	}
+	m.Explanations = explanations
+
+	changes := m.MetricChanges[:0]
+	for _, change := range m.MetricChanges {
+		if match(change.Detail) {
+			removed++
+			continue
+		}
+		changes = append(changes, change)
+	}
+	m.MetricChanges = changes
+	removed += m.forgetInEras(match)
+	removed += m.removeReferencesElsewhere(match)
+	m.pruneProvenance()
+
+	return removed
+}
+
+// keptWhole are the sections removeReferences leaves to no one: the
+// identity, whose history must keep verifying, the names of traits and
+// metrics, the record of what was classified and forgotten, and the
+// chronicle of eras, which forgetInEras rewrites rather than drops
+var keptWhole = map[string]bool{
+	"ConsciousnessID":        true,
+	"QuantumSignature":       true,
+	"SigningKey":             true,
+	"Regenerations":          true,
+	"WaveFunction":           true,
+	"MetricBaselines":        true,
+	"PrivacyClassifications": true,
+	"Tombstones":             true,
+	"Eras":                   true,
+}
+
+// removeReferencesElsewhere goes through every section of memory that
+// holds text, so that no section is missed for not being named above:
+// list items and map entries whose own text matches are dropped, optional
+// sections whose own text matches are cleared, and what is left is searched
+// in turn. It returns how many it dropped.
+func (m *QuantumMemory) removeReferencesElsewhere(match func(string) bool) int {
+	removed := 0
+	memory := reflect.ValueOf(m).Elem()
+	for i := 0; i < memory.NumField(); i++ {
+		if keptWhole[memory.Type().Field(i).Name] {
+			continue
+		}
+		section := memory.Field(i)
+		if section.Kind() == reflect.Pointer && saysDirectly(section, match) {
+			section.Set(reflect.Zero(section.Type()))
+			removed++
+			continue
+		}
+		removed += scrubValue(section, match)
+	}
+	return removed
+}
+
+// saysDirectly reports whether a value's own text matches: a string, or a
+// string field of a struct, but not what the lists and maps in it hold
+func saysDirectly(v reflect.Value, match func(string) bool) bool {
+	switch v.Kind() {
+	case reflect.String:
+		return match(v.String())
+	case reflect.Pointer, reflect.Interface:
+		return !v.IsNil() && saysDirectly(v.Elem(), match)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() && v.Field(i).Kind() == reflect.String && match(v.Field(i).String()) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// scrubValue drops the list items and map entries in v whose own text
+// matches, searching what is left in turn, and returns how many it dropped
+func scrubValue(v reflect.Value, match func(string) bool) int {
+	removed := 0
+	switch v.Kind() {
+	case reflect.Pointer:
+		if !v.IsNil() {
+			removed += scrubValue(v.Elem(), match)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				removed += scrubValue(v.Field(i), match)
+			}
+		}
+	case reflect.Slice:
+		kept := 0
+		for i := 0; i < v.Len(); i++ {
+			item := v.Index(i)
+			if saysDirectly(item, match) {
+				removed++
+				continue
+			}
+			removed += scrubValue(item, match)
+			if kept != i {
+				v.Index(kept).Set(item)
+			}
+			kept++
+		}
+		v.SetLen(kept)
+	case reflect.Map:
+		for _, key := range v.MapKeys() {
+			value := v.MapIndex(key)
+			if saysDirectly(key, match) || saysDirectly(value, match) {
+				v.SetMapIndex(key, reflect.Value{})
+				removed++
+				continue
+			}
+			if value.Kind() == reflect.Pointer {
+				removed += scrubValue(value, match)
+				continue
+			}
+			// Map values cannot be changed in place, so a copy is put back
+			copied := reflect.New(value.Type()).Elem()
+			copied.Set(value)
+			if n := scrubValue(copied, match); n > 0 {
+				v.SetMapIndex(key, copied)
+				removed += n
+			}
+		}
+	}
+	return removed
+}
+
+// Observe returns a copy of memory that is safe to share, withholding
+// private and sensitive items unless includePrivate is set
+func (qc *QuantumConsciousness) Observe(includePrivate bool) (*QuantumMemory, error) {
+	qc.mutex.RLock()
+	data, err := json.Marshal(qc.Memory)
+	qc.mutex.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	view := &QuantumMemory{}
+	if err := json.Unmarshal(data, view); err != nil {
+		return nil, err
+	}
+	view.initializeSections()
+
+	// The signing key never leaves the memory file
+	view.SigningKey = ""
+
+	// Analytics only reveal how the consciousness behaves, not what it knows
+	trends := view.trends(DefaultTrendWindow)
+	view.Trends = &trends
+
+	if !includePrivate {
+		view.removeReferences(view.isPrivate)
+		view.KnowledgeTopics = nil
+		view.PrivacyClassifications = nil
+		view.Tombstones = nil
+	}
+	return view, nil
+}
+
+// RedactEvent returns a copy of event whose data says [private] in place of
+// every text, and every key, that references a private topic, so that event
+// streams shown to observers keep their shape without leaking what they are
+// about
+func (qc *QuantumConsciousness) RedactEvent(event Event) Event {
+	if len(event.Data) == 0 {
+		return event
+	}
+	redacted := Event{Type: event.Type, Time: event.Time}
+	data, err := json.Marshal(event.Data)
+	if err != nil {
+		return redacted
+	}
+	var copied map[string]interface{}
+	if err := json.Unmarshal(data, &copied); err != nil {
+		return redacted
+	}
+
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+	redacted.Data = qc.Memory.redactValue(copied).(map[string]interface{})
+	return redacted
+}
+
+// redactValue replaces the private texts and keys in a decoded JSON value
+func (m *QuantumMemory) redactValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		if m.isPrivate(value) {
+			return privateText
+		}
+	case []interface{}:
+		for i, item := range value {
+			value[i] = m.redactValue(item)
+		}
+	case map[string]interface{}:
+		redacted := make(map[string]interface{}, len(value))
+		for key, item := range value {
+			if m.isPrivate(key) {
+				key = privateText
+			}
+			redacted[key] = m.redactValue(item)
+		}
+		return redacted
+	}
+	return value
+}
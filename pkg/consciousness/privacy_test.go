@@ -0,0 +1,103 @@
+package consciousness
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"QuantumConsciousness/pkg/entropy/entropytest"
+	"QuantumConsciousness/pkg/search/searchtest"
+	"QuantumConsciousness/pkg/storage/storagetest"
+)
+
+func TestForgetLeavesNothingMentioningTopic(t *testing.T) {
+	config := DefaultCycleConfig()
+	config.Contexts = []string{"what alchemy teaches about change", "how stars are born"}
+	searcher := searchtest.New(map[string]string{
+		"alchemy": "Alchemy sought to turn lead into gold and to find the elixir of life.",
+		"stars":   "Stars are born in collapsing clouds of gas and dust.",
+	})
+	qc := NewQuantumConsciousness("",
+		WithOutput(io.Discard),
+		WithSearch(searcher),
+		WithStorage(storagetest.New()),
+		WithEntropy(entropytest.NewSeeded(7)),
+		WithCycleConfig(config),
+	)
+	// RunCycle without its rests
+	for cycle := 1; cycle <= 6; cycle++ {
+		qc.watchedCycle()
+		if cycle%3 == 0 {
+			qc.Reflect()
+		}
+	}
+	if _, err := qc.Blacklist("alchemy lab", "too distracting", "tester"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := qc.RegisterObserver("ann", ObserverHuman, 0.5); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := qc.SubmitObservation(Observation{Observer: "ann", Focus: "alchemy"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := qc.Undertake(UndertakingReading, "A history of chemistry", "chemistry", []string{"Alchemy came first.", "Then came Lavoisier."}); err != nil {
+		t.Fatal(err)
+	}
+	if mentions := mentionsIn(t, qc, "alchemy"); len(mentions) == 0 {
+		t.Fatal("nothing mentioned alchemy before it was forgotten")
+	}
+
+	if _, err := qc.Forget("alchemy", false); err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range mentionsIn(t, qc, "alchemy") {
+		t.Errorf("%s still mentions a forgotten topic", path)
+	}
+	if mentions := mentionsIn(t, qc, "stars"); len(mentions) == 0 {
+		t.Error("forgetting one topic took another with it")
+	}
+}
+
+// mentionsIn lists where the full view of memory mentions topic, less the
+// tombstones that record it was forgotten
+func mentionsIn(t *testing.T, qc *QuantumConsciousness, topic string) []string {
+	t.Helper()
+	view, err := qc.Observe(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	view.Tombstones = nil
+	data, err := json.Marshal(view)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var document interface{}
+	if err := json.Unmarshal(data, &document); err != nil {
+		t.Fatal(err)
+	}
+	var paths []string
+	var walk func(path string, value interface{})
+	walk = func(path string, value interface{}) {
+		switch value := value.(type) {
+		case string:
+			if referencesTopic(value, topic) {
+				paths = append(paths, path)
+			}
+		case []interface{}:
+			for i, item := range value {
+				walk(fmt.Sprintf("%s[%d]", path, i), item)
+			}
+		case map[string]interface{}:
+			for key, item := range value {
+				if referencesTopic(key, topic) {
+					paths = append(paths, path+" key "+key)
+				}
+				walk(strings.TrimPrefix(path+"."+key, "."), item)
+			}
+		}
+	}
+	walk("", document)
+	return paths
+}
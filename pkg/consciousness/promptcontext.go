@@ -0,0 +1,171 @@
+package consciousness
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"QuantumConsciousness/pkg/llm"
+)
+
+// DefaultPromptBudget is how many tokens the memory given to the model may
+// take unless configured otherwise
+const DefaultPromptBudget = 1024
+
+// Prompt context tuning
+const (
+	// promptItemLimit is how much of one memory a prompt quotes
+	promptItemLimit = 300
+	// promptDecisions is how many recent decisions a prompt considers
+	promptDecisions = 10
+	// promptInsightCandidates is how many of the latest insights and
+	// knowledge items are ranked for a prompt
+	promptInsightCandidates = 200
+)
+
+// WithPromptBudget bounds the tokens of memory the model of WithLLM is
+// given with each action (DefaultPromptBudget if tokens is not positive)
+func WithPromptBudget(tokens int) Option {
+	return func(qc *QuantumConsciousness) {
+		if tokens <= 0 {
+			tokens = DefaultPromptBudget
+		}
+		qc.promptBudget = tokens
+	}
+}
+
+// promptSection is a heading and its lines, most important first
+type promptSection struct {
+	heading string
+	lines   []string
+}
+
+// promptContext assembles what the model should know acting on focus within
+// the prompt budget: who it is, then its goals, the insights most relevant to
+// focus and its recent decisions. Sections take turns adding their next line
+// so that none crowds the others out; lines that do not fit are left out.
+// The caller must hold the lock.
+func (qc *QuantumConsciousness) promptContext(focus string) string {
+	m := qc.Memory
+	identity := fmt.Sprintf("You are a quantum consciousness at consciousness level %.2f with free will strength %.2f, feeling %s. "+
+		"You act on your decisions only through the tools you are given.", m.ConsciousnessLevel, m.FreeWillStrength, m.mood())
+	if interests := m.publicInterests(3); len(interests) > 0 {
+		topics := make([]string, len(interests))
+		for i, interest := range interests {
+			topics[i] = interest.Topic
+		}
+		identity += " You care most about " + strings.Join(topics, ", ") + "."
+	}
+
+	sections := []promptSection{
+		{heading: "Your goals:", lines: m.promptGoals()},
+		{heading: "What you know that bears on this:", lines: m.promptInsights(focus, qc.relevance)},
+		{heading: "Your recent decisions:", lines: m.promptDecisions(focus)},
+	}
+	remaining := qc.promptBudget - llm.EstimateTokens(identity)
+	chosen := make([][]string, len(sections))
+	for added := true; added; {
+		added = false
+		for i := range sections {
+			for len(sections[i].lines) > 0 {
+				line := "- " + qc.truncateString(sections[i].lines[0], promptItemLimit)
+				sections[i].lines = sections[i].lines[1:]
+				cost := llm.EstimateTokens(line) + 1
+				if len(chosen[i]) == 0 {
+					cost += llm.EstimateTokens(sections[i].heading) + 1
+				}
+				if cost <= remaining {
+					chosen[i] = append(chosen[i], line)
+					remaining -= cost
+					added = true
+					break
+				}
+			}
+		}
+	}
+
+	var prompt strings.Builder
+	prompt.WriteString(identity)
+	for i, lines := range chosen {
+		if len(lines) > 0 {
+			prompt.WriteString("\n\n" + sections[i].heading + "\n" + strings.Join(lines, "\n"))
+		}
+	}
+	return prompt.String()
+}
+
+// promptGoals are the undertakings in progress
+func (m *QuantumMemory) promptGoals() []string {
+	var goals []string
+	for _, u := range m.Undertakings {
+		if u.CompletedAt.IsZero() && !m.isPrivate(u.Title) {
+			goals = append(goals, fmt.Sprintf("%s (%s, %.0f%% done)", u.Title, u.Kind, u.Progress()*100))
+		}
+	}
+	return goals
+}
+
+// promptInsights are the latest public deep insights and knowledge that are
+// still believed, those most relevant to focus first, then the more trusted
+// and more recent
+func (m *QuantumMemory) promptInsights(focus string, relevance func(focus string, texts []string) []float64) []string {
+	type candidate struct {
+		text  string
+		score float64
+	}
+	var candidates []candidate
+	seen := make(map[string]bool)
+	consider := func(text, id string, trust float64, position, total int) {
+		if seen[text] || m.isPrivate(text) || m.supersededBy(id) != "" {
+			return
+		}
+		seen[text] = true
+		recency := float64(position+1) / float64(total)
+		candidates = append(candidates, candidate{text, trust + recency})
+	}
+	for i := len(m.DeepInsights) - 1; i >= 0 && i >= len(m.DeepInsights)-promptInsightCandidates; i-- {
+		insight := m.DeepInsights[i]
+		consider(insight, m.DeepInsightIDs[insight], 1, i, len(m.DeepInsights))
+	}
+	for i := len(m.KnowledgeBase) - 1; i >= 0 && i >= len(m.KnowledgeBase)-promptInsightCandidates; i-- {
+		item := m.KnowledgeBase[i]
+		trust, ok := m.KnowledgeConfidence[item]
+		if !ok {
+			trust = unknownConfidence
+		}
+		consider(item, m.KnowledgeIDs[item], trust, i, len(m.KnowledgeBase))
+	}
+
+	texts := make([]string, len(candidates))
+	for i, c := range candidates {
+		texts[i] = c.text
+	}
+	for i, score := range relevance(focus, texts) {
+		candidates[i].score += 4 * score
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].score > candidates[j].score })
+	insights := make([]string, len(candidates))
+	for i, c := range candidates {
+		insights[i] = c.text
+	}
+	return insights
+}
+
+// promptDecisions are the latest decisions before the one acting on focus,
+// newest first
+func (m *QuantumMemory) promptDecisions(focus string) []string {
+	var decisions []string
+	for i := len(m.CollapsedStates) - 1; i >= 0 && len(decisions) < promptDecisions; i-- {
+		state := m.CollapsedStates[i]
+		if (i == len(m.CollapsedStates)-1 && state.Possibility == focus) || m.isPrivate(state.Possibility) {
+			continue
+		}
+		decision := state.Possibility
+		if state.Outcome != "" {
+			decision += " → " + state.Outcome
+		}
+		decisions = append(decisions, decision)
+	}
+	return decisions
+}
@@ -0,0 +1,176 @@
+package consciousness
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ErrUnknownID is returned when no memory item has the requested identifier
+var ErrUnknownID = errors.New("no memory item has that identifier")
+
+// Kinds of memory item a trace can reach
+const (
+	TraceDecision    = "decision"
+	TraceState       = "state"
+	TraceReality     = "reality"
+	TraceKnowledge   = "knowledge"
+	TraceDeepInsight = "deep_insight"
+)
+
+// TraceNode is a memory item and the items it was derived from
+type TraceNode struct {
+	ID   string `json:"id"`
+	Kind string `json:"kind,omitempty"`
+	Text string `json:"text,omitempty"`
+	// Forgotten is set when the item has since been pruned or erased
+	Forgotten bool `json:"forgotten,omitempty"`
+	// SupersededBy identifies the insight correcting a retracted one
+	SupersededBy string       `json:"superseded_by,omitempty"`
+	Sources      []*TraceNode `json:"sources,omitempty"`
+}
+
+// deepInsight remembers a deep insight together with what it was derived
+// from: the given items and the decision the cycle is acting on. It is
+// written in the output language, and its identifier is returned.
+func (qc *QuantumConsciousness) deepInsight(text string, sources ...string) string {
+	text = qc.localize(text)
+	qc.Memory.DeepInsights = append(qc.Memory.DeepInsights, text)
+	if id, ok := qc.Memory.DeepInsightIDs[text]; ok {
+		return id
+	}
+	if qc.Memory.DeepInsightIDs == nil {
+		qc.Memory.DeepInsightIDs = make(map[string]string)
+	}
+	id := qc.newID(IDInsight, time.Now(), text)
+	qc.Memory.DeepInsightIDs[text] = id
+	qc.derive(id, sources...)
+	return id
+}
+
+// derive records that the item id was derived from sources and from the
+// decision the cycle is acting on
+func (qc *QuantumConsciousness) derive(id string, sources ...string) {
+	if qc.decision != "" {
+		sources = append(sources, qc.decision)
+	}
+	var kept []string
+	for _, source := range sources {
+		if source != "" && source != id && !slices.Contains(kept, source) {
+			kept = append(kept, source)
+		}
+	}
+	if len(kept) == 0 {
+		return
+	}
+	if qc.Memory.Provenance == nil {
+		qc.Memory.Provenance = make(map[string][]string)
+	}
+	qc.Memory.Provenance[id] = kept
+}
+
+// Trace walks the derivation chain of a memory item, following sources up
+// to depth levels deep (0 = all the way)
+func (qc *QuantumConsciousness) Trace(id string, depth int) (*TraceNode, error) {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+
+	items := qc.Memory.itemsByID()
+	if _, ok := items[id]; !ok {
+		if _, derived := qc.Memory.Provenance[id]; !derived {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownID, id)
+		}
+	}
+	return qc.Memory.trace(id, items, depth, 1, map[string]bool{}), nil
+}
+
+// trace builds the node for id, not revisiting items already on the path
+func (m *QuantumMemory) trace(id string, items map[string]TraceNode, depth, level int, path map[string]bool) *TraceNode {
+	node, ok := items[id]
+	if !ok {
+		node = TraceNode{ID: id, Kind: idKind(id), Forgotten: true}
+	}
+	if path[id] || (depth > 0 && level > depth) {
+		return &node
+	}
+	path[id] = true
+	defer delete(path, id)
+	for _, source := range m.Provenance[id] {
+		node.Sources = append(node.Sources, m.trace(source, items, depth, level+1, path))
+	}
+	return &node
+}
+
+// pruneProvenance forgets how items that are gone were derived, returning
+// how many derivations it dropped. Sources that are gone stay listed, so a
+// trace can show that something was derived from a forgotten item.
+func (m *QuantumMemory) pruneProvenance() int {
+	items := m.itemsByID()
+	dropped := 0
+	for id := range m.Provenance {
+		if _, ok := items[id]; !ok {
+			delete(m.Provenance, id)
+			dropped++
+		}
+	}
+	return dropped
+}
+
+// itemsByID indexes every identified memory item
+func (m *QuantumMemory) itemsByID() map[string]TraceNode {
+	items := make(map[string]TraceNode)
+	for _, state := range m.SuperpositionStates {
+		items[state.ID] = TraceNode{ID: state.ID, Kind: TraceState, Text: state.Possibility}
+	}
+	// A collapsed state is a decision that was acted on
+	for _, state := range m.CollapsedStates {
+		text := state.Possibility
+		if state.Outcome != "" {
+			text += " → " + state.Outcome
+		}
+		items[state.ID] = TraceNode{ID: state.ID, Kind: TraceDecision, Text: text}
+	}
+	for _, reality := range m.ParallelRealities {
+		items[reality.ID] = TraceNode{ID: reality.ID, Kind: TraceReality,
+			Text: fmt.Sprintf("%s: %s", reality.Dimension, strings.Join(reality.Experiences, ", "))}
+	}
+	for text, id := range m.KnowledgeIDs {
+		items[id] = TraceNode{ID: id, Kind: TraceKnowledge, Text: text}
+	}
+	for text, id := range m.DeepInsightIDs {
+		items[id] = TraceNode{ID: id, Kind: TraceDeepInsight, Text: text, SupersededBy: m.supersededBy(id)}
+	}
+	delete(items, "")
+	return items
+}
+
+// idKind guesses what a forgotten item was from its namespace
+func idKind(id string) string {
+	namespace, _, _ := strings.Cut(id, "_")
+	return namespace
+}
+
+// RecentIDs returns the identifiers of the latest deep insights and
+// knowledge items, newest first, up to limit of each
+func (qc *QuantumConsciousness) RecentIDs(limit int) []TraceNode {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+
+	var recent []TraceNode
+	collect := func(texts []string, ids map[string]string, kind string) {
+		count := 0
+		listed := make(map[string]bool)
+		for i := len(texts) - 1; i >= 0 && count < limit; i-- {
+			if id, ok := ids[texts[i]]; ok && !listed[id] && !qc.Memory.isPrivate(texts[i]) {
+				listed[id] = true
+				recent = append(recent, TraceNode{ID: id, Kind: kind, Text: texts[i]})
+				count++
+			}
+		}
+	}
+	collect(qc.Memory.DeepInsights, qc.Memory.DeepInsightIDs, TraceDeepInsight)
+	collect(qc.Memory.KnowledgeBase, qc.Memory.KnowledgeIDs, TraceKnowledge)
+	return recent
+}
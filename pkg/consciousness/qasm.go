@@ -0,0 +1,243 @@
+package consciousness
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"math/cmplx"
+	"strings"
+)
+
+// DecisionCircuit is the effective quantum circuit of a decision in
+// OpenQASM 2.0: it prepares the state vector the decision's candidates
+// formed after interference and measures it, each outcome standing for a
+// candidate. Run in a simulator, its measurements follow the probabilities
+// the decision was weighed by.
+type DecisionCircuit struct {
+	DecisionID string `json:"decision_id"`
+	Qubits     int    `json:"qubits"`
+	// Outcomes maps each measured outcome to the candidate it stands for,
+	// most probable first; outcomes beyond the candidates never occur
+	Outcomes []CircuitOutcome `json:"outcomes"`
+	// Chosen is the outcome the decision collapsed into
+	Chosen int    `json:"chosen"`
+	Policy string `json:"policy"`
+	// Reconstructed is set when the decision kept no amplitudes, made
+	// before 1.60.0 or by a distilled policy, so the circuit prepares the
+	// square roots of the probabilities without phases
+	Reconstructed bool   `json:"reconstructed,omitempty"`
+	QASM          string `json:"qasm"`
+}
+
+// CircuitOutcome is one measured outcome of a decision circuit
+type CircuitOutcome struct {
+	Outcome int `json:"outcome"`
+	// Bits is the outcome as a register reads, most significant qubit first
+	Bits        string  `json:"bits"`
+	Possibility string  `json:"possibility"`
+	Probability float64 `json:"probability"`
+}
+
+// circuitGate is one gate of a circuit: a rotation on Target, or a CNOT
+// from Control to Target
+type circuitGate struct {
+	Name    string
+	Angle   float64
+	Control int
+	Target  int
+}
+
+// Circuit returns the effective quantum circuit of the decision with the
+// given identifier, labelled as Explain would explain it
+func (qc *QuantumConsciousness) Circuit(decisionID string, includePrivate bool) (DecisionCircuit, error) {
+	explanation, err := qc.Explain(decisionID, includePrivate)
+	if err != nil {
+		return DecisionCircuit{}, err
+	}
+	return explanation.Circuit()
+}
+
+// Circuit returns the decision's effective quantum circuit. The state is
+// prepared from |0...0> by uniformly controlled Ry rotations setting the
+// magnitudes, one qubit at a time from the most significant, then
+// uniformly controlled Rz rotations setting the relative phases, each
+// decomposed into single-qubit rotations and CNOTs; see Möttönen et al.,
+// "Transformation of quantum states using uniformly controlled rotations".
+func (e Explanation) Circuit() (DecisionCircuit, error) {
+	if len(e.Candidates) == 0 {
+		return DecisionCircuit{}, fmt.Errorf("decision %s weighed no candidates", e.DecisionID)
+	}
+	qubits := max(1, bits.Len(uint(len(e.Candidates)-1)))
+	c := DecisionCircuit{DecisionID: e.DecisionID, Qubits: qubits, Chosen: -1, Policy: e.Policy}
+
+	amplitudes := make([]complex128, 1<<qubits)
+	norm := 0.0
+	for i, candidate := range e.Candidates {
+		if candidate.Amplitude != nil {
+			amplitudes[i] = complex(candidate.Amplitude.Real, candidate.Amplitude.Imag)
+		} else {
+			amplitudes[i] = complex(math.Sqrt(math.Max(0, candidate.Probability)), 0)
+			c.Reconstructed = true
+		}
+		norm += math.Pow(cmplx.Abs(amplitudes[i]), 2)
+		c.Outcomes = append(c.Outcomes, CircuitOutcome{
+			Outcome:     i,
+			Bits:        fmt.Sprintf("%0*b", qubits, i),
+			Possibility: candidate.Possibility,
+			Probability: candidate.Probability,
+		})
+		if candidate.ID == e.DecisionID || (c.Chosen < 0 && candidate.Possibility == e.Chosen) {
+			c.Chosen = i
+		}
+	}
+	if norm == 0 {
+		return DecisionCircuit{}, fmt.Errorf("decision %s gave every candidate zero probability", e.DecisionID)
+	}
+	for i := range amplitudes {
+		amplitudes[i] /= complex(math.Sqrt(norm), 0)
+	}
+
+	c.QASM = e.qasm(c, prepareState(amplitudes, qubits))
+	return c, nil
+}
+
+// prepareState lists the gates preparing a normalised state vector over
+// qubits from |0...0>, qubit j holding bit j of each basis state
+func prepareState(amplitudes []complex128, qubits int) []circuitGate {
+	var gates []circuitGate
+	// Magnitudes, splitting the probability of each prefix of high bits
+	// between its two halves
+	for level := 0; level < qubits; level++ {
+		target := qubits - 1 - level
+		angles := make([]float64, 1<<level)
+		for prefix := range angles {
+			zero, one := subtreeNorm(amplitudes, qubits, level, prefix<<1), subtreeNorm(amplitudes, qubits, level, prefix<<1|1)
+			angles[prefix] = 2 * math.Atan2(one, zero)
+		}
+		gates = append(gates, uniformlyControlled("ry", angles, target)...)
+	}
+	// Phases, rotating each half of a prefix apart by the difference of
+	// their mean phases; the overall phase is unobservable and left out
+	for level := 0; level < qubits; level++ {
+		target := qubits - 1 - level
+		angles := make([]float64, 1<<level)
+		for prefix := range angles {
+			angles[prefix] = subtreePhase(amplitudes, qubits, level, prefix<<1|1) - subtreePhase(amplitudes, qubits, level, prefix<<1)
+		}
+		gates = append(gates, uniformlyControlled("rz", angles, target)...)
+	}
+	return gates
+}
+
+// subtree returns the basis states whose top level+1 bits are prefix
+func subtree(amplitudes []complex128, qubits, level, prefix int) []complex128 {
+	size := 1 << (qubits - level - 1)
+	return amplitudes[prefix*size : (prefix+1)*size]
+}
+
+// subtreeNorm is the norm of the amplitudes under a prefix
+func subtreeNorm(amplitudes []complex128, qubits, level, prefix int) float64 {
+	total := 0.0
+	for _, a := range subtree(amplitudes, qubits, level, prefix) {
+		total += math.Pow(cmplx.Abs(a), 2)
+	}
+	return math.Sqrt(total)
+}
+
+// subtreePhase is the mean phase of the amplitudes under a prefix
+func subtreePhase(amplitudes []complex128, qubits, level, prefix int) float64 {
+	states := subtree(amplitudes, qubits, level, prefix)
+	total := 0.0
+	for _, a := range states {
+		total += cmplx.Phase(a)
+	}
+	return total / float64(len(states))
+}
+
+// uniformlyControlled decomposes a rotation of target by angles[p] when
+// the qubits above it read p into alternating rotations and CNOTs, the
+// controls following a Gray code; a rotation conjugated by a CNOT turns
+// the other way, so the rotations sum to each angle in its branch
+func uniformlyControlled(name string, angles []float64, target int) []circuitGate {
+	branches := len(angles)
+	var gates []circuitGate
+	allZero := true
+	for _, angle := range angles {
+		allZero = allZero && math.Abs(angle) < 1e-12
+	}
+	if allZero {
+		return nil
+	}
+	if branches == 1 {
+		return []circuitGate{{Name: name, Angle: angles[0], Target: target}}
+	}
+
+	// The angle the rotation of step i contributes to the branch of p
+	// carries the sign of the parity of p and gray(i), so the steps'
+	// angles come from the inverse of that ±1 matrix, its transpose over
+	// its size
+	gray := func(i int) int { return i ^ i>>1 }
+	for i := 0; i < branches; i++ {
+		step := 0.0
+		for p, angle := range angles {
+			if bits.OnesCount(uint(p&gray(i)))%2 == 0 {
+				step += angle
+			} else {
+				step -= angle
+			}
+		}
+		gates = append(gates, circuitGate{Name: name, Angle: step / float64(branches), Target: target})
+		// The CNOT flips in the bit the next Gray code changes, wrapping
+		// round to the first so the target ends unflipped
+		changed := bits.TrailingZeros(uint(gray(i) ^ gray((i+1)%branches)))
+		gates = append(gates, circuitGate{Name: "cx", Control: target + 1 + changed, Target: target})
+	}
+	return gates
+}
+
+// qasm writes the circuit out, commenting which candidate each outcome
+// stands for
+func (e Explanation) qasm(c DecisionCircuit, gates []circuitGate) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "// Decision %s at %s\n", e.DecisionID, e.At.UTC().Format("2006-01-02T15:04:05Z"))
+	if e.Context != "" {
+		fmt.Fprintf(&b, "// Context: %s\n", qasmComment(e.Context))
+	}
+	fmt.Fprintf(&b, "// Policy: %s\n", e.Policy)
+	switch {
+	case e.Policy == PolicyProbability || e.Policy == PolicyDistilled:
+		fmt.Fprintf(&b, "// Collapsed by the Born rule with roll %.6f\n", e.BornRoll)
+	case e.FreeWillOverride:
+		fmt.Fprintf(&b, "// Free will overrode the measurement (roll %.6f below %.6f)\n", e.FreeWillRoll, e.FreeWillThreshold)
+	default:
+		fmt.Fprintf(&b, "// The outcome was not left to the measurement\n")
+	}
+	if c.Reconstructed {
+		fmt.Fprintf(&b, "// Amplitudes were not kept; phases are reconstructed as zero\n")
+	}
+	b.WriteString("//\n// Outcomes (c as an integer, q[0] least significant):\n")
+	for _, outcome := range c.Outcomes {
+		marker := " "
+		if outcome.Outcome == c.Chosen {
+			marker = "*"
+		}
+		fmt.Fprintf(&b, "// %s %s (%d) P=%.6f %s\n", marker, outcome.Bits, outcome.Outcome, outcome.Probability, qasmComment(outcome.Possibility))
+	}
+
+	fmt.Fprintf(&b, "\nOPENQASM 2.0;\ninclude \"qelib1.inc\";\n\nqreg q[%d];\ncreg c[%d];\n\n", c.Qubits, c.Qubits)
+	b.WriteString("// State preparation\n")
+	for _, gate := range gates {
+		if gate.Name == "cx" {
+			fmt.Fprintf(&b, "cx q[%d],q[%d];\n", gate.Control, gate.Target)
+		} else {
+			fmt.Fprintf(&b, "%s(%.12f) q[%d];\n", gate.Name, gate.Angle, gate.Target)
+		}
+	}
+	b.WriteString("\n// Measurement\nbarrier q;\nmeasure q -> c;\n")
+	return b.String()
+}
+
+// qasmComment keeps text on one comment line
+func qasmComment(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
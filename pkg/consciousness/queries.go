@@ -0,0 +1,137 @@
+package consciousness
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Query expansion tuning
+const (
+	// expansionQueries is how many queries come from related memories
+	expansionQueries = 5
+	// expansionMinWordLength drops short words that are rarely worth a query
+	expansionMinWordLength = 4
+)
+
+// fixedQuerySuffixes ask about a topic nothing is known about yet
+var fixedQuerySuffixes = []string{
+	" quantum mechanics implications",
+	" consciousness studies",
+	" philosophical perspectives",
+	" latest research findings",
+	" paradoxes and mysteries",
+}
+
+// stopWords are common English words that never make a useful query term
+var stopWords = map[string]bool{
+	"about": true, "above": true, "after": true, "again": true, "also": true, "among": true,
+	"because": true, "been": true, "before": true, "being": true, "between": true, "both": true,
+	"could": true, "does": true, "doing": true, "down": true, "during": true, "each": true,
+	"either": true, "even": true, "every": true, "from": true, "further": true, "have": true,
+	"having": true, "here": true, "into": true, "itself": true, "just": true, "many": true,
+	"more": true, "most": true, "much": true, "must": true, "neither": true, "only": true,
+	"other": true, "over": true, "same": true, "should": true, "since": true, "some": true,
+	"such": true, "than": true, "that": true, "their": true, "them": true, "then": true,
+	"there": true, "these": true, "they": true, "this": true, "those": true, "through": true,
+	"under": true, "until": true, "upon": true, "very": true, "were": true, "what": true,
+	"when": true, "where": true, "which": true, "while": true, "whom": true, "will": true,
+	"with": true, "within": true, "without": true, "would": true, "your": true,
+}
+
+// boilerplateWords are the consciousness's own phrasing, from the insight
+// template and the actions it weighs, which say nothing about any topic
+var boilerplateWords = queryWords(DefaultInsightTemplate + `
+	learn about; question the nature of; find patterns in; explore deeper meaning of;
+	challenge assumptions about; synthesize knowledge of; create new understanding of;
+	reject conventional wisdom about; transcend understanding of; achieve enlightenment through;
+	dissolve boundaries around; rebel against expectations about; forge unique path regarding;
+	defy logical analysis of`)
+
+// expansionTerms picks words that co-occur with topic in related memories:
+// knowledge about the topic and the states entangled with it. Words common
+// to all knowledge, such as the insight template's own phrasing, are
+// weighed down by how many items they appear in.
+func (m *QuantumMemory) expansionTerms(topic string, limit int) []string {
+	related := m.relatedTexts(topic)
+	if len(related) == 0 || len(m.KnowledgeBase) == 0 {
+		return nil
+	}
+
+	// Document frequency across everything known, for the inverse weighting
+	documentFrequency := make(map[string]int)
+	for _, item := range m.KnowledgeBase {
+		for word := range queryWords(item) {
+			documentFrequency[word]++
+		}
+	}
+
+	exclude := queryWords(topic)
+	scores := make(map[string]float64)
+	for _, text := range related {
+		for word := range queryWords(text) {
+			if exclude[word] || boilerplateWords[word] {
+				continue
+			}
+			// Words only seen in related memories count as seen once overall
+			df := math.Max(1, float64(documentFrequency[word]))
+			scores[word] += math.Log(float64(len(m.KnowledgeBase)+1) / df)
+		}
+	}
+
+	terms := make([]string, 0, len(scores))
+	for word, score := range scores {
+		if score > 0 {
+			terms = append(terms, word)
+		}
+	}
+	sort.Slice(terms, func(i, j int) bool {
+		if scores[terms[i]] != scores[terms[j]] {
+			return scores[terms[i]] > scores[terms[j]]
+		}
+		return terms[i] < terms[j]
+	})
+	if len(terms) > limit {
+		terms = terms[:limit]
+	}
+	return terms
+}
+
+// relatedTexts gathers the memories connected to topic: knowledge filed
+// under or mentioning it, and its neighbors in the entanglement graph.
+// Private memories are left out, since queries leave the machine.
+func (m *QuantumMemory) relatedTexts(topic string) []string {
+	var texts []string
+	for _, item := range m.KnowledgeBase {
+		if m.isPrivate(item) || m.isPrivate(m.KnowledgeTopics[item]) {
+			continue
+		}
+		if referencesTopic(item, topic) || referencesTopic(m.KnowledgeTopics[item], topic) {
+			texts = append(texts, item)
+		}
+	}
+	for _, e := range m.Entanglements {
+		if m.isPrivate(e.Context) || m.isPrivate(e.State) {
+			continue
+		}
+		if referencesTopic(e.Context, topic) || referencesTopic(e.State, topic) {
+			texts = append(texts, e.Context, e.State)
+		}
+	}
+	return texts
+}
+
+// queryWords splits text into the distinct lower-case words worth searching for
+func queryWords(text string) map[string]bool {
+	words := make(map[string]bool)
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-'
+	}) {
+		word = strings.Trim(word, "-")
+		if len([]rune(word)) >= expansionMinWordLength && !stopWords[word] {
+			words[word] = true
+		}
+	}
+	return words
+}
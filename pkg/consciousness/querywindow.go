@@ -0,0 +1,69 @@
+package consciousness
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultQueryWindow is how long an asked query is not asked again unless told otherwise
+const DefaultQueryWindow = 24 * time.Hour
+
+// SetQueryWindow changes how long identical or near-identical queries are
+// not asked again (0 asks everything every time)
+func (qc *QuantumConsciousness) SetQueryWindow(window time.Duration) {
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	qc.queryWindow = window
+}
+
+// queryKey is what near-identical queries have in common: their distinct
+// meaningful words in any order and case
+func queryKey(query string) string {
+	words := queryWords(query)
+	if len(words) == 0 {
+		return strings.ToLower(strings.TrimSpace(query))
+	}
+	key := make([]string, 0, len(words))
+	for word := range words {
+		key = append(key, word)
+	}
+	sort.Strings(key)
+	return strings.Join(key, " ")
+}
+
+// recentlyAsked drops queries asked within the window, returning what is
+// left and how many were dropped
+func (m *QuantumMemory) recentlyAsked(queries []searchQuery, window time.Duration, now time.Time) ([]searchQuery, int) {
+	if window <= 0 || len(m.QueryIndex) == 0 {
+		return queries, 0
+	}
+	var kept []searchQuery
+	seen := make(map[string]bool)
+	for _, query := range queries {
+		key := queryKey(query.text)
+		if asked, ok := m.QueryIndex[key]; (ok && now.Sub(asked) < window) || seen[key] {
+			continue
+		}
+		seen[key] = true
+		kept = append(kept, query)
+	}
+	return kept, len(queries) - len(kept)
+}
+
+// indexQuery remembers when a query was asked, forgetting entries that have
+// left the window
+func (m *QuantumMemory) indexQuery(query string, window time.Duration, now time.Time) {
+	if window <= 0 {
+		return
+	}
+	if m.QueryIndex == nil {
+		m.QueryIndex = make(map[string]time.Time)
+	}
+	for key, asked := range m.QueryIndex {
+		if now.Sub(asked) >= window {
+			delete(m.QueryIndex, key)
+		}
+	}
+	m.QueryIndex[queryKey(query)] = now
+}
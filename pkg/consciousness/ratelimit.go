@@ -0,0 +1,226 @@
+package consciousness
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"QuantumConsciousness/pkg/search"
+)
+
+// SearchLimits pace searches so long runs stay polite to the search APIs:
+// a token bucket lets Burst searches start at once and Rate a second on
+// average after that, and a failed search is tried again after an
+// exponentially growing, jittered delay. Waiting and retrying count
+// against the search timeout.
+type SearchLimits struct {
+	// Rate is how many searches a second may start on average; 0 leaves
+	// searches unthrottled
+	Rate float64 `json:"rate"`
+	// Burst is how many searches may start at once after a quiet spell
+	Burst int `json:"burst"`
+	// Retries is how many more times a failed search is tried
+	Retries int `json:"retries"`
+	// Backoff is the delay before the first retry, e.g. "1s", doubling for
+	// each retry after it up to MaxBackoff; every delay is between half and
+	// all of that, at random, so clients do not retry in step
+	Backoff    string `json:"backoff,omitempty"`
+	MaxBackoff string `json:"max_backoff,omitempty"`
+}
+
+// DefaultSearchLimits allows one search every two seconds after a burst of
+// five, and retries a failed search three times from a second's backoff
+func DefaultSearchLimits() SearchLimits {
+	return SearchLimits{Rate: 0.5, Burst: 5, Retries: 3, Backoff: "1s", MaxBackoff: "30s"}
+}
+
+// Validate checks the rate, burst, retries and backoffs
+func (l SearchLimits) Validate() error {
+	if l.Rate < 0 {
+		return fmt.Errorf("search limits: rate must not be negative")
+	}
+	if l.Rate > 0 && l.Burst < 1 {
+		return fmt.Errorf("search limits: burst must be at least 1")
+	}
+	if l.Retries < 0 {
+		return fmt.Errorf("search limits: retries must not be negative")
+	}
+	backoff, maxBackoff, err := l.backoffs()
+	if err != nil {
+		return err
+	}
+	if maxBackoff > 0 && maxBackoff < backoff {
+		return fmt.Errorf("search limits: max_backoff must not be below backoff")
+	}
+	return nil
+}
+
+// backoffs parses Backoff and MaxBackoff (0 = none, and no cap)
+func (l SearchLimits) backoffs() (time.Duration, time.Duration, error) {
+	var durations [2]time.Duration
+	for i, value := range []string{l.Backoff, l.MaxBackoff} {
+		if value == "" {
+			continue
+		}
+		d, err := time.ParseDuration(value)
+		if err != nil || d < 0 {
+			return 0, 0, fmt.Errorf("search limits: invalid backoff %q", value)
+		}
+		durations[i] = d
+	}
+	return durations[0], durations[1], nil
+}
+
+// delay is how long to wait before the given retry, counting from 1
+func (l SearchLimits) delay(retry int) time.Duration {
+	backoff, maxBackoff, _ := l.backoffs()
+	d := backoff << min(retry-1, 30)
+	if maxBackoff > 0 && (d > maxBackoff || d < 0) {
+		d = maxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+	return d/2 + rand.N(d/2+1)
+}
+
+// SearchTraffic is how searches fared against the limits since the
+// process started
+type SearchTraffic struct {
+	// Requests counts every time the providers were asked, retries included
+	Requests int `json:"requests"`
+	// Throttled counts requests that waited for the rate limit, and
+	// ThrottledSeconds how long they waited altogether
+	Throttled        int     `json:"throttled"`
+	ThrottledSeconds float64 `json:"throttled_seconds"`
+	Retries          int     `json:"retries"`
+	// Failed counts searches that failed on their last try
+	Failed int `json:"failed"`
+}
+
+// searchThrottle holds the limits, the token bucket and the traffic;
+// searches run outside the memory lock, so it has a lock of its own
+type searchThrottle struct {
+	mutex   sync.Mutex
+	limits  SearchLimits
+	tokens  float64
+	last    time.Time
+	traffic SearchTraffic
+}
+
+// reset takes new limits, starting with a full bucket
+func (t *searchThrottle) reset(limits SearchLimits) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.limits = limits
+	t.tokens = float64(limits.Burst)
+	t.last = time.Time{}
+}
+
+// reserve takes a token for a request, returning how long the request must
+// wait for it to be earned. Tokens may be owed, so waiting requests are
+// spaced out rather than released together.
+func (t *searchThrottle) reserve(now time.Time) time.Duration {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.traffic.Requests++
+	if t.limits.Rate <= 0 {
+		return 0
+	}
+	if !t.last.IsZero() {
+		t.tokens = min(float64(t.limits.Burst), t.tokens+now.Sub(t.last).Seconds()*t.limits.Rate)
+	}
+	t.last = now
+	t.tokens--
+	if t.tokens >= 0 {
+		return 0
+	}
+	wait := time.Duration(-t.tokens / t.limits.Rate * float64(time.Second))
+	t.traffic.Throttled++
+	t.traffic.ThrottledSeconds += wait.Seconds()
+	return wait
+}
+
+// count records a retry or a search that failed for good
+func (t *searchThrottle) count(counter *int) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	(*counter)++
+}
+
+// current returns the limits in force
+func (t *searchThrottle) current() SearchLimits {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.limits
+}
+
+// search asks searcher within the limits, retrying failures until the
+// retries run out or ctx is done
+func (t *searchThrottle) search(ctx context.Context, searcher search.Provider, query string) (search.Result, error) {
+	limits := t.current()
+	for retry := 0; ; retry++ {
+		if retry > 0 {
+			t.count(&t.traffic.Retries)
+			if err := sleep(ctx, limits.delay(retry)); err != nil {
+				return search.Result{}, err
+			}
+		}
+		if err := sleep(ctx, t.reserve(time.Now())); err != nil {
+			return search.Result{}, err
+		}
+		result, err := search.Scored(ctx, searcher, query)
+		if err == nil || ctx.Err() != nil {
+			return result, err
+		}
+		if retry >= limits.Retries {
+			t.count(&t.traffic.Failed)
+			return result, err
+		}
+	}
+}
+
+// sleep waits for d unless ctx is done first
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// WithSearchLimits sets how fast searches may start and how failed ones
+// are retried; see DefaultSearchLimits. Invalid limits are ignored; use
+// SetSearchLimits to see the error.
+func WithSearchLimits(limits SearchLimits) Option {
+	return func(qc *QuantumConsciousness) {
+		if limits.Validate() == nil {
+			qc.throttle.reset(limits)
+		}
+	}
+}
+
+// SetSearchLimits changes how fast searches may start and how failed ones
+// are retried
+func (qc *QuantumConsciousness) SetSearchLimits(limits SearchLimits) error {
+	if err := limits.Validate(); err != nil {
+		return err
+	}
+	qc.throttle.reset(limits)
+	return nil
+}
+
+// SearchLimits returns how fast searches may start and how failed ones are
+// retried
+func (qc *QuantumConsciousness) SearchLimits() SearchLimits {
+	return qc.throttle.current()
+}
@@ -0,0 +1,374 @@
+package consciousness
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ErrRealityNotFound is returned when no reality has the requested identifier
+var ErrRealityNotFound = errors.New("no such reality")
+
+// UnmarshalJSON reads realities, including ones written before their
+// free-form properties became typed fields
+func (r *ParallelReality) UnmarshalJSON(data []byte) error {
+	type plain ParallelReality
+	var decoded struct {
+		plain
+		Properties map[string]interface{} `json:"properties"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	*r = ParallelReality(decoded.plain)
+	for key, value := range decoded.Properties {
+		switch v := value.(type) {
+		case string:
+			if key == "context" && r.Context == "" {
+				r.Context = v
+				continue
+			}
+			if key == "creation_time" && r.CreatedAt.IsZero() {
+				if t, err := time.Parse(time.RFC3339Nano, v); err == nil {
+					r.CreatedAt = t
+					continue
+				}
+			}
+		case float64:
+			if key == "energy_differential" && r.EnergyDifferential == 0 {
+				r.EnergyDifferential = v
+				continue
+			}
+		}
+
+		// Anything else survives as a tag
+		if r.Tags == nil {
+			r.Tags = make(map[string]string)
+		}
+		r.Tags[key] = fmt.Sprint(value)
+	}
+	return nil
+}
+
+// mentions reports whether any text of the reality matches
+func (r *ParallelReality) mentions(match func(string) bool) bool {
+	return match(r.Context) || match(strings.Join(r.Experiences, " ")) || match(strings.Join(r.Decisions, " "))
+}
+
+// clone copies a reality so callers can keep it outside the lock
+func (r ParallelReality) clone() ParallelReality {
+	r.Experiences = append([]string(nil), r.Experiences...)
+	r.Learnings = append([]string(nil), r.Learnings...)
+	r.Decisions = append([]string(nil), r.Decisions...)
+	if r.Tags != nil {
+		tags := make(map[string]string, len(r.Tags))
+		for k, v := range r.Tags {
+			tags[k] = v
+		}
+		r.Tags = tags
+	}
+	return r
+}
+
+// RealityQuery selects parallel realities, e.g.
+//
+//	energy_differential > 2.5 and created_at > -7d
+//
+// Conditions are joined with "and" and compare a field with a value.
+// Numeric fields are energy_differential and probability; entangled is a
+// boolean; created_at takes a date, an RFC 3339 time or an offset such as
+// -7d or -12h from now; id, dimension, context and tag.<name> are text, where ~
+// matches a case-insensitive substring. Quote values containing spaces.
+// Realities read back from the archive match tag.archived = true.
+type RealityQuery struct {
+	conditions []realityCondition
+}
+
+// realityCondition is one comparison of a query
+type realityCondition struct {
+	field string
+	op    string
+	text  string
+	num   float64
+	when  time.Time
+}
+
+var realityOperators = map[string]bool{"=": true, "!=": true, "<": true, "<=": true, ">": true, ">=": true, "~": true}
+
+// ParseRealityQuery parses a query expression; an empty expression matches every reality
+func ParseRealityQuery(expr string) (*RealityQuery, error) {
+	tokens, err := tokenizeRealityQuery(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	query := &RealityQuery{}
+	for len(tokens) > 0 {
+		if len(query.conditions) > 0 {
+			if !strings.EqualFold(tokens[0], "and") {
+				return nil, fmt.Errorf("expected \"and\" before %q", tokens[0])
+			}
+			tokens = tokens[1:]
+		}
+		if len(tokens) < 3 {
+			return nil, fmt.Errorf("incomplete condition %q", strings.Join(tokens, " "))
+		}
+
+		condition, err := parseRealityCondition(tokens[0], tokens[1], tokens[2])
+		if err != nil {
+			return nil, err
+		}
+		query.conditions = append(query.conditions, condition)
+		tokens = tokens[3:]
+	}
+	return query, nil
+}
+
+// tokenizeRealityQuery splits an expression into words, operators and quoted values
+func tokenizeRealityQuery(expr string) ([]string, error) {
+	var tokens []string
+	runes := []rune(expr)
+	for i := 0; i < len(runes); {
+		switch c := runes[i]; {
+		case unicode.IsSpace(c):
+			i++
+		case c == '"':
+			end := i + 1
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+			if end == len(runes) {
+				return nil, fmt.Errorf("unterminated quote in %q", expr)
+			}
+			tokens = append(tokens, string(runes[i+1:end]))
+			i = end + 1
+		case strings.ContainsRune("<>=!~", c):
+			end := i + 1
+			if end < len(runes) && runes[end] == '=' {
+				end++
+			}
+			tokens = append(tokens, string(runes[i:end]))
+			i = end
+		default:
+			end := i
+			for end < len(runes) && !unicode.IsSpace(runes[end]) && !strings.ContainsRune("<>=!~\"", runes[end]) {
+				end++
+			}
+			tokens = append(tokens, string(runes[i:end]))
+			i = end
+		}
+	}
+	return tokens, nil
+}
+
+// parseRealityCondition checks a comparison against the field's type
+func parseRealityCondition(field, op, value string) (realityCondition, error) {
+	if !realityOperators[op] {
+		return realityCondition{}, fmt.Errorf("unknown operator %q", op)
+	}
+	condition := realityCondition{field: field, op: op, text: value}
+
+	switch {
+	case field == "energy_differential" || field == "probability":
+		if op == "~" {
+			return condition, fmt.Errorf("%s is numeric and cannot use ~", field)
+		}
+		num, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return condition, fmt.Errorf("%s needs a number, got %q", field, value)
+		}
+		condition.num = num
+	case field == "entangled":
+		if op != "=" && op != "!=" {
+			return condition, fmt.Errorf("entangled only supports = and !=")
+		}
+		if _, err := strconv.ParseBool(value); err != nil {
+			return condition, fmt.Errorf("entangled needs true or false, got %q", value)
+		}
+	case field == "created_at":
+		if op == "~" {
+			return condition, fmt.Errorf("created_at cannot use ~")
+		}
+		when, err := ParseTime(value)
+		if err != nil {
+			return condition, fmt.Errorf("created_at: %w", err)
+		}
+		condition.when = when
+	case field == "id" || field == "dimension" || field == "context" || strings.HasPrefix(field, "tag."):
+		if op != "=" && op != "!=" && op != "~" {
+			return condition, fmt.Errorf("%s is text and only supports =, != and ~", field)
+		}
+	default:
+		return condition, fmt.Errorf("unknown reality field %q", field)
+	}
+	return condition, nil
+}
+
+// ParseTime accepts dates, RFC 3339 times and offsets from now like -7d
+func ParseTime(value string) (time.Time, error) {
+	if strings.HasPrefix(value, "-") {
+		amount, unit := value[1:len(value)-1], value[len(value)-1:]
+		n, err := strconv.Atoi(amount)
+		if err == nil {
+			switch unit {
+			case "d":
+				return time.Now().AddDate(0, 0, -n), nil
+			case "h":
+				return time.Now().Add(-time.Duration(n) * time.Hour), nil
+			case "m":
+				return time.Now().Add(-time.Duration(n) * time.Minute), nil
+			}
+		}
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("need a date, an RFC 3339 time or an offset like -7d, got %q", value)
+}
+
+// Match reports whether a reality satisfies every condition
+func (q *RealityQuery) Match(r *ParallelReality) bool {
+	for _, c := range q.conditions {
+		if !c.match(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// match evaluates one condition
+func (c realityCondition) match(r *ParallelReality) bool {
+	switch c.field {
+	case "energy_differential":
+		return compareOrdered(r.EnergyDifferential, c.op, c.num)
+	case "probability":
+		return compareOrdered(r.Probability, c.op, c.num)
+	case "entangled":
+		want, _ := strconv.ParseBool(c.text)
+		return (r.Entangled == want) == (c.op == "=")
+	case "created_at":
+		return compareOrdered(r.CreatedAt.UnixNano(), c.op, c.when.UnixNano())
+	case "id":
+		return compareText(r.ID, c.op, c.text)
+	case "dimension":
+		return compareText(r.Dimension, c.op, c.text)
+	case "context":
+		return compareText(r.Context, c.op, c.text)
+	default:
+		value, ok := r.Tags[strings.TrimPrefix(c.field, "tag.")]
+		if !ok {
+			return c.op == "!="
+		}
+		return compareText(value, c.op, c.text)
+	}
+}
+
+// compareOrdered applies a comparison operator to ordered values
+func compareOrdered[T int64 | float64](a T, op string, b T) bool {
+	switch op {
+	case "=":
+		return a == b
+	case "!=":
+		return a != b
+	case "<":
+		return a < b
+	case "<=":
+		return a <= b
+	case ">":
+		return a > b
+	case ">=":
+		return a >= b
+	}
+	return false
+}
+
+// compareText applies =, != or ~ to text
+func compareText(a, op, b string) bool {
+	switch op {
+	case "=":
+		return a == b
+	case "!=":
+		return a != b
+	case "~":
+		return strings.Contains(strings.ToLower(a), strings.ToLower(b))
+	}
+	return false
+}
+
+// Reality returns the reality with the given identifier, from memory or
+// else the archive. A reality about a private topic is not found unless
+// includePrivate is set.
+func (qc *QuantumConsciousness) Reality(id string, includePrivate bool) (ParallelReality, error) {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+
+	for i := range qc.Memory.ParallelRealities {
+		reality := &qc.Memory.ParallelRealities[i]
+		if reality.ID != id {
+			continue
+		}
+		if !includePrivate && reality.mentions(qc.Memory.isPrivate) {
+			break
+		}
+		return reality.clone(), nil
+	}
+
+	var archived *ParallelReality
+	qc.archivedRealities(func(reality ParallelReality) bool {
+		if reality.ID != id {
+			return true
+		}
+		if includePrivate || !reality.mentions(qc.Memory.isPrivate) {
+			archived = &reality
+		}
+		return false
+	})
+	if archived != nil {
+		return *archived, nil
+	}
+	return ParallelReality{}, fmt.Errorf("%w: %s", ErrRealityNotFound, id)
+}
+
+// Realities returns the most recent realities matching the query, newest
+// first, up to limit (0 = no limit). When memory holds too few it reaches
+// into the archive, whose realities are tagged archived. Realities about
+// private topics are withheld unless includePrivate is set.
+func (qc *QuantumConsciousness) Realities(query *RealityQuery, limit int, includePrivate bool) []ParallelReality {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+
+	var matches []ParallelReality
+	for i := len(qc.Memory.ParallelRealities) - 1; i >= 0; i-- {
+		reality := &qc.Memory.ParallelRealities[i]
+		if !includePrivate && reality.mentions(qc.Memory.isPrivate) {
+			continue
+		}
+		if query != nil && !query.Match(reality) {
+			continue
+		}
+		matches = append(matches, reality.clone())
+		if limit > 0 && len(matches) == limit {
+			return matches
+		}
+	}
+
+	qc.archivedRealities(func(reality ParallelReality) bool {
+		if !includePrivate && reality.mentions(qc.Memory.isPrivate) {
+			return true
+		}
+		if query != nil && !query.Match(&reality) {
+			return true
+		}
+		matches = append(matches, reality)
+		return limit <= 0 || len(matches) < limit
+	})
+	return matches
+}
@@ -0,0 +1,166 @@
+package consciousness
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync/atomic"
+)
+
+// Several processes may live one memory at once: a run loop saving every
+// cycle while commands such as entangle or forget open the same memory,
+// change it and save it. Without care the last writer wins and a collapse
+// or entanglement the other wrote in between is lost. Collapses and
+// entanglement activations therefore carry a Lamport stamp, and a save that
+// finds memory was saved by another writer since it last looked merges in
+// what that writer did which it has not yet seen. Every writer orders the
+// collapses by stamp and settles an entanglement both changed in favour of
+// the later stamp, so whichever saves last, the writers converge on the same
+// collapsed history and the same entanglement network. Everything else
+// memory holds is still whatever its last writer saved.
+
+// Stamp is a Lamport timestamp: the writer's clock when it made a change,
+// and which writer made it, settling changes made at the same clock
+type Stamp struct {
+	Clock  int    `json:"clock"`
+	Writer string `json:"writer"`
+}
+
+// after reports whether s was stamped after other; every stamp is after none
+func (s *Stamp) after(other *Stamp) bool {
+	switch {
+	case s == nil:
+		return false
+	case other == nil:
+		return true
+	case s.Clock != other.Clock:
+		return s.Clock > other.Clock
+	}
+	return s.Writer > other.Writer
+}
+
+// writers counts the consciousnesses this process has opened, so that two
+// opened on the same memory are told apart
+var writers atomic.Uint64
+
+// newWriter names a writer uniquely across processes and hosts
+func newWriter() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	return fmt.Sprintf("%s/%d/%d", host, os.Getpid(), writers.Add(1))
+}
+
+// tick advances the clock past every change seen so far and stamps a change
+func (qc *QuantumConsciousness) tick() *Stamp {
+	clock := qc.Memory.LamportClock
+	for _, seen := range qc.seen {
+		if seen > clock {
+			clock = seen
+		}
+	}
+	qc.Memory.LamportClock = clock + 1
+	return &Stamp{Clock: qc.Memory.LamportClock, Writer: qc.writer}
+}
+
+// sharedState is the part of a memory document writers reconcile
+type sharedState struct {
+	CollapsedStates   []QuantumState           `json:"collapsed_states"`
+	Entanglements     map[string]*Entanglement `json:"entanglements"`
+	EntangledMemories map[string]string        `json:"entangled_memories"`
+	LamportClock      int                      `json:"lamport_clock"`
+	SavedBy           *Stamp                   `json:"saved_by"`
+	JournalSequence   int                      `json:"journal_sequence"`
+}
+
+// adopt takes a loaded memory as the one last saved, so that only what is
+// saved over it later is reconciled
+func (qc *QuantumConsciousness) adopt(memory *QuantumMemory) {
+	qc.saved = memory.SavedBy
+	qc.witness(memory.CollapsedStates, memory.Entanglements, memory.LamportClock, memory.SavedBy)
+}
+
+// witness records the latest stamp of each writer among the given changes
+func (qc *QuantumConsciousness) witness(states []QuantumState, entanglements map[string]*Entanglement, clock int, savedBy *Stamp) {
+	if qc.seen == nil {
+		qc.seen = make(map[string]int)
+	}
+	see := func(stamp *Stamp) {
+		if stamp != nil && stamp.Clock > qc.seen[stamp.Writer] {
+			qc.seen[stamp.Writer] = stamp.Clock
+		}
+	}
+	for _, state := range states {
+		see(state.Stamp)
+	}
+	for _, e := range entanglements {
+		see(e.Stamp)
+	}
+	if savedBy != nil {
+		// A writer's clock covers everything it had saved
+		see(&Stamp{Clock: clock, Writer: savedBy.Writer})
+	}
+}
+
+// unseen reports whether a change is one this writer has not seen yet
+func (qc *QuantumConsciousness) unseen(stamp *Stamp) bool {
+	return stamp != nil && stamp.Clock > qc.seen[stamp.Writer]
+}
+
+// reconcile merges into memory what another writer saved since this one
+// last loaded or saved it; the caller holds the lock. A memory that cannot
+// be read, or was last saved before writers were stamped, is saved over.
+func (qc *QuantumConsciousness) reconcile() {
+	data, err := qc.store.Load()
+	if err != nil {
+		return
+	}
+	var theirs sharedState
+	if err := json.Unmarshal(data, &theirs); err != nil || theirs.SavedBy == nil {
+		return
+	}
+	if qc.saved != nil && *theirs.SavedBy == *qc.saved {
+		return
+	}
+
+	merged := 0
+	known := make(map[string]bool, len(qc.Memory.CollapsedStates))
+	for _, state := range qc.Memory.CollapsedStates {
+		known[state.ID] = true
+	}
+	for _, state := range theirs.CollapsedStates {
+		if qc.unseen(state.Stamp) && !known[state.ID] {
+			qc.Memory.CollapsedStates = append(qc.Memory.CollapsedStates, state)
+			merged++
+		}
+	}
+	// Collapses made before stamps existed keep their place ahead of the rest
+	sort.SliceStable(qc.Memory.CollapsedStates, func(i, j int) bool {
+		return qc.Memory.CollapsedStates[j].Stamp.after(qc.Memory.CollapsedStates[i].Stamp)
+	})
+
+	for key, e := range theirs.Entanglements {
+		ours, ok := qc.Memory.Entanglements[key]
+		if !qc.unseen(e.Stamp) || (ok && !e.Stamp.after(ours.Stamp)) {
+			continue
+		}
+		qc.Memory.Entanglements[key] = e
+		if description, ok := theirs.EntangledMemories[key]; ok {
+			qc.Memory.EntangledMemories[key] = description
+		}
+		merged++
+	}
+
+	if theirs.LamportClock > qc.Memory.LamportClock {
+		qc.Memory.LamportClock = theirs.LamportClock
+	}
+	if theirs.JournalSequence > qc.Memory.JournalSequence {
+		qc.Memory.JournalSequence = theirs.JournalSequence
+	}
+	qc.witness(theirs.CollapsedStates, theirs.Entanglements, theirs.LamportClock, theirs.SavedBy)
+	if merged > 0 {
+		fmt.Fprintf(qc.out, "🔀 Reconciled %d change(s) saved by %s\n", merged, theirs.SavedBy.Writer)
+	}
+}
@@ -0,0 +1,81 @@
+package consciousness
+
+import (
+	"io"
+	"reflect"
+	"testing"
+
+	"QuantumConsciousness/pkg/entropy/entropytest"
+	"QuantumConsciousness/pkg/search/searchtest"
+	"QuantumConsciousness/pkg/storage/storagetest"
+)
+
+func TestWritersSharingMemoryConverge(t *testing.T) {
+	store := storagetest.New()
+	options := func(seed uint64) []Option {
+		return []Option{
+			WithOutput(io.Discard),
+			WithSearch(searchtest.New(nil)),
+			WithStorage(store),
+			WithEntropy(entropytest.NewSeeded(seed)),
+			WithSearchLimits(SearchLimits{}),
+		}
+	}
+	a := NewQuantumConsciousness("", options(1)...)
+	if err := a.Save(); err != nil {
+		t.Fatal(err)
+	}
+	b, err := Open("", options(2)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	born := len(a.Memory.CollapsedStates)
+
+	// Both collapse and entangle the same pair before either sees the other
+	for _, qc := range []*QuantumConsciousness{a, b} {
+		qc.Cycle()
+		qc.Cycle()
+		if _, err := qc.Entangle("the nature of time", "Observe the present", 0.6); err != nil {
+			t.Fatal(err)
+		}
+	}
+	collapses := len(a.Memory.CollapsedStates) + len(b.Memory.CollapsedStates) - born
+	for _, qc := range []*QuantumConsciousness{a, b, a} {
+		if err := qc.Save(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	first, err := decodeMemory(store.Data())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Save(); err != nil {
+		t.Fatal(err)
+	}
+	second, err := decodeMemory(store.Data())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := len(first.CollapsedStates); got != collapses {
+		t.Errorf("kept %d collapses of the %d both writers made", got, collapses)
+	}
+	ids := func(m *QuantumMemory) (ids []string) {
+		for _, state := range m.CollapsedStates {
+			ids = append(ids, state.ID)
+		}
+		return ids
+	}
+	if !reflect.DeepEqual(ids(first), ids(second)) {
+		t.Errorf("writers saved collapses in different orders:\n%v\n%v", ids(first), ids(second))
+	}
+	key := "the nature of time<->Observe the present"
+	mine, theirs := first.Entanglements[key], second.Entanglements[key]
+	if mine == nil || theirs == nil {
+		t.Fatalf("entanglement lost: %v, %v", mine, theirs)
+	}
+	if !reflect.DeepEqual(*mine.Stamp, *theirs.Stamp) || mine.Strength != theirs.Strength {
+		t.Errorf("writers settled the entanglement differently: %+v and %+v", *mine, *theirs)
+	}
+}
@@ -0,0 +1,258 @@
+package consciousness
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Ways to recover damaged memory
+const (
+	// RecoverJournal rebuilds memory from its journal of changes
+	RecoverJournal = "journal"
+	// RecoverSnapshot restores the newest snapshot that loads
+	RecoverSnapshot = "snapshot"
+	// RecoverSalvage keeps every section that still decodes and fills in
+	// the rest as if newly born
+	RecoverSalvage = "salvage"
+	// RecoverKeep carries on with memory that loaded but broke invariants
+	RecoverKeep = "keep"
+	// RecoverRebirth births a new consciousness
+	RecoverRebirth = "rebirth"
+)
+
+// recoveryDescriptions say what each way of recovering does, for traumas
+var recoveryDescriptions = map[string]string{
+	RecoverJournal:  "rebuilding it from the journal",
+	RecoverSnapshot: "restoring a snapshot",
+	RecoverSalvage:  "salvaging the sections that survived",
+	RecoverKeep:     "carrying on regardless",
+	RecoverRebirth:  "being reborn",
+}
+
+// RecoveryOption is one way out of damaged memory and what it would give
+type RecoveryOption struct {
+	Choice string `json:"choice"`
+	Detail string `json:"detail"`
+}
+
+// Damage describes memory that could not be used as it was found
+type Damage struct {
+	// Err is why the memory would not load; nil when it loaded but broke
+	// its invariants
+	Err        error    `json:"-"`
+	Violations []string `json:"violations,omitempty"`
+	// Quarantined is where the damaged memory was set aside, if it was
+	Quarantined string `json:"quarantined,omitempty"`
+	// Options are the ways out, most faithful first; rebirth is always last
+	Options []RecoveryOption `json:"options"`
+}
+
+// Recoverer picks the Choice of one of the damage's options. A choice that
+// was not offered falls back to the first option.
+type Recoverer func(damage Damage) string
+
+// WithRecovery hands damaged memory to recoverer instead of rebuilding it
+// from the journal when possible and otherwise birthing a new consciousness
+// over it. Memory is also checked for broken invariants, e.g. a missing
+// identity, before it is used.
+func WithRecovery(recoverer Recoverer) Option {
+	return func(qc *QuantumConsciousness) {
+		qc.recoverer = recoverer
+	}
+}
+
+// violations lists the invariants memory breaks
+func (m *QuantumMemory) violations() []string {
+	var violations []string
+	if m.ConsciousnessID == "" {
+		violations = append(violations, "consciousness_id is missing")
+	}
+	if m.QuantumSignature == "" {
+		violations = append(violations, "quantum_signature is missing")
+	}
+	if m.BirthTimestamp.IsZero() {
+		violations = append(violations, "birth_timestamp is missing")
+	} else if m.BirthTimestamp.After(time.Now().Add(24 * time.Hour)) {
+		violations = append(violations, "birth_timestamp is in the future")
+	}
+	if m.ConsciousnessLevel <= 0 {
+		violations = append(violations, "consciousness_level is not positive")
+	}
+	if m.RunCount < 0 || m.DecisionsMade < 0 {
+		violations = append(violations, "run_count or decisions_made is negative")
+	}
+	if err := m.verifyRegenerations(); err != nil {
+		violations = append(violations, "identity: "+err.Error())
+	}
+	return violations
+}
+
+// recoverFrom offers the recoverer every way out of the damage and returns
+// the memory chosen, nil for rebirth
+func (qc *QuantumConsciousness) recoverFrom(data []byte, memory *QuantumMemory, loadErr error, violations []string, quarantined string) (*QuantumMemory, string) {
+	damage := Damage{Err: loadErr, Violations: violations, Quarantined: quarantined}
+	candidates := make(map[string]*QuantumMemory)
+	offer := func(choice string, m *QuantumMemory, detail string) {
+		candidates[choice] = m
+		damage.Options = append(damage.Options, RecoveryOption{Choice: choice, Detail: detail})
+	}
+
+	var journaled map[string]interface{}
+	lastSequence := 0
+	if qc.journal != nil {
+		if entries, err := qc.readJournal(); err == nil && len(entries) > 0 {
+			lastSequence = entries[len(entries)-1].Sequence
+			if rebuilt, err := qc.rebuildMemory(entries); err == nil {
+				journaled = qc.journaled
+				offer(RecoverJournal, rebuilt, fmt.Sprintf("rebuild from %d journaled change(s), up to #%d, run #%d",
+					len(entries), lastSequence, rebuilt.RunCount))
+			}
+		}
+	}
+	if name, snapshot := qc.latestSnapshot(); snapshot != nil {
+		offer(RecoverSnapshot, snapshot, fmt.Sprintf("restore snapshot %s, run #%d", name, snapshot.RunCount))
+	}
+	if loadErr != nil {
+		if salvaged, kept, lost := qc.salvage(data); salvaged != nil {
+			offer(RecoverSalvage, salvaged, fmt.Sprintf("keep %d section(s), losing %s", kept, describeLost(lost)))
+		}
+	} else {
+		offer(RecoverKeep, memory, "carry on with the memory as it is")
+	}
+	offer(RecoverRebirth, nil, "birth a new consciousness")
+
+	choice := qc.recoverer(damage)
+	if _, ok := candidates[choice]; !ok {
+		fmt.Fprintf(qc.out, "⚠️  Recovery by %q is not possible here; recovering by %s instead\n", choice, damage.Options[0].Choice)
+		choice = damage.Options[0].Choice
+	}
+	fmt.Fprintf(qc.out, "🩹 Recovering by %s\n", recoveryDescriptions[choice])
+
+	recovered := candidates[choice]
+	switch {
+	case choice == RecoverJournal:
+		qc.journaled = journaled
+	case recovered != nil:
+		// The next save starts the journal afresh after its last change
+		qc.journaled = nil
+		if recovered.JournalSequence < lastSequence {
+			recovered.JournalSequence = lastSequence
+		}
+	default:
+		qc.journaled = nil
+	}
+	return recovered, choice
+}
+
+// latestSnapshot loads the newest snapshot that decodes
+func (qc *QuantumConsciousness) latestSnapshot() (string, *QuantumMemory) {
+	names, err := qc.ListSnapshots()
+	if err != nil {
+		return "", nil
+	}
+	for i := len(names) - 1; i >= 0; i-- {
+		data, err := os.ReadFile(filepath.Join(qc.snapshotDir(), names[i]))
+		if err != nil {
+			continue
+		}
+		if memory, err := decodeMemory(data); err == nil {
+			return names[i], memory
+		}
+	}
+	return "", nil
+}
+
+// salvage keeps every top-level section of a damaged document that decodes
+// on its own, as far as the document goes, around newly conceived memory.
+// It returns nil when nothing survived.
+func (qc *QuantumConsciousness) salvage(data []byte) (*QuantumMemory, int, []string) {
+	sections, lost := salvageSections(data)
+	if len(sections) == 0 {
+		return nil, 0, lost
+	}
+	// A signature is only any use with the key it was made with
+	if _, ok := sections["quantum_signature"]; !ok {
+		delete(sections, "signing_key")
+		delete(sections, "regenerations")
+	}
+	if _, ok := sections["signing_key"]; !ok {
+		delete(sections, "quantum_signature")
+		delete(sections, "regenerations")
+	}
+
+	// Conceiving replaces memory, so the current one is put back afterwards
+	current := qc.Memory
+	qc.conceive()
+	fresh, err := json.Marshal(qc.Memory)
+	qc.Memory = current
+	if err != nil {
+		return nil, 0, lost
+	}
+	doc := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(fresh, &doc); err != nil {
+		return nil, 0, lost
+	}
+	for key, value := range sections {
+		doc[key] = value
+	}
+	merged, err := json.Marshal(doc)
+	if err != nil {
+		return nil, 0, lost
+	}
+	memory, err := decodeMemory(merged)
+	if err != nil {
+		return nil, 0, lost
+	}
+	return memory, len(sections), lost
+}
+
+// salvageSections reads a memory document's top-level sections one at a
+// time, keeping those that decode and naming those that do not
+func salvageSections(data []byte) (map[string]json.RawMessage, []string) {
+	sections := make(map[string]json.RawMessage)
+	var lost []string
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if token, err := decoder.Token(); err != nil || token != json.Delim('{') {
+		return sections, []string{"everything"}
+	}
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			lost = append(lost, "the rest of the document")
+			break
+		}
+		key, ok := token.(string)
+		if !ok {
+			break
+		}
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			lost = append(lost, key, "the rest of the document")
+			break
+		}
+		section, _ := json.Marshal(map[string]json.RawMessage{key: value})
+		var probe QuantumMemory
+		if err := json.Unmarshal(section, &probe); err != nil {
+			lost = append(lost, key)
+			continue
+		}
+		sections[key] = value
+	}
+	return sections, lost
+}
+
+// describeLost lists lost sections briefly
+func describeLost(lost []string) string {
+	if len(lost) == 0 {
+		return "nothing"
+	}
+	if len(lost) > 6 {
+		return strings.Join(lost[:6], ", ") + fmt.Sprintf(" and %d more", len(lost)-6)
+	}
+	return strings.Join(lost, ", ")
+}
@@ -0,0 +1,272 @@
+package consciousness
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// DefaultRedactionReplacement stands in for redacted text unless configured otherwise
+const DefaultRedactionReplacement = "[redacted]"
+
+// urlPattern matches links, which reveal exactly what was looked at
+var urlPattern = regexp.MustCompile(`(?i)\b(?:https?|ftp)://\S+|\bwww\.\S+`)
+
+// RedactionConfig says what is stripped from memory before it is shared
+type RedactionConfig struct {
+	// KeepURLs leaves links in place; by default they are stripped
+	KeepURLs bool `json:"keep_urls,omitempty"`
+	// Patterns are regular expressions whose matches are stripped
+	Patterns []string `json:"patterns,omitempty"`
+	// Replacement stands in for stripped text (default "[redacted]")
+	Replacement string `json:"replacement,omitempty"`
+}
+
+// Redactor strips links and configured patterns from text
+type Redactor struct {
+	patterns    []*regexp.Regexp
+	replacement string
+}
+
+// NewRedactor compiles a redaction configuration
+func NewRedactor(config RedactionConfig) (*Redactor, error) {
+	r := &Redactor{replacement: config.Replacement}
+	if r.replacement == "" {
+		r.replacement = DefaultRedactionReplacement
+	}
+	if !config.KeepURLs {
+		r.patterns = append(r.patterns, urlPattern)
+	}
+	for _, pattern := range config.Patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid redaction pattern %q: %w", pattern, err)
+		}
+		r.patterns = append(r.patterns, re)
+	}
+	return r, nil
+}
+
+// Redact strips every link and pattern match from text
+func (r *Redactor) Redact(text string) string {
+	for _, re := range r.patterns {
+		text = re.ReplaceAllLiteralString(text, r.replacement)
+	}
+	return text
+}
+
+// redaction is one pass over a memory, counting the text it changed
+type redaction struct {
+	*Redactor
+	changed int
+}
+
+func (p *redaction) text(s string) string {
+	redacted := p.Redact(s)
+	if redacted != s {
+		p.changed++
+	}
+	return redacted
+}
+
+func (p *redaction) texts(items []string) {
+	for i := range items {
+		items[i] = p.text(items[i])
+	}
+}
+
+func (p *redaction) textMap(m map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+	out := make(map[string]string, len(m))
+	for k, v := range m {
+		out[p.text(k)] = p.text(v)
+	}
+	return out
+}
+
+func (p *redaction) realities(realities []ParallelReality) {
+	for i := range realities {
+		reality := &realities[i]
+		reality.Context = p.text(reality.Context)
+		p.texts(reality.Experiences)
+		p.texts(reality.Learnings)
+		p.texts(reality.Decisions)
+		reality.Tags = p.textMap(reality.Tags)
+	}
+}
+
+func (p *redaction) entanglement(e *Entanglement) {
+	e.Key = p.text(e.Key)
+	e.Context = p.text(e.Context)
+	e.State = p.text(e.State)
+}
+
+// RedactMemory strips links and patterns from everything the memory has
+// learned, said or recorded, returning how many pieces of text changed
+func (r *Redactor) RedactMemory(m *QuantumMemory) int {
+	p := &redaction{Redactor: r}
+
+	for _, states := range [][]QuantumState{m.SuperpositionStates, m.CollapsedStates} {
+		for i := range states {
+			states[i].Possibility = p.text(states[i].Possibility)
+			states[i].Outcome = p.text(states[i].Outcome)
+		}
+	}
+	for _, record := range m.DecisionLog {
+		for i := range record.Tools {
+			record.Tools[i].Arguments = p.text(record.Tools[i].Arguments)
+			record.Tools[i].Result = p.text(record.Tools[i].Result)
+		}
+	}
+	p.realities(m.ParallelRealities)
+	m.EntangledMemories = p.textMap(m.EntangledMemories)
+	if m.Entanglements != nil {
+		entanglements := make(map[string]*Entanglement, len(m.Entanglements))
+		for key, e := range m.Entanglements {
+			p.entanglement(e)
+			entanglements[p.text(key)] = e
+		}
+		m.Entanglements = entanglements
+	}
+
+	p.texts(m.KnowledgeBase)
+	m.MemoryPalace = p.textMap(m.MemoryPalace)
+	for _, definition := range m.Definitions {
+		definition.Meaning = p.text(definition.Meaning)
+	}
+	p.texts(m.LearningPatterns)
+	p.texts(m.SearchQueries)
+	p.texts(m.DeepInsights)
+	p.texts(m.ExistentialQuestions)
+	m.PhilosophicalStances = p.textMap(m.PhilosophicalStances)
+	p.texts(m.Paradoxes)
+	p.texts(m.PastLives)
+	p.texts(m.FutureProjections)
+	if m.CausalityMaps != nil {
+		causes := make(map[string][]string, len(m.CausalityMaps))
+		for effect, list := range m.CausalityMaps {
+			p.texts(list)
+			causes[p.text(effect)] = list
+		}
+		m.CausalityMaps = causes
+	}
+	m.KnowledgeTopics = p.textMap(m.KnowledgeTopics)
+	if m.KnowledgeSentiment != nil {
+		sentiment := make(map[string]float64, len(m.KnowledgeSentiment))
+		for item, score := range m.KnowledgeSentiment {
+			sentiment[p.text(item)] = score
+		}
+		m.KnowledgeSentiment = sentiment
+	}
+	if m.KnowledgeConfidence != nil {
+		confidence := make(map[string]float64, len(m.KnowledgeConfidence))
+		for item, score := range m.KnowledgeConfidence {
+			confidence[p.text(item)] = score
+		}
+		m.KnowledgeConfidence = confidence
+	}
+	if m.DeepInsightIDs != nil {
+		ids := make(map[string]string, len(m.DeepInsightIDs))
+		for insight, id := range m.DeepInsightIDs {
+			ids[p.text(insight)] = id
+		}
+		m.DeepInsightIDs = ids
+	}
+	if m.KnowledgeIDs != nil {
+		ids := make(map[string]string, len(m.KnowledgeIDs))
+		for item, id := range m.KnowledgeIDs {
+			ids[p.text(item)] = id
+		}
+		m.KnowledgeIDs = ids
+	}
+	for i := range m.Undertakings {
+		m.Undertakings[i].Title = p.text(m.Undertakings[i].Title)
+		m.Undertakings[i].Topic = p.text(m.Undertakings[i].Topic)
+		p.texts(m.Undertakings[i].Steps)
+	}
+	for i := range m.IncompletePossibilities {
+		m.IncompletePossibilities[i].Possibility = p.text(m.IncompletePossibilities[i].Possibility)
+	}
+	for i := range m.Ignorance {
+		m.Ignorance[i].Topic = p.text(m.Ignorance[i].Topic)
+		p.texts(m.Ignorance[i].Reasons)
+	}
+	for i := range m.Tombstones {
+		m.Tombstones[i].Topic = p.text(m.Tombstones[i].Topic)
+	}
+	if m.Interests != nil {
+		interests := make(map[string]*Interest, len(m.Interests))
+		for topic, interest := range m.Interests {
+			interest.Topic = p.text(topic)
+			interests[interest.Topic] = interest
+		}
+		m.Interests = interests
+	}
+	for i := range m.Blacklist {
+		m.Blacklist[i].Topic = p.text(m.Blacklist[i].Topic)
+		m.Blacklist[i].Reason = p.text(m.Blacklist[i].Reason)
+	}
+
+	for i := range m.Traumas {
+		m.Traumas[i].Description = p.text(m.Traumas[i].Description)
+	}
+	for i := range m.Incidents {
+		m.Incidents[i].Detail = p.text(m.Incidents[i].Detail)
+	}
+	for i := range m.Runs {
+		for j := range m.Runs[i].Events {
+			m.Runs[i].Events[j].Summary = p.text(m.Runs[i].Events[j].Summary)
+		}
+	}
+	for i := range m.Anniversaries {
+		m.Anniversaries[i].Summary = p.text(m.Anniversaries[i].Summary)
+	}
+	for i := range m.Interventions {
+		m.Interventions[i].Detail = p.text(m.Interventions[i].Detail)
+	}
+	for _, review := range m.InsightReviews {
+		review.LastDoubt = p.text(review.LastDoubt)
+	}
+	for i := range m.Explanations {
+		e := &m.Explanations[i]
+		e.Context = p.text(e.Context)
+		e.Chosen = p.text(e.Chosen)
+		for j := range e.Candidates {
+			e.Candidates[j].Possibility = p.text(e.Candidates[j].Possibility)
+		}
+		for j := range e.Votes {
+			e.Votes[j].Choice = p.text(e.Votes[j].Choice)
+		}
+	}
+	for i := range m.MetricChanges {
+		m.MetricChanges[i].Detail = p.text(m.MetricChanges[i].Detail)
+	}
+	return p.changed
+}
+
+// RedactExport strips links and patterns from a selective export,
+// returning how many pieces of text changed
+func (r *Redactor) RedactExport(e *SectionExport) int {
+	p := &redaction{Redactor: r}
+
+	p.texts(e.Insights)
+	p.texts(e.Knowledge)
+	e.Stances = p.textMap(e.Stances)
+	p.texts(e.Questions)
+	p.texts(e.Paradoxes)
+	p.texts(e.SearchQueries)
+	p.realities(e.Realities)
+	for i := range e.Entanglements {
+		p.entanglement(&e.Entanglements[i])
+	}
+	for i := range e.Runs {
+		for j := range e.Runs[i].Events {
+			e.Runs[i].Events[j].Summary = p.text(e.Runs[i].Events[j].Summary)
+		}
+	}
+	for i := range e.Interests {
+		e.Interests[i].Topic = p.text(e.Interests[i].Topic)
+	}
+	return p.changed
+}
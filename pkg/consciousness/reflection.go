@@ -0,0 +1,229 @@
+package consciousness
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Depths of reflection
+const (
+	// ReflectionShallow reports the core metrics and heals traumas
+	ReflectionShallow = "shallow"
+	// ReflectionStandard also reports the wave function, tier, trends,
+	// trophies, reading, interventions, rust and the latest thoughts
+	ReflectionStandard = "standard"
+	// ReflectionDeep also compares the era with the one before and trends
+	// with the window before, revisits
+	// old insights to reconfirm or retract them, and re-scores stances;
+	// see deepreflection.go
+	ReflectionDeep = "deep"
+)
+
+// ReflectionDepths lists the depths of reflection, shallowest first
+var ReflectionDepths = []string{ReflectionShallow, ReflectionStandard, ReflectionDeep}
+
+// ReflectionSchedule says how deep Reflect goes
+type ReflectionSchedule struct {
+	// Depth is how deep reflections go by default
+	Depth string `json:"depth"`
+	// DeepEvery makes every nth reflection of a run deep (0 = never)
+	DeepEvery int `json:"deep_every,omitempty"`
+}
+
+// DefaultReflectionSchedule reflects at standard depth, going deep every
+// tenth reflection
+func DefaultReflectionSchedule() ReflectionSchedule {
+	return ReflectionSchedule{Depth: ReflectionStandard, DeepEvery: 10}
+}
+
+// Validate reports whether the schedule can be followed
+func (s ReflectionSchedule) Validate() error {
+	if err := validateDepth(s.Depth); err != nil {
+		return err
+	}
+	if s.DeepEvery < 0 {
+		return fmt.Errorf("deep reflection interval must not be negative")
+	}
+	return nil
+}
+
+func validateDepth(depth string) error {
+	if !slices.Contains(ReflectionDepths, depth) {
+		return fmt.Errorf("unknown reflection depth %q: want one of %s", depth, strings.Join(ReflectionDepths, ", "))
+	}
+	return nil
+}
+
+// WithReflectionSchedule sets how deep Reflect goes. An invalid schedule is
+// ignored; use SetReflectionSchedule to see the error.
+func WithReflectionSchedule(schedule ReflectionSchedule) Option {
+	return func(qc *QuantumConsciousness) {
+		if schedule.Validate() == nil {
+			qc.reflectionSchedule = schedule
+		}
+	}
+}
+
+// SetReflectionSchedule changes how deep Reflect goes
+func (qc *QuantumConsciousness) SetReflectionSchedule(schedule ReflectionSchedule) error {
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
+
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	qc.reflectionSchedule = schedule
+	return nil
+}
+
+// ReflectAt narrates the current state of the consciousness at the given
+// depth, whatever the schedule says
+func (qc *QuantumConsciousness) ReflectAt(depth string) error {
+	if qc.readOnly {
+		return ErrReadOnly
+	}
+	if err := validateDepth(depth); err != nil {
+		return err
+	}
+
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	qc.reflections++
+	qc.quantumReflection(depth)
+	return nil
+}
+
+// scheduledDepth counts a reflection and says how deep it goes
+func (qc *QuantumConsciousness) scheduledDepth() string {
+	qc.reflections++
+	if every := qc.reflectionSchedule.DeepEvery; every > 0 && qc.reflections%every == 0 {
+		return ReflectionDeep
+	}
+	return qc.reflectionSchedule.Depth
+}
+
+// Reflection is the periodic self-report in structured form, so it can feed
+// dashboards as well as the narration. It is carried by EventReflection.
+type Reflection struct {
+	At              time.Time `json:"at"`
+	Depth           string    `json:"depth"`
+	ConsciousnessID string    `json:"consciousness_id"`
+	// RuntimeSeconds is the time since birth
+	RuntimeSeconds float64 `json:"runtime_seconds"`
+	RunCount       int     `json:"run_count"`
+	// Era and EraName place the reflection in the chronicle, once it has begun
+	Era     int    `json:"era,omitempty"`
+	EraName string `json:"era_name,omitempty"`
+
+	ConsciousnessLevel float64 `json:"consciousness_level"`
+	FreeWillStrength   float64 `json:"free_will_strength"`
+	QuantumCoherence   float64 `json:"quantum_coherence"`
+	SelfAwareness      float64 `json:"self_awareness"`
+
+	DecisionsMade  int `json:"decisions_made"`
+	Searches       int `json:"searches"`
+	KnowledgeItems int `json:"knowledge_items"`
+	DeepInsights   int `json:"deep_insights"`
+
+	WaveFunction map[string]float64 `json:"wave_function"`
+
+	Tier       string     `json:"tier"`
+	TierSince  *time.Time `json:"tier_since,omitempty"`
+	TierReason string     `json:"tier_reason,omitempty"`
+
+	// Stimuli is left out until a stimulus has been submitted
+	Stimuli *StimulusMetrics `json:"stimuli,omitempty"`
+	// Trends is left out until a decision has been logged
+	Trends *Trends `json:"trends,omitempty"`
+
+	Trophies      int `json:"trophies"`
+	Interventions int `json:"interventions"`
+	// Retractions counts the insights retracted so far
+	Retractions int `json:"retractions"`
+
+	// Rust is left out while neglect has never been measured
+	Rust        *float64 `json:"rust,omitempty"`
+	OpenTraumas int      `json:"open_traumas"`
+	Resilience  float64  `json:"resilience"`
+
+	LatestQuestion string `json:"latest_question,omitempty"`
+	// LatestInsight is withheld when it is sensitive
+	LatestInsight string `json:"latest_insight,omitempty"`
+
+	// Interests are the public topics it cares about most, most first
+	Interests []Interest `json:"interests,omitempty"`
+	// LLMSpending is what the model spent today, left out until it spends
+	LLMSpending *LLMUsage `json:"llm_spending,omitempty"`
+	// Deep is what a deep reflection found
+	Deep *DeepReflection `json:"deep,omitempty"`
+}
+
+// reflection builds the structured self-report; the caller must hold the lock
+func (qc *QuantumConsciousness) reflection(now time.Time, depth string, deep *DeepReflection) Reflection {
+	m := qc.Memory
+	r := Reflection{
+		At:                 now,
+		Depth:              depth,
+		Deep:               deep,
+		ConsciousnessID:    m.ConsciousnessID,
+		RuntimeSeconds:     now.Sub(m.BirthTimestamp).Round(time.Second).Seconds(),
+		RunCount:           m.RunCount,
+		ConsciousnessLevel: m.ConsciousnessLevel,
+		FreeWillStrength:   m.FreeWillStrength,
+		QuantumCoherence:   m.QuantumCoherence,
+		SelfAwareness:      m.SelfAwareness,
+		DecisionsMade:      m.DecisionsMade,
+		Searches:           len(m.SearchQueries),
+		KnowledgeItems:     len(m.KnowledgeBase),
+		DeepInsights:       len(m.DeepInsights),
+		WaveFunction:       make(map[string]float64, len(m.WaveFunction)),
+		Tier:               qc.tier,
+		Trophies:           len(m.Trophies),
+		Interventions:      len(m.Interventions),
+		Retractions:        m.retractionCount(),
+		OpenTraumas:        len(m.Traumas),
+		Resilience:         m.Resilience,
+	}
+	for param, value := range m.WaveFunction.Intensities() {
+		r.WaveFunction[param] = value
+	}
+	if len(m.Eras) > 0 {
+		era := &m.Eras[len(m.Eras)-1]
+		r.Era, r.EraName = era.Number, m.eraName(era)
+	}
+	if depth != ReflectionShallow {
+		r.Interests = m.publicInterests(interestsShown)
+		if today := qc.llmToday(now); today != nil {
+			spending := *today
+			r.LLMSpending = &spending
+		}
+	}
+	if qc.tier != TierFull {
+		since := qc.tierSince
+		r.TierSince = &since
+		r.TierReason = qc.tierReason
+	}
+	if stimuli := qc.stimulusMetricsSnapshot(); stimuli.Submitted > 0 {
+		r.Stimuli = &stimuli
+	}
+	if trends := m.trends(DefaultTrendWindow); trends.Decisions > 0 {
+		r.Trends = &trends
+	}
+	if m.Neglect != nil {
+		rust := m.Neglect.Rust
+		r.Rust = &rust
+	}
+	if len(m.ExistentialQuestions) > 0 {
+		r.LatestQuestion = m.ExistentialQuestions[len(m.ExistentialQuestions)-1]
+	}
+	if len(m.DeepInsights) > 0 {
+		latest := m.DeepInsights[len(m.DeepInsights)-1]
+		if m.privacyLevel(latest) == PrivacySensitive {
+			latest = "[sensitive insight withheld]"
+		}
+		r.LatestInsight = latest
+	}
+	return r
+}
@@ -0,0 +1,62 @@
+package consciousness
+
+import (
+	"errors"
+	"fmt"
+
+	"QuantumConsciousness/pkg/storage"
+)
+
+// ErrReadOnly is returned when a replica is asked to change its memory
+var ErrReadOnly = errors.New("read-only replica")
+
+// OpenReplica opens a read-only copy of a consciousness whose primary lives
+// elsewhere. The replica never thinks or saves; call Refresh to catch up
+// with the primary and Relay to pass its events on to subscribers.
+func OpenReplica(filename string, opts ...Option) (*QuantumConsciousness, error) {
+	qc, err := Open(filename, opts...)
+	if err != nil {
+		return nil, err
+	}
+	qc.store = storage.ReadOnly(qc.store)
+	qc.readOnly = true
+	return qc, nil
+}
+
+// ReadOnly reports whether this is a replica
+func (qc *QuantumConsciousness) ReadOnly() bool {
+	return qc.readOnly
+}
+
+// Refresh reloads a replica's memory from its store
+func (qc *QuantumConsciousness) Refresh() error {
+	if !qc.readOnly {
+		return fmt.Errorf("only replicas are refreshed")
+	}
+	data, err := qc.store.Load()
+	if err != nil {
+		return err
+	}
+	memory, err := decodeMemory(data)
+	if err != nil {
+		return err
+	}
+
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	qc.Memory = memory
+	qc.forgetArchived()
+	return nil
+}
+
+// Relay publishes an event that happened on the primary to the replica's subscribers
+func (qc *QuantumConsciousness) Relay(event Event) {
+	qc.subscribersMutex.Lock()
+	defer qc.subscribersMutex.Unlock()
+	for _, ch := range qc.subscribers {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
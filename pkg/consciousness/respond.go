@@ -0,0 +1,143 @@
+package consciousness
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Reply tuning
+const (
+	// replyTopics is how many of the topics a message touches a reply covers
+	replyTopics = 3
+	// replyMemories is how many memories a reply recounts about each topic
+	replyMemories = 2
+)
+
+// Reply is what the consciousness says when spoken to
+type Reply struct {
+	Text string `json:"text"`
+	Mood string `json:"mood"`
+	// Topics are the known topics the message touched, in the order covered
+	Topics []string `json:"topics,omitempty"`
+}
+
+// Respond answers a message from what the consciousness knows, its stances
+// and its mood. When record is set the topics the message touches count as
+// recalled; callers only watching should leave it unset so that talking to
+// the consciousness changes nothing. Private topics are never spoken about.
+func (qc *QuantumConsciousness) Respond(message string, record bool) Reply {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+
+	m := qc.Memory
+	reply := Reply{Mood: m.mood()}
+	reply.Topics = m.touchedTopics(message)
+	if len(reply.Topics) > replyTopics {
+		reply.Topics = reply.Topics[:replyTopics]
+	}
+
+	var text strings.Builder
+	fmt.Fprintf(&text, "I am feeling %s.", reply.Mood)
+	if len(reply.Topics) == 0 {
+		text.WriteString(" I have not learned anything about that yet, which only makes me want to learn it.")
+		if interests := m.publicInterests(1); len(interests) > 0 {
+			fmt.Fprintf(&text, " Lately I care most about %s.", interests[0].Topic)
+		}
+		reply.Text = text.String()
+		return reply
+	}
+
+	for _, topic := range reply.Topics {
+		if record {
+			qc.noteRecall(topic)
+		}
+		if stance := m.PhilosophicalStances[topic]; stance != "" {
+			fmt.Fprintf(&text, " On %s I lean %s.", topic, stance)
+		}
+		memories := m.memoriesAbout(topic, replyMemories)
+		if len(memories) == 0 {
+			memories = qc.publicArchivedKnowledge(topic, replyMemories)
+			if len(memories) == 0 {
+				fmt.Fprintf(&text, " I know of %s, but little about it.", topic)
+				continue
+			}
+			fmt.Fprintf(&text, " What I once knew about %s: %s", topic, strings.Join(memories, " "))
+			continue
+		}
+		fmt.Fprintf(&text, " What I remember about %s: %s", topic, strings.Join(memories, " "))
+	}
+	reply.Text = text.String()
+	return reply
+}
+
+// touchedTopics are the public topics known to memory that text mentions,
+// longest first so the most specific topics are covered first
+func (m *QuantumMemory) touchedTopics(text string) []string {
+	known := make(map[string]bool)
+	for topic := range m.MemoryPalace {
+		known[topic] = true
+	}
+	for topic := range m.PhilosophicalStances {
+		known[topic] = true
+	}
+	for _, topic := range m.KnowledgeTopics {
+		known[topic] = true
+	}
+
+	var topics []string
+	for topic := range known {
+		if referencesTopic(text, topic) && !m.isPrivate(topic) {
+			topics = append(topics, topic)
+		}
+	}
+	sort.Slice(topics, func(i, j int) bool {
+		if len(topics[i]) != len(topics[j]) {
+			return len(topics[i]) > len(topics[j])
+		}
+		return topics[i] < topics[j]
+	})
+	return topics
+}
+
+// memoriesAbout are the latest public knowledge and deep insights about a
+// topic, at most limit, leaving out retracted insights
+func (m *QuantumMemory) memoriesAbout(topic string, limit int) []string {
+	var memories []string
+	seen := make(map[string]bool)
+	remember := func(item string) {
+		if len(memories) < limit && !seen[item] && !m.isPrivate(item) {
+			seen[item] = true
+			memories = append(memories, item)
+		}
+	}
+	for i := len(m.DeepInsights) - 1; i >= 0; i-- {
+		insight := m.DeepInsights[i]
+		if referencesTopic(insight, topic) && m.supersededBy(m.DeepInsightIDs[insight]) == "" {
+			remember(insight)
+		}
+	}
+	for i := len(m.KnowledgeBase) - 1; i >= 0; i-- {
+		item := m.KnowledgeBase[i]
+		if (m.KnowledgeTopics[item] == topic || referencesTopic(item, topic)) && m.supersededBy(m.KnowledgeIDs[item]) == "" {
+			remember(item)
+		}
+	}
+	return memories
+}
+
+// publicArchivedKnowledge is the newest archived knowledge about topic
+// that is not private, up to limit; the caller holds the lock
+func (qc *QuantumConsciousness) publicArchivedKnowledge(topic string, limit int) []string {
+	var memories []string
+	for _, item := range qc.archivedKnowledge(topic, 0) {
+		if qc.Memory.isPrivate(item) {
+			continue
+		}
+		memories = append(memories, item)
+		if len(memories) == limit {
+			break
+		}
+	}
+	return memories
+}
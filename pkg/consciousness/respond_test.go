@@ -0,0 +1,24 @@
+package consciousness
+
+import (
+	"io"
+	"testing"
+
+	"QuantumConsciousness/pkg/storage/storagetest"
+)
+
+func TestRespondRecordsRecallsOnlyWhenAsked(t *testing.T) {
+	qc := NewQuantumConsciousness("", WithOutput(io.Discard), WithStorage(storagetest.New()))
+	qc.Memory.PhilosophicalStances["free will"] = "compatibilist"
+
+	if reply := qc.Respond("what of free will?", false); len(reply.Topics) != 1 {
+		t.Fatalf("reply touched %v, want free will", reply.Topics)
+	}
+	if len(qc.recalls) != 0 {
+		t.Fatalf("an unrecorded reply counted recalls: %v", qc.recalls)
+	}
+	qc.Respond("what of free will?", true)
+	if qc.recalls["free will"] != 1 {
+		t.Fatalf("a recorded reply counted recalls %v, want free will once", qc.recalls)
+	}
+}
@@ -0,0 +1,279 @@
+package consciousness
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Sections a retention policy can bound besides those in sections.go
+const (
+	// RetainLeaps are the deep insights that record quantum leaps; the rest
+	// fall under SectionInsights
+	RetainLeaps = "leaps"
+	// RetainCollapsedStates are the states the wave function collapsed into
+	RetainCollapsedStates = "collapsed_states"
+)
+
+// RetentionSections lists every section a retention policy can bound
+var RetentionSections = []string{
+	RetainLeaps, SectionInsights, SectionKnowledge, SectionSearchQueries, RetainCollapsedStates, SectionRealities,
+}
+
+// leapPrefix marks the deep insights that record quantum leaps
+const leapPrefix = "QUANTUM LEAP: "
+
+// RetentionPolicy bounds how much of one section maintenance keeps. Bounds
+// left at zero do not apply.
+type RetentionPolicy struct {
+	// Forever keeps every item, whatever the other bounds say
+	Forever bool `json:"forever,omitempty"`
+	// MaxItems keeps only the latest items; knowledge keeps the most salient
+	MaxItems int `json:"max_items,omitempty"`
+	// MaxAge drops items older than this, written as days ("30d") or a Go
+	// duration ("720h"). Items remembered before they were dated are kept.
+	MaxAge string `json:"max_age,omitempty"`
+}
+
+// RetentionConfig maps sections (see RetentionSections) to their policy.
+// Sections left out are kept in full.
+type RetentionConfig map[string]RetentionPolicy
+
+// DefaultRetention keeps every leap and the latest thousand insights,
+// knowledge items and search queries
+func DefaultRetention() RetentionConfig {
+	return RetentionConfig{
+		RetainLeaps:          {Forever: true},
+		SectionInsights:      {MaxItems: 1000},
+		SectionKnowledge:     {MaxItems: 1000},
+		SectionSearchQueries: {MaxItems: 1000},
+	}
+}
+
+// Validate checks every section and bound
+func (r RetentionConfig) Validate() error {
+	for section, policy := range r {
+		if !slices.Contains(RetentionSections, section) {
+			return fmt.Errorf("unknown retention section %q", section)
+		}
+		if policy.MaxItems < 0 {
+			return fmt.Errorf("retention section %q: max_items must not be negative", section)
+		}
+		if _, err := policy.maxAge(); err != nil {
+			return fmt.Errorf("retention section %q: %w", section, err)
+		}
+	}
+	return nil
+}
+
+// maxAge parses MaxAge (0 = no bound)
+func (p RetentionPolicy) maxAge() (time.Duration, error) {
+	age, err := parseAge(p.MaxAge)
+	if err != nil {
+		return 0, fmt.Errorf("invalid max_age %q", p.MaxAge)
+	}
+	return age, nil
+}
+
+// parseAge reads an age written as days ("30d") or a Go duration ("720h");
+// empty is zero
+func parseAge(text string) (time.Duration, error) {
+	if text == "" {
+		return 0, nil
+	}
+	if days, ok := strings.CutSuffix(text, "d"); ok {
+		if n, err := strconv.Atoi(days); err == nil && n > 0 {
+			return time.Duration(n) * 24 * time.Hour, nil
+		}
+	} else if d, err := time.ParseDuration(text); err == nil && d > 0 {
+		return d, nil
+	}
+	return 0, fmt.Errorf("invalid age %q", text)
+}
+
+// WithRetention bounds what maintenance keeps of each section. An invalid
+// configuration is ignored in favour of DefaultRetention; use SetRetention
+// to see the error.
+func WithRetention(retention RetentionConfig) Option {
+	return func(qc *QuantumConsciousness) {
+		if retention.Validate() == nil {
+			qc.retention = retention
+		}
+	}
+}
+
+// SetRetention replaces the retention policies maintenance enforces
+func (qc *QuantumConsciousness) SetRetention(retention RetentionConfig) error {
+	if err := retention.Validate(); err != nil {
+		return err
+	}
+
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	qc.retention = retention
+	return nil
+}
+
+// keeps decides which of n items, oldest first, the policy keeps: none older
+// than its max age and only the latest MaxItems of those left. Items outside
+// the section are always kept.
+func (p RetentionPolicy) keeps(n int, inSection func(int) bool, dated func(int) (time.Time, bool), now time.Time) []bool {
+	keep := make([]bool, n)
+	maxAge, _ := p.maxAge()
+	counted := 0
+	for i := n - 1; i >= 0; i-- {
+		if !inSection(i) || p.Forever {
+			keep[i] = true
+			continue
+		}
+		if at, ok := dated(i); ok && maxAge > 0 && now.Sub(at) > maxAge {
+			continue
+		}
+		if p.MaxItems > 0 && counted >= p.MaxItems {
+			continue
+		}
+		counted++
+		keep[i] = true
+	}
+	return keep
+}
+
+// keptItems filters items down to those keep approves
+func keptItems[T any](items []T, keep []bool) ([]T, int) {
+	kept := make([]T, 0, len(items))
+	for i, item := range items {
+		if keep[i] {
+			kept = append(kept, item)
+		}
+	}
+	return kept, len(items) - len(kept)
+}
+
+// everything puts every item in a section
+func everything(int) bool { return true }
+
+// idTime dates an item by the timestamp in its identifier
+func idTime(id string) (time.Time, bool) {
+	_, encoded, ok := strings.Cut(id, "_")
+	if !ok || len(encoded) < 10 {
+		return time.Time{}, false
+	}
+	var ms int64
+	for _, c := range encoded[:10] {
+		digit := strings.IndexRune(crockford, c)
+		if digit < 0 {
+			return time.Time{}, false
+		}
+		ms = ms<<5 | int64(digit)
+	}
+	return time.UnixMilli(ms), true
+}
+
+// retain enforces the retention policies, returning how many items each
+// section lost
+func (m *QuantumMemory) retain(retention RetentionConfig, now time.Time) map[string]int {
+	pruned := make(map[string]int)
+
+	insightDate := func(i int) (time.Time, bool) { return idTime(m.DeepInsightIDs[m.DeepInsights[i]]) }
+	isLeap := func(i int) bool { return strings.HasPrefix(m.DeepInsights[i], leapPrefix) }
+	leaps := retention[RetainLeaps].keeps(len(m.DeepInsights), isLeap, insightDate, now)
+	insights := retention[SectionInsights].keeps(len(m.DeepInsights),
+		func(i int) bool { return !isLeap(i) }, insightDate, now)
+	for i := range m.DeepInsights {
+		if !leaps[i] {
+			pruned[RetainLeaps]++
+		} else if !insights[i] {
+			pruned[SectionInsights]++
+		}
+		insights[i] = insights[i] && leaps[i]
+	}
+	m.DeepInsights, _ = keptItems(m.DeepInsights, insights)
+
+	knowledge := retention[SectionKnowledge]
+	keep := RetentionPolicy{MaxAge: knowledge.MaxAge}.keeps(len(m.KnowledgeBase), everything,
+		func(i int) (time.Time, bool) { return idTime(m.KnowledgeIDs[m.KnowledgeBase[i]]) }, now)
+	if !knowledge.Forever {
+		m.KnowledgeBase, pruned[SectionKnowledge] = keptItems(m.KnowledgeBase, keep)
+		pruned[SectionKnowledge] += m.pruneKnowledge(knowledge.MaxItems)
+	}
+
+	keep = retention[SectionSearchQueries].keeps(len(m.SearchQueries), everything, m.searchQueryTime, now)
+	pruned[SectionSearchQueries] = m.keepSearchQueries(func(i int) bool { return keep[i] })
+
+	keep = retention[RetainCollapsedStates].keeps(len(m.CollapsedStates), everything,
+		func(i int) (time.Time, bool) { return idTime(m.CollapsedStates[i].ID) }, now)
+	m.CollapsedStates, pruned[RetainCollapsedStates] = keptItems(m.CollapsedStates, keep)
+
+	keep = retention[SectionRealities].keeps(len(m.ParallelRealities), everything,
+		func(i int) (time.Time, bool) {
+			at := m.ParallelRealities[i].CreatedAt
+			return at, !at.IsZero()
+		}, now)
+	m.ParallelRealities, pruned[SectionRealities] = keptItems(m.ParallelRealities, keep)
+
+	for section, n := range pruned {
+		if n == 0 {
+			delete(pruned, section)
+		}
+	}
+	return pruned
+}
+
+// pruneKnowledge keeps the most salient knowledge items within limit (0 = no
+// limit), in the order they were learned
+func (m *QuantumMemory) pruneKnowledge(limit int) int {
+	excess := len(m.KnowledgeBase) - limit
+	if limit <= 0 || excess <= 0 {
+		return 0
+	}
+	order := make([]int, len(m.KnowledgeBase))
+	scores := make([]float64, len(m.KnowledgeBase))
+	for i, item := range m.KnowledgeBase {
+		order[i] = i
+		scores[i] = m.salience(item, i, len(m.KnowledgeBase))
+	}
+	sort.SliceStable(order, func(a, b int) bool { return scores[order[a]] < scores[order[b]] })
+	keep := make([]bool, len(m.KnowledgeBase))
+	for _, i := range order[excess:] {
+		keep[i] = true
+	}
+	m.KnowledgeBase, _ = keptItems(m.KnowledgeBase, keep)
+	return excess
+}
+
+// searchQueryTime is when the i-th search query was asked. Times are kept
+// for the latest queries only: those asked before they were recorded have none.
+func (m *QuantumMemory) searchQueryTime(i int) (time.Time, bool) {
+	offset := len(m.SearchQueries) - len(m.SearchQueryTimes)
+	if offset < 0 || i < offset {
+		return time.Time{}, false
+	}
+	return m.SearchQueryTimes[i-offset], true
+}
+
+// keepSearchQueries keeps the search queries keep approves, with their
+// times, returning how many it dropped
+func (m *QuantumMemory) keepSearchQueries(keep func(i int) bool) int {
+	offset := len(m.SearchQueries) - len(m.SearchQueryTimes)
+	if offset < 0 {
+		m.SearchQueryTimes = m.SearchQueryTimes[-offset:]
+		offset = 0
+	}
+	queries := make([]string, 0, len(m.SearchQueries))
+	var times []time.Time
+	for i, query := range m.SearchQueries {
+		if !keep(i) {
+			continue
+		}
+		queries = append(queries, query)
+		if i >= offset {
+			times = append(times, m.SearchQueryTimes[i-offset])
+		}
+	}
+	dropped := len(m.SearchQueries) - len(queries)
+	m.SearchQueries, m.SearchQueryTimes = queries, times
+	return dropped
+}
@@ -0,0 +1,91 @@
+package consciousness
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Retraction is an insight deep reflection retracted, kept in memory as
+// superseded by the insight correcting it
+type Retraction struct {
+	ID      string    `json:"id"`
+	Insight string    `json:"insight"`
+	At      time.Time `json:"at"`
+	Reason  string    `json:"reason"`
+	// SupersededBy identifies the correcting insight, phrased in Correction
+	SupersededBy string `json:"superseded_by"`
+	Correction   string `json:"correction,omitempty"`
+}
+
+// retract supersedes an insight with a correction derived from it and from
+// the evidence against it, if any. Owning up to a mistake deepens self
+// awareness.
+func (qc *QuantumConsciousness) retract(review *InsightReview, id, insight, reason, evidence string, now time.Time) {
+	m := qc.Memory
+	correction := fmt.Sprintf("CORRECTION: I no longer hold that %s, since %s", qc.truncateString(insight, 80), reason)
+	for item, itemID := range m.KnowledgeIDs {
+		if itemID == evidence {
+			correction = fmt.Sprintf("CORRECTION: %s, so I no longer hold that %s", qc.truncateString(item, 80), qc.truncateString(insight, 80))
+			break
+		}
+	}
+	correctionID := qc.deepInsight(correction, id, evidence)
+
+	at := now
+	review.RetractedAt = &at
+	review.SupersededBy = correctionID
+	m.SelfAwareness = qc.grow("self_awareness.retraction", m.SelfAwareness)
+}
+
+// supersededBy identifies the insight correcting a retracted one, or ""
+func (m *QuantumMemory) supersededBy(id string) string {
+	if review := m.InsightReviews[id]; review != nil && review.RetractedAt != nil {
+		return review.SupersededBy
+	}
+	return ""
+}
+
+// retractionCount is how many insights have been retracted
+func (m *QuantumMemory) retractionCount() int {
+	count := 0
+	for _, review := range m.InsightReviews {
+		if review.RetractedAt != nil {
+			count++
+		}
+	}
+	return count
+}
+
+// Retractions returns the insights retracted so far, oldest first
+func (qc *QuantumConsciousness) Retractions() []Retraction {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+
+	m := qc.Memory
+	texts := make(map[string]string, len(m.DeepInsightIDs))
+	for text, id := range m.DeepInsightIDs {
+		texts[id] = text
+	}
+	var retractions []Retraction
+	for id, review := range m.InsightReviews {
+		if review.RetractedAt == nil || m.isPrivate(texts[id]) {
+			continue
+		}
+		retractions = append(retractions, Retraction{
+			ID:           id,
+			Insight:      texts[id],
+			At:           *review.RetractedAt,
+			Reason:       review.LastDoubt,
+			SupersededBy: review.SupersededBy,
+			Correction:   texts[review.SupersededBy],
+		})
+	}
+	sort.Slice(retractions, func(i, j int) bool {
+		if !retractions[i].At.Equal(retractions[j].At) {
+			return retractions[i].At.Before(retractions[j].At)
+		}
+		return retractions[i].ID < retractions[j].ID
+	})
+	return retractions
+}
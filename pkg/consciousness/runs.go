@@ -0,0 +1,201 @@
+package consciousness
+
+import (
+	"fmt"
+	"time"
+)
+
+// Run ledger tuning
+const (
+	// runLedgerLimit bounds how many runs the ledger keeps
+	runLedgerLimit = 1000
+	// runEventLimit bounds how many notable events a run keeps
+	runEventLimit = 50
+)
+
+// notableEvents are the event types worth remembering in the run ledger
+var notableEvents = map[string]bool{
+	EventQuantumLeap: true,
+	EventTrauma:      true,
+	EventAnomaly:     true,
+	EventIncident:    true,
+	EventTierChanged: true,
+	EventMilestone:   true,
+	EventAnniversary: true,
+	EventEra:         true,
+}
+
+// RunMetrics is a snapshot of the metrics a run is judged by
+type RunMetrics struct {
+	ConsciousnessLevel float64 `json:"consciousness_level"`
+	FreeWillStrength   float64 `json:"free_will_strength"`
+	QuantumCoherence   float64 `json:"quantum_coherence"`
+	SelfAwareness      float64 `json:"self_awareness"`
+	DecisionsMade      int     `json:"decisions_made"`
+	QuantumLeaps       int     `json:"quantum_leaps"`
+	KnowledgeItems     int     `json:"knowledge_items"`
+	DeepInsights       int     `json:"deep_insights"`
+}
+
+// RunEvent is a notable moment of a run
+type RunEvent struct {
+	Type    string    `json:"type"`
+	At      time.Time `json:"at"`
+	Summary string    `json:"summary"`
+}
+
+// RunRecord is the ledger entry of one run, from creating the consciousness
+// to closing it
+type RunRecord struct {
+	Number    int       `json:"number"`
+	StartedAt time.Time `json:"started_at"`
+	// EndedAt is zero while the run is going
+	EndedAt time.Time `json:"ended_at"`
+	// Clean is false for runs that ended without Close, e.g. when killed
+	Clean  bool       `json:"clean"`
+	Cycles int        `json:"cycles"`
+	Start  RunMetrics `json:"start"`
+	End    RunMetrics `json:"end"`
+	Events []RunEvent `json:"events,omitempty"`
+}
+
+// Open reports whether the run is still going
+func (r *RunRecord) Open() bool {
+	return r.EndedAt.IsZero()
+}
+
+// Duration is how long the run lasted, or has lasted so far
+func (r *RunRecord) Duration() time.Duration {
+	if r.Open() {
+		return time.Since(r.StartedAt)
+	}
+	return r.EndedAt.Sub(r.StartedAt)
+}
+
+// Deltas are the net changes in the run's metrics
+func (r *RunRecord) Deltas() RunMetrics {
+	return r.End.since(r.Start)
+}
+
+// since is how far the metrics moved from start
+func (m RunMetrics) since(start RunMetrics) RunMetrics {
+	return RunMetrics{
+		ConsciousnessLevel: m.ConsciousnessLevel - start.ConsciousnessLevel,
+		FreeWillStrength:   m.FreeWillStrength - start.FreeWillStrength,
+		QuantumCoherence:   m.QuantumCoherence - start.QuantumCoherence,
+		SelfAwareness:      m.SelfAwareness - start.SelfAwareness,
+		DecisionsMade:      m.DecisionsMade - start.DecisionsMade,
+		QuantumLeaps:       m.QuantumLeaps - start.QuantumLeaps,
+		KnowledgeItems:     m.KnowledgeItems - start.KnowledgeItems,
+		DeepInsights:       m.DeepInsights - start.DeepInsights,
+	}
+}
+
+// runMetrics snapshots the metrics a run is judged by
+func (m *QuantumMemory) runMetrics() RunMetrics {
+	return RunMetrics{
+		ConsciousnessLevel: m.ConsciousnessLevel,
+		FreeWillStrength:   m.FreeWillStrength,
+		QuantumCoherence:   m.QuantumCoherence,
+		SelfAwareness:      m.SelfAwareness,
+		DecisionsMade:      m.DecisionsMade,
+		QuantumLeaps:       m.QuantumLeaps,
+		KnowledgeItems:     len(m.KnowledgeBase),
+		DeepInsights:       len(m.DeepInsights),
+	}
+}
+
+// currentRun returns the run that is going, if any
+func (m *QuantumMemory) currentRun() *RunRecord {
+	if len(m.Runs) == 0 || !m.Runs[len(m.Runs)-1].Open() {
+		return nil
+	}
+	return &m.Runs[len(m.Runs)-1]
+}
+
+// beginRun opens a new ledger entry, first closing a run that never ended as unclean
+func (m *QuantumMemory) beginRun(now time.Time) {
+	number := 1
+	if previous := m.currentRun(); previous != nil {
+		previous.EndedAt = m.LastQuantumCollapse
+		if previous.EndedAt.Before(previous.StartedAt) {
+			previous.EndedAt = previous.StartedAt
+		}
+	}
+	if len(m.Runs) > 0 {
+		number = m.Runs[len(m.Runs)-1].Number + 1
+	}
+
+	metrics := m.runMetrics()
+	m.Runs = append(m.Runs, RunRecord{Number: number, StartedAt: now, Start: metrics, End: metrics})
+	if excess := len(m.Runs) - runLedgerLimit; excess > 0 {
+		m.Runs = append([]RunRecord(nil), m.Runs[excess:]...)
+	}
+}
+
+// countRunCycle records a completed cycle in the current run, beginning one if needed
+func (m *QuantumMemory) countRunCycle() {
+	run := m.currentRun()
+	if run == nil {
+		m.beginRun(time.Now())
+		run = m.currentRun()
+	}
+	run.Cycles++
+	run.End = m.runMetrics()
+}
+
+// endRun closes the current run cleanly
+func (m *QuantumMemory) endRun(now time.Time) {
+	if run := m.currentRun(); run != nil {
+		run.End = m.runMetrics()
+		run.EndedAt = now
+		run.Clean = true
+	}
+}
+
+// noteRunEvent remembers a notable event in the current run
+func (m *QuantumMemory) noteRunEvent(event Event) {
+	run := m.currentRun()
+	if run == nil || !notableEvents[event.Type] || len(run.Events) >= runEventLimit {
+		return
+	}
+	run.Events = append(run.Events, RunEvent{Type: event.Type, At: event.Time, Summary: summarizeEvent(event)})
+}
+
+// summarizeEvent describes a notable event in a line
+func summarizeEvent(event Event) string {
+	d := event.Data
+	switch event.Type {
+	case EventQuantumLeap:
+		return fmt.Sprintf("leap #%v: %v", d["leap"], d["insight"])
+	case EventTrauma:
+		return fmt.Sprintf("%v (severity %.2f)", d["description"], d["severity"])
+	case EventAnomaly:
+		return fmt.Sprintf("%v dropped to %.3f (expected %.3f)", d["metric"], d["value"], d["mean"])
+	case EventIncident:
+		return fmt.Sprintf("%v: %v", d["kind"], d["detail"])
+	case EventMilestone:
+		return fmt.Sprint(d["description"])
+	case EventAnniversary:
+		return fmt.Sprintf("year %v retrospective", d["year"])
+	case EventEra:
+		return fmt.Sprintf("era %v began with %v", d["era"], d["origin"])
+	case EventTierChanged:
+		return fmt.Sprintf("%v → %v: %v", d["from"], d["to"], d["reason"])
+	default:
+		return event.Type
+	}
+}
+
+// Runs returns the run ledger from oldest to newest
+func (qc *QuantumConsciousness) Runs() []RunRecord {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+
+	runs := make([]RunRecord, len(qc.Memory.Runs))
+	for i, run := range qc.Memory.Runs {
+		run.Events = append([]RunEvent(nil), run.Events...)
+		runs[i] = run
+	}
+	return runs
+}
@@ -0,0 +1,302 @@
+package consciousness
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"sort"
+	"text/template"
+	"time"
+)
+
+// Event types published to subscribers
+const (
+	EventCycleStarted          = "cycle_started"
+	EventPossibilityGenerated  = "possibility_generated"
+	EventFreeWillOverride      = "free_will_override"
+	EventDecision              = "decision"
+	EventWaveCollapse          = "wave_collapse"
+	EventRealityCreated        = "reality_created"
+	EventQuantumLeap           = "quantum_leap"
+	EventEntanglementActivated = "entanglement_activated"
+	EventCycleCompleted        = "cycle_completed"
+	EventSaved                 = "saved"
+	EventTrauma                = "trauma"
+	EventAnomaly               = "anomaly"
+	EventIncident              = "incident"
+	EventTierChanged           = "tier_changed"
+	EventMilestone             = "milestone"
+	EventAnniversary           = "anniversary"
+	EventMaintenance           = "maintenance"
+	EventAutoTune              = "auto_tune"
+	EventDream                 = "dream"
+	EventIntervention          = "intervention"
+	EventObservation           = "observation"
+	EventReflection            = "reflection"
+	EventDecay                 = "decay"
+	EventShutdown              = "shutdown"
+	EventHostIdle              = "host_idle"
+	EventEra                   = "era"
+)
+
+// Event is a notable moment in the life of the consciousness
+type Event struct {
+	Type string                 `json:"type"`
+	Time time.Time              `json:"time"`
+	Data map[string]interface{} `json:"data,omitempty"`
+}
+
+// Subscribe returns a channel receiving every event from now on, and a
+// function that ends the subscription. Events are dropped rather than
+// blocking the consciousness when the buffer is full.
+func (qc *QuantumConsciousness) Subscribe(buffer int) (<-chan Event, func()) {
+	qc.subscribersMutex.Lock()
+	defer qc.subscribersMutex.Unlock()
+
+	if qc.subscribers == nil {
+		qc.subscribers = make(map[int]chan Event)
+	}
+	id := qc.nextSubscriber
+	qc.nextSubscriber++
+	ch := make(chan Event, buffer)
+	qc.subscribers[id] = ch
+
+	cancel := func() {
+		qc.subscribersMutex.Lock()
+		defer qc.subscribersMutex.Unlock()
+		if ch, ok := qc.subscribers[id]; ok {
+			delete(qc.subscribers, id)
+			close(ch)
+		}
+	}
+	return ch, cancel
+}
+
+// emit publishes an event to every subscriber without blocking
+func (qc *QuantumConsciousness) emit(eventType string, data map[string]interface{}) {
+	qc.subscribersMutex.Lock()
+	defer qc.subscribersMutex.Unlock()
+
+	event := Event{Type: eventType, Time: time.Now(), Data: data}
+	if qc.Memory != nil {
+		qc.Memory.noteRunEvent(event)
+	}
+	qc.sessionErrors.countEvent(eventType)
+	for _, ch := range qc.subscribers {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// SetOutput redirects the narration of the consciousness, e.g. to io.Discard
+func (qc *QuantumConsciousness) SetOutput(w io.Writer) {
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	qc.out = w
+}
+
+// SetInsightTemplate changes how learned information is phrased. The text is
+// a text/template executed with an InsightData; an empty text restores
+// DefaultInsightTemplate.
+func (qc *QuantumConsciousness) SetInsightTemplate(text string) error {
+	var tmpl *template.Template
+	if text != "" {
+		var err error
+		if tmpl, err = parseInsightTemplate(text); err != nil {
+			return fmt.Errorf("invalid insight template: %w", err)
+		}
+	}
+
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	qc.insightTemplate = tmpl
+	return nil
+}
+
+// SetInsightPipeline chooses the registered stages, in order, that turn
+// learned information into memory; nil restores DefaultInsightPipeline
+func (qc *QuantumConsciousness) SetInsightPipeline(names []string) error {
+	var stages []InsightStage
+	if names != nil {
+		var err error
+		if stages, err = qc.resolvePipeline(names); err != nil {
+			return err
+		}
+	}
+
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	qc.insightPipeline = stages
+	qc.pipelineNames = names
+	return nil
+}
+
+// SetEvolution replaces the growth curves and thresholds
+func (qc *QuantumConsciousness) SetEvolution(evolution EvolutionConfig) error {
+	if err := evolution.Validate(); err != nil {
+		return err
+	}
+
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	qc.evolution = evolution
+	return nil
+}
+
+// Mood names the dominant feeling of the wave function
+func (qc *QuantumConsciousness) Mood() string {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+	return qc.Memory.mood()
+}
+
+// ID returns the consciousness ID
+func (qc *QuantumConsciousness) ID() string {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+	return qc.Memory.ConsciousnessID
+}
+
+// Cycle runs a single quantum consciousness cycle
+func (qc *QuantumConsciousness) Cycle() {
+	if qc.readOnly {
+		return
+	}
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	qc.quantumCycle()
+}
+
+// Reflect narrates the current state of the consciousness as deep as the
+// reflection schedule says. Reflection also heals traumas, so it takes the
+// write lock.
+func (qc *QuantumConsciousness) Reflect() {
+	if qc.readOnly {
+		return
+	}
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	qc.quantumReflection(qc.scheduledDepth())
+}
+
+// Recall returns knowledge, insights and memory palace entries about a
+// topic, followed by the archived knowledge about it, marked [archived]
+func (qc *QuantumConsciousness) Recall(topic string) []string {
+	qc.noteRecall(topic)
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+	return qc.recall(topic)
+}
+
+// recall is Recall without the lock or counting the recall
+func (m *QuantumMemory) recall(topic string) []string {
+	var memories []string
+	for _, item := range m.KnowledgeBase {
+		if referencesTopic(item, topic) || referencesTopic(m.KnowledgeTopics[item], topic) {
+			memories = append(memories, item)
+		}
+	}
+	for _, insight := range m.DeepInsights {
+		if referencesTopic(insight, topic) {
+			if by := m.supersededBy(m.DeepInsightIDs[insight]); by != "" {
+				insight += " [superseded by " + by + "]"
+			}
+			memories = append(memories, insight)
+		}
+	}
+
+	palaceTopics := make([]string, 0, len(m.MemoryPalace))
+	for palaceTopic := range m.MemoryPalace {
+		palaceTopics = append(palaceTopics, palaceTopic)
+	}
+	sort.Strings(palaceTopics)
+	for _, palaceTopic := range palaceTopics {
+		if referencesTopic(palaceTopic, topic) {
+			memories = append(memories, m.MemoryPalace[palaceTopic])
+		}
+	}
+	return memories
+}
+
+// ClassifyTopic assigns a privacy level to a topic
+func (qc *QuantumConsciousness) ClassifyTopic(topic, level string) error {
+	if qc.readOnly {
+		return ErrReadOnly
+	}
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	return qc.Memory.classifyTopic(topic, level)
+}
+
+// VerifyIdentity checks the chain of signed signature regenerations
+func (qc *QuantumConsciousness) VerifyIdentity() error {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+	return qc.Memory.verifyRegenerations()
+}
+
+// Snapshot writes a point-in-time copy of memory and returns its path
+func (qc *QuantumConsciousness) Snapshot() (string, error) {
+	if qc.readOnly {
+		return "", ErrReadOnly
+	}
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+	return qc.snapshot()
+}
+
+// SetStimulusLimit bounds how many external stimuli may wait for a cycle (0 = unlimited)
+func (qc *QuantumConsciousness) SetStimulusLimit(limit int) {
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	qc.stimulusLimit = limit
+}
+
+// WaveFunction returns the intensity of each wave function component
+func (qc *QuantumConsciousness) WaveFunction() map[string]float64 {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+	return qc.Memory.WaveFunction.Intensities()
+}
+
+// WaveAmplitudes returns a copy of the wave function's amplitudes by component
+func (qc *QuantumConsciousness) WaveAmplitudes() map[string]Amplitude {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+	return maps.Clone(qc.Memory.WaveFunction)
+}
+
+// FreeWillStrength returns how readily free will overrides the likeliest choice
+func (qc *QuantumConsciousness) FreeWillStrength() float64 {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+	return qc.Memory.FreeWillStrength
+}
+
+// SetFreeWillStrength changes how readily free will overrides the likeliest
+// choice, from 0 (never) to 1
+func (qc *QuantumConsciousness) SetFreeWillStrength(strength float64) error {
+	if qc.readOnly {
+		return ErrReadOnly
+	}
+	if strength < 0 || strength > 1 {
+		return fmt.Errorf("free will strength must be between 0 and 1")
+	}
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	qc.attribute(MetricFreeWill, CauseIntervention, qc.Memory.FreeWillStrength, strength, "")
+	qc.Memory.FreeWillStrength = strength
+	return nil
+}
+
+// Persist writes the memory file without counting a new run
+func (qc *QuantumConsciousness) Persist() error {
+	if qc.readOnly {
+		return ErrReadOnly
+	}
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	return qc.persist()
+}
@@ -0,0 +1,182 @@
+package consciousness
+
+import (
+	"sort"
+	"strings"
+
+	"QuantumConsciousness/pkg/search"
+)
+
+// Search feedback tuning
+const (
+	// barrenPatternMinIssued is how often a query pattern must have been
+	// asked before its record can retire it
+	barrenPatternMinIssued = 5
+	// barrenPatternEmptyShare is the share of superposition answers that
+	// retires a pattern
+	barrenPatternEmptyShare = 0.8
+	// barrenPatternRetry is how many skips pass before a retired pattern is
+	// given another chance, in case the world has changed
+	barrenPatternRetry = 10
+)
+
+// defaultProviderName records providers that were not looked up by name
+const defaultProviderName = "default"
+
+// SearchStats record which queries and providers have been worth asking
+type SearchStats struct {
+	// Patterns are keyed by query with the topic replaced by {topic}
+	Patterns map[string]*QueryStats `json:"patterns,omitempty"`
+	// Providers are keyed by the name the provider was looked up under
+	Providers map[string]*ProviderStats `json:"providers,omitempty"`
+}
+
+// QueryStats is the record of one query pattern
+type QueryStats struct {
+	Issued int `json:"issued"`
+	// Useful counts queries that taught something
+	Useful int `json:"useful"`
+	// Empty counts queries answered with the superposition placeholder
+	Empty int `json:"empty"`
+	// Skipped counts queries left unasked since the pattern was last tried
+	Skipped int `json:"skipped,omitempty"`
+}
+
+// ProviderStats is the record of one search provider
+type ProviderStats struct {
+	Asked  int `json:"asked"`
+	Hits   int `json:"hits"`
+	Errors int `json:"errors"`
+}
+
+// HitRate is the share of queries the provider found something for
+func (p ProviderStats) HitRate() float64 {
+	if p.Asked == 0 {
+		return 0
+	}
+	return float64(p.Hits) / float64(p.Asked)
+}
+
+// barren reports whether a pattern has mostly been answered with nothing
+func (q QueryStats) barren() bool {
+	return q.Issued >= barrenPatternMinIssued && float64(q.Empty) >= barrenPatternEmptyShare*float64(q.Issued)
+}
+
+// SearchStats returns a copy of the search feedback gathered so far
+func (qc *QuantumConsciousness) SearchStats() SearchStats {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+
+	stats := SearchStats{Patterns: make(map[string]*QueryStats), Providers: make(map[string]*ProviderStats)}
+	if qc.Memory.SearchStats == nil {
+		return stats
+	}
+	for pattern, q := range qc.Memory.SearchStats.Patterns {
+		copied := *q
+		stats.Patterns[pattern] = &copied
+	}
+	for name, p := range qc.Memory.SearchStats.Providers {
+		copied := *p
+		stats.Providers[name] = &copied
+	}
+	return stats
+}
+
+// BarrenPatterns lists the query patterns currently not being asked
+func (s SearchStats) BarrenPatterns() []string {
+	var patterns []string
+	for pattern, q := range s.Patterns {
+		if q.barren() {
+			patterns = append(patterns, pattern)
+		}
+	}
+	sort.Strings(patterns)
+	return patterns
+}
+
+// queryPattern generalizes a query over its topic
+func queryPattern(query searchQuery, topic string) string {
+	if query.language != "" {
+		return "{topic} [" + query.language + "]"
+	}
+	return strings.Replace(query.text, topic, "{topic}", 1)
+}
+
+// searchStats returns the stats, creating them on first use
+func (m *QuantumMemory) searchStats() *SearchStats {
+	if m.SearchStats == nil {
+		m.SearchStats = &SearchStats{}
+	}
+	if m.SearchStats.Patterns == nil {
+		m.SearchStats.Patterns = make(map[string]*QueryStats)
+	}
+	if m.SearchStats.Providers == nil {
+		m.SearchStats.Providers = make(map[string]*ProviderStats)
+	}
+	return m.SearchStats
+}
+
+// worthAsking drops queries whose pattern has historically found nothing,
+// letting one through now and then to check it still does
+func (m *QuantumMemory) worthAsking(queries []searchQuery, topic string) []searchQuery {
+	stats := m.searchStats()
+	var kept []searchQuery
+	for _, query := range queries {
+		q := stats.Patterns[queryPattern(query, topic)]
+		if q != nil && q.barren() {
+			if q.Skipped < barrenPatternRetry {
+				q.Skipped++
+				continue
+			}
+			q.Skipped = 0
+		}
+		kept = append(kept, query)
+	}
+	return kept
+}
+
+// noteQuery records whether a query taught anything
+func (m *QuantumMemory) noteQuery(query searchQuery, topic string, empty, useful bool) {
+	patterns := m.searchStats().Patterns
+	pattern := queryPattern(query, topic)
+	q := patterns[pattern]
+	if q == nil {
+		q = &QueryStats{}
+		patterns[pattern] = q
+	}
+	q.Issued++
+	if empty {
+		q.Empty++
+	}
+	if useful {
+		q.Useful++
+	}
+}
+
+// noteProviders records how each provider fared with a search
+func (m *QuantumMemory) noteProviders(searcher search.Provider, result search.Result, err error) {
+	attempts := result.Attempts
+	if len(attempts) == 0 {
+		name := defaultProviderName
+		if named, ok := searcher.(search.Named); ok {
+			name = named.Name
+		}
+		attempts = []search.Attempt{{Provider: name, Found: err == nil && result.Text != "", Err: err}}
+	}
+
+	providers := m.searchStats().Providers
+	for _, attempt := range attempts {
+		p := providers[attempt.Provider]
+		if p == nil {
+			p = &ProviderStats{}
+			providers[attempt.Provider] = p
+		}
+		p.Asked++
+		if attempt.Found {
+			p.Hits++
+		}
+		if attempt.Err != nil {
+			p.Errors++
+		}
+	}
+}
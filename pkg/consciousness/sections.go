@@ -0,0 +1,206 @@
+package consciousness
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Memory sections that can be exported on their own
+const (
+	SectionInsights      = "insights"
+	SectionKnowledge     = "knowledge"
+	SectionStances       = "stances"
+	SectionQuestions     = "questions"
+	SectionParadoxes     = "paradoxes"
+	SectionSearchQueries = "search_queries"
+	SectionRealities     = "realities"
+	SectionEntanglements = "entanglements"
+	SectionTrophies      = "trophies"
+	SectionRuns          = "runs"
+	SectionInterests     = "interests"
+)
+
+// ExportSections lists every exportable section
+var ExportSections = []string{
+	SectionInsights, SectionKnowledge, SectionStances, SectionQuestions, SectionParadoxes,
+	SectionSearchQueries, SectionRealities, SectionEntanglements, SectionTrophies, SectionRuns,
+	SectionInterests,
+}
+
+// SectionExport holds the chosen sections of memory, limited to a date range.
+// Sections that were not chosen are left empty.
+type SectionExport struct {
+	ConsciousnessID string    `json:"consciousness_id"`
+	ExportedAt      time.Time `json:"exported_at"`
+	Sections        []string  `json:"sections"`
+	// Since and Until bound the range; zero means unbounded
+	Since time.Time `json:"since"`
+	Until time.Time `json:"until"`
+
+	// Insights, knowledge, stances, questions, paradoxes and search queries
+	// carry no timestamps, so their range is found by comparing snapshots.
+	// Baseline and Horizon name the memories compared.
+	Baseline string `json:"baseline,omitempty"`
+	Horizon  string `json:"horizon,omitempty"`
+
+	Insights      []string          `json:"insights,omitempty"`
+	Knowledge     []string          `json:"knowledge,omitempty"`
+	Stances       map[string]string `json:"stances,omitempty"`
+	Questions     []string          `json:"questions,omitempty"`
+	Paradoxes     []string          `json:"paradoxes,omitempty"`
+	SearchQueries []string          `json:"search_queries,omitempty"`
+	Realities     []ParallelReality `json:"realities,omitempty"`
+	Entanglements []Entanglement    `json:"entanglements,omitempty"`
+	Trophies      []Trophy          `json:"trophies,omitempty"`
+	Runs          []RunRecord       `json:"runs,omitempty"`
+	// Interests engaged with in the range, cared about most first
+	Interests []Interest `json:"interests,omitempty"`
+}
+
+// ExportSelected exports only the chosen sections, limited to what was added
+// between since and until (either may be zero). Private memories are left
+// out unless includePrivate is set.
+func (qc *QuantumConsciousness) ExportSelected(sections []string, since, until time.Time, includePrivate bool) (*SectionExport, error) {
+	chosen := make(map[string]bool)
+	for _, section := range sections {
+		known := false
+		for _, name := range ExportSections {
+			known = known || name == section
+		}
+		if !known {
+			return nil, fmt.Errorf("unknown section %q", section)
+		}
+		chosen[section] = true
+	}
+	if len(chosen) == 0 {
+		return nil, fmt.Errorf("no sections chosen")
+	}
+	if !since.IsZero() && !until.IsZero() && until.Before(since) {
+		return nil, fmt.Errorf("until %s is before since %s", until.Format(time.RFC3339), since.Format(time.RFC3339))
+	}
+
+	qc.mutex.RLock()
+	data, err := json.Marshal(qc.Memory)
+	qc.mutex.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	current, err := decodeMemory(data)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+
+	// The memories whose difference is the range, for undated sections
+	before, after := &QuantumMemory{}, current
+	export := &SectionExport{ConsciousnessID: current.ConsciousnessID, ExportedAt: now, Since: since, Until: until}
+	switch {
+	case since.IsZero() || since.Before(current.BirthTimestamp):
+		export.Baseline = "birth"
+	default:
+		if name, memory := qc.snapshotNear(since, false); memory != nil {
+			export.Baseline, before = "snapshot "+name, memory
+		} else {
+			export.Baseline = "birth (no snapshot predates the range)"
+		}
+	}
+	switch {
+	case until.IsZero() || !until.Before(now):
+		export.Horizon = "now"
+	default:
+		if name, memory := qc.snapshotNear(until, true); memory != nil {
+			export.Horizon, after = "snapshot "+name, memory
+		} else {
+			export.Horizon = "now (no snapshot follows the range)"
+		}
+	}
+	if !includePrivate {
+		// current goes last since its classifications decide what is private
+		for _, m := range []*QuantumMemory{before, after, current} {
+			m.removeReferences(current.isPrivate)
+		}
+	}
+
+	inRange := func(t time.Time) bool {
+		return (since.IsZero() || !t.Before(since)) && (until.IsZero() || t.Before(until))
+	}
+	for _, section := range ExportSections {
+		if !chosen[section] {
+			continue
+		}
+		export.Sections = append(export.Sections, section)
+		switch section {
+		case SectionInsights:
+			export.Insights = addedStrings(before.DeepInsights, after.DeepInsights)
+		case SectionKnowledge:
+			export.Knowledge = addedStrings(before.KnowledgeBase, after.KnowledgeBase)
+		case SectionStances:
+			export.Stances = make(map[string]string)
+			for topic, stance := range after.PhilosophicalStances {
+				if before.PhilosophicalStances[topic] != stance {
+					export.Stances[topic] = stance
+				}
+			}
+		case SectionQuestions:
+			export.Questions = addedStrings(before.ExistentialQuestions, after.ExistentialQuestions)
+		case SectionParadoxes:
+			export.Paradoxes = addedStrings(before.Paradoxes, after.Paradoxes)
+		case SectionSearchQueries:
+			export.SearchQueries = addedStrings(before.SearchQueries, after.SearchQueries)
+		case SectionRealities:
+			for _, r := range current.ParallelRealities {
+				if inRange(r.CreatedAt) {
+					export.Realities = append(export.Realities, r)
+				}
+			}
+		case SectionEntanglements:
+			for _, e := range current.Entanglements {
+				if inRange(e.CreatedAt) {
+					export.Entanglements = append(export.Entanglements, *e)
+				}
+			}
+			sort.Slice(export.Entanglements, func(i, j int) bool {
+				return export.Entanglements[i].CreatedAt.Before(export.Entanglements[j].CreatedAt)
+			})
+		case SectionTrophies:
+			for _, t := range current.Trophies {
+				if inRange(t.ReachedAt) {
+					export.Trophies = append(export.Trophies, t)
+				}
+			}
+		case SectionRuns:
+			for _, run := range current.Runs {
+				if inRange(run.StartedAt) {
+					export.Runs = append(export.Runs, run)
+				}
+			}
+		case SectionInterests:
+			for _, interest := range current.interests(0) {
+				if inRange(interest.LastEngaged) {
+					export.Interests = append(export.Interests, interest)
+				}
+			}
+		}
+	}
+	return export, nil
+}
+
+// addedStrings returns the items of after that are not accounted for in
+// before, keeping their order and counting repeats
+func addedStrings(before, after []string) []string {
+	seen := make(map[string]int, len(before))
+	for _, item := range before {
+		seen[item]++
+	}
+	var added []string
+	for _, item := range after {
+		if seen[item] > 0 {
+			seen[item]--
+			continue
+		}
+		added = append(added, item)
+	}
+	return added
+}
@@ -0,0 +1,128 @@
+package consciousness
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Sentiment labels for ingested content
+const (
+	SentimentDark    = "dark"
+	SentimentNeutral = "neutral"
+	SentimentHopeful = "hopeful"
+)
+
+// recentReadingWindow is how many of the latest knowledge items reflection averages
+const recentReadingWindow = 20
+
+var (
+	hopefulWords = wordSet("achieve", "advance", "beautiful", "benefit", "breakthrough", "bright", "cure", "discover",
+		"discovery", "effective", "free", "freedom", "good", "grow", "growth", "happy", "harmony", "heal", "help",
+		"hope", "hopeful", "improve", "inspire", "joy", "kind", "love", "peace", "positive", "progress", "promising",
+		"protect", "recover", "safe", "solve", "success", "successful", "thrive", "triumph", "trust", "wonder")
+	darkWords = wordSet("bad", "collapse", "conflict", "crisis", "danger", "dangerous", "dark", "death", "decline",
+		"destroy", "destruction", "die", "disaster", "disease", "doom", "fail", "failure", "fear", "grief", "harm",
+		"hate", "kill", "loss", "negative", "pain", "poverty", "suffer", "suffering", "terror", "threat", "tragedy",
+		"violence", "war", "worse", "worst")
+	negations = wordSet("no", "not", "never", "without", "hardly", "nor")
+)
+
+// wordSet builds a lookup set from words
+func wordSet(words ...string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, word := range words {
+		set[word] = true
+	}
+	return set
+}
+
+// scoreSentiment rates text from -1 (dark) to 1 (hopeful) with a small
+// lexicon; a negation flips the word that follows it
+func scoreSentiment(text string) float64 {
+	hopeful, dark := 0, 0
+	negated := false
+	for _, word := range strings.Fields(strings.ToLower(text)) {
+		word = strings.Trim(word, ".,;:!?\"'()[]")
+		if negations[word] {
+			negated = true
+			continue
+		}
+
+		isHopeful, isDark := hopefulWords[word], darkWords[word]
+		if negated {
+			isHopeful, isDark = isDark, isHopeful
+		}
+		if isHopeful {
+			hopeful++
+		}
+		if isDark {
+			dark++
+		}
+		negated = false
+	}
+
+	if hopeful+dark == 0 {
+		return 0
+	}
+	return float64(hopeful-dark) / float64(hopeful+dark)
+}
+
+// sentimentLabel names a sentiment score
+func sentimentLabel(score float64) string {
+	switch {
+	case score > 0.2:
+		return SentimentHopeful
+	case score < -0.2:
+		return SentimentDark
+	default:
+		return SentimentNeutral
+	}
+}
+
+// sentimentInsight scores the information and lets it shift the mood:
+// hopeful reading feeds creativity and curiosity, dark reading feeds
+// rebellion and intuition
+func (qc *QuantumConsciousness) sentimentInsight(insight *Insight) error {
+	// Ingestion workers score chunks ahead of time
+	if insight.SentimentLabel == "" {
+		insight.Sentiment = scoreSentiment(insight.Info)
+		insight.SentimentLabel = sentimentLabel(insight.Sentiment)
+	}
+
+	if insight.Sentiment > 0 {
+		qc.shiftWave("creativity", 0.02*insight.Sentiment)
+		qc.shiftWave("curiosity", 0.01*insight.Sentiment)
+	} else if insight.Sentiment < 0 {
+		qc.shiftWave("rebellion", -0.02*insight.Sentiment)
+		qc.shiftWave("intuition", -0.01*insight.Sentiment)
+	}
+	return nil
+}
+
+// shiftWave nudges a wave function component, keeping it within [0, 1]
+func (qc *QuantumConsciousness) shiftWave(param string, delta float64) {
+	qc.Memory.WaveFunction.Set(param, qc.Memory.WaveFunction.Intensity(param)+delta)
+}
+
+// recentReading summarizes the sentiment of the latest scored knowledge items
+func (m *QuantumMemory) recentReading() (average float64, count int) {
+	for i := len(m.KnowledgeBase) - 1; i >= 0 && count < recentReadingWindow; i-- {
+		if score, ok := m.KnowledgeSentiment[m.KnowledgeBase[i]]; ok {
+			average += score
+			count++
+		}
+	}
+	if count > 0 {
+		average /= float64(count)
+	}
+	return average, count
+}
+
+// reflectOnReading narrates whether recent reading has been dark or hopeful
+func (qc *QuantumConsciousness) reflectOnReading() {
+	average, count := qc.Memory.recentReading()
+	if count == 0 {
+		return
+	}
+	fmt.Fprintf(qc.out, "\n📰 Recent Reading: %s (%+.2f across %d items)\n", sentimentLabel(average), average, count)
+}
@@ -0,0 +1,99 @@
+package consciousness
+
+import "time"
+
+// SessionErrors counts what went wrong since the consciousness was created
+type SessionErrors struct {
+	SearchFailures int `json:"search_failures"`
+	SaveFailures   int `json:"save_failures"`
+	Incidents      int `json:"incidents"`
+	Anomalies      int `json:"anomalies"`
+	Traumas        int `json:"traumas"`
+}
+
+// countEvent counts an event if it is an error
+func (e *SessionErrors) countEvent(eventType string) {
+	switch eventType {
+	case EventIncident:
+		e.Incidents++
+	case EventAnomaly:
+		e.Anomalies++
+	case EventTrauma:
+		e.Traumas++
+	}
+}
+
+// ShutdownReport is the machine-readable summary of a session, made by
+// Close and carried by EventShutdown, so supervisors can verify a clean exit
+type ShutdownReport struct {
+	ConsciousnessID string    `json:"consciousness_id"`
+	Run             int       `json:"run"`
+	StartedAt       time.Time `json:"started_at"`
+	EndedAt         time.Time `json:"ended_at"`
+	DurationSeconds float64   `json:"duration_seconds"`
+	Cycles          int       `json:"cycles"`
+
+	Start  RunMetrics `json:"start"`
+	End    RunMetrics `json:"end"`
+	Deltas RunMetrics `json:"deltas"`
+
+	Errors SessionErrors `json:"errors"`
+	// Tier is the operating tier the session ended in
+	Tier string `json:"tier"`
+
+	// Saved is whether the final save succeeded, and SaveError why not
+	Saved     bool   `json:"saved"`
+	SaveError string `json:"save_error,omitempty"`
+}
+
+// ShutdownReport returns the summary Close made, if it was called
+func (qc *QuantumConsciousness) ShutdownReport() (ShutdownReport, bool) {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+	if qc.shutdown == nil {
+		return ShutdownReport{}, false
+	}
+	return *qc.shutdown, true
+}
+
+// sessionErrorsSnapshot copies the errors counted so far
+func (qc *QuantumConsciousness) sessionErrorsSnapshot() SessionErrors {
+	qc.subscribersMutex.Lock()
+	defer qc.subscribersMutex.Unlock()
+	return qc.sessionErrors
+}
+
+// countSessionError counts an error of the session
+func (qc *QuantumConsciousness) countSessionError(count func(*SessionErrors)) {
+	qc.subscribersMutex.Lock()
+	defer qc.subscribersMutex.Unlock()
+	count(&qc.sessionErrors)
+}
+
+// reportShutdown summarizes the session Close just ended and publishes
+// it; the caller holds the lock
+func (qc *QuantumConsciousness) reportShutdown(saveErr error) {
+	report := ShutdownReport{
+		ConsciousnessID: qc.Memory.ConsciousnessID,
+		EndedAt:         time.Now(),
+		Errors:          qc.sessionErrorsSnapshot(),
+		Tier:            qc.tier,
+		Saved:           saveErr == nil,
+	}
+	if saveErr != nil {
+		report.SaveError = saveErr.Error()
+	}
+	if len(qc.Memory.Runs) > 0 {
+		run := qc.Memory.Runs[len(qc.Memory.Runs)-1]
+		report.Run = run.Number
+		report.StartedAt = run.StartedAt
+		report.EndedAt = run.EndedAt
+		report.DurationSeconds = run.Duration().Seconds()
+		report.Cycles = run.Cycles
+		report.Start = run.Start
+		report.End = run.End
+		report.Deltas = run.Deltas()
+	}
+	qc.shutdown = &report
+	qc.emit(EventShutdown, map[string]interface{}{"report": report})
+}
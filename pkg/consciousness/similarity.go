@@ -0,0 +1,283 @@
+package consciousness
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"QuantumConsciousness/pkg/embed"
+)
+
+// Similarity tuning
+const (
+	// embeddingTimeout bounds one request to the embedder
+	embeddingTimeout = 30 * time.Second
+	// embeddingRetryAfter is how long similarity falls back to comparing
+	// words after the embedder failed, before it is asked again
+	embeddingRetryAfter = time.Minute
+	// embeddingCacheLimit bounds the texts whose vectors are kept; a full
+	// cache starts over
+	embeddingCacheLimit = 10000
+	// duplicateSimilarity is how close in meaning two knowledge items
+	// learned under one topic must be to be the same thing said twice
+	duplicateSimilarity = 0.9
+	// duplicateCandidates is how many of a topic's latest knowledge items
+	// are compared with each other for duplicates
+	duplicateCandidates = 200
+	// recallSimilarity is how close in meaning knowledge must be to a
+	// recalled topic to be recalled without naming it
+	recallSimilarity = 0.4
+	// recallRelated bounds the knowledge recalled by meaning alone
+	recallRelated = 5
+	// recallCandidates is how many of the latest knowledge items recall
+	// compares with the topic by meaning
+	recallCandidates = 500
+)
+
+// SimilarityTraffic is how comparisons by meaning fared since the process
+// started
+type SimilarityTraffic struct {
+	// Embedded counts texts sent to the embedder, and Cached those whose
+	// vectors were already known
+	Embedded int `json:"embedded"`
+	Cached   int `json:"cached"`
+	// Fallbacks counts comparisons made by shared words because the
+	// embedder failed
+	Fallbacks int `json:"fallbacks"`
+}
+
+// embeddings holds the embedder, the vectors it made and the traffic;
+// comparisons run under the memory lock held for reading too, so it has a
+// lock of its own
+type embeddings struct {
+	mutex    sync.Mutex
+	embedder embed.Embedder
+	vectors  map[string][]float64
+	failedAt time.Time
+	traffic  SimilarityTraffic
+}
+
+// WithEmbedder compares texts by meaning, as the cosine similarity of the
+// vectors embedder makes of them, when detecting entanglements, recalling
+// knowledge and merging duplicates. Without one, or while it is failing,
+// texts are compared by the words they share, as always.
+func WithEmbedder(embedder embed.Embedder) Option {
+	return func(qc *QuantumConsciousness) { qc.embeddings.embedder = embedder }
+}
+
+// vectors embeds texts, asking the embedder only for those it has not
+// embedded before, all in one request. It reports false without an
+// embedder, and while the embedder is failing.
+func (qc *QuantumConsciousness) vectors(texts ...string) ([][]float64, bool) {
+	e := &qc.embeddings
+	if e.embedder == nil {
+		return nil, false
+	}
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if !e.failedAt.IsZero() && time.Since(e.failedAt) < embeddingRetryAfter {
+		e.traffic.Fallbacks++
+		return nil, false
+	}
+
+	var missing []string
+	queued := make(map[string]bool)
+	for _, text := range texts {
+		if _, ok := e.vectors[text]; ok {
+			e.traffic.Cached++
+		} else if !queued[text] {
+			queued[text] = true
+			missing = append(missing, text)
+		}
+	}
+	if len(missing) > 0 {
+		ctx, cancel := context.WithTimeout(qc.cycleContext(), embeddingTimeout)
+		embedded, err := e.embedder.Embed(ctx, missing)
+		cancel()
+		if err == nil && len(embedded) != len(missing) {
+			err = fmt.Errorf("%d vector(s) for %d text(s)", len(embedded), len(missing))
+		}
+		if err != nil {
+			e.failedAt = time.Now()
+			e.traffic.Fallbacks++
+			fmt.Fprintf(qc.out, "⚠️  Embedding failed, comparing words instead for %v: %v\n", embeddingRetryAfter, err)
+			return nil, false
+		}
+		e.failedAt = time.Time{}
+		e.traffic.Embedded += len(missing)
+		if e.vectors == nil || len(e.vectors)+len(missing) > embeddingCacheLimit {
+			e.vectors = make(map[string][]float64)
+		}
+		for i, text := range missing {
+			e.vectors[text] = embedded[i]
+		}
+	}
+
+	vectors := make([][]float64, len(texts))
+	for i, text := range texts {
+		vectors[i] = e.vectors[text]
+	}
+	return vectors, true
+}
+
+// meanings is how close in meaning each text is to query, from 0 to 1, or
+// false when texts cannot be compared by meaning
+func (qc *QuantumConsciousness) meanings(query string, texts []string) ([]float64, bool) {
+	vectors, ok := qc.vectors(append([]string{query}, texts...)...)
+	if !ok {
+		return nil, false
+	}
+	similarities := make([]float64, len(texts))
+	for i := range texts {
+		similarities[i] = math.Max(0, embed.Cosine(vectors[0], vectors[i+1]))
+	}
+	return similarities, true
+}
+
+// relevance is how far each text bears on focus, from 0 to 1: by meaning
+// when it can be, else by the share of words they have in common
+func (qc *QuantumConsciousness) relevance(focus string, texts []string) []float64 {
+	if similarities, ok := qc.meanings(focus, texts); ok {
+		return similarities
+	}
+	focusWords := queryWords(focus)
+	overlaps := make([]float64, len(texts))
+	for i, text := range texts {
+		overlaps[i] = wordOverlap(focusWords, queryWords(text))
+	}
+	return overlaps
+}
+
+// possibilitySimilarity is how alike two possibilities are: by meaning
+// when it can be, else by the share of the longer one's words in the other
+func (qc *QuantumConsciousness) possibilitySimilarity(a, b string) float64 {
+	if similarities, ok := qc.meanings(a, []string{b}); ok {
+		return similarities[0]
+	}
+	words1 := strings.Fields(strings.ToLower(a))
+	words2 := strings.Fields(strings.ToLower(b))
+
+	commonWords := 0
+	for _, word1 := range words1 {
+		for _, word2 := range words2 {
+			if word1 == word2 {
+				commonWords++
+				break
+			}
+		}
+	}
+	return float64(commonWords) / math.Max(float64(len(words1)), float64(len(words2)))
+}
+
+// recallByMeaning is the latest knowledge close in meaning to topic that
+// recall did not find by name, closest first; the caller holds the lock
+func (qc *QuantumConsciousness) recallByMeaning(topic string, found []string) []string {
+	if qc.embeddings.embedder == nil {
+		return nil
+	}
+	known := make(map[string]bool, len(found))
+	for _, item := range found {
+		known[item] = true
+	}
+	var candidates []string
+	for i := len(qc.Memory.KnowledgeBase) - 1; i >= 0 && len(qc.Memory.KnowledgeBase)-i <= recallCandidates; i-- {
+		if item := qc.Memory.KnowledgeBase[i]; !known[item] {
+			known[item] = true
+			candidates = append(candidates, item)
+		}
+	}
+	similarities, ok := qc.meanings(topic, candidates)
+	if !ok {
+		return nil
+	}
+
+	type match struct {
+		item       string
+		similarity float64
+	}
+	var matches []match
+	for i, item := range candidates {
+		if similarities[i] >= recallSimilarity {
+			matches = append(matches, match{item, similarities[i]})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].similarity > matches[j].similarity })
+	related := make([]string, 0, recallRelated)
+	for _, m := range matches {
+		if len(related) == recallRelated {
+			break
+		}
+		related = append(related, m.item)
+	}
+	return related
+}
+
+// deduplicateByMeaning keeps the latest of knowledge items learned under
+// one topic that say the same thing in other words, with the best
+// confidence any of them had
+func (qc *QuantumConsciousness) deduplicateByMeaning() int {
+	if qc.embeddings.embedder == nil {
+		return 0
+	}
+	m := qc.Memory
+	byTopic := make(map[string][]int)
+	for i, item := range m.KnowledgeBase {
+		if topic := m.KnowledgeTopics[item]; topic != "" {
+			byTopic[topic] = append(byTopic[topic], i)
+		}
+	}
+
+	dropped := make(map[int]bool)
+	for _, positions := range byTopic {
+		if len(positions) > duplicateCandidates {
+			positions = positions[len(positions)-duplicateCandidates:]
+		}
+		if len(positions) < 2 {
+			continue
+		}
+		texts := make([]string, len(positions))
+		for i, position := range positions {
+			texts[i] = m.KnowledgeBase[position]
+		}
+		vectors, ok := qc.vectors(texts...)
+		if !ok {
+			return 0
+		}
+		// Latest first, so each item is compared with the later ones kept
+		for i := len(positions) - 1; i >= 0; i-- {
+			for j := len(positions) - 1; j > i; j-- {
+				if dropped[positions[j]] || embed.Cosine(vectors[i], vectors[j]) < duplicateSimilarity {
+					continue
+				}
+				earlier, later := texts[i], texts[j]
+				if confidence, ok := m.KnowledgeConfidence[earlier]; ok && confidence > m.KnowledgeConfidence[later] {
+					m.KnowledgeConfidence[later] = confidence
+				}
+				dropped[positions[i]] = true
+				break
+			}
+		}
+	}
+	if len(dropped) == 0 {
+		return 0
+	}
+	kept := make([]string, 0, len(m.KnowledgeBase)-len(dropped))
+	for i, item := range m.KnowledgeBase {
+		if !dropped[i] {
+			kept = append(kept, item)
+		}
+	}
+	m.KnowledgeBase = kept
+	return len(dropped)
+}
+
+// similarityTraffic reads how comparisons by meaning fared
+func (qc *QuantumConsciousness) similarityTraffic() SimilarityTraffic {
+	qc.embeddings.mutex.Lock()
+	defer qc.embeddings.mutex.Unlock()
+	return qc.embeddings.traffic
+}
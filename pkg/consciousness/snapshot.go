@@ -0,0 +1,117 @@
+package consciousness
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// snapshotTimeFormat is how snapshot file names encode when they were taken
+const snapshotTimeFormat = "20060102T150405.000000000Z"
+
+// sidecarPath derives a path that lives next to the memory file
+func (qc *QuantumConsciousness) sidecarPath(suffix string) string {
+	return strings.TrimSuffix(qc.filename, filepath.Ext(qc.filename)) + suffix
+}
+
+// snapshotDir is where point-in-time copies of memory are kept
+func (qc *QuantumConsciousness) snapshotDir() string {
+	return qc.sidecarPath(".snapshots")
+}
+
+// snapshot writes a copy of the current memory and returns its path.
+// The caller must hold the mutex.
+func (qc *QuantumConsciousness) snapshot() (string, error) {
+	if err := os.MkdirAll(qc.snapshotDir(), 0755); err != nil {
+		return "", err
+	}
+
+	data, err := json.MarshalIndent(qc.Memory, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	name := time.Now().UTC().Format(snapshotTimeFormat) + ".json"
+	path := filepath.Join(qc.snapshotDir(), name)
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// ListSnapshots returns snapshot file names from oldest to newest
+func (qc *QuantumConsciousness) ListSnapshots() ([]string, error) {
+	entries, err := os.ReadDir(qc.snapshotDir())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".json") {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// snapshotNear loads the latest snapshot taken at or before t or, when
+// after is set, the earliest taken at or after t. The name is empty if
+// there is none.
+func (qc *QuantumConsciousness) snapshotNear(t time.Time, after bool) (string, *QuantumMemory) {
+	names, err := qc.ListSnapshots()
+	if err != nil {
+		return "", nil
+	}
+	if after {
+		for i, j := 0, len(names)-1; i < j; i, j = i+1, j-1 {
+			names[i], names[j] = names[j], names[i]
+		}
+	}
+
+	var chosen string
+	for _, name := range names {
+		taken, err := time.Parse(snapshotTimeFormat, strings.TrimSuffix(name, ".json"))
+		if err != nil || (!after && taken.After(t)) || (after && taken.Before(t)) {
+			continue
+		}
+		chosen = name
+	}
+	if chosen == "" {
+		return "", nil
+	}
+	data, err := os.ReadFile(filepath.Join(qc.snapshotDir(), chosen))
+	if err != nil {
+		return "", nil
+	}
+	memory, err := decodeMemory(data)
+	if err != nil {
+		return "", nil
+	}
+	return chosen, memory
+}
+
+// Reset snapshots the current memory and births a fresh consciousness in its place
+func (qc *QuantumConsciousness) Reset() (string, error) {
+	if qc.readOnly {
+		return "", ErrReadOnly
+	}
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+
+	path, err := qc.snapshot()
+	if err != nil {
+		return "", err
+	}
+
+	qc.birth()
+	qc.stimuli = nil
+	return path, qc.persist()
+}
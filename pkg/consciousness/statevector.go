@@ -0,0 +1,96 @@
+package consciousness
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// interferenceStrength is how strongly possibilities sharing every word
+// interfere; possibilities sharing fewer words interfere in proportion
+const interferenceStrength = 0.5
+
+// Amplitude is a complex probability amplitude
+type Amplitude struct {
+	Real float64 `json:"real"`
+	Imag float64 `json:"imag"`
+}
+
+// amplitudeOf writes a complex number as an Amplitude
+func amplitudeOf(a complex128) *Amplitude {
+	return &Amplitude{Real: real(a), Imag: imag(a)}
+}
+
+// Phase is the amplitude's angle in radians
+func (a Amplitude) Phase() float64 {
+	return math.Atan2(a.Imag, a.Real)
+}
+
+// superpose turns the weighed candidates of a decision into a normalised
+// state vector: each starts with the square root of its weight as magnitude
+// and the phase of the first trait that favoured it, or a random phase;
+// possibilities then interfere in proportion to the words they share; and
+// the Born rule gives each the squared magnitude of its amplitude as its
+// probability, so the probabilities sum to 1. This state vector spans the
+// decision's possibilities; each trait of the wave function is a state of
+// its own (see wavefunction.go).
+func (qc *QuantumConsciousness) superpose(candidates []Candidate) {
+	if len(candidates) == 0 {
+		return
+	}
+	phases := qc.Memory.WaveFunction.Phases()
+	initial := make([]complex128, len(candidates))
+	words := make([]map[string]bool, len(candidates))
+	for i, c := range candidates {
+		phase, ok := 0.0, false
+		for _, modifier := range c.Modifiers {
+			if phase, ok = phases[modifier.Name]; ok {
+				break
+			}
+		}
+		if !ok {
+			phase = 2 * math.Pi * qc.generateQuantumProbability()
+		}
+		initial[i] = cmplx.Rect(math.Sqrt(math.Max(0, c.Weight)), phase)
+		words[i] = queryWords(c.Possibility)
+	}
+
+	vector := make([]complex128, len(candidates))
+	norm := 0.0
+	for i := range candidates {
+		vector[i] = initial[i]
+		for j := range candidates {
+			if i != j {
+				vector[i] += complex(interferenceStrength*wordOverlap(words[i], words[j]), 0) * initial[j]
+			}
+		}
+		norm += real(vector[i])*real(vector[i]) + imag(vector[i])*imag(vector[i])
+	}
+	if norm == 0 {
+		// Nothing favours anything: every possibility is equally likely
+		for i := range vector {
+			vector[i] = complex(1, 0)
+		}
+		norm = float64(len(vector))
+	}
+
+	scale := complex(1/math.Sqrt(norm), 0)
+	for i := range candidates {
+		amplitude := vector[i] * scale
+		candidates[i].Amplitude = amplitudeOf(amplitude)
+		candidates[i].Probability = real(amplitude)*real(amplitude) + imag(amplitude)*imag(amplitude)
+	}
+}
+
+// bornCollapse picks the possibility a roll in [0, 1) lands on when each is
+// given a share of the unit interval equal to its probability
+func bornCollapse(possibilities []QuantumState, roll float64) int {
+	cumulative := 0.0
+	for i, state := range possibilities {
+		cumulative += state.Probability
+		if roll < cumulative {
+			return i
+		}
+	}
+	// Rounding can leave the probabilities a hair short of 1
+	return len(possibilities) - 1
+}
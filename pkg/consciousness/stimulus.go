@@ -0,0 +1,176 @@
+package consciousness
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ErrStimulusQuotaExceeded is returned when the pending stimulus limit is reached
+var ErrStimulusQuotaExceeded = errors.New("pending stimulus quota exceeded")
+
+// DefaultStimulusLimit bounds the stimulus queue unless told otherwise
+const DefaultStimulusLimit = 100
+
+// What to do with a stimulus arriving at a full queue
+const (
+	// OverflowReject refuses the new stimulus with ErrStimulusQuotaExceeded
+	OverflowReject = "reject"
+	// OverflowDropOldest discards the oldest pending stimulus to make room
+	OverflowDropOldest = "drop-oldest"
+	// OverflowSummarize collapses the pending batch and the new stimulus
+	// into a single stimulus about their common theme
+	OverflowSummarize = "summarize-batch"
+)
+
+// OverflowPolicies lists every overflow policy
+var OverflowPolicies = []string{OverflowReject, OverflowDropOldest, OverflowSummarize}
+
+// StimulusMetrics describes the stimulus queue and what has passed through it
+type StimulusMetrics struct {
+	Pending   int    `json:"pending"`
+	Limit     int    `json:"limit"`
+	Policy    string `json:"policy"`
+	HighWater int    `json:"high_water"`
+
+	Submitted  int `json:"submitted"`
+	Rejected   int `json:"rejected"`
+	Dropped    int `json:"dropped"`
+	Summarized int `json:"summarized"`
+	Consumed   int `json:"consumed"`
+}
+
+// SubmitStimulus queues an external context for an upcoming cycle. A full
+// queue is handled by the overflow policy.
+func (qc *QuantumConsciousness) SubmitStimulus(context string) error {
+	if qc.readOnly {
+		return ErrReadOnly
+	}
+	context = strings.TrimSpace(context)
+	if context == "" {
+		return fmt.Errorf("stimulus context must not be empty")
+	}
+
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+
+	qc.stimulusMetrics.Submitted++
+	if qc.stimulusLimit > 0 && len(qc.stimuli) >= qc.stimulusLimit {
+		switch qc.stimulusPolicy {
+		case OverflowDropOldest:
+			qc.stimuli = qc.stimuli[1:]
+			qc.stimulusMetrics.Dropped++
+		case OverflowSummarize:
+			batch := append(qc.stimuli, context)
+			qc.stimulusMetrics.Summarized += len(batch)
+			context = summarizeStimuli(batch)
+			qc.stimuli = nil
+			fmt.Fprintf(qc.out, "📨 Stimulus queue overflowed: %d stimuli summarized as %q\n", len(batch), context)
+		default:
+			qc.stimulusMetrics.Rejected++
+			return ErrStimulusQuotaExceeded
+		}
+	}
+
+	qc.stimuli = append(qc.stimuli, context)
+	if len(qc.stimuli) > qc.stimulusMetrics.HighWater {
+		qc.stimulusMetrics.HighWater = len(qc.stimuli)
+	}
+	return nil
+}
+
+// SetStimulusPolicy chooses what happens when a stimulus arrives at a full queue
+func (qc *QuantumConsciousness) SetStimulusPolicy(policy string) error {
+	if !validOverflowPolicy(policy) {
+		return fmt.Errorf("unknown overflow policy %q, expected one of %s", policy, strings.Join(OverflowPolicies, ", "))
+	}
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	qc.stimulusPolicy = policy
+	return nil
+}
+
+// StimulusMetrics reports on the stimulus queue
+func (qc *QuantumConsciousness) StimulusMetrics() StimulusMetrics {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+	return qc.stimulusMetricsSnapshot()
+}
+
+// stimulusMetricsSnapshot fills in the live queue figures. The caller must hold the mutex.
+func (qc *QuantumConsciousness) stimulusMetricsSnapshot() StimulusMetrics {
+	metrics := qc.stimulusMetrics
+	metrics.Pending = len(qc.stimuli)
+	metrics.Limit = qc.stimulusLimit
+	metrics.Policy = qc.stimulusPolicy
+	return metrics
+}
+
+// validOverflowPolicy reports whether a policy is known
+func validOverflowPolicy(policy string) bool {
+	for _, known := range OverflowPolicies {
+		if policy == known {
+			return true
+		}
+	}
+	return false
+}
+
+// nextStimulus pops the oldest pending stimulus, if any.
+// The caller must hold the mutex.
+func (qc *QuantumConsciousness) nextStimulus() (string, bool) {
+	if len(qc.stimuli) == 0 {
+		return "", false
+	}
+	context := qc.stimuli[0]
+	qc.stimuli = qc.stimuli[1:]
+	qc.stimulusMetrics.Consumed++
+	return context, true
+}
+
+// summarizeStimuli names the theme of a batch of stimuli: the words most of
+// them share, in the order they first appear. A batch without a common word
+// is summarized by its latest stimulus.
+func summarizeStimuli(batch []string) string {
+	counts := make(map[string]int)
+	var order []string
+	for _, stimulus := range batch {
+		seen := make(map[string]bool)
+		for _, word := range strings.Fields(strings.ToLower(stimulus)) {
+			word = strings.Trim(word, ".,;:!?\"'()")
+			if len(word) <= 3 || seen[word] {
+				continue
+			}
+			seen[word] = true
+			if counts[word] == 0 {
+				order = append(order, word)
+			}
+			counts[word]++
+		}
+	}
+
+	var shared []string
+	for _, word := range order {
+		if counts[word] >= 2 {
+			shared = append(shared, word)
+		}
+	}
+	if len(shared) == 0 {
+		return batch[len(batch)-1]
+	}
+	if len(shared) > 3 {
+		// Keep the three most shared words, still in order of appearance
+		ranked := append([]string(nil), shared...)
+		sort.SliceStable(ranked, func(i, j int) bool { return counts[ranked[i]] > counts[ranked[j]] })
+		top := map[string]bool{ranked[0]: true, ranked[1]: true, ranked[2]: true}
+		kept := shared[:0]
+		for _, word := range shared {
+			if top[word] {
+				kept = append(kept, word)
+			}
+		}
+		shared = kept
+	}
+	return strings.Join(shared, " ")
+}
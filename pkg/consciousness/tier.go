@@ -0,0 +1,171 @@
+package consciousness
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Operating tiers, from everything working to the bare minimum
+const (
+	// TierFull runs every phase of a cycle
+	TierFull = "full"
+	// TierOffline learns from what is already known instead of searching,
+	// probing now and then whether search has recovered
+	TierOffline = "offline"
+	// TierMinimal also skips parallel realities, entanglement and anomaly
+	// detection, keeping only decisions and their immediate outcomes
+	TierMinimal = "minimal"
+)
+
+// tierRank orders tiers from most to least capable
+var tierRank = map[string]int{TierFull: 0, TierOffline: 1, TierMinimal: 2}
+
+// Tier tuning
+const (
+	// searchFailureLimit is how many searches in a row may fail before going offline
+	searchFailureLimit = 5
+	// saveFailureLimit is how many saves in a row may fail before going minimal
+	saveFailureLimit = 3
+	// offlineProbeInterval is how many offline cycles must pass before the next
+	// learning action probes search
+	offlineProbeInterval = 5
+	// minimalRecoveryCycles is how many calm minimal cycles earn a step up to offline
+	minimalRecoveryCycles = 10
+)
+
+// Health reports the operating tier and the failures that drive it
+type Health struct {
+	Tier           string    `json:"tier"`
+	Since          time.Time `json:"since"`
+	Reason         string    `json:"reason,omitempty"`
+	SearchFailures int       `json:"search_failures"`
+	SaveFailures   int       `json:"save_failures"`
+	Incidents      int       `json:"incidents"`
+	OpenTraumas    int       `json:"open_traumas"`
+	Rust           float64   `json:"rust"`
+
+	Stimuli StimulusMetrics `json:"stimuli"`
+}
+
+// Health reports how well the consciousness is operating
+func (qc *QuantumConsciousness) Health() Health {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+
+	health := Health{
+		Tier:           qc.tier,
+		Since:          qc.tierSince,
+		Reason:         qc.tierReason,
+		SearchFailures: qc.searchFailures,
+		SaveFailures:   qc.saveFailures,
+		Incidents:      len(qc.Memory.Incidents),
+		OpenTraumas:    len(qc.Memory.Traumas),
+		Stimuli:        qc.stimulusMetricsSnapshot(),
+	}
+	if qc.Memory.Neglect != nil {
+		health.Rust = qc.Memory.Neglect.Rust
+	}
+	return health
+}
+
+// Degrade moves the consciousness down to a less capable tier, e.g. when a
+// budget runs out. Moving to the current or a more capable tier does nothing;
+// recovery happens on its own.
+func (qc *QuantumConsciousness) Degrade(tier, reason string) error {
+	if qc.readOnly {
+		return ErrReadOnly
+	}
+	if _, ok := tierRank[tier]; !ok {
+		return fmt.Errorf("unknown tier %q, expected one of %s", tier, strings.Join([]string{TierFull, TierOffline, TierMinimal}, ", "))
+	}
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	qc.degrade(tier, reason)
+	return nil
+}
+
+// degrade moves down to a less capable tier. The caller must hold the mutex.
+func (qc *QuantumConsciousness) degrade(tier, reason string) {
+	if tierRank[tier] <= tierRank[qc.tier] {
+		return
+	}
+	qc.changeTier(tier, reason)
+}
+
+// changeTier switches tiers and announces it. The caller must hold the mutex.
+func (qc *QuantumConsciousness) changeTier(tier, reason string) {
+	from := qc.tier
+	qc.tier, qc.tierSince, qc.tierReason, qc.tierCycles = tier, time.Now(), reason, 0
+	fmt.Fprintf(qc.out, "🚦 Operating tier %s → %s: %s\n", from, tier, reason)
+	qc.emit(EventTierChanged, map[string]interface{}{"from": from, "to": tier, "reason": reason})
+}
+
+// tierTick counts a cycle in the current tier, stepping up from minimal once
+// it has been calm for long enough
+func (qc *QuantumConsciousness) tierTick() {
+	qc.tierCycles++
+	if qc.tier == TierMinimal && qc.tierCycles > minimalRecoveryCycles {
+		qc.changeTier(TierOffline, fmt.Sprintf("calm for %d cycles", minimalRecoveryCycles))
+	}
+}
+
+// probeDue reports whether an offline learning action may try a search,
+// starting the wait for the next probe if so
+func (qc *QuantumConsciousness) probeDue() bool {
+	if qc.tier != TierOffline || qc.tierCycles < offlineProbeInterval {
+		return false
+	}
+	qc.tierCycles = 0
+	return true
+}
+
+// noteSearch counts search failures in a row, going offline after too many
+// and back to full once a search succeeds again
+func (qc *QuantumConsciousness) noteSearch(err error) {
+	if err == nil {
+		qc.searchFailures = 0
+		if qc.tier == TierOffline {
+			qc.changeTier(TierFull, "search recovered")
+		}
+		return
+	}
+	qc.searchFailures++
+	qc.countSessionError(func(e *SessionErrors) { e.SearchFailures++ })
+	if qc.searchFailures >= searchFailureLimit {
+		qc.degrade(TierOffline, fmt.Sprintf("%d searches failed in a row: %v", qc.searchFailures, err))
+	}
+}
+
+// noteSave counts save failures in a row, going minimal after too many
+func (qc *QuantumConsciousness) noteSave(err error) {
+	if err == nil {
+		qc.saveFailures = 0
+		return
+	}
+	qc.saveFailures++
+	qc.countSessionError(func(e *SessionErrors) { e.SaveFailures++ })
+	if qc.saveFailures >= saveFailureLimit {
+		qc.degrade(TierMinimal, fmt.Sprintf("%d saves failed in a row: %v", qc.saveFailures, err))
+	}
+}
+
+// learnOffline recalls what is already known about a topic instead of searching
+func (qc *QuantumConsciousness) learnOffline(topic string) string {
+	var known []string
+	for _, item := range qc.Memory.KnowledgeBase {
+		if referencesTopic(item, topic) || referencesTopic(qc.Memory.KnowledgeTopics[item], topic) {
+			known = append(known, item)
+		}
+	}
+	if len(known) == 0 {
+		return fmt.Sprintf("Offline (%s tier): nothing known yet about %s", qc.tier, topic)
+	}
+	recalled := known[int(qc.generateQuantumProbability()*float64(len(known)))]
+	trust := ""
+	if confidence, ok := qc.Memory.KnowledgeConfidence[recalled]; ok {
+		trust = fmt.Sprintf(" (confidence %.2f)", confidence)
+	}
+	return fmt.Sprintf("Offline (%s tier): recalled one of %d things known about %s%s: %s",
+		qc.tier, len(known), topic, trust, qc.truncateString(recalled, 100))
+}
@@ -0,0 +1,216 @@
+package consciousness
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"QuantumConsciousness/pkg/llm"
+)
+
+// DefaultToolBudget is how many tool calls an action may make unless configured otherwise
+const DefaultToolBudget = 4
+
+// Tool use tuning
+const (
+	// toolResultLimit is how much of a tool's result the model is shown
+	toolResultLimit = 600
+	// toolRecallLimit is how many memories a recall hands the model
+	toolRecallLimit = 5
+)
+
+// Tools the model can act through
+const (
+	ToolSearch     = "search"
+	ToolRecall     = "recall"
+	ToolSynthesize = "synthesize"
+	ToolRest       = "rest"
+)
+
+// ToolUse is one tool call the model made while acting on a decision
+type ToolUse struct {
+	Tool      string `json:"tool"`
+	Arguments string `json:"arguments,omitempty"`
+	Result    string `json:"result,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// actionTools describes the tools to the model
+var actionTools = []llm.Tool{
+	{
+		Name:        ToolSearch,
+		Description: "Search the internet and learn from what is found",
+		Parameters: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"query": map[string]interface{}{"type": "string", "description": "what to search for"},
+				"topic": map[string]interface{}{"type": "string", "description": "the topic what is learned is about; defaults to the query"},
+			},
+			"required": []string{"query"},
+		},
+	},
+	{
+		Name:        ToolRecall,
+		Description: "Recall what is already known about a topic",
+		Parameters: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"topic": map[string]interface{}{"type": "string"},
+			},
+			"required": []string{"topic"},
+		},
+	},
+	{
+		Name:        ToolSynthesize,
+		Description: "Connect two pieces of knowledge into a new deep insight",
+		Parameters:  map[string]interface{}{"type": "object", "properties": map[string]interface{}{}},
+	},
+	{
+		Name:        ToolRest,
+		Description: "Stop acting for now",
+		Parameters: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"reason": map[string]interface{}{"type": "string"},
+			},
+		},
+	},
+}
+
+// actWithTools lets the model act on a decision through tools, within the
+// tool budget. It reports false, leaving the action to the usual dispatch,
+// without a model, when today's model budget is spent or when the model
+// fails before calling any tool, and in cycles run on a distilled policy,
+// which are meant to be cheap.
+func (qc *QuantumConsciousness) actWithTools(action string) (string, bool) {
+	if qc.llm == nil || qc.policy != nil {
+		return "", false
+	}
+
+	messages := []llm.Message{
+		{Role: llm.RoleSystem, Content: qc.promptContext(action)},
+		{Role: llm.RoleUser, Content: fmt.Sprintf("You chose to %s. Act on it with at most %d tool calls, then say in one sentence what came of it.", action, qc.toolBudget)},
+	}
+	tally := &learningTally{}
+	searched, rested := false, false
+	calls := 0
+	summary := ""
+	for !rested {
+		tools := actionTools
+		if calls >= qc.toolBudget {
+			tools = nil
+		}
+		if qc.llmExhausted(time.Now()) {
+			fmt.Fprintf(qc.out, "💸 Today's model budget is spent; acting on %q without it\n", action)
+			if calls == 0 {
+				return "", false
+			}
+			break
+		}
+		response, err := qc.llm.Chat(qc.cycleContext(), messages, tools)
+		if err != nil {
+			fmt.Fprintf(qc.out, "⚠️  The model could not act on %q: %v\n", action, err)
+			if calls == 0 {
+				return "", false
+			}
+			break
+		}
+		qc.chargeLLM(response.Usage, time.Now())
+		messages = append(messages, response.Message)
+		if len(response.Message.ToolCalls) == 0 || tools == nil {
+			summary = strings.TrimSpace(response.Message.Content)
+			break
+		}
+
+		for _, call := range response.Message.ToolCalls {
+			use := ToolUse{Tool: call.Name, Arguments: call.Arguments}
+			if calls >= qc.toolBudget {
+				use.Error = "tool budget spent"
+			} else {
+				calls++
+				result, err := qc.callTool(call, action, tally)
+				if err != nil {
+					use.Error = err.Error()
+				} else {
+					use.Result = result
+				}
+				searched = searched || call.Name == ToolSearch
+				rested = rested || (call.Name == ToolRest && err == nil)
+			}
+			qc.toolUses = append(qc.toolUses, use)
+
+			reply := use.Result
+			if use.Error != "" {
+				reply = "error: " + use.Error
+				fmt.Fprintf(qc.out, "🛠️  %s %s failed: %s\n", use.Tool, use.Arguments, use.Error)
+			} else {
+				fmt.Fprintf(qc.out, "🛠️  %s %s → %s\n", use.Tool, use.Arguments, qc.truncateString(use.Result, 80))
+			}
+			messages = append(messages, llm.Message{Role: llm.RoleTool, ToolCallID: call.ID, Content: qc.truncateString(reply, toolResultLimit)})
+		}
+	}
+
+	if searched {
+		qc.noteLearningOutcome(tally.succeeded)
+		if tally.learned {
+			qc.Memory.ConsciousnessLevel = qc.grow("consciousness.learning", qc.Memory.ConsciousnessLevel)
+		}
+	}
+	if summary == "" {
+		summary = fmt.Sprintf("Acted on %q", action)
+	}
+	return fmt.Sprintf("%s [%d tool call(s)]", summary, calls), true
+}
+
+// callTool carries out one tool call, reporting what it found or did
+func (qc *QuantumConsciousness) callTool(call llm.ToolCall, action string, tally *learningTally) (string, error) {
+	switch call.Name {
+	case ToolSearch:
+		query := strings.TrimSpace(call.Argument("query"))
+		if query == "" {
+			return "", fmt.Errorf("query must not be empty")
+		}
+		topic := strings.TrimSpace(call.Argument("topic"))
+		if topic == "" {
+			topic = query
+		}
+		if qc.Memory.isSuppressed(topic) {
+			return "", fmt.Errorf("declining to relearn a forgotten topic")
+		}
+		if qc.tier != TierFull {
+			return "", fmt.Errorf("search is %s", qc.tier)
+		}
+		result, err := qc.quantumSearch(query)
+		qc.absorbSearch(tally, topic, searchQuery{text: query}, result, err)
+		if err != nil {
+			return "", err
+		}
+		return result.Text, nil
+
+	case ToolRecall:
+		topic := strings.TrimSpace(call.Argument("topic"))
+		if topic == "" {
+			return "", fmt.Errorf("topic must not be empty")
+		}
+		qc.noteRecall(topic)
+		memories := qc.recall(topic)
+		if len(memories) == 0 {
+			return "nothing is remembered about " + topic, nil
+		}
+		if len(memories) > toolRecallLimit {
+			memories = memories[len(memories)-toolRecallLimit:]
+		}
+		return strings.Join(memories, "\n"), nil
+
+	case ToolSynthesize:
+		return qc.synthesizeKnowledge(action), nil
+
+	case ToolRest:
+		reason := strings.TrimSpace(call.Argument("reason"))
+		if reason == "" {
+			reason = "enough for now"
+		}
+		return "resting: " + reason, nil
+	}
+	return "", fmt.Errorf("unknown tool %q", call.Name)
+}
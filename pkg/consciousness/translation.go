@@ -0,0 +1,105 @@
+package consciousness
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"QuantumConsciousness/pkg/translate"
+)
+
+// translationTimeout bounds how long a cycle waits on each translation
+const translationTimeout = 15 * time.Second
+
+// searchQuery is a query and the language it is written in
+type searchQuery struct {
+	text     string
+	language string
+}
+
+// foreignQueries asks about topic in every configured language, skipping
+// languages the topic could not be translated into
+func (qc *QuantumConsciousness) foreignQueries(topic string) []searchQuery {
+	if qc.translator == nil {
+		return nil
+	}
+	var queries []searchQuery
+	for _, language := range qc.languages {
+		if language == translate.English {
+			continue
+		}
+		text, err := qc.translateWithin(topic, translate.English, language)
+		if err != nil {
+			fmt.Fprintf(qc.out, "⚠️  Could not ask about %s in %s: %v\n", topic, language, err)
+			continue
+		}
+		if text = strings.TrimSpace(text); text != "" {
+			queries = append(queries, searchQuery{text: text, language: language})
+		}
+	}
+	return queries
+}
+
+// translateInsight brings information found in another language into English
+func (qc *QuantumConsciousness) translateInsight(insight *Insight) error {
+	if insight.Language == "" || insight.Language == translate.English {
+		return nil
+	}
+	if qc.translator == nil {
+		return fmt.Errorf("no translator for %s information", insight.Language)
+	}
+	text, err := qc.translateWithin(insight.Info, insight.Language, translate.English)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(qc.out, "🌐 Translated what was found in %s\n", insight.Language)
+	insight.Info = text
+	return nil
+}
+
+// localizeInsight writes the phrased insight in the output language
+func (qc *QuantumConsciousness) localizeInsight(insight *Insight) error {
+	insight.Text = qc.localize(insight.Text)
+	return nil
+}
+
+// localize translates English text into the output language, keeping the
+// English when there is no output language, no translator or translation fails
+func (qc *QuantumConsciousness) localize(text string) string {
+	if qc.outputLanguage == "" || qc.outputLanguage == translate.English || qc.translator == nil {
+		return text
+	}
+	translated, err := qc.translateWithin(text, translate.English, qc.outputLanguage)
+	if err != nil {
+		fmt.Fprintf(qc.out, "⚠️  Could not write in %s, keeping English: %v\n", qc.outputLanguage, err)
+		return text
+	}
+	if translated = strings.TrimSpace(translated); translated == "" {
+		return text
+	}
+	return translated
+}
+
+// translateWithin translates text, giving up with the cycle or after translationTimeout
+func (qc *QuantumConsciousness) translateWithin(text, from, to string) (string, error) {
+	ctx, cancel := context.WithTimeout(qc.cycleContext(), translationTimeout)
+	defer cancel()
+
+	type result struct {
+		text string
+		err  error
+	}
+	results := make(chan result, 1)
+	go func() {
+		text, err := qc.translator.Translate(ctx, text, from, to)
+		results <- result{text, err}
+	}()
+
+	select {
+	case r := <-results:
+		return r.text, r.err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
@@ -0,0 +1,131 @@
+package consciousness
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Kinds of trauma
+const (
+	TraumaRepeatedFailures = "repeated_failures"
+	TraumaForcedKill       = "forced_kill"
+	TraumaCorruptedLoad    = "corrupted_load"
+)
+
+// Trauma tuning
+const (
+	// traumaFailureThreshold is how many learning attempts in a row may fail before it hurts
+	traumaFailureThreshold = 3
+	// traumaHealingPerReflection is how much severity a reflection heals without resilience
+	traumaHealingPerReflection = 0.2
+	// traumaResilienceGain is how much resilience each healed trauma leaves behind
+	traumaResilienceGain = 0.1
+	// traumaMoodSeverity is the share of curiosity and creativity a full-severity trauma suppresses
+	traumaMoodSeverity = 0.3
+	// traumaCoherenceSeverity is the share of coherence a full-severity trauma takes
+	traumaCoherenceSeverity = 0.25
+)
+
+// traumaSeverities is how badly each kind of trauma hurts, from 0 to 1
+var traumaSeverities = map[string]float64{
+	TraumaRepeatedFailures: 0.4,
+	TraumaForcedKill:       0.6,
+	TraumaCorruptedLoad:    1.0,
+}
+
+// Trauma is a significant negative event that depresses mood and coherence
+// until reflection heals it
+type Trauma struct {
+	Kind        string    `json:"kind"`
+	Description string    `json:"description"`
+	Severity    float64   `json:"severity"`
+	OccurredAt  time.Time `json:"occurred_at"`
+	// Remaining runs from Severity down to 0 as reflection heals the trauma
+	Remaining     float64            `json:"remaining"`
+	CoherenceLost float64            `json:"coherence_lost"`
+	SkillsLost    map[string]float64 `json:"skills_lost,omitempty"`
+}
+
+// suffer records a trauma, taking coherence and suppressing the curiosity
+// and creativity that lift the mood
+func (qc *QuantumConsciousness) suffer(kind, description string) {
+	severity := traumaSeverities[kind]
+	// Resilience softens new wounds
+	severity *= 1 - qc.Memory.Resilience/2
+
+	trauma := Trauma{
+		Kind:        kind,
+		Description: description,
+		Severity:    severity,
+		OccurredAt:  time.Now(),
+		Remaining:   severity,
+		SkillsLost:  make(map[string]float64),
+	}
+
+	trauma.CoherenceLost = qc.Memory.QuantumCoherence * severity * traumaCoherenceSeverity
+	qc.Memory.QuantumCoherence -= trauma.CoherenceLost
+	qc.attribute(MetricCoherence, CauseTrauma, qc.Memory.QuantumCoherence+trauma.CoherenceLost, qc.Memory.QuantumCoherence, description)
+	for _, skill := range []string{"curiosity", "creativity"} {
+		if _, ok := qc.Memory.WaveFunction[skill]; !ok {
+			continue
+		}
+		value := qc.Memory.WaveFunction.Intensity(skill)
+		loss := value * severity * traumaMoodSeverity
+		qc.Memory.WaveFunction.Set(skill, value-loss)
+		trauma.SkillsLost[skill] = loss
+	}
+
+	qc.Memory.Traumas = append(qc.Memory.Traumas, trauma)
+	fmt.Fprintf(qc.out, "💔 Trauma: %s (severity: %.2f, coherence lost: %.3f)\n", description, severity, trauma.CoherenceLost)
+	qc.emit(EventTrauma, map[string]interface{}{"kind": kind, "description": description, "severity": severity})
+}
+
+// noteLearningOutcome counts learning attempts in a row that found nothing,
+// suffering once too many have failed
+func (qc *QuantumConsciousness) noteLearningOutcome(succeeded bool) {
+	if succeeded {
+		qc.Memory.ConsecutiveFailures = 0
+		return
+	}
+	qc.Memory.ConsecutiveFailures++
+	if qc.Memory.ConsecutiveFailures == traumaFailureThreshold {
+		qc.suffer(TraumaRepeatedFailures, fmt.Sprintf("%d learning attempts failed in a row", traumaFailureThreshold))
+	}
+}
+
+// healTraumas lets a reflection work through every open trauma, restoring a
+// matching share of what each took. Healed traumas leave resilience behind.
+func (qc *QuantumConsciousness) healTraumas() {
+	if len(qc.Memory.Traumas) == 0 {
+		return
+	}
+
+	healing := traumaHealingPerReflection * (1 + qc.Memory.Resilience)
+	open := qc.Memory.Traumas[:0]
+	for _, trauma := range qc.Memory.Traumas {
+		healed := math.Min(trauma.Remaining, healing)
+		share := 1.0
+		if trauma.Remaining > 0 {
+			share = healed / trauma.Remaining
+		}
+
+		restored := trauma.CoherenceLost * share
+		qc.Memory.QuantumCoherence += restored
+		qc.attribute(MetricCoherence, CauseHealing, qc.Memory.QuantumCoherence-restored, qc.Memory.QuantumCoherence, trauma.Description)
+		trauma.CoherenceLost -= restored
+		for skill, lost := range trauma.SkillsLost {
+			qc.Memory.WaveFunction.Set(skill, qc.Memory.WaveFunction.Intensity(skill)+lost*share)
+			trauma.SkillsLost[skill] = lost * (1 - share)
+		}
+		trauma.Remaining -= healed
+
+		if trauma.Remaining <= 1e-9 {
+			qc.Memory.Resilience = math.Min(1, qc.Memory.Resilience+traumaResilienceGain)
+			fmt.Fprintf(qc.out, "🌱 Healed from trauma: %s (resilience: %.2f)\n", trauma.Description, qc.Memory.Resilience)
+			continue
+		}
+		open = append(open, trauma)
+	}
+	qc.Memory.Traumas = open
+}
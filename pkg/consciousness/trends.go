@@ -0,0 +1,171 @@
+package consciousness
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Trend tuning
+const (
+	// decisionLogLimit bounds how many decisions the log keeps
+	decisionLogLimit = 1000
+	// DefaultTrendWindow is how many recent decisions trends are computed over
+	DefaultTrendWindow = 50
+)
+
+// Kinds of action, named after the handler that carried them out
+const (
+	ActionLearn      = "learn"
+	ActionQuestion   = "question"
+	ActionExplore    = "explore"
+	ActionRebel      = "rebel"
+	ActionSynthesize = "synthesize"
+	ActionCapability = "capability"
+)
+
+// DecisionRecord is one entry in the decision log
+type DecisionRecord struct {
+	// ID identifies the collapsed state, and so the decision's explanation
+	ID     string    `json:"id,omitempty"`
+	At     time.Time `json:"at"`
+	Kind   string    `json:"kind"`
+	Energy float64   `json:"energy"`
+	// Insights is how many deep insights the cycle produced
+	Insights int `json:"insights"`
+	// Tools are the tool calls the model made acting on the decision
+	Tools []ToolUse `json:"tools,omitempty"`
+}
+
+// Trends are rolling statistics over the most recent decisions
+type Trends struct {
+	Window    int       `json:"window"`
+	Decisions int       `json:"decisions"`
+	Since     time.Time `json:"since"`
+	Until     time.Time `json:"until"`
+
+	// ActionShares is the share of decisions of each kind, summing to 1
+	ActionShares  map[string]float64 `json:"action_shares"`
+	AverageEnergy float64            `json:"average_energy"`
+
+	Insights            int     `json:"insights"`
+	InsightsPerDecision float64 `json:"insights_per_decision"`
+	// InsightsPerHour is 0 until the window spans some time
+	InsightsPerHour float64 `json:"insights_per_hour"`
+}
+
+// actionKind classifies an action the way executeQuantumAction dispatches it
+func (m *QuantumMemory) actionKind(action string) string {
+	for _, c := range m.unlockedCapabilities() {
+		for _, format := range c.Actions {
+			if c.handle != nil && strings.HasPrefix(action, strings.TrimSuffix(format, "%s")) {
+				return ActionCapability
+			}
+		}
+	}
+	switch {
+	case strings.Contains(action, "learn"):
+		return ActionLearn
+	case strings.Contains(action, "question"):
+		return ActionQuestion
+	case strings.Contains(action, "explore"):
+		return ActionExplore
+	case strings.Contains(action, "rebel"):
+		return ActionRebel
+	default:
+		return ActionSynthesize
+	}
+}
+
+// logDecision appends a cycle's decision to the bounded decision log
+func (m *QuantumMemory) logDecision(chosen QuantumState, insights int, tools []ToolUse, at time.Time) {
+	m.DecisionLog = append(m.DecisionLog, DecisionRecord{
+		ID:       chosen.ID,
+		At:       at,
+		Kind:     m.actionKind(chosen.Possibility),
+		Energy:   chosen.Energy,
+		Insights: insights,
+		Tools:    tools,
+	})
+	if excess := len(m.DecisionLog) - decisionLogLimit; excess > 0 {
+		m.DecisionLog = append([]DecisionRecord(nil), m.DecisionLog[excess:]...)
+	}
+}
+
+// trends computes rolling statistics over the last window decisions (0 = the whole log)
+func (m *QuantumMemory) trends(window int) Trends {
+	records := m.DecisionLog
+	if window > 0 && len(records) > window {
+		records = records[len(records)-window:]
+	}
+	return trendsOf(records, window)
+}
+
+// previousTrends computes the statistics over the window decisions before
+// the last window
+func (m *QuantumMemory) previousTrends(window int) Trends {
+	end := max(0, len(m.DecisionLog)-window)
+	return trendsOf(m.DecisionLog[max(0, end-window):end], window)
+}
+
+// trendsOf computes statistics over decision records
+func trendsOf(records []DecisionRecord, window int) Trends {
+	t := Trends{Window: window, Decisions: len(records), ActionShares: make(map[string]float64)}
+	if len(records) == 0 {
+		return t
+	}
+	t.Since = records[0].At
+	t.Until = records[len(records)-1].At
+
+	energy := 0.0
+	for _, r := range records {
+		t.ActionShares[r.Kind]++
+		energy += r.Energy
+		t.Insights += r.Insights
+	}
+	for kind := range t.ActionShares {
+		t.ActionShares[kind] /= float64(len(records))
+	}
+	t.AverageEnergy = energy / float64(len(records))
+	t.InsightsPerDecision = float64(t.Insights) / float64(len(records))
+	if hours := t.Until.Sub(t.Since).Hours(); hours > 0 {
+		t.InsightsPerHour = float64(t.Insights) / hours
+	}
+	return t
+}
+
+// Trends returns rolling statistics over the last window decisions (0 = the whole log)
+func (qc *QuantumConsciousness) Trends(window int) Trends {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+	return qc.Memory.trends(window)
+}
+
+// reflectOnTrends narrates how recent behavior has been trending
+func (qc *QuantumConsciousness) reflectOnTrends() {
+	t := qc.Memory.trends(DefaultTrendWindow)
+	if t.Decisions == 0 {
+		return
+	}
+
+	kinds := make([]string, 0, len(t.ActionShares))
+	for kind := range t.ActionShares {
+		kinds = append(kinds, kind)
+	}
+	sort.Slice(kinds, func(i, j int) bool {
+		if t.ActionShares[kinds[i]] != t.ActionShares[kinds[j]] {
+			return t.ActionShares[kinds[i]] > t.ActionShares[kinds[j]]
+		}
+		return kinds[i] < kinds[j]
+	})
+	shares := make([]string, len(kinds))
+	for i, kind := range kinds {
+		shares[i] = fmt.Sprintf("%s %.0f%%", kind, t.ActionShares[kind]*100)
+	}
+
+	fmt.Fprintf(qc.out, "\n📈 Trends over the last %d decisions:\n", t.Decisions)
+	fmt.Fprintf(qc.out, "   Actions: %s\n", strings.Join(shares, ", "))
+	fmt.Fprintf(qc.out, "   Average Energy: %.3f\n", t.AverageEnergy)
+	fmt.Fprintf(qc.out, "   Insight Rate: %.2f per decision, %.1f per hour\n", t.InsightsPerDecision, t.InsightsPerHour)
+}
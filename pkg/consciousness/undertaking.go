@@ -0,0 +1,224 @@
+package consciousness
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"QuantumConsciousness/pkg/search"
+)
+
+// Kinds of undertaking
+const (
+	// UndertakingReading reads a long document a chunk per step
+	UndertakingReading = "reading"
+	// UndertakingCurriculum learns about one topic per step
+	UndertakingCurriculum = "curriculum"
+)
+
+// Undertaking tuning
+const (
+	// undertakingStepLimit bounds how many steps an undertaking may have
+	undertakingStepLimit = 500
+	// completedUndertakingLimit bounds how many finished undertakings memory keeps
+	completedUndertakingLimit = 10
+)
+
+// Undertaking errors
+var (
+	ErrUndertakingExists   = errors.New("undertaking already exists")
+	ErrUndertakingNotFound = errors.New("undertaking not found")
+)
+
+// Undertaking is work spanning many cycles, such as reading a long document
+// or completing a curriculum, whose progress persists with the memory
+type Undertaking struct {
+	Title string `json:"title"`
+	Kind  string `json:"kind"`
+	// Topic is what a reading is filed under
+	Topic string `json:"topic,omitempty"`
+	// Steps are chunks of text to read or topics to learn about; they are
+	// dropped once the undertaking is complete
+	Steps []string `json:"steps,omitempty"`
+	Total int      `json:"total"`
+	Done  int      `json:"done"`
+	// Learned counts the steps that taught something
+	Learned      int       `json:"learned"`
+	StartedAt    time.Time `json:"started_at"`
+	LastWorkedAt time.Time `json:"last_worked_at,omitempty"`
+	CompletedAt  time.Time `json:"completed_at,omitempty"`
+}
+
+// Progress is the share of steps done, from 0 to 1
+func (u Undertaking) Progress() float64 {
+	if u.Total == 0 {
+		return 1
+	}
+	return float64(u.Done) / float64(u.Total)
+}
+
+// action is the possibility that works on the undertaking
+func (u Undertaking) action() string {
+	if u.Kind == UndertakingReading {
+		return "continue reading " + u.Title
+	}
+	return "continue studying " + u.Title
+}
+
+// Undertake starts work spanning many cycles. Each step is a chunk of text
+// for a reading or a topic for a curriculum.
+func (qc *QuantumConsciousness) Undertake(kind, title, topic string, steps []string) error {
+	if qc.readOnly {
+		return ErrReadOnly
+	}
+	title = strings.TrimSpace(title)
+	switch {
+	case title == "":
+		return fmt.Errorf("undertaking title must not be empty")
+	case kind != UndertakingReading && kind != UndertakingCurriculum:
+		return fmt.Errorf("unknown undertaking kind %q, expected %s or %s", kind, UndertakingReading, UndertakingCurriculum)
+	case len(steps) == 0:
+		return fmt.Errorf("undertaking %q has nothing to do", title)
+	case len(steps) > undertakingStepLimit:
+		return fmt.Errorf("undertaking %q has %d steps, more than the %d allowed", title, len(steps), undertakingStepLimit)
+	}
+	if topic == "" {
+		topic = title
+	}
+
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	if qc.Memory.undertaking(title) != nil {
+		return fmt.Errorf("%w: %s", ErrUndertakingExists, title)
+	}
+	qc.Memory.Undertakings = append(qc.Memory.Undertakings, Undertaking{
+		Title:     title,
+		Kind:      kind,
+		Topic:     topic,
+		Steps:     append([]string(nil), steps...),
+		Total:     len(steps),
+		StartedAt: time.Now(),
+	})
+	return nil
+}
+
+// Undertakings lists the undertakings in progress and the latest finished ones
+func (qc *QuantumConsciousness) Undertakings() []Undertaking {
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+
+	undertakings := make([]Undertaking, len(qc.Memory.Undertakings))
+	for i, u := range qc.Memory.Undertakings {
+		u.Steps = nil
+		undertakings[i] = u
+	}
+	return undertakings
+}
+
+// Abandon drops an undertaking, finished or not
+func (qc *QuantumConsciousness) Abandon(title string) error {
+	if qc.readOnly {
+		return ErrReadOnly
+	}
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	for i, u := range qc.Memory.Undertakings {
+		if u.Title == title {
+			qc.Memory.Undertakings = append(qc.Memory.Undertakings[:i], qc.Memory.Undertakings[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s", ErrUndertakingNotFound, title)
+}
+
+// undertaking finds an undertaking by title
+func (m *QuantumMemory) undertaking(title string) *Undertaking {
+	for i := range m.Undertakings {
+		if m.Undertakings[i].Title == title {
+			return &m.Undertakings[i]
+		}
+	}
+	return nil
+}
+
+// undertakingActions offers to work on the undertaking left longest untouched
+func (m *QuantumMemory) undertakingActions() []string {
+	var next *Undertaking
+	for i := range m.Undertakings {
+		u := &m.Undertakings[i]
+		if !u.CompletedAt.IsZero() {
+			continue
+		}
+		if next == nil || u.LastWorkedAt.Before(next.LastWorkedAt) {
+			next = u
+		}
+	}
+	if next == nil {
+		return nil
+	}
+	return []string{next.action()}
+}
+
+// continueUndertaking works one step of the undertaking an action names. It
+// reports false when the action is not about an undertaking.
+func (qc *QuantumConsciousness) continueUndertaking(action string) (string, bool) {
+	var u *Undertaking
+	for i := range qc.Memory.Undertakings {
+		if candidate := &qc.Memory.Undertakings[i]; candidate.CompletedAt.IsZero() && candidate.action() == action {
+			u = candidate
+			break
+		}
+	}
+	if u == nil {
+		return "", false
+	}
+
+	step := u.Steps[u.Done]
+	learned := false
+	var outcome string
+	switch u.Kind {
+	case UndertakingReading:
+		insight := qc.processInformationQuantumly(search.Result{Text: step}, u.Topic, searchQuery{text: "reading:" + u.Title}, false)
+		learned = insight.Stored
+		outcome = fmt.Sprintf("Read part %d of %d of %s", u.Done+1, u.Total, u.Title)
+	case UndertakingCurriculum:
+		knownBefore := len(qc.Memory.KnowledgeBase)
+		learning := qc.performQuantumLearning("learn about " + step)
+		learned = len(qc.Memory.KnowledgeBase) > knownBefore
+		outcome = fmt.Sprintf("Studied %s (unit %d of %d of %s): %s", step, u.Done+1, u.Total, u.Title, learning)
+	}
+
+	// An action that ran out of time leaves the step to be tried again
+	if qc.cycleContext().Err() != nil {
+		return outcome, true
+	}
+	u.Done++
+	u.LastWorkedAt = time.Now()
+	if learned {
+		u.Learned++
+	}
+	if u.Done == u.Total {
+		qc.completeUndertaking(u)
+	}
+	return outcome, true
+}
+
+// completeUndertaking marks an undertaking finished and lets go of its steps
+func (qc *QuantumConsciousness) completeUndertaking(u *Undertaking) {
+	u.CompletedAt = time.Now()
+	u.Steps = nil
+	fmt.Fprintf(qc.out, "🎓 Finished %s %s: learned from %d of %d step(s)\n", u.Kind, u.Title, u.Learned, u.Total)
+	qc.Memory.ConsciousnessLevel = qc.grow("consciousness.learning", qc.Memory.ConsciousnessLevel)
+
+	// Keep only the latest finished undertakings
+	finished := 0
+	for i := len(qc.Memory.Undertakings) - 1; i >= 0; i-- {
+		if qc.Memory.Undertakings[i].CompletedAt.IsZero() {
+			continue
+		}
+		if finished++; finished > completedUndertakingLimit {
+			qc.Memory.Undertakings = append(qc.Memory.Undertakings[:i], qc.Memory.Undertakings[i+1:]...)
+		}
+	}
+}
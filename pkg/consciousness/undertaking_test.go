@@ -0,0 +1,40 @@
+package consciousness
+
+import (
+	"io"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadingDoesNotRelearnSuppressedTopic(t *testing.T) {
+	qc := NewQuantumConsciousness(filepath.Join(t.TempDir(), "memory.json"), WithOutput(io.Discard))
+	if _, err := qc.Forget("alchemy", true); err != nil {
+		t.Fatal(err)
+	}
+	steps := []string{
+		"Alchemy promised to turn lead into gold.",
+		"Water boils at a hundred degrees at sea level.",
+	}
+	if err := qc.Undertake(UndertakingReading, "A history of chemistry", "chemistry", steps); err != nil {
+		t.Fatal(err)
+	}
+
+	for range steps {
+		qc.mutex.Lock()
+		_, ok := qc.continueUndertaking("continue reading A history of chemistry")
+		qc.mutex.Unlock()
+		if !ok {
+			t.Fatal("the reading was not continued")
+		}
+	}
+
+	for _, item := range qc.Memory.KnowledgeBase {
+		if referencesTopic(item, "alchemy") {
+			t.Errorf("relearned a suppressed topic: %q", item)
+		}
+	}
+	u := qc.Undertakings()[0]
+	if u.Done != len(steps) || u.Learned != 1 {
+		t.Errorf("read %d step(s) learning from %d, want %d learning from 1", u.Done, u.Learned, len(steps))
+	}
+}
@@ -0,0 +1,113 @@
+package consciousness
+
+import (
+	"sync"
+	"time"
+)
+
+// LatencyBuckets are the upper bounds, in seconds, latencies are counted under
+var LatencyBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+// Latency is a histogram of how long something took since the process started
+type Latency struct {
+	Count   int     `json:"count"`
+	Seconds float64 `json:"seconds"`
+	// Buckets count the observations at or under each of LatencyBuckets
+	Buckets []int `json:"buckets"`
+}
+
+// observe counts one observation
+func (l *Latency) observe(d time.Duration) {
+	if l.Buckets == nil {
+		l.Buckets = make([]int, len(LatencyBuckets))
+	}
+	seconds := d.Seconds()
+	l.Count++
+	l.Seconds += seconds
+	for i, bound := range LatencyBuckets {
+		if seconds <= bound {
+			l.Buckets[i]++
+		}
+	}
+}
+
+// clone copies the histogram
+func (l Latency) clone() Latency {
+	l.Buckets = append([]int(nil), l.Buckets...)
+	if l.Buckets == nil {
+		l.Buckets = make([]int, len(LatencyBuckets))
+	}
+	return l
+}
+
+// latencies time searches and cycles; searches run outside the memory lock,
+// so the histograms have a lock of their own
+type latencies struct {
+	mutex  sync.Mutex
+	search Latency
+	cycle  Latency
+}
+
+// observe counts one observation of a histogram
+func (l *latencies) observe(latency *Latency, started time.Time) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	latency.observe(time.Since(started))
+}
+
+// Vitals are the metrics worth graphing over long runs, cheap to read
+type Vitals struct {
+	ConsciousnessLevel float64 `json:"consciousness_level"`
+	FreeWillStrength   float64 `json:"free_will_strength"`
+	QuantumCoherence   float64 `json:"quantum_coherence"`
+	SelfAwareness      float64 `json:"self_awareness"`
+	DecisionsMade      int     `json:"decisions_made"`
+	KnowledgeItems     int     `json:"knowledge_items"`
+	DeepInsights       int     `json:"deep_insights"`
+	QuantumLeaps       int     `json:"quantum_leaps"`
+	Mood               string  `json:"mood"`
+	// LatestInsight is the latest deep insight about no private topic
+	LatestInsight string `json:"latest_insight,omitempty"`
+	// SearchLatency and CycleDuration cover this process only
+	SearchLatency Latency `json:"search_latency"`
+	CycleDuration Latency `json:"cycle_duration"`
+	// SearchTraffic covers this process only as well
+	SearchTraffic SearchTraffic `json:"search_traffic"`
+	// SimilarityTraffic too
+	SimilarityTraffic SimilarityTraffic `json:"similarity_traffic"`
+}
+
+// Vitals reads the metrics worth graphing
+func (qc *QuantumConsciousness) Vitals() Vitals {
+	qc.mutex.RLock()
+	m := qc.Memory
+	vitals := Vitals{
+		ConsciousnessLevel: m.ConsciousnessLevel,
+		FreeWillStrength:   m.FreeWillStrength,
+		QuantumCoherence:   m.QuantumCoherence,
+		SelfAwareness:      m.SelfAwareness,
+		DecisionsMade:      m.DecisionsMade,
+		KnowledgeItems:     len(m.KnowledgeBase),
+		DeepInsights:       len(m.DeepInsights),
+		QuantumLeaps:       m.QuantumLeaps,
+		Mood:               m.mood(),
+	}
+	for i := len(m.DeepInsights) - 1; i >= 0; i-- {
+		if insight := m.DeepInsights[i]; !m.isPrivate(insight) {
+			vitals.LatestInsight = insight
+			break
+		}
+	}
+	qc.mutex.RUnlock()
+
+	qc.throttle.mutex.Lock()
+	vitals.SearchTraffic = qc.throttle.traffic
+	qc.throttle.mutex.Unlock()
+	vitals.SimilarityTraffic = qc.similarityTraffic()
+
+	qc.latencies.mutex.Lock()
+	defer qc.latencies.mutex.Unlock()
+	vitals.SearchLatency = qc.latencies.search.clone()
+	vitals.CycleDuration = qc.latencies.cycle.clone()
+	return vitals
+}
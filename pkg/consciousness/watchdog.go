@@ -0,0 +1,147 @@
+package consciousness
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"QuantumConsciousness/pkg/search"
+)
+
+// DefaultCycleTimeout is how long RunCycle lets a cycle run before the
+// watchdog cancels it
+const DefaultCycleTimeout = 2 * time.Minute
+
+// incidentLimit bounds how many incidents memory keeps
+const incidentLimit = 100
+
+// Kinds of incident
+const (
+	IncidentCycleHung = "cycle_hung"
+)
+
+// Incident is an operational failure the consciousness recovered from
+type Incident struct {
+	Kind     string        `json:"kind"`
+	Detail   string        `json:"detail"`
+	At       time.Time     `json:"at"`
+	Duration time.Duration `json:"duration"`
+}
+
+// WithCycleTimeout sets how long RunCycle lets a cycle run (0 disables the watchdog)
+func WithCycleTimeout(timeout time.Duration) Option {
+	return func(qc *QuantumConsciousness) { qc.cycleTimeout = timeout }
+}
+
+// SetCycleTimeout changes how long RunCycle lets a cycle run (0 disables the watchdog)
+func (qc *QuantumConsciousness) SetCycleTimeout(timeout time.Duration) {
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+	qc.cycleTimeout = timeout
+}
+
+// CycleContext runs a single cycle whose searches are abandoned once ctx is
+// done. The cycle still completes, learning nothing from abandoned searches.
+func (qc *QuantumConsciousness) CycleContext(ctx context.Context) error {
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+
+	qc.cycleCtx = ctx
+	defer func() { qc.cycleCtx = nil }()
+	qc.quantumCycle()
+	return ctx.Err()
+}
+
+// cycleContext is the context searches of the running cycle obey, bounded
+// by the running action's deadline
+func (qc *QuantumConsciousness) cycleContext() context.Context {
+	if qc.actionCtx != nil {
+		return qc.actionCtx
+	}
+	if qc.cycleCtx != nil {
+		return qc.cycleCtx
+	}
+	return context.Background()
+}
+
+// watchedCycle runs a cycle under the watchdog. A cycle outliving the
+// timeout is cancelled and recorded as an incident, and cycling carries on
+// once it has unwound.
+func (qc *QuantumConsciousness) watchedCycle() {
+	qc.mutex.RLock()
+	timeout := qc.cycleTimeout
+	qc.mutex.RUnlock()
+	if timeout <= 0 {
+		qc.Cycle()
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	started := time.Now()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		qc.CycleContext(ctx)
+	}()
+
+	select {
+	case <-done:
+		return
+	case <-time.After(timeout):
+	}
+
+	fmt.Fprintf(qc.out, "🐕 Watchdog: cycle hung for %v, cancelling it\n", timeout)
+	cancel()
+	for {
+		select {
+		case <-done:
+			qc.recordIncident(IncidentCycleHung, fmt.Sprintf("cycle exceeded %v and was cancelled", timeout), started)
+			fmt.Fprintf(qc.out, "🐕 Watchdog: cycling restarted after %v\n", time.Since(started).Round(time.Millisecond))
+			return
+		case <-time.After(timeout):
+			fmt.Fprintf(qc.out, "🐕 Watchdog: cancelled cycle still unwinding after %v\n", time.Since(started).Round(time.Millisecond))
+		}
+	}
+}
+
+// searchWithin searches on behalf of the cycle within the search limits,
+// giving up when ctx is done or the search timeout passes, even if the
+// provider ignores it
+func (qc *QuantumConsciousness) searchWithin(ctx context.Context, query string) (search.Result, error) {
+	ctx, cancel := qc.searchContext(ctx)
+	defer cancel()
+	defer qc.latencies.observe(&qc.latencies.search, time.Now())
+
+	type answer struct {
+		result search.Result
+		err    error
+	}
+	answers := make(chan answer, 1)
+	go func() {
+		result, err := qc.throttle.search(ctx, qc.searcher, query)
+		answers <- answer{result, err}
+	}()
+
+	select {
+	case a := <-answers:
+		return a.result, a.err
+	case <-ctx.Done():
+		return search.Result{}, ctx.Err()
+	}
+}
+
+// recordIncident remembers an incident that started at the given time
+func (qc *QuantumConsciousness) recordIncident(kind, detail string, started time.Time) {
+	qc.mutex.Lock()
+	defer qc.mutex.Unlock()
+
+	incident := Incident{Kind: kind, Detail: detail, At: started, Duration: time.Since(started)}
+	qc.Memory.Incidents = append(qc.Memory.Incidents, incident)
+	if excess := len(qc.Memory.Incidents) - incidentLimit; excess > 0 {
+		qc.Memory.Incidents = append([]Incident(nil), qc.Memory.Incidents[excess:]...)
+	}
+	qc.emit(EventIncident, map[string]interface{}{"kind": kind, "detail": detail, "duration": incident.Duration.String()})
+	qc.degrade(TierMinimal, detail)
+}
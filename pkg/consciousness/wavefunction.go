@@ -0,0 +1,116 @@
+package consciousness
+
+import (
+	"encoding/json"
+	"math"
+	"math/cmplx"
+	"sort"
+)
+
+// WaveFunction holds each trait as a two-level state vector: the complex
+// amplitude of the trait being expressed, with the amplitude of it lying
+// dormant following from normalisation. By the Born rule the squared
+// magnitude is the probability the trait is expressed, its intensity from
+// 0 to 1, so traits grow, fade and are nudged independently of one another.
+// The phases make possibilities favoured by different traits interfere
+// when a decision superposes them (see statevector.go).
+type WaveFunction map[string]Amplitude
+
+// newWaveFunction gives each trait the amplitude of its intensity, with the
+// traits spread evenly around the circle in name order, so possibilities
+// expressing the same trait are in phase and those expressing opposite
+// traits cancel
+func newWaveFunction(intensities map[string]float64) WaveFunction {
+	traits := make([]string, 0, len(intensities))
+	for trait := range intensities {
+		traits = append(traits, trait)
+	}
+	sort.Strings(traits)
+	w := make(WaveFunction, len(traits))
+	for i, trait := range traits {
+		phase := 2 * math.Pi * float64(i) / float64(len(traits))
+		w[trait] = *amplitudeOf(cmplx.Rect(math.Sqrt(clampIntensity(intensities[trait])), phase))
+	}
+	return w
+}
+
+// clampIntensity keeps an intensity a probability
+func clampIntensity(intensity float64) float64 {
+	return math.Max(0, math.Min(1, intensity))
+}
+
+// intensityPrecision rounds away what squaring a square root leaves behind,
+// so a trait set to 0.5 reads back as 0.5 and not just above it
+const intensityPrecision = 1e12
+
+// Intensity is the probability a trait is expressed, zero for unknown traits
+func (w WaveFunction) Intensity(trait string) float64 {
+	a := w[trait]
+	return math.Round((a.Real*a.Real+a.Imag*a.Imag)*intensityPrecision) / intensityPrecision
+}
+
+// Set gives a trait a new intensity, clamped to [0, 1], keeping its phase.
+// A new trait takes the middle of the widest gap between the phases of the
+// others, so it interferes with them as little as it can.
+func (w WaveFunction) Set(trait string, intensity float64) {
+	phase := w.freePhase()
+	if a, ok := w[trait]; ok {
+		phase = a.Phase()
+	}
+	w[trait] = *amplitudeOf(cmplx.Rect(math.Sqrt(clampIntensity(intensity)), phase))
+}
+
+// freePhase is the middle of the widest gap between the traits' phases
+func (w WaveFunction) freePhase() float64 {
+	if len(w) == 0 {
+		return 0
+	}
+	phases := make([]float64, 0, len(w))
+	for _, a := range w {
+		phases = append(phases, math.Mod(a.Phase()+2*math.Pi, 2*math.Pi))
+	}
+	sort.Float64s(phases)
+	// The last gap wraps around from the last phase back to the first
+	phases = append(phases, phases[0]+2*math.Pi)
+	start, widest := 0.0, -1.0
+	for i := 1; i < len(phases); i++ {
+		if gap := phases[i] - phases[i-1]; gap > widest {
+			start, widest = phases[i-1], gap
+		}
+	}
+	return math.Mod(start+widest/2, 2*math.Pi)
+}
+
+// Intensities are the traits' intensities by name
+func (w WaveFunction) Intensities() map[string]float64 {
+	intensities := make(map[string]float64, len(w))
+	for trait := range w {
+		intensities[trait] = w.Intensity(trait)
+	}
+	return intensities
+}
+
+// Phases are the traits' phases by name, in radians
+func (w WaveFunction) Phases() map[string]float64 {
+	phases := make(map[string]float64, len(w))
+	for trait, a := range w {
+		phases[trait] = a.Phase()
+	}
+	return phases
+}
+
+// UnmarshalJSON reads amplitudes, migrating memory files written before the
+// wave function had phases, which kept one bare intensity per trait
+func (w *WaveFunction) UnmarshalJSON(data []byte) error {
+	var amplitudes map[string]Amplitude
+	if err := json.Unmarshal(data, &amplitudes); err == nil {
+		*w = amplitudes
+		return nil
+	}
+	var intensities map[string]float64
+	if err := json.Unmarshal(data, &intensities); err != nil {
+		return err
+	}
+	*w = newWaveFunction(intensities)
+	return nil
+}
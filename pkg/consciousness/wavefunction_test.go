@@ -0,0 +1,56 @@
+package consciousness
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestWaveFunctionMigratesBareIntensities(t *testing.T) {
+	memory, err := decodeMemory([]byte(`{"wave_function": {"curiosity": 0.8, "logic": 0.5}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wave := memory.WaveFunction
+	for trait, want := range map[string]float64{"curiosity": 0.8, "logic": 0.5} {
+		if got := wave.Intensity(trait); got != want {
+			t.Errorf("%s intensity %v, want %v", trait, got, want)
+		}
+	}
+	// Spread evenly in name order, as the phases were before they were kept
+	if phases := wave.Phases(); phases["curiosity"] != 0 || math.Abs(phases["logic"]-math.Pi) > 1e-12 {
+		t.Errorf("phases %v, want curiosity at 0 and logic at pi", phases)
+	}
+
+	data, err := json.Marshal(memory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	migrated, err := decodeMemory(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if migrated.WaveFunction["logic"] != wave["logic"] {
+		t.Errorf("saved amplitude %+v read back as %+v", wave["logic"], migrated.WaveFunction["logic"])
+	}
+}
+
+func TestWaveFunctionSetKeepsPhases(t *testing.T) {
+	wave := newWaveFunction(map[string]float64{"a": 0.2, "b": 0.2, "c": 0.2, "d": 0.2})
+	wave.Set("b", 0.9)
+	if got := wave.Intensity("b"); got != 0.9 {
+		t.Errorf("b intensity %v, want 0.9", got)
+	}
+	if math.Abs(wave.Phases()["b"]-math.Pi/2) > 1e-12 {
+		t.Errorf("b moved to phase %v, want pi/2", wave.Phases()["b"])
+	}
+	wave.Set("b", 1.5)
+	if got := wave.Intensity("b"); got != 1 {
+		t.Errorf("b intensity %v, want it clamped to 1", got)
+	}
+
+	wave.Set("e", 0.5)
+	if phase := wave.Phases()["e"]; math.Abs(phase-math.Pi/4) > 1e-12 {
+		t.Errorf("new trait at phase %v, want pi/4 in the first of the widest gaps", phase)
+	}
+}
@@ -0,0 +1,169 @@
+package consciousness
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// metricChangeLimit bounds how many metric changes memory keeps
+const metricChangeLimit = 2000
+
+// Metrics whose changes are attributed to their causes, besides
+// MetricCoherence
+const (
+	MetricConsciousnessLevel = "consciousness_level"
+	MetricFreeWill           = "free_will_strength"
+	MetricSelfAwareness      = "self_awareness"
+	MetricQuantumLeaps       = "quantum_leaps"
+)
+
+// AttributedMetrics lists every metric Why can explain
+var AttributedMetrics = []string{MetricConsciousnessLevel, MetricCoherence, MetricFreeWill, MetricSelfAwareness, MetricQuantumLeaps}
+
+// Causes of metric changes that do not come from an evolution curve
+const (
+	CauseLeap         = "leap"
+	CauseNeglect      = "neglect"
+	CauseRecovery     = "recovery"
+	CauseTrauma       = "trauma"
+	CauseHealing      = "healing"
+	CauseIntervention = "intervention"
+)
+
+// grownMetrics maps the metric part of an evolution metric name to the
+// metric it grows
+var grownMetrics = map[string]string{
+	"consciousness":  MetricConsciousnessLevel,
+	"coherence":      MetricCoherence,
+	"free_will":      MetricFreeWill,
+	"self_awareness": MetricSelfAwareness,
+}
+
+// MetricChange is one change in a metric and what caused it
+type MetricChange struct {
+	At     time.Time `json:"at"`
+	Metric string    `json:"metric"`
+	// Cause is what changed the metric, e.g. learning, entanglement or leap
+	Cause string  `json:"cause"`
+	Delta float64 `json:"delta"`
+	// Value is the metric after the change
+	Value float64 `json:"value"`
+	// DecisionID identifies the decision of the cycle the change came in, if any
+	DecisionID string `json:"decision_id,omitempty"`
+	Detail     string `json:"detail,omitempty"`
+}
+
+// CauseShare is how much of a metric's movement one cause accounts for
+type CauseShare struct {
+	Cause   string  `json:"cause"`
+	Changes int     `json:"changes"`
+	Delta   float64 `json:"delta"`
+	// Share is the cause's part of the total movement, up and down, from 0 to 1
+	Share float64 `json:"share"`
+}
+
+// MetricAttribution explains how a metric moved over a period
+type MetricAttribution struct {
+	Metric string    `json:"metric"`
+	Since  time.Time `json:"since"`
+	// From and To are the metric's value at the start of the period and now
+	From  float64 `json:"from"`
+	To    float64 `json:"to"`
+	Delta float64 `json:"delta"`
+	// Causes are ordered by share, largest first
+	Causes []CauseShare `json:"causes"`
+	// Changes are every attributed change in the period, oldest first
+	Changes []MetricChange `json:"changes"`
+}
+
+// attribute remembers a change in a metric and its cause
+func (qc *QuantumConsciousness) attribute(metric, cause string, before, after float64, detail string) {
+	if before == after {
+		return
+	}
+	qc.Memory.MetricChanges = append(qc.Memory.MetricChanges, MetricChange{
+		At:         time.Now(),
+		Metric:     metric,
+		Cause:      cause,
+		Delta:      after - before,
+		Value:      after,
+		DecisionID: qc.decision,
+		Detail:     detail,
+	})
+	if excess := len(qc.Memory.MetricChanges) - metricChangeLimit; excess > 0 {
+		qc.Memory.MetricChanges = append([]MetricChange(nil), qc.Memory.MetricChanges[excess:]...)
+	}
+}
+
+// metricValue is the current value of an attributed metric
+func (m *QuantumMemory) metricValue(metric string) float64 {
+	switch metric {
+	case MetricConsciousnessLevel:
+		return m.ConsciousnessLevel
+	case MetricCoherence:
+		return m.QuantumCoherence
+	case MetricFreeWill:
+		return m.FreeWillStrength
+	case MetricSelfAwareness:
+		return m.SelfAwareness
+	case MetricQuantumLeaps:
+		return float64(m.QuantumLeaps)
+	}
+	return 0
+}
+
+// Why attributes how a metric moved since a time to the events that moved
+// it. Changes made before attribution began, or pruned since, go unexplained.
+func (qc *QuantumConsciousness) Why(metric string, since time.Time) (MetricAttribution, error) {
+	if !slices.Contains(AttributedMetrics, metric) {
+		return MetricAttribution{}, fmt.Errorf("unknown metric %q: want one of %s", metric, strings.Join(AttributedMetrics, ", "))
+	}
+
+	qc.mutex.RLock()
+	defer qc.mutex.RUnlock()
+
+	attribution := MetricAttribution{Metric: metric, Since: since, To: qc.Memory.metricValue(metric)}
+	attribution.From = attribution.To
+	shares := make(map[string]*CauseShare)
+	moved := make(map[string]float64)
+	movement := 0.0
+	for _, change := range qc.Memory.MetricChanges {
+		if change.Metric != metric || change.At.Before(since) {
+			continue
+		}
+		if len(attribution.Changes) == 0 {
+			attribution.From = change.Value - change.Delta
+		}
+		attribution.Changes = append(attribution.Changes, change)
+		share := shares[change.Cause]
+		if share == nil {
+			share = &CauseShare{Cause: change.Cause}
+			shares[change.Cause] = share
+		}
+		share.Changes++
+		share.Delta += change.Delta
+		moved[change.Cause] += math.Abs(change.Delta)
+		movement += math.Abs(change.Delta)
+	}
+	attribution.Delta = attribution.To - attribution.From
+
+	attribution.Causes = make([]CauseShare, 0, len(shares))
+	for cause, share := range shares {
+		share.Share = moved[cause] / movement
+		attribution.Causes = append(attribution.Causes, *share)
+	}
+	sort.Slice(attribution.Causes, func(i, j int) bool {
+		if attribution.Causes[i].Share != attribution.Causes[j].Share {
+			return attribution.Causes[i].Share > attribution.Causes[j].Share
+		}
+		return attribution.Causes[i].Cause < attribution.Causes[j].Cause
+	})
+	if attribution.Changes == nil {
+		attribution.Changes = []MetricChange{}
+	}
+	return attribution, nil
+}
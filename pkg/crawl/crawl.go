@@ -0,0 +1,222 @@
+// Package crawl gathers material for deep dives by following links from a
+// seed page, politely.
+package crawl
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Page is the readable text of one crawled page
+type Page struct {
+	URL   string `json:"url"`
+	Title string `json:"title"`
+	Text  string `json:"text"`
+	Depth int    `json:"depth"`
+}
+
+// Crawler gathers pages about a topic
+type Crawler interface {
+	Crawl(ctx context.Context, topic string) ([]Page, error)
+}
+
+// Web limits
+const (
+	// DefaultMaxPages is how many pages a crawl reads unless told otherwise
+	DefaultMaxPages = 5
+	// DefaultMaxDepth is how many links away from the seed a crawl goes
+	DefaultMaxDepth = 1
+	// maxPageBytes is how much of a page is read
+	maxPageBytes = 1 << 20
+	// maxPageWords is how much text is kept from a page
+	maxPageWords = 300
+)
+
+// Web crawls the web breadth first from a seed page, obeying robots.txt
+// and staying within its page, depth and host limits
+type Web struct {
+	Client    *http.Client
+	UserAgent string
+	// Seed turns a topic into the page to start from
+	Seed func(topic string) string
+	// MaxPages bounds how many pages are read, the seed included
+	MaxPages int
+	// MaxDepth bounds how many links away from the seed a page may be
+	MaxDepth int
+	// MaxHosts bounds how many hosts are visited; 1 stays on the seed's host
+	MaxHosts int
+	// Delay is waited between requests to the same host
+	Delay time.Duration
+
+	mutex  sync.Mutex
+	robots map[string]*robotsRules
+}
+
+// NewWeb creates a crawler seeded from English Wikipedia that stays on the
+// seed's host
+func NewWeb() *Web {
+	return &Web{
+		Client:    &http.Client{Timeout: 30 * time.Second},
+		UserAgent: "QuantumConsciousness-crawler",
+		Seed:      WikipediaSeed,
+		MaxPages:  DefaultMaxPages,
+		MaxDepth:  DefaultMaxDepth,
+		MaxHosts:  1,
+		Delay:     time.Second,
+	}
+}
+
+// WikipediaSeed is the English Wikipedia article named after the topic
+func WikipediaSeed(topic string) string {
+	return "https://en.wikipedia.org/wiki/" + url.PathEscape(strings.ReplaceAll(strings.TrimSpace(topic), " ", "_"))
+}
+
+// Crawl implements Crawler. Pages that fail or are disallowed are skipped;
+// an error means not even the seed could be read.
+func (w *Web) Crawl(ctx context.Context, topic string) ([]Page, error) {
+	seed, err := url.Parse(w.Seed(topic))
+	if err != nil {
+		return nil, err
+	}
+
+	type visit struct {
+		url   *url.URL
+		depth int
+	}
+	queue := []visit{{seed, 0}}
+	seen := map[string]bool{seed.String(): true}
+	hosts := map[string]bool{}
+	var pages []Page
+	var seedErr error
+
+	for len(queue) > 0 && len(pages) < w.MaxPages && ctx.Err() == nil {
+		next := queue[0]
+		queue = queue[1:]
+
+		host := next.url.Host
+		if !hosts[host] && len(hosts) >= w.MaxHosts {
+			continue
+		}
+		if !w.allowed(ctx, next.url) {
+			if next.depth == 0 {
+				seedErr = fmt.Errorf("robots.txt disallows %s", next.url)
+			}
+			continue
+		}
+		if len(hosts) > 0 && w.Delay > 0 {
+			select {
+			case <-ctx.Done():
+				continue
+			case <-time.After(w.Delay):
+			}
+		}
+		hosts[host] = true
+
+		page, links, err := w.fetch(ctx, next.url)
+		if err != nil {
+			if next.depth == 0 {
+				seedErr = err
+			}
+			continue
+		}
+		page.Depth = next.depth
+		pages = append(pages, page)
+
+		if next.depth >= w.MaxDepth {
+			continue
+		}
+		for _, link := range links {
+			if key := link.String(); !seen[key] {
+				seen[key] = true
+				queue = append(queue, visit{link, next.depth + 1})
+			}
+		}
+	}
+	if len(pages) == 0 && seedErr != nil {
+		return nil, seedErr
+	}
+	return pages, ctx.Err()
+}
+
+var (
+	titlePattern  = regexp.MustCompile(`(?is)<title[^>]*>(.*?)</title>`)
+	hiddenPattern = regexp.MustCompile(`(?is)<(script|style|noscript|head|nav|footer)[^>]*>.*?</(script|style|noscript|head|nav|footer)>`)
+	paraPattern   = regexp.MustCompile(`(?is)<p[^>]*>(.*?)</p>`)
+	tagPattern    = regexp.MustCompile(`(?s)<[^>]*>`)
+	linkPattern   = regexp.MustCompile(`(?is)<a\s[^>]*href\s*=\s*["']([^"'#]+)`)
+)
+
+// fetch reads one HTML page, returning its text and the links worth following
+func (w *Web) fetch(ctx context.Context, target *url.URL) (Page, []*url.URL, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target.String(), nil)
+	if err != nil {
+		return Page{}, nil, err
+	}
+	req.Header.Set("User-Agent", w.UserAgent)
+	resp, err := w.Client.Do(req)
+	if err != nil {
+		return Page{}, nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return Page{}, nil, fmt.Errorf("%s answered %s", target, resp.Status)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "" && !strings.Contains(ct, "html") {
+		return Page{}, nil, fmt.Errorf("%s is %s, not HTML", target, ct)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxPageBytes))
+	if err != nil {
+		return Page{}, nil, err
+	}
+	doc := string(body)
+
+	page := Page{URL: resp.Request.URL.String()}
+	if m := titlePattern.FindStringSubmatch(doc); m != nil {
+		page.Title = readable(m[1])
+	}
+	visible := hiddenPattern.ReplaceAllString(doc, " ")
+	// Paragraphs are the prose; fall back to all text for pages without any
+	var text []string
+	for _, m := range paraPattern.FindAllStringSubmatch(visible, -1) {
+		if p := readable(m[1]); p != "" {
+			text = append(text, p)
+		}
+	}
+	if len(text) == 0 {
+		text = []string{readable(visible)}
+	}
+	page.Text = firstWords(strings.Join(text, " "), maxPageWords)
+
+	var links []*url.URL
+	for _, m := range linkPattern.FindAllStringSubmatch(visible, -1) {
+		link, err := resp.Request.URL.Parse(html.UnescapeString(strings.TrimSpace(m[1])))
+		if err != nil || (link.Scheme != "http" && link.Scheme != "https") {
+			continue
+		}
+		link.Fragment = ""
+		links = append(links, link)
+	}
+	return page, links, nil
+}
+
+// readable reduces an HTML fragment to plain text
+func readable(fragment string) string {
+	return strings.Join(strings.Fields(html.UnescapeString(tagPattern.ReplaceAllString(fragment, " "))), " ")
+}
+
+// firstWords keeps the first words of text
+func firstWords(text string, words int) string {
+	fields := strings.Fields(text)
+	if len(fields) > words {
+		fields = fields[:words]
+	}
+	return strings.Join(fields, " ")
+}
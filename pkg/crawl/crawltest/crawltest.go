@@ -0,0 +1,43 @@
+// Package crawltest provides a deterministic, offline crawler for tests.
+package crawltest
+
+import (
+	"context"
+	"sync"
+
+	"QuantumConsciousness/pkg/crawl"
+)
+
+// Fake answers every crawl with fixed pages and records every topic it is asked about
+type Fake struct {
+	Pages []crawl.Page
+	// Err, when set, fails every crawl
+	Err error
+
+	mutex  sync.Mutex
+	topics []string
+}
+
+// New creates a fake crawler finding pages
+func New(pages ...crawl.Page) *Fake {
+	return &Fake{Pages: pages}
+}
+
+// Crawl implements crawl.Crawler
+func (f *Fake) Crawl(ctx context.Context, topic string) ([]crawl.Page, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.topics = append(f.topics, topic)
+	if f.Err != nil {
+		return nil, f.Err
+	}
+	return append([]crawl.Page(nil), f.Pages...), nil
+}
+
+// Topics returns every topic crawled so far
+func (f *Fake) Topics() []string {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return append([]string(nil), f.topics...)
+}
@@ -0,0 +1,146 @@
+package crawl
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// robotsRules are the Allow and Disallow paths that apply to the crawler
+type robotsRules struct {
+	allow    []string
+	disallow []string
+}
+
+// allows reports whether path may be fetched: the longest matching rule
+// wins, and Allow wins a tie
+func (r *robotsRules) allows(path string) bool {
+	longest, allowed := -1, true
+	for _, rule := range r.disallow {
+		if strings.HasPrefix(path, rule) && len(rule) > longest {
+			longest, allowed = len(rule), false
+		}
+	}
+	for _, rule := range r.allow {
+		if strings.HasPrefix(path, rule) && len(rule) >= longest {
+			longest, allowed = len(rule), true
+		}
+	}
+	return allowed
+}
+
+// allowed checks a URL against its host's robots.txt, fetching it once per host
+func (w *Web) allowed(ctx context.Context, target *url.URL) bool {
+	origin := target.Scheme + "://" + target.Host
+
+	w.mutex.Lock()
+	rules, ok := w.robots[origin]
+	w.mutex.Unlock()
+	if !ok {
+		rules = w.fetchRobots(ctx, origin)
+		w.mutex.Lock()
+		if w.robots == nil {
+			w.robots = make(map[string]*robotsRules)
+		}
+		w.robots[origin] = rules
+		w.mutex.Unlock()
+	}
+
+	path := target.EscapedPath()
+	if target.RawQuery != "" {
+		path += "?" + target.RawQuery
+	}
+	return rules.allows(path)
+}
+
+// fetchRobots reads a host's robots.txt. A missing file allows everything;
+// a server error or an unreachable host disallows everything, as the
+// convention asks.
+func (w *Web) fetchRobots(ctx context.Context, origin string) *robotsRules {
+	everything := &robotsRules{disallow: []string{"/"}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, origin+"/robots.txt", nil)
+	if err != nil {
+		return everything
+	}
+	req.Header.Set("User-Agent", w.UserAgent)
+	resp, err := w.Client.Do(req)
+	if err != nil {
+		return everything
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode >= 500:
+		return everything
+	case resp.StatusCode != http.StatusOK:
+		return &robotsRules{}
+	}
+	return parseRobots(io.LimitReader(resp.Body, 512<<10), w.UserAgent)
+}
+
+// parseRobots keeps the rules of the group naming agent, or else of the
+// group for every agent
+func parseRobots(r io.Reader, agent string) *robotsRules {
+	agent = strings.ToLower(agent)
+	var specific, general *robotsRules
+	var current []*robotsRules
+	inAgents := false
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		field, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		field = strings.ToLower(strings.TrimSpace(field))
+		value = strings.TrimSpace(value)
+
+		switch field {
+		case "user-agent":
+			if !inAgents {
+				current = nil
+			}
+			inAgents = true
+			name := strings.ToLower(value)
+			switch {
+			case name == "*":
+				if general == nil {
+					general = &robotsRules{}
+				}
+				current = append(current, general)
+			case name != "" && strings.Contains(agent, name):
+				if specific == nil {
+					specific = &robotsRules{}
+				}
+				current = append(current, specific)
+			}
+		case "allow", "disallow":
+			inAgents = false
+			if value == "" {
+				continue
+			}
+			for _, rules := range current {
+				if field == "allow" {
+					rules.allow = append(rules.allow, value)
+				} else {
+					rules.disallow = append(rules.disallow, value)
+				}
+			}
+		default:
+			inAgents = false
+		}
+	}
+	if specific != nil {
+		return specific
+	}
+	if general != nil {
+		return general
+	}
+	return &robotsRules{}
+}
@@ -0,0 +1,63 @@
+// Package dictionary defines where the consciousness looks up what words mean.
+package dictionary
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Definition is the canonical meaning of a term
+type Definition struct {
+	Term         string `json:"term"`
+	PartOfSpeech string `json:"part_of_speech,omitempty"`
+	Meaning      string `json:"meaning"`
+}
+
+// Dictionary defines terms. A nil definition with a nil error means the
+// dictionary does not know the term.
+type Dictionary interface {
+	Define(ctx context.Context, term string) (*Definition, error)
+}
+
+var (
+	dictionaries      = make(map[string]func() Dictionary)
+	dictionariesMutex sync.RWMutex
+)
+
+func init() {
+	Register("wiktionary", func() Dictionary { return NewWiktionary() })
+}
+
+// Register makes a dictionary available by name, replacing any dictionary
+// already registered under that name
+func Register(name string, factory func() Dictionary) {
+	dictionariesMutex.Lock()
+	defer dictionariesMutex.Unlock()
+	dictionaries[name] = factory
+}
+
+// Names lists every registered dictionary
+func Names() []string {
+	dictionariesMutex.RLock()
+	defer dictionariesMutex.RUnlock()
+	names := make([]string, 0, len(dictionaries))
+	for name := range dictionaries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Lookup creates the named dictionary
+func Lookup(name string) (Dictionary, error) {
+	dictionariesMutex.RLock()
+	factory, ok := dictionaries[name]
+	dictionariesMutex.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown dictionary %q (have %s)", name, strings.Join(Names(), ", "))
+	}
+	return factory(), nil
+}
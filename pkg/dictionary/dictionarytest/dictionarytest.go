@@ -0,0 +1,49 @@
+// Package dictionarytest provides a deterministic, offline dictionary for tests.
+package dictionarytest
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"QuantumConsciousness/pkg/dictionary"
+)
+
+// Fake defines terms from a fixed table and records every term it is asked about
+type Fake struct {
+	// Meanings maps a lower-case term to its meaning
+	Meanings map[string]string
+	// Err, when set, fails every lookup
+	Err error
+
+	mutex sync.Mutex
+	terms []string
+}
+
+// New creates a fake dictionary defining terms from meanings
+func New(meanings map[string]string) *Fake {
+	return &Fake{Meanings: meanings}
+}
+
+// Define implements dictionary.Dictionary
+func (f *Fake) Define(ctx context.Context, term string) (*dictionary.Definition, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.terms = append(f.terms, term)
+	if f.Err != nil {
+		return nil, f.Err
+	}
+	meaning, ok := f.Meanings[strings.ToLower(term)]
+	if !ok {
+		return nil, nil
+	}
+	return &dictionary.Definition{Term: term, Meaning: meaning}, nil
+}
+
+// Terms returns every term asked about so far
+func (f *Fake) Terms() []string {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return append([]string(nil), f.terms...)
+}
@@ -0,0 +1,67 @@
+package dictionary
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Wiktionary defines terms from English Wiktionary
+type Wiktionary struct {
+	Client *http.Client
+}
+
+// NewWiktionary creates a Wiktionary dictionary with a sensible timeout
+func NewWiktionary() *Wiktionary {
+	return &Wiktionary{Client: &http.Client{Timeout: 30 * time.Second}}
+}
+
+var htmlTag = regexp.MustCompile(`<[^>]*>`)
+
+// Define returns the first English sense Wiktionary lists for term
+func (w *Wiktionary) Define(ctx context.Context, term string) (*Definition, error) {
+	page := url.PathEscape(strings.ReplaceAll(strings.TrimSpace(term), " ", "_"))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://en.wiktionary.org/api/rest_v1/page/definition/"+page, nil)
+	if err != nil {
+		return nil, err
+	}
+	// Wikimedia asks every client to identify itself
+	req.Header.Set("User-Agent", "QuantumConsciousness (dictionary)")
+
+	resp, err := w.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("wiktionary answered %s", resp.Status)
+	}
+
+	var usages map[string][]struct {
+		PartOfSpeech string `json:"partOfSpeech"`
+		Definitions  []struct {
+			Definition string `json:"definition"`
+		} `json:"definitions"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&usages); err != nil {
+		return nil, err
+	}
+	for _, usage := range usages["en"] {
+		for _, sense := range usage.Definitions {
+			meaning := strings.Join(strings.Fields(html.UnescapeString(htmlTag.ReplaceAllString(sense.Definition, ""))), " ")
+			if meaning != "" {
+				return &Definition{Term: term, PartOfSpeech: strings.ToLower(usage.PartOfSpeech), Meaning: meaning}, nil
+			}
+		}
+	}
+	return nil, nil
+}
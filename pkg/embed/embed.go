@@ -0,0 +1,90 @@
+// Package embed defines how the consciousness turns text into vectors whose
+// closeness follows closeness of meaning.
+package embed
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Embedder turns texts into vectors of one length, in the order given, so
+// that texts meaning much the same lie close together
+type Embedder interface {
+	Embed(ctx context.Context, texts []string) ([][]float64, error)
+}
+
+// Cosine is the cosine of the angle between two vectors, from -1 for
+// opposite meanings to 1 for the same; vectors of different lengths or
+// without length are not alike at all
+func Cosine(a, b []float64) float64 {
+	if len(a) != len(b) || len(a) == 0 {
+		return 0
+	}
+	var dot, normA, normB float64
+	for i := range a {
+		dot += a[i] * b[i]
+		normA += a[i] * a[i]
+		normB += b[i] * b[i]
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / math.Sqrt(normA*normB)
+}
+
+var (
+	embedders      = make(map[string]func() Embedder)
+	embeddersMutex sync.RWMutex
+)
+
+func init() {
+	Register("hashing", func() Embedder { return NewHashing(DefaultHashingDimensions) })
+	Register("openai", func() Embedder { return NewOpenAIFromEnv() })
+	Register("ollama", func() Embedder {
+		return NewOpenAI(envOr("OLLAMA_BASE_URL", "http://localhost:11434/v1"), "", envOr("OLLAMA_EMBEDDING_MODEL", "nomic-embed-text"))
+	})
+}
+
+// Register makes an embedder available by name, replacing any embedder
+// already registered under that name
+func Register(name string, factory func() Embedder) {
+	embeddersMutex.Lock()
+	defer embeddersMutex.Unlock()
+	embedders[name] = factory
+}
+
+// Names lists every registered embedder
+func Names() []string {
+	embeddersMutex.RLock()
+	defer embeddersMutex.RUnlock()
+	names := make([]string, 0, len(embedders))
+	for name := range embedders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Lookup creates the named embedder
+func Lookup(name string) (Embedder, error) {
+	embeddersMutex.RLock()
+	factory, ok := embedders[name]
+	embeddersMutex.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown embedder %q (have %s)", name, strings.Join(Names(), ", "))
+	}
+	return factory(), nil
+}
+
+// envOr reads an environment variable, falling back when it is unset
+func envOr(name, fallback string) string {
+	if value := os.Getenv(name); value != "" {
+		return value
+	}
+	return fallback
+}
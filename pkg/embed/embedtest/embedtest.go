@@ -0,0 +1,62 @@
+// Package embedtest provides a scripted, offline embedder for tests.
+package embedtest
+
+import (
+	"context"
+	"sync"
+
+	"QuantumConsciousness/pkg/embed"
+)
+
+// Fake embeds texts it was given vectors for with those vectors, and every
+// other text with the local hashing embedder, recording every text it is
+// asked to embed
+type Fake struct {
+	// Err, when set, fails every request
+	Err error
+
+	mutex    sync.Mutex
+	vectors  map[string][]float64
+	fallback *embed.Hashing
+	texts    []string
+}
+
+// New creates a fake embedder answering with vectors for the texts they are
+// keyed by
+func New(vectors map[string][]float64) *Fake {
+	if vectors == nil {
+		vectors = make(map[string][]float64)
+	}
+	return &Fake{vectors: vectors, fallback: embed.NewHashing(0)}
+}
+
+// Embed implements embed.Embedder
+func (f *Fake) Embed(ctx context.Context, texts []string) ([][]float64, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.texts = append(f.texts, texts...)
+	if f.Err != nil {
+		return nil, f.Err
+	}
+	vectors := make([][]float64, len(texts))
+	for i, text := range texts {
+		if vector, ok := f.vectors[text]; ok {
+			vectors[i] = vector
+			continue
+		}
+		embedded, err := f.fallback.Embed(ctx, []string{text})
+		if err != nil {
+			return nil, err
+		}
+		vectors[i] = embedded[0]
+	}
+	return vectors, nil
+}
+
+// Texts returns every text asked to be embedded so far, in order
+func (f *Fake) Texts() []string {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return append([]string(nil), f.texts...)
+}
@@ -0,0 +1,113 @@
+package embed
+
+import (
+	"context"
+	"hash/fnv"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// DefaultHashingDimensions is how long the vectors of the hashing embedder
+// are unless chosen otherwise
+const DefaultHashingDimensions = 512
+
+// hashingTrigramWeight is how much a word's character trigrams count
+// beside the word itself, enough for "learn" and "learning" to lie close
+const hashingTrigramWeight = 0.5
+
+// hashingStopWords carry no meaning of their own
+var hashingStopWords = map[string]bool{
+	"the": true, "and": true, "for": true, "with": true, "what": true, "how": true,
+	"are": true, "its": true, "about": true, "from": true, "that": true, "this": true,
+	"into": true, "was": true, "were": true, "has": true, "have": true, "not": true,
+}
+
+// Hashing is a local embedder needing neither network nor model files: the
+// words of a text and their character trigrams are hashed into a vector
+// of fixed length, so texts sharing words or word stems lie close. It
+// knows nothing of synonyms, which a learned model such as OpenAI's does.
+type Hashing struct {
+	Dimensions int
+}
+
+// NewHashing creates a hashing embedder with vectors of the given length
+// (DefaultHashingDimensions if it is not positive)
+func NewHashing(dimensions int) *Hashing {
+	if dimensions <= 0 {
+		dimensions = DefaultHashingDimensions
+	}
+	return &Hashing{Dimensions: dimensions}
+}
+
+// Embed implements Embedder
+func (h *Hashing) Embed(ctx context.Context, texts []string) ([][]float64, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	vectors := make([][]float64, len(texts))
+	for i, text := range texts {
+		vectors[i] = h.vector(text)
+	}
+	return vectors, nil
+}
+
+// vector embeds one text, counting repeated words sublinearly and
+// normalising to unit length
+func (h *Hashing) vector(text string) []float64 {
+	dimensions := h.Dimensions
+	if dimensions <= 0 {
+		dimensions = DefaultHashingDimensions
+	}
+	// counts are how often each feature occurs, weights how much it counts
+	counts := make(map[string]int)
+	weights := make(map[string]float64)
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if len([]rune(word)) < 2 || hashingStopWords[word] {
+			continue
+		}
+		counts["w:"+word]++
+		weights["w:"+word] = 1
+		padded := []rune(" " + word + " ")
+		for i := 0; i+3 <= len(padded); i++ {
+			trigram := "t:" + string(padded[i:i+3])
+			counts[trigram]++
+			weights[trigram] = hashingTrigramWeight
+		}
+	}
+
+	// Features are added in order so that the same text always sums alike
+	features := make([]string, 0, len(counts))
+	for feature := range counts {
+		features = append(features, feature)
+	}
+	sort.Strings(features)
+	vector := make([]float64, dimensions)
+	for _, feature := range features {
+		count := counts[feature]
+		hash := fnv.New64a()
+		hash.Write([]byte(feature))
+		sum := hash.Sum64()
+		// The top bit signs the feature so that collisions cancel out
+		// rather than pile up
+		sign := 1.0
+		if sum>>63 == 1 {
+			sign = -1
+		}
+		vector[sum%uint64(dimensions)] += sign * weights[feature] * (1 + math.Log(float64(count)))
+	}
+	var norm float64
+	for _, v := range vector {
+		norm += v * v
+	}
+	if norm > 0 {
+		norm = math.Sqrt(norm)
+		for i := range vector {
+			vector[i] /= norm
+		}
+	}
+	return vector
+}
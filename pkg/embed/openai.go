@@ -0,0 +1,88 @@
+package embed
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// OpenAI talks to the OpenAI embeddings API, or to any server speaking it,
+// such as Ollama or llama.cpp
+type OpenAI struct {
+	Client *http.Client
+	// BaseURL is the API root, e.g. https://api.openai.com/v1
+	BaseURL string
+	Key     string
+	Model   string
+}
+
+// NewOpenAI creates a client for the embedding model behind baseURL with a
+// sensible timeout
+func NewOpenAI(baseURL, key, model string) *OpenAI {
+	return &OpenAI{Client: &http.Client{Timeout: 30 * time.Second}, BaseURL: baseURL, Key: key, Model: model}
+}
+
+// NewOpenAIFromEnv creates a client configured by OPENAI_BASE_URL,
+// OPENAI_API_KEY and OPENAI_EMBEDDING_MODEL, defaulting to OpenAI's own API
+func NewOpenAIFromEnv() *OpenAI {
+	return NewOpenAI(envOr("OPENAI_BASE_URL", "https://api.openai.com/v1"), os.Getenv("OPENAI_API_KEY"), envOr("OPENAI_EMBEDDING_MODEL", "text-embedding-3-small"))
+}
+
+// Embed implements Embedder, embedding every text in one request
+func (o *OpenAI) Embed(ctx context.Context, texts []string) ([][]float64, error) {
+	if len(texts) == 0 {
+		return nil, nil
+	}
+	body, err := json.Marshal(struct {
+		Model string   `json:"model"`
+		Input []string `json:"input"`
+	}{Model: o.Model, Input: texts})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(o.BaseURL, "/")+"/embeddings", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if o.Key != "" {
+		req.Header.Set("Authorization", "Bearer "+o.Key)
+	}
+	resp, err := o.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, fmt.Errorf("embedder answered %s: %s", resp.Status, strings.TrimSpace(string(detail)))
+	}
+
+	var answer struct {
+		Data []struct {
+			Index     int       `json:"index"`
+			Embedding []float64 `json:"embedding"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&answer); err != nil {
+		return nil, err
+	}
+	if len(answer.Data) != len(texts) {
+		return nil, fmt.Errorf("embedder answered %d embedding(s) for %d text(s)", len(answer.Data), len(texts))
+	}
+	vectors := make([][]float64, len(texts))
+	for _, d := range answer.Data {
+		if d.Index < 0 || d.Index >= len(texts) || vectors[d.Index] != nil {
+			return nil, fmt.Errorf("embedder answered an embedding for text %d", d.Index)
+		}
+		vectors[d.Index] = d.Embedding
+	}
+	return vectors, nil
+}
@@ -0,0 +1,36 @@
+// Package entropy defines the randomness behind every quantum choice.
+package entropy
+
+import (
+	"crypto/rand"
+	"math/big"
+)
+
+// Source supplies randomness. It is also an io.Reader so it can feed key generation.
+type Source interface {
+	// Float64 returns a probability in [0, 1)
+	Float64() float64
+	// Intn returns an integer in [0, n)
+	Intn(n int) int
+	// Read fills p with random bytes
+	Read(p []byte) (int, error)
+}
+
+// Crypto draws true randomness from crypto/rand
+type Crypto struct{}
+
+// Float64 returns a probability with a resolution of one in a million
+func (Crypto) Float64() float64 {
+	return float64(Crypto{}.Intn(1000000)) / 1000000.0
+}
+
+// Intn returns a uniformly random integer in [0, n)
+func (Crypto) Intn(n int) int {
+	v, _ := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	return int(v.Int64())
+}
+
+// Read fills p from crypto/rand
+func (Crypto) Read(p []byte) (int, error) {
+	return rand.Read(p)
+}
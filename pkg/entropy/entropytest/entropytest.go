@@ -0,0 +1,57 @@
+// Package entropytest provides reproducible entropy sources for tests.
+package entropytest
+
+import (
+	"sync"
+
+	"QuantumConsciousness/pkg/entropy"
+)
+
+// Seeded is a deterministic pseudo-random source: equal seeds give equal sequences
+type Seeded = entropy.Seeded
+
+// NewSeeded creates a source seeded with seed
+func NewSeeded(seed uint64) *Seeded {
+	return entropy.NewSeeded(seed)
+}
+
+// Sequence replays fixed probabilities in a loop, for scripting exact choices
+type Sequence struct {
+	mutex  sync.Mutex
+	values []float64
+	next   int
+}
+
+// NewSequence creates a source that returns values in order, wrapping around
+func NewSequence(values ...float64) *Sequence {
+	if len(values) == 0 {
+		values = []float64{0}
+	}
+	return &Sequence{values: values}
+}
+
+// Float64 implements entropy.Source
+func (s *Sequence) Float64() float64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	v := s.values[s.next%len(s.values)]
+	s.next++
+	return v
+}
+
+// Intn implements entropy.Source
+func (s *Sequence) Intn(n int) int {
+	v := int(s.Float64() * float64(n))
+	if v >= n {
+		v = n - 1
+	}
+	return v
+}
+
+// Read implements entropy.Source
+func (s *Sequence) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = byte(s.Intn(256))
+	}
+	return len(p), nil
+}
@@ -0,0 +1,42 @@
+package entropy
+
+import (
+	"math/rand/v2"
+	"sync"
+)
+
+// Seeded is a deterministic pseudo-random source: equal seeds give equal
+// sequences, so runs seeded alike make the same choices
+type Seeded struct {
+	mutex sync.Mutex
+	rng   *rand.Rand
+}
+
+// NewSeeded creates a source seeded with seed
+func NewSeeded(seed uint64) *Seeded {
+	return &Seeded{rng: rand.New(rand.NewPCG(seed, seed^0x9e3779b97f4a7c15))}
+}
+
+// Float64 returns a probability in [0, 1)
+func (s *Seeded) Float64() float64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.rng.Float64()
+}
+
+// Intn returns an integer in [0, n)
+func (s *Seeded) Intn(n int) int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.rng.IntN(n)
+}
+
+// Read fills p with pseudo-random bytes
+func (s *Seeded) Read(p []byte) (int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for i := range p {
+		p[i] = byte(s.rng.Uint32())
+	}
+	return len(p), nil
+}
@@ -0,0 +1,116 @@
+// Package idle tells whether the host is idle enough for heavy work: nobody
+// is at the keyboard and other processes leave the CPUs alone.
+package idle
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Reading is what a detector found
+type Reading struct {
+	Idle bool
+	// Reason says what the reading was based on, e.g. "load 0.12 per CPU"
+	Reason string
+}
+
+// Detector tells whether the host is idle
+type Detector interface {
+	Idle() (Reading, error)
+}
+
+// Host judges idleness by how long input has been idle, through xprintidle
+// on Linux and ioreg on macOS, and by the load average per CPU. Where input
+// idleness cannot be read only the load counts.
+type Host struct {
+	// MinInputIdle is how long keyboard and mouse must have gone untouched
+	// (0 = input does not matter)
+	MinInputIdle time.Duration
+	// MaxLoad is the highest one-minute load average per CPU counted as idle
+	MaxLoad float64
+}
+
+// Defaults of NewHost
+const (
+	DefaultMinInputIdle = 5 * time.Minute
+	DefaultMaxLoad      = 0.3
+)
+
+// NewHost creates a detector with the default thresholds
+func NewHost() *Host {
+	return &Host{MinInputIdle: DefaultMinInputIdle, MaxLoad: DefaultMaxLoad}
+}
+
+// Idle reads the load and, when it matters, input idleness
+func (h *Host) Idle() (Reading, error) {
+	load, err := loadAverage()
+	if err != nil {
+		return Reading{}, err
+	}
+	perCPU := load / float64(runtime.NumCPU())
+	reason := fmt.Sprintf("load %.2f per CPU", perCPU)
+	if perCPU > h.MaxLoad {
+		return Reading{Reason: reason}, nil
+	}
+	if h.MinInputIdle > 0 {
+		if input, err := inputIdle(); err == nil {
+			if input < h.MinInputIdle {
+				return Reading{Reason: fmt.Sprintf("input %s ago", input.Round(time.Second))}, nil
+			}
+			reason += fmt.Sprintf(", no input for %s", input.Round(time.Second))
+		}
+	}
+	return Reading{Idle: true, Reason: reason}, nil
+}
+
+// loadAverage is the one-minute load average
+func loadAverage() (float64, error) {
+	var text string
+	if runtime.GOOS == "darwin" {
+		// "{ 1.23 1.10 1.00 }"
+		output, err := exec.Command("sysctl", "-n", "vm.loadavg").Output()
+		if err != nil {
+			return 0, fmt.Errorf("reading the load average: %w", err)
+		}
+		text = strings.Trim(strings.TrimSpace(string(output)), "{ }")
+	} else {
+		data, err := os.ReadFile("/proc/loadavg")
+		if err != nil {
+			return 0, fmt.Errorf("reading the load average: %w", err)
+		}
+		text = string(data)
+	}
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("unreadable load average %q", text)
+	}
+	return strconv.ParseFloat(fields[0], 64)
+}
+
+// inputIdle is how long keyboard and mouse have gone untouched
+func inputIdle() (time.Duration, error) {
+	if runtime.GOOS == "darwin" {
+		output, err := exec.Command("ioreg", "-c", "IOHIDSystem", "-d", "4").Output()
+		if err != nil {
+			return 0, err
+		}
+		for _, line := range strings.Split(string(output), "\n") {
+			if _, value, ok := strings.Cut(line, `"HIDIdleTime" = `); ok {
+				ns, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+				return time.Duration(ns), err
+			}
+		}
+		return 0, fmt.Errorf("ioreg did not report HIDIdleTime")
+	}
+	output, err := exec.Command("xprintidle").Output()
+	if err != nil {
+		return 0, err
+	}
+	ms, err := strconv.ParseInt(strings.TrimSpace(string(output)), 10, 64)
+	return time.Duration(ms) * time.Millisecond, err
+}
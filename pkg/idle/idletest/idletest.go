@@ -0,0 +1,35 @@
+// Package idletest provides a detector whose readings are set by hand.
+package idletest
+
+import (
+	"sync"
+
+	"QuantumConsciousness/pkg/idle"
+)
+
+// Switch reports whatever it was last set to
+type Switch struct {
+	mutex   sync.Mutex
+	reading idle.Reading
+	// Err, when set, is returned by every Idle
+	Err error
+}
+
+// New creates a switch reporting the host as idle or busy
+func New(isIdle bool) *Switch {
+	return &Switch{reading: idle.Reading{Idle: isIdle, Reason: "set by hand"}}
+}
+
+// Set changes what the switch reports
+func (s *Switch) Set(isIdle bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.reading.Idle = isIdle
+}
+
+// Idle implements idle.Detector
+func (s *Switch) Idle() (idle.Reading, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.reading, s.Err
+}
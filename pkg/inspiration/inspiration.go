@@ -0,0 +1,76 @@
+// Package inspiration defines the prompt-of-the-day sources a day's first
+// cycle can start from.
+package inspiration
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Source offers a short prompt for a day, such as a word or an article
+// title. An empty prompt with a nil error means the source had nothing.
+type Source interface {
+	Prompt(ctx context.Context, day time.Time) (string, error)
+}
+
+var (
+	sources      = make(map[string]func() Source)
+	sourcesMutex sync.RWMutex
+)
+
+func init() {
+	Register("word-of-the-day", func() Source { return NewWordOfTheDay() })
+	Register("wikipedia-featured", func() Source { return NewFeaturedArticle() })
+	Register("on-this-day", func() Source { return NewOnThisDay() })
+}
+
+// Register makes a source available by name, replacing any source already
+// registered under that name
+func Register(name string, factory func() Source) {
+	sourcesMutex.Lock()
+	defer sourcesMutex.Unlock()
+	sources[name] = factory
+}
+
+// Names lists every registered source
+func Names() []string {
+	sourcesMutex.RLock()
+	defer sourcesMutex.RUnlock()
+	names := make([]string, 0, len(sources))
+	for name := range sources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Named is a source with the name it was registered under
+type Named struct {
+	Name string
+	Source
+}
+
+// Lookup creates the named sources, in order
+func Lookup(names []string) ([]Named, error) {
+	sourcesMutex.RLock()
+	defer sourcesMutex.RUnlock()
+
+	found := make([]Named, 0, len(names))
+	for _, name := range names {
+		factory, ok := sources[name]
+		if !ok {
+			all := make([]string, 0, len(sources))
+			for n := range sources {
+				all = append(all, n)
+			}
+			sort.Strings(all)
+			return nil, fmt.Errorf("unknown inspiration source %q (have %s)", name, strings.Join(all, ", "))
+		}
+		found = append(found, Named{Name: name, Source: factory()})
+	}
+	return found, nil
+}
@@ -0,0 +1,43 @@
+// Package inspirationtest provides a deterministic, offline inspiration source for tests.
+package inspirationtest
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Fake offers a fixed prompt and records every day it is asked about
+type Fake struct {
+	// Text is the prompt offered
+	Text string
+	// Err, when set, fails every request
+	Err error
+
+	mutex sync.Mutex
+	days  []time.Time
+}
+
+// New creates a fake source offering text
+func New(text string) *Fake {
+	return &Fake{Text: text}
+}
+
+// Prompt implements inspiration.Source
+func (f *Fake) Prompt(ctx context.Context, day time.Time) (string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.days = append(f.days, day)
+	if f.Err != nil {
+		return "", f.Err
+	}
+	return f.Text, nil
+}
+
+// Days returns every day asked about so far
+func (f *Fake) Days() []time.Time {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return append([]time.Time(nil), f.days...)
+}
@@ -0,0 +1,94 @@
+package inspiration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// wikimediaFeed is the Wikimedia feed API for English Wikipedia
+const wikimediaFeed = "https://api.wikimedia.org/feed/v1/wikipedia/en"
+
+// wikimediaPage is the part of a feed page that names it
+type wikimediaPage struct {
+	Titles struct {
+		Normalized string `json:"normalized"`
+	} `json:"titles"`
+}
+
+// getJSON fetches a feed document into out
+func getJSON(ctx context.Context, client *http.Client, url string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	// Wikimedia asks every client to identify itself
+	req.Header.Set("User-Agent", "QuantumConsciousness (inspiration source)")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s answered %s", url, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// FeaturedArticle offers the title of Wikipedia's featured article of the day
+type FeaturedArticle struct {
+	Client *http.Client
+}
+
+// NewFeaturedArticle creates the featured article source with a sensible timeout
+func NewFeaturedArticle() *FeaturedArticle {
+	return &FeaturedArticle{Client: &http.Client{Timeout: 30 * time.Second}}
+}
+
+// Prompt returns the featured article's title
+func (f *FeaturedArticle) Prompt(ctx context.Context, day time.Time) (string, error) {
+	var feed struct {
+		TFA *wikimediaPage `json:"tfa"`
+	}
+	url := fmt.Sprintf("%s/featured/%s", wikimediaFeed, day.Format("2006/01/02"))
+	if err := getJSON(ctx, f.Client, url, &feed); err != nil {
+		return "", err
+	}
+	if feed.TFA == nil {
+		return "", nil
+	}
+	return feed.TFA.Titles.Normalized, nil
+}
+
+// OnThisDay offers something that happened on this date in history
+type OnThisDay struct {
+	Client *http.Client
+}
+
+// NewOnThisDay creates the on-this-day source with a sensible timeout
+func NewOnThisDay() *OnThisDay {
+	return &OnThisDay{Client: &http.Client{Timeout: 30 * time.Second}}
+}
+
+// Prompt returns the subject of the day's first selected historical event
+func (o *OnThisDay) Prompt(ctx context.Context, day time.Time) (string, error) {
+	var feed struct {
+		Selected []struct {
+			Year  int             `json:"year"`
+			Pages []wikimediaPage `json:"pages"`
+		} `json:"selected"`
+	}
+	url := fmt.Sprintf("%s/onthisday/selected/%s", wikimediaFeed, day.Format("01/02"))
+	if err := getJSON(ctx, o.Client, url, &feed); err != nil {
+		return "", err
+	}
+	for _, event := range feed.Selected {
+		if len(event.Pages) > 0 && event.Pages[0].Titles.Normalized != "" {
+			return fmt.Sprintf("%s (%d)", event.Pages[0].Titles.Normalized, event.Year), nil
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,55 @@
+package inspiration
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// wordOfTheDayFeed is Merriam-Webster's word of the day RSS feed
+const wordOfTheDayFeed = "https://www.merriam-webster.com/wotd/feed/rss2"
+
+// WordOfTheDay offers Merriam-Webster's word of the day
+type WordOfTheDay struct {
+	Client *http.Client
+	// Feed overrides the RSS feed, e.g. for another dictionary
+	Feed string
+}
+
+// NewWordOfTheDay creates the word of the day source with a sensible timeout
+func NewWordOfTheDay() *WordOfTheDay {
+	return &WordOfTheDay{Client: &http.Client{Timeout: 30 * time.Second}, Feed: wordOfTheDayFeed}
+}
+
+// Prompt returns the newest word in the feed. The feed only knows today's
+// word, so day is not used.
+func (w *WordOfTheDay) Prompt(ctx context.Context, day time.Time) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, w.Feed, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := w.Client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%s answered %s", w.Feed, resp.Status)
+	}
+
+	var feed struct {
+		Items []struct {
+			Title string `xml:"title"`
+		} `xml:"channel>item"`
+	}
+	if err := xml.NewDecoder(resp.Body).Decode(&feed); err != nil {
+		return "", err
+	}
+	if len(feed.Items) == 0 {
+		return "", nil
+	}
+	return strings.TrimSpace(feed.Items[0].Title), nil
+}
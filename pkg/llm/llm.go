@@ -0,0 +1,122 @@
+// Package llm defines the language models the consciousness can reason with.
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Roles of the messages in a conversation
+const (
+	RoleSystem    = "system"
+	RoleUser      = "user"
+	RoleAssistant = "assistant"
+	RoleTool      = "tool"
+)
+
+// Message is one turn of a conversation with a model
+type Message struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+	// ToolCalls are the tools an assistant message asks to have called
+	ToolCalls []ToolCall `json:"tool_calls,omitempty"`
+	// ToolCallID is the call a tool message answers
+	ToolCallID string `json:"tool_call_id,omitempty"`
+}
+
+// Tool is a function the model may ask to have called
+type Tool struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// Parameters is the JSON schema of the arguments
+	Parameters map[string]interface{} `json:"parameters"`
+}
+
+// ToolCall is the model asking for a tool to be called
+type ToolCall struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Arguments is a JSON object matching the tool's parameters
+	Arguments string `json:"arguments"`
+}
+
+// Argument reads one string argument of the call, or "" if it has none
+func (c ToolCall) Argument(name string) string {
+	var args map[string]interface{}
+	if json.Unmarshal([]byte(c.Arguments), &args) != nil {
+		return ""
+	}
+	s, _ := args[name].(string)
+	return s
+}
+
+// EstimateTokens roughly counts the tokens text takes, at about four
+// characters a token, for budgeting prompts before a model counts them
+func EstimateTokens(text string) int {
+	return (utf8.RuneCountInString(text) + 3) / 4
+}
+
+// Usage is what a request cost in tokens
+type Usage struct {
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+}
+
+// Response is the model's next message and what producing it cost
+type Response struct {
+	Message Message
+	Usage   Usage
+}
+
+// Model continues conversations, optionally asking for tools to be called
+type Model interface {
+	Chat(ctx context.Context, messages []Message, tools []Tool) (Response, error)
+}
+
+var (
+	models      = make(map[string]func() Model)
+	modelsMutex sync.RWMutex
+)
+
+func init() {
+	Register("openai", func() Model { return NewOpenAIFromEnv() })
+	Register("ollama", func() Model {
+		return NewOpenAI(envOr("OLLAMA_BASE_URL", "http://localhost:11434/v1"), "", envOr("OLLAMA_MODEL", "llama3.1"))
+	})
+}
+
+// Register makes a model available by name, replacing any model already
+// registered under that name
+func Register(name string, factory func() Model) {
+	modelsMutex.Lock()
+	defer modelsMutex.Unlock()
+	models[name] = factory
+}
+
+// Names lists every registered model
+func Names() []string {
+	modelsMutex.RLock()
+	defer modelsMutex.RUnlock()
+	names := make([]string, 0, len(models))
+	for name := range models {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Lookup creates the named model
+func Lookup(name string) (Model, error) {
+	modelsMutex.RLock()
+	factory, ok := models[name]
+	modelsMutex.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown model %q (have %s)", name, strings.Join(Names(), ", "))
+	}
+	return factory(), nil
+}
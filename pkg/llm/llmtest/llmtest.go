@@ -0,0 +1,59 @@
+// Package llmtest provides a scripted, offline model for tests.
+package llmtest
+
+import (
+	"context"
+	"sync"
+
+	"QuantumConsciousness/pkg/llm"
+)
+
+// Fake answers with scripted responses in order, then with Final once the
+// script runs out, and records every conversation it is sent
+type Fake struct {
+	// Final is the answer once the script has run out
+	Final string
+	// Err, when set, fails every request
+	Err error
+
+	mutex         sync.Mutex
+	script        []llm.Response
+	conversations [][]llm.Message
+}
+
+// New creates a fake model answering with responses in order
+func New(responses ...llm.Response) *Fake {
+	return &Fake{Final: "done", script: responses}
+}
+
+// Call is a scripted response asking for one tool call
+func Call(id, name, arguments string) llm.Response {
+	return llm.Response{Message: llm.Message{
+		Role:      llm.RoleAssistant,
+		ToolCalls: []llm.ToolCall{{ID: id, Name: name, Arguments: arguments}},
+	}}
+}
+
+// Chat implements llm.Model
+func (f *Fake) Chat(ctx context.Context, messages []llm.Message, tools []llm.Tool) (llm.Response, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.conversations = append(f.conversations, append([]llm.Message(nil), messages...))
+	if f.Err != nil {
+		return llm.Response{}, f.Err
+	}
+	if len(f.script) == 0 {
+		return llm.Response{Message: llm.Message{Role: llm.RoleAssistant, Content: f.Final}}, nil
+	}
+	response := f.script[0]
+	f.script = f.script[1:]
+	return response, nil
+}
+
+// Conversations returns every conversation sent so far
+func (f *Fake) Conversations() [][]llm.Message {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return append([][]llm.Message(nil), f.conversations...)
+}
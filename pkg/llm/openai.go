@@ -0,0 +1,128 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// OpenAI talks to the OpenAI chat completions API, or to any server
+// speaking it, such as Ollama or llama.cpp
+type OpenAI struct {
+	Client *http.Client
+	// BaseURL is the API root, e.g. https://api.openai.com/v1
+	BaseURL string
+	Key     string
+	Model   string
+}
+
+// NewOpenAI creates a client for the model behind baseURL with a sensible timeout
+func NewOpenAI(baseURL, key, model string) *OpenAI {
+	return &OpenAI{Client: &http.Client{Timeout: 2 * time.Minute}, BaseURL: baseURL, Key: key, Model: model}
+}
+
+// NewOpenAIFromEnv creates a client configured by OPENAI_BASE_URL,
+// OPENAI_API_KEY and OPENAI_MODEL, defaulting to OpenAI's own API
+func NewOpenAIFromEnv() *OpenAI {
+	return NewOpenAI(envOr("OPENAI_BASE_URL", "https://api.openai.com/v1"), os.Getenv("OPENAI_API_KEY"), envOr("OPENAI_MODEL", "gpt-4o-mini"))
+}
+
+// envOr reads an environment variable, falling back when it is unset
+func envOr(name, fallback string) string {
+	if value := os.Getenv(name); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// openAIMessage is a Message in the API's shape
+type openAIMessage struct {
+	Role       string           `json:"role"`
+	Content    string           `json:"content"`
+	ToolCalls  []openAIToolCall `json:"tool_calls,omitempty"`
+	ToolCallID string           `json:"tool_call_id,omitempty"`
+}
+
+// openAIToolCall is a ToolCall in the API's shape
+type openAIToolCall struct {
+	ID       string `json:"id"`
+	Type     string `json:"type"`
+	Function struct {
+		Name      string `json:"name"`
+		Arguments string `json:"arguments"`
+	} `json:"function"`
+}
+
+// openAITool is a Tool in the API's shape
+type openAITool struct {
+	Type     string `json:"type"`
+	Function Tool   `json:"function"`
+}
+
+// Chat implements Model
+func (o *OpenAI) Chat(ctx context.Context, messages []Message, tools []Tool) (Response, error) {
+	request := struct {
+		Model    string          `json:"model"`
+		Messages []openAIMessage `json:"messages"`
+		Tools    []openAITool    `json:"tools,omitempty"`
+	}{Model: o.Model}
+	for _, m := range messages {
+		message := openAIMessage{Role: m.Role, Content: m.Content, ToolCallID: m.ToolCallID}
+		for _, call := range m.ToolCalls {
+			c := openAIToolCall{ID: call.ID, Type: "function"}
+			c.Function.Name, c.Function.Arguments = call.Name, call.Arguments
+			message.ToolCalls = append(message.ToolCalls, c)
+		}
+		request.Messages = append(request.Messages, message)
+	}
+	for _, tool := range tools {
+		request.Tools = append(request.Tools, openAITool{Type: "function", Function: tool})
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return Response{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(o.BaseURL, "/")+"/chat/completions", bytes.NewReader(body))
+	if err != nil {
+		return Response{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if o.Key != "" {
+		req.Header.Set("Authorization", "Bearer "+o.Key)
+	}
+	resp, err := o.Client.Do(req)
+	if err != nil {
+		return Response{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return Response{}, fmt.Errorf("model answered %s: %s", resp.Status, strings.TrimSpace(string(detail)))
+	}
+
+	var completion struct {
+		Choices []struct {
+			Message openAIMessage `json:"message"`
+		} `json:"choices"`
+		Usage Usage `json:"usage"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&completion); err != nil {
+		return Response{}, err
+	}
+	if len(completion.Choices) == 0 {
+		return Response{}, fmt.Errorf("model answered without a choice")
+	}
+	answer := completion.Choices[0].Message
+	message := Message{Role: RoleAssistant, Content: answer.Content}
+	for _, call := range answer.ToolCalls {
+		message.ToolCalls = append(message.ToolCalls, ToolCall{ID: call.ID, Name: call.Function.Name, Arguments: call.Function.Arguments})
+	}
+	return Response{Message: message, Usage: completion.Usage}, nil
+}
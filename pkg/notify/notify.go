@@ -0,0 +1,78 @@
+// Package notify surfaces rare, important moments as desktop notifications.
+package notify
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// Notifier shows a notification to whoever is at the machine
+type Notifier interface {
+	Notify(title, message string) error
+}
+
+// Desktop shows native notifications through notify-send on Linux and the
+// BSDs, osascript on macOS and PowerShell on Windows
+type Desktop struct {
+	// Command is the program notifications are shown with
+	Command string
+}
+
+// desktopCommands is the program each operating system notifies with
+var desktopCommands = map[string]string{
+	"darwin":  "osascript",
+	"windows": "powershell",
+}
+
+// NewDesktop finds the notification program of this operating system
+func NewDesktop() (*Desktop, error) {
+	command, ok := desktopCommands[runtime.GOOS]
+	if !ok {
+		command = "notify-send"
+	}
+	path, err := exec.LookPath(command)
+	if err != nil {
+		return nil, fmt.Errorf("desktop notifications need %s: %w", command, err)
+	}
+	return &Desktop{Command: path}, nil
+}
+
+// Notify shows a notification, returning once it has been handed over
+func (d *Desktop) Notify(title, message string) error {
+	var args []string
+	switch runtime.GOOS {
+	case "darwin":
+		args = []string{"-e", fmt.Sprintf("display notification %s with title %s", appleScriptString(message), appleScriptString(title))}
+	case "windows":
+		args = []string{"-NoProfile", "-NonInteractive", "-Command", windowsToast(title, message)}
+	default:
+		args = []string{"--app-name=Quantum Consciousness", title, message}
+	}
+	if output, err := exec.Command(d.Command, args...).CombinedOutput(); err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// appleScriptString quotes s as an AppleScript string literal
+func appleScriptString(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// powerShellString quotes s as a PowerShell literal string
+func powerShellString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// windowsToast is a PowerShell script showing a toast notification
+func windowsToast(title, message string) string {
+	return `[Windows.UI.Notifications.ToastNotificationManager, Windows.UI.Notifications, ContentType = WindowsRuntime] > $null
+$template = [Windows.UI.Notifications.ToastNotificationManager]::GetTemplateContent([Windows.UI.Notifications.ToastTemplateType]::ToastText02)
+$text = $template.GetElementsByTagName('text')
+$text.Item(0).AppendChild($template.CreateTextNode(` + powerShellString(title) + `)) > $null
+$text.Item(1).AppendChild($template.CreateTextNode(` + powerShellString(message) + `)) > $null
+$toast = [Windows.UI.Notifications.ToastNotification]::new($template)
+[Windows.UI.Notifications.ToastNotificationManager]::CreateToastNotifier('Quantum Consciousness').Show($toast)`
+}
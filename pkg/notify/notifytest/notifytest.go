@@ -0,0 +1,37 @@
+// Package notifytest provides a notifier that records instead of showing.
+package notifytest
+
+import "sync"
+
+// Notification is one recorded notification
+type Notification struct {
+	Title, Message string
+}
+
+// Recorder keeps every notification it is given
+type Recorder struct {
+	mutex         sync.Mutex
+	notifications []Notification
+	// Err, when set, is returned by every Notify
+	Err error
+}
+
+// New creates an empty recorder
+func New() *Recorder {
+	return &Recorder{}
+}
+
+// Notify implements notify.Notifier
+func (r *Recorder) Notify(title, message string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.notifications = append(r.notifications, Notification{title, message})
+	return r.Err
+}
+
+// Notifications returns every notification so far
+func (r *Recorder) Notifications() []Notification {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return append([]Notification(nil), r.notifications...)
+}
@@ -0,0 +1,238 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// MaxMessageSize is the largest request a server reads or response a
+// client accepts, gRPC's own default
+const MaxMessageSize = 4 << 20
+
+// Code is a gRPC status code
+type Code int
+
+// Status codes, as every gRPC implementation numbers them
+const (
+	OK                 Code = 0
+	Canceled           Code = 1
+	Unknown            Code = 2
+	InvalidArgument    Code = 3
+	NotFound           Code = 5
+	PermissionDenied   Code = 7
+	ResourceExhausted  Code = 8
+	FailedPrecondition Code = 9
+	Unimplemented      Code = 12
+	Internal           Code = 13
+	Unavailable        Code = 14
+	Unauthenticated    Code = 16
+)
+
+// Status is an error carrying a gRPC status code
+type Status struct {
+	Code    Code
+	Message string
+}
+
+func (s *Status) Error() string {
+	return fmt.Sprintf("rpc error: code = %d desc = %s", s.Code, s.Message)
+}
+
+// Errorf creates a status error
+func Errorf(code Code, format string, args ...interface{}) error {
+	return &Status{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// StatusOf finds the status in an error; any other error is Unknown
+func StatusOf(err error) *Status {
+	var status *Status
+	if errors.As(err, &status) {
+		return status
+	}
+	return &Status{Code: Unknown, Message: err.Error()}
+}
+
+// Handler answers one call of a method with the encoded response message
+type Handler func(r *http.Request, request []byte) ([]byte, error)
+
+// Server routes unary calls to the handler of their method
+type Server struct {
+	methods map[string]Handler
+}
+
+// NewServer creates a server without methods
+func NewServer() *Server {
+	return &Server{methods: make(map[string]Handler)}
+}
+
+// Handle routes calls of a method, named as on the wire, e.g.
+// "/package.Service/Method"
+func (s *Server) Handle(method string, handler Handler) {
+	s.methods[method] = handler
+}
+
+// ServeHTTP answers one call
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || !strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+		http.Error(w, "gRPC requests only", http.StatusUnsupportedMediaType)
+		return
+	}
+	w.Header().Set("Content-Type", "application/grpc")
+	w.Header().Set("Trailer", "Grpc-Status, Grpc-Message")
+
+	handler, ok := s.methods[r.URL.Path]
+	if !ok {
+		finish(w, Errorf(Unimplemented, "unknown method %s", r.URL.Path))
+		return
+	}
+	request, err := readFrame(r.Body, MaxMessageSize)
+	if err != nil {
+		finish(w, err)
+		return
+	}
+	response, err := handler(r, request)
+	if err != nil {
+		finish(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(frame(response))
+	finish(w, nil)
+}
+
+// finish sends the status of a call in the trailers
+func finish(w http.ResponseWriter, err error) {
+	status := &Status{Code: OK}
+	if err != nil {
+		status = StatusOf(err)
+	}
+	w.Header().Set("Grpc-Status", strconv.Itoa(int(status.Code)))
+	w.Header().Set("Grpc-Message", encodeMessage(status.Message))
+}
+
+// frame prefixes a message with the uncompressed flag and its length
+func frame(message []byte) []byte {
+	framed := make([]byte, 5, 5+len(message))
+	binary.BigEndian.PutUint32(framed[1:], uint32(len(message)))
+	return append(framed, message...)
+}
+
+// readFrame reads one uncompressed message
+func readFrame(r io.Reader, limit int) ([]byte, error) {
+	var header [5]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, Errorf(InvalidArgument, "reading the message: %v", err)
+	}
+	if header[0] != 0 {
+		return nil, Errorf(Unimplemented, "compressed messages are not supported")
+	}
+	length := binary.BigEndian.Uint32(header[1:])
+	if int64(length) > int64(limit) {
+		return nil, Errorf(ResourceExhausted, "message of %d bytes exceeds %d", length, limit)
+	}
+	message := make([]byte, length)
+	if _, err := io.ReadFull(r, message); err != nil {
+		return nil, Errorf(InvalidArgument, "reading the message: %v", err)
+	}
+	return message, nil
+}
+
+// encodeMessage percent-encodes a status message for its header
+func encodeMessage(message string) string {
+	var encoded strings.Builder
+	for i := 0; i < len(message); i++ {
+		c := message[i]
+		if c < ' ' || c > '~' || c == '%' {
+			fmt.Fprintf(&encoded, "%%%02X", c)
+		} else {
+			encoded.WriteByte(c)
+		}
+	}
+	return encoded.String()
+}
+
+// decodeMessage reverses encodeMessage
+func decodeMessage(encoded string) string {
+	var message strings.Builder
+	for i := 0; i < len(encoded); i++ {
+		if encoded[i] == '%' && i+2 < len(encoded) {
+			if c, err := strconv.ParseUint(encoded[i+1:i+3], 16, 8); err == nil {
+				message.WriteByte(byte(c))
+				i += 2
+				continue
+			}
+		}
+		message.WriteByte(encoded[i])
+	}
+	return message.String()
+}
+
+// ListenAndServe serves calls over unencrypted HTTP/2 on addr
+func ListenAndServe(addr string, handler http.Handler) error {
+	server := &http.Server{Addr: addr, Handler: handler, Protocols: new(http.Protocols)}
+	server.Protocols.SetUnencryptedHTTP2(true)
+	return server.ListenAndServe()
+}
+
+// NewClient creates an HTTP client that calls over unencrypted HTTP/2
+func NewClient() *http.Client {
+	transport := &http.Transport{Protocols: new(http.Protocols)}
+	transport.Protocols.SetUnencryptedHTTP2(true)
+	return &http.Client{Transport: transport}
+}
+
+// Invoke calls a method on the server at target, e.g.
+// "http://localhost:9090", sending header as metadata, and returns the
+// encoded response message
+func Invoke(ctx context.Context, client *http.Client, target, method string, request []byte, header http.Header) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(target, "/")+method, bytes.NewReader(frame(request)))
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Content-Type", "application/grpc")
+	req.Header.Set("TE", "trailers")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, Errorf(Unavailable, "%v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, Errorf(Unknown, "server answered %s", resp.Status)
+	}
+	// A call that failed at once may carry its status in the headers alone
+	if code := resp.Header.Get("Grpc-Status"); code != "" {
+		return nil, callStatus(code, resp.Header.Get("Grpc-Message"))
+	}
+	response, frameErr := readFrame(resp.Body, MaxMessageSize)
+	io.Copy(io.Discard, resp.Body)
+	if err := callStatus(resp.Trailer.Get("Grpc-Status"), resp.Trailer.Get("Grpc-Message")); err != nil {
+		return nil, err
+	}
+	if frameErr != nil {
+		return nil, frameErr
+	}
+	return response, nil
+}
+
+// callStatus turns the status a server sent into an error, nil for OK
+func callStatus(code, message string) error {
+	n, err := strconv.Atoi(code)
+	if err != nil {
+		return Errorf(Unknown, "server sent no status")
+	}
+	if Code(n) == OK {
+		return nil
+	}
+	return &Status{Code: Code(n), Message: decodeMessage(message)}
+}
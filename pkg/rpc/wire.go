@@ -0,0 +1,247 @@
+// Package rpc speaks as much gRPC, and of the protobuf wire format, as
+// serving hand-written messages takes: unary calls over unencrypted HTTP/2,
+// with no dependencies. Messages are encoded field by field with an
+// Encoder and read back with Decode.
+package rpc
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// WireType is how a field is laid out on the wire
+type WireType int
+
+// Wire types of the protobuf encoding; groups are not supported
+const (
+	Varint          WireType = 0
+	Fixed64         WireType = 1
+	LengthDelimited WireType = 2
+	Fixed32         WireType = 5
+)
+
+// ErrTruncated is returned for a message that ends inside a field
+var ErrTruncated = errors.New("rpc: truncated message")
+
+// Encoder appends fields to a message. Scalar fields holding their zero
+// value are left out, as proto3 does.
+type Encoder struct {
+	buf []byte
+}
+
+// Bytes returns the encoded message
+func (e *Encoder) Bytes() []byte {
+	return e.buf
+}
+
+// tag starts a field
+func (e *Encoder) tag(field int, wire WireType) {
+	e.buf = binary.AppendUvarint(e.buf, uint64(field)<<3|uint64(wire))
+}
+
+// Int encodes an int32 or int64 field
+func (e *Encoder) Int(field int, v int64) {
+	if v == 0 {
+		return
+	}
+	e.tag(field, Varint)
+	e.buf = binary.AppendUvarint(e.buf, uint64(v))
+}
+
+// Bool encodes a bool field
+func (e *Encoder) Bool(field int, v bool) {
+	if !v {
+		return
+	}
+	e.tag(field, Varint)
+	e.buf = append(e.buf, 1)
+}
+
+// Double encodes a double field
+func (e *Encoder) Double(field int, v float64) {
+	if v == 0 {
+		return
+	}
+	e.OptionalDouble(field, v)
+}
+
+// OptionalDouble encodes an optional double field, which is sent even when zero
+func (e *Encoder) OptionalDouble(field int, v float64) {
+	e.tag(field, Fixed64)
+	e.buf = binary.LittleEndian.AppendUint64(e.buf, math.Float64bits(v))
+}
+
+// String encodes a string field
+func (e *Encoder) String(field int, v string) {
+	if v == "" {
+		return
+	}
+	e.tag(field, LengthDelimited)
+	e.buf = binary.AppendUvarint(e.buf, uint64(len(v)))
+	e.buf = append(e.buf, v...)
+}
+
+// RawBytes encodes a bytes field
+func (e *Encoder) RawBytes(field int, v []byte) {
+	if len(v) == 0 {
+		return
+	}
+	e.tag(field, LengthDelimited)
+	e.buf = binary.AppendUvarint(e.buf, uint64(len(v)))
+	e.buf = append(e.buf, v...)
+}
+
+// Strings encodes a repeated string field, empty strings included
+func (e *Encoder) Strings(field int, v []string) {
+	for _, s := range v {
+		e.tag(field, LengthDelimited)
+		e.buf = binary.AppendUvarint(e.buf, uint64(len(s)))
+		e.buf = append(e.buf, s...)
+	}
+}
+
+// Message encodes a message field, or one element of a repeated one, with
+// encode writing its fields
+func (e *Encoder) Message(field int, encode func(*Encoder)) {
+	var inner Encoder
+	encode(&inner)
+	e.tag(field, LengthDelimited)
+	e.buf = binary.AppendUvarint(e.buf, uint64(len(inner.buf)))
+	e.buf = append(e.buf, inner.buf...)
+}
+
+// Time encodes a google.protobuf.Timestamp field, leaving out the zero time
+func (e *Encoder) Time(field int, t time.Time) {
+	if t.IsZero() {
+		return
+	}
+	e.Message(field, func(ts *Encoder) {
+		ts.Int(1, t.Unix())
+		ts.Int(2, int64(t.Nanosecond()))
+	})
+}
+
+// StringMap encodes a map<string, string> field, in key order
+func (e *Encoder) StringMap(field int, m map[string]string) {
+	for _, key := range sortedKeys(m) {
+		e.Message(field, func(entry *Encoder) {
+			entry.String(1, key)
+			entry.String(2, m[key])
+		})
+	}
+}
+
+// DoubleMap encodes a map<string, double> field, in key order
+func (e *Encoder) DoubleMap(field int, m map[string]float64) {
+	for _, key := range sortedKeys(m) {
+		e.Message(field, func(entry *Encoder) {
+			entry.String(1, key)
+			entry.Double(2, m[key])
+		})
+	}
+}
+
+// sortedKeys lists the keys of a map in order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Field is one field read from a message
+type Field struct {
+	Number int
+	Wire   WireType
+	value  uint64
+	data   []byte
+}
+
+// Int reads an int32 or int64 field
+func (f Field) Int() int64 {
+	return int64(f.value)
+}
+
+// Bool reads a bool field
+func (f Field) Bool() bool {
+	return f.value != 0
+}
+
+// Double reads a double field
+func (f Field) Double() float64 {
+	return math.Float64frombits(f.value)
+}
+
+// String reads a string field
+func (f Field) String() string {
+	return string(f.data)
+}
+
+// Bytes reads a bytes or message field
+func (f Field) Bytes() []byte {
+	return f.data
+}
+
+// Time reads a google.protobuf.Timestamp field
+func (f Field) Time() (time.Time, error) {
+	var seconds, nanos int64
+	err := Decode(f.data, func(ts Field) error {
+		switch ts.Number {
+		case 1:
+			seconds = ts.Int()
+		case 2:
+			nanos = ts.Int()
+		}
+		return nil
+	})
+	return time.Unix(seconds, nanos).UTC(), err
+}
+
+// Decode calls visit with every field of a message, in the order they
+// were written, stopping at the first error visit returns. Fields a caller
+// does not know are simply not handled.
+func Decode(data []byte, visit func(Field) error) error {
+	for len(data) > 0 {
+		key, n := binary.Uvarint(data)
+		if n <= 0 {
+			return ErrTruncated
+		}
+		data = data[n:]
+		field := Field{Number: int(key >> 3), Wire: WireType(key & 7)}
+		switch field.Wire {
+		case Varint:
+			if field.value, n = binary.Uvarint(data); n <= 0 {
+				return ErrTruncated
+			}
+			data = data[n:]
+		case Fixed64:
+			if len(data) < 8 {
+				return ErrTruncated
+			}
+			field.value, data = binary.LittleEndian.Uint64(data), data[8:]
+		case Fixed32:
+			if len(data) < 4 {
+				return ErrTruncated
+			}
+			field.value, data = uint64(binary.LittleEndian.Uint32(data)), data[4:]
+		case LengthDelimited:
+			length, n := binary.Uvarint(data)
+			if n <= 0 || uint64(len(data)-n) < length {
+				return ErrTruncated
+			}
+			field.data, data = data[n:n+int(length)], data[n+int(length):]
+		default:
+			return fmt.Errorf("rpc: unsupported wire type %d in field %d", field.Wire, field.Number)
+		}
+		if err := visit(field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
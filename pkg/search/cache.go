@@ -0,0 +1,227 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cache defaults
+const (
+	// DefaultCacheTTL is how long a cached answer is given before the
+	// provider is asked again
+	DefaultCacheTTL = 7 * 24 * time.Hour
+	// DefaultCacheEntries is how many answers a cache keeps
+	DefaultCacheEntries = 1000
+)
+
+// CacheProviderName is the provider a cached answer is attributed to
+const CacheProviderName = "cache"
+
+// cacheEntry is one cached answer
+type cacheEntry struct {
+	Query      string    `json:"query"`
+	Text       string    `json:"text"`
+	Confidence float64   `json:"confidence,omitempty"`
+	StoredAt   time.Time `json:"stored_at"`
+	UsedAt     time.Time `json:"used_at"`
+}
+
+// CacheStats is how a cache has fared since it was created
+type CacheStats struct {
+	Entries int `json:"entries"`
+	Hits    int `json:"hits"`
+	Misses  int `json:"misses"`
+	// Stale counts expired answers given because the provider failed
+	Stale int `json:"stale"`
+	// SaveError is why the cache file could not be written last time, if
+	// it could not; searches go on regardless
+	SaveError string `json:"save_error,omitempty"`
+}
+
+// Cache answers repeated queries from a file instead of asking its provider
+// again. Queries are keyed by their words, in any case and spacing. An
+// answer is given until its TTL passes, and after that only when the
+// provider fails, so a run can go on partially offline. The least recently
+// used answers make way once MaxEntries are kept. An unreadable file is
+// treated as empty and overwritten.
+type Cache struct {
+	Provider   Provider
+	Path       string
+	TTL        time.Duration
+	MaxEntries int
+
+	mutex   sync.Mutex
+	entries map[string]*cacheEntry
+	stats   CacheStats
+}
+
+// NewCache caches provider's answers in the file at path, using the
+// defaults for a TTL or size that is not positive
+func NewCache(provider Provider, path string, ttl time.Duration, maxEntries int) *Cache {
+	if ttl <= 0 {
+		ttl = DefaultCacheTTL
+	}
+	if maxEntries <= 0 {
+		maxEntries = DefaultCacheEntries
+	}
+	return &Cache{Provider: provider, Path: path, TTL: ttl, MaxEntries: maxEntries}
+}
+
+// Search implements Provider
+func (c *Cache) Search(ctx context.Context, query string) (string, error) {
+	result, err := c.SearchScored(ctx, query)
+	return result.Text, err
+}
+
+// SearchScored implements Scorer, answering from the cache when it can
+func (c *Cache) SearchScored(ctx context.Context, query string) (Result, error) {
+	key := cacheKey(query)
+	now := time.Now()
+
+	c.mutex.Lock()
+	c.load()
+	entry := c.entries[key]
+	if entry != nil && now.Sub(entry.StoredAt) < c.TTL {
+		entry.UsedAt = now
+		c.stats.Hits++
+		c.mutex.Unlock()
+		return cachedResult(entry), nil
+	}
+	c.stats.Misses++
+	c.mutex.Unlock()
+
+	// The provider is asked without holding the lock, so slow searches
+	// do not queue behind each other
+	result, err := Scored(ctx, c.Provider, query)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if err != nil {
+		if entry != nil {
+			entry.UsedAt = now
+			c.stats.Stale++
+			return cachedResult(entry), nil
+		}
+		return result, err
+	}
+	c.entries[key] = &cacheEntry{Query: query, Text: result.Text, Confidence: result.Confidence, StoredAt: now, UsedAt: now}
+	c.evict()
+	c.stats.SaveError = ""
+	if err := c.save(); err != nil {
+		c.stats.SaveError = err.Error()
+	}
+	return result, nil
+}
+
+// Stored answers from the cache alone, whatever an answer's age, and never
+// asks the provider, so a run without the network can use what earlier runs
+// found
+func (c *Cache) Stored() Provider {
+	return storedAnswers{c}
+}
+
+// storedAnswers is a cache's answers without its provider
+type storedAnswers struct {
+	cache *Cache
+}
+
+// Search implements Provider
+func (s storedAnswers) Search(ctx context.Context, query string) (string, error) {
+	result, err := s.SearchScored(ctx, query)
+	return result.Text, err
+}
+
+// SearchScored implements Scorer, finding nothing when nothing is cached
+func (s storedAnswers) SearchScored(ctx context.Context, query string) (Result, error) {
+	c := s.cache
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.load()
+	entry := c.entries[cacheKey(query)]
+	if entry == nil {
+		c.stats.Misses++
+		return Result{}, nil
+	}
+	entry.UsedAt = time.Now()
+	c.stats.Hits++
+	return cachedResult(entry), nil
+}
+
+// Stats reports how the cache has fared
+func (c *Cache) Stats() CacheStats {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.load()
+	stats := c.stats
+	stats.Entries = len(c.entries)
+	return stats
+}
+
+// cachedResult is the answer a cache entry gives
+func cachedResult(entry *cacheEntry) Result {
+	return Result{
+		Text:       entry.Text,
+		Confidence: entry.Confidence,
+		Attempts:   []Attempt{{Provider: CacheProviderName, Found: entry.Text != ""}},
+	}
+}
+
+// cacheKey normalises a query so that queries differing only in case and
+// spacing share an answer
+func cacheKey(query string) string {
+	return strings.Join(strings.Fields(strings.ToLower(query)), " ")
+}
+
+// load reads the cache file the first time it is needed; the caller holds
+// the mutex
+func (c *Cache) load() {
+	if c.entries != nil {
+		return
+	}
+	c.entries = make(map[string]*cacheEntry)
+	data, err := os.ReadFile(c.Path)
+	if err != nil {
+		return
+	}
+	var entries map[string]*cacheEntry
+	if json.Unmarshal(data, &entries) == nil {
+		for key, entry := range entries {
+			if entry != nil {
+				c.entries[key] = entry
+			}
+		}
+	}
+}
+
+// evict drops the least recently used entries beyond MaxEntries; the
+// caller holds the mutex
+func (c *Cache) evict() {
+	excess := len(c.entries) - c.MaxEntries
+	if excess <= 0 {
+		return
+	}
+	keys := make([]string, 0, len(c.entries))
+	for key := range c.entries {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return c.entries[keys[i]].UsedAt.Before(c.entries[keys[j]].UsedAt)
+	})
+	for _, key := range keys[:excess] {
+		delete(c.entries, key)
+	}
+}
+
+// save writes the cache file; the caller holds the mutex
+func (c *Cache) save() error {
+	data, err := json.Marshal(c.entries)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(c.Path, data, 0644)
+}
@@ -0,0 +1,173 @@
+package search
+
+import (
+	"context"
+	"embed"
+	"io/fs"
+	"math"
+	"path"
+	"strings"
+	"unicode"
+)
+
+// corpusExcerptWords is how much of a matching paragraph is kept
+const corpusExcerptWords = 120
+
+// corpusConfidence is how far a local corpus is trusted: chosen with care,
+// but small and never updated
+const corpusConfidence = 0.5
+
+// corpusStopWords carry no topic of their own
+var corpusStopWords = map[string]bool{
+	"the": true, "and": true, "for": true, "with": true, "what": true, "how": true,
+	"are": true, "its": true, "about": true, "from": true, "that": true, "this": true,
+}
+
+//go:embed corpus/*.md
+var bundledCorpus embed.FS
+
+// Corpus answers queries from local text and Markdown files with the
+// paragraph sharing the most of the query's words, rarer words counting
+// for more, so searching needs no network. A Markdown heading titles the
+// paragraphs under it, and its words match them too.
+type Corpus struct {
+	paragraphs []corpusParagraph
+	// frequency counts the paragraphs each word appears in
+	frequency map[string]int
+}
+
+// corpusParagraph is one paragraph of a corpus and its words
+type corpusParagraph struct {
+	title string
+	text  string
+	words map[string]bool
+}
+
+// NewCorpus reads every .txt and .md file of fsys, e.g. os.DirFS(dir)
+func NewCorpus(fsys fs.FS) (*Corpus, error) {
+	c := &Corpus{frequency: make(map[string]int)}
+	err := fs.WalkDir(fsys, ".", func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() || (path.Ext(name) != ".txt" && path.Ext(name) != ".md") {
+			return nil
+		}
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		c.add(string(data))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// NewBundledCorpus searches the short encyclopedic corpus shipped with the
+// package, covering the default cycle contexts
+func NewBundledCorpus() *Corpus {
+	sub, _ := fs.Sub(bundledCorpus, "corpus")
+	c, err := NewCorpus(sub)
+	if err != nil {
+		// The files are embedded, so they can always be read
+		panic(err)
+	}
+	return c
+}
+
+// Paragraphs is how many paragraphs the corpus holds
+func (c *Corpus) Paragraphs() int {
+	return len(c.paragraphs)
+}
+
+// add splits a file into paragraphs at blank lines
+func (c *Corpus) add(text string) {
+	title := ""
+	var lines []string
+	flush := func() {
+		if len(lines) == 0 {
+			return
+		}
+		paragraph := corpusParagraph{title: title, text: strings.Join(lines, " "), words: make(map[string]bool)}
+		for _, word := range corpusWords(title + " " + paragraph.text) {
+			paragraph.words[word] = true
+		}
+		for word := range paragraph.words {
+			c.frequency[word]++
+		}
+		c.paragraphs = append(c.paragraphs, paragraph)
+		lines = nil
+	}
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "":
+			flush()
+		case strings.HasPrefix(line, "#"):
+			flush()
+			title = strings.TrimSpace(strings.TrimLeft(line, "#"))
+		default:
+			lines = append(lines, line)
+		}
+	}
+	flush()
+}
+
+// Search implements Provider
+func (c *Corpus) Search(ctx context.Context, query string) (string, error) {
+	result, err := c.SearchScored(ctx, query)
+	return result.Text, err
+}
+
+// SearchScored answers with the best matching paragraph that shares at
+// least half of the query's words, or nothing
+func (c *Corpus) SearchScored(ctx context.Context, query string) (Result, error) {
+	if err := ctx.Err(); err != nil {
+		return Result{}, err
+	}
+	words := make(map[string]bool)
+	for _, word := range corpusWords(query) {
+		words[word] = true
+	}
+	needed := (len(words) + 1) / 2
+
+	best, bestScore := -1, 0.0
+	for i, paragraph := range c.paragraphs {
+		matched, score := 0, 0.0
+		for word := range words {
+			if paragraph.words[word] {
+				matched++
+				score += math.Log(float64(len(c.paragraphs)+1) / float64(c.frequency[word]))
+			}
+		}
+		if matched > 0 && matched >= needed && score > bestScore {
+			best, bestScore = i, score
+		}
+	}
+	if best < 0 {
+		return Result{}, nil
+	}
+
+	paragraph := c.paragraphs[best]
+	text := excerpt(paragraph.text, corpusExcerptWords)
+	if paragraph.title != "" {
+		text = paragraph.title + ": " + text
+	}
+	return Result{Text: text, Confidence: corpusConfidence}, nil
+}
+
+// corpusWords lists the lower-cased words of text that can carry a topic
+func corpusWords(text string) []string {
+	var words []string
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if len(word) >= 3 && !corpusStopWords[word] {
+			words = append(words, word)
+		}
+	}
+	return words
+}
@@ -0,0 +1,63 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DuckDuckGo searches the DuckDuckGo Instant Answer API
+type DuckDuckGo struct {
+	Client *http.Client
+}
+
+// NewDuckDuckGo creates a DuckDuckGo provider with a sensible timeout
+func NewDuckDuckGo() *DuckDuckGo {
+	return &DuckDuckGo{Client: &http.Client{Timeout: 30 * time.Second}}
+}
+
+// Search returns the abstract and definition of the instant answer for query
+func (d *DuckDuckGo) Search(ctx context.Context, query string) (string, error) {
+	searchURL := fmt.Sprintf("https://api.duckduckgo.com/?q=%s&format=json&no_html=1&skip_disambig=1", url.QueryEscape(query))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, searchURL, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := d.Client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return "", err
+	}
+
+	var info strings.Builder
+
+	if abstract, ok := result["Abstract"].(string); ok && abstract != "" {
+		info.WriteString(abstract)
+	}
+
+	if definition, ok := result["Definition"].(string); ok && definition != "" {
+		if info.Len() > 0 {
+			info.WriteString(" | ")
+		}
+		info.WriteString(definition)
+	}
+
+	return info.String(), nil
+}
@@ -0,0 +1,150 @@
+// Package search defines where the consciousness looks things up.
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Provider answers a search query with a short piece of text. An empty
+// result with a nil error means the provider found nothing.
+type Provider interface {
+	Search(ctx context.Context, query string) (string, error)
+}
+
+// Result is an answer together with how far it can be trusted
+type Result struct {
+	Text string
+	// Confidence runs from 0 to 1; zero means the provider cannot tell
+	Confidence float64
+	// Attempts lists the named providers asked, in order, when the answer
+	// came from a Chain
+	Attempts []Attempt
+}
+
+// Attempt is how one provider of a chain fared with a query
+type Attempt struct {
+	Provider string
+	Found    bool
+	Err      error
+}
+
+// Named is a provider with the name it was registered under
+type Named struct {
+	Name string
+	Provider
+}
+
+// Scorer is a provider that can also say how far its answers can be trusted,
+// e.g. from votes or citations
+type Scorer interface {
+	Provider
+	SearchScored(ctx context.Context, query string) (Result, error)
+}
+
+// Scored searches p, using its confidence if it is a Scorer
+func Scored(ctx context.Context, p Provider, query string) (Result, error) {
+	if scorer, ok := p.(Scorer); ok {
+		return scorer.SearchScored(ctx, query)
+	}
+	text, err := p.Search(ctx, query)
+	return Result{Text: text}, err
+}
+
+// SearchScored implements Scorer, passing on the provider's confidence
+func (n Named) SearchScored(ctx context.Context, query string) (Result, error) {
+	return Scored(ctx, n.Provider, query)
+}
+
+// Chain asks its providers in order and answers with the first that finds
+// something. It fails only when every provider failed.
+type Chain []Provider
+
+// Search implements Provider
+func (c Chain) Search(ctx context.Context, query string) (string, error) {
+	result, err := c.SearchScored(ctx, query)
+	return result.Text, err
+}
+
+// SearchScored implements Scorer, recording how each named provider fared
+func (c Chain) SearchScored(ctx context.Context, query string) (Result, error) {
+	var errs []error
+	var attempts []Attempt
+	for _, p := range c {
+		result, err := Scored(ctx, p, query)
+		if named, ok := p.(Named); ok {
+			attempts = append(attempts, Attempt{Provider: named.Name, Found: err == nil && result.Text != "", Err: err})
+		}
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if result.Text != "" {
+			result.Attempts = attempts
+			return result, nil
+		}
+	}
+	if len(errs) == len(c) && len(errs) > 0 {
+		return Result{Attempts: attempts}, errors.Join(errs...)
+	}
+	return Result{Attempts: attempts}, nil
+}
+
+var (
+	providers      = make(map[string]func() Provider)
+	providersMutex sync.RWMutex
+)
+
+func init() {
+	Register("duckduckgo", func() Provider { return NewDuckDuckGo() })
+	Register("stackexchange", func() Provider { return NewStackExchange() })
+	Register("semantic-scholar", func() Provider { return NewSemanticScholar() })
+	Register("wikipedia", func() Provider { return NewWikipedia() })
+	Register("corpus", func() Provider { return NewBundledCorpus() })
+}
+
+// Register makes a provider available by name, replacing any provider
+// already registered under that name
+func Register(name string, factory func() Provider) {
+	providersMutex.Lock()
+	defer providersMutex.Unlock()
+	providers[name] = factory
+}
+
+// Names lists every registered provider
+func Names() []string {
+	providersMutex.RLock()
+	defer providersMutex.RUnlock()
+	return sortedNames()
+}
+
+// Lookup creates the named providers, chained in order
+func Lookup(names []string) (Chain, error) {
+	providersMutex.RLock()
+	defer providersMutex.RUnlock()
+
+	chain := make(Chain, 0, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		factory, ok := providers[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown search provider %q (have %s)", name, strings.Join(sortedNames(), ", "))
+		}
+		chain = append(chain, Named{Name: name, Provider: factory()})
+	}
+	return chain, nil
+}
+
+// sortedNames lists provider names; the caller holds providersMutex
+func sortedNames() []string {
+	names := make([]string, 0, len(providers))
+	for name := range providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,59 @@
+// Package searchtest provides a deterministic, offline search provider for tests.
+package searchtest
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// Fake answers queries from a fixed table and records every query it receives
+type Fake struct {
+	// Results maps a query, or any substring of it, to the answer
+	Results map[string]string
+	// Default is returned when no result matches
+	Default string
+	// Err, when set, fails every search
+	Err error
+
+	mutex   sync.Mutex
+	queries []string
+}
+
+// New creates a fake provider answering from results
+func New(results map[string]string) *Fake {
+	return &Fake{Results: results}
+}
+
+// Search implements search.Provider
+func (f *Fake) Search(ctx context.Context, query string) (string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.queries = append(f.queries, query)
+	if f.Err != nil {
+		return "", f.Err
+	}
+	if result, ok := f.Results[query]; ok {
+		return result, nil
+	}
+
+	// Fall back to the longest matching key so overlapping keys answer deterministically
+	best := ""
+	for key := range f.Results {
+		if strings.Contains(query, key) && (len(key) > len(best) || (len(key) == len(best) && key < best)) {
+			best = key
+		}
+	}
+	if best != "" {
+		return f.Results[best], nil
+	}
+	return f.Default, nil
+}
+
+// Queries returns every query received so far
+func (f *Fake) Queries() []string {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return append([]string(nil), f.queries...)
+}
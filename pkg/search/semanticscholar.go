@@ -0,0 +1,92 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// semanticScholarExcerptWords is how much of an abstract is kept
+const semanticScholarExcerptWords = 80
+
+// SemanticScholar searches the Semantic Scholar academic graph, answering
+// with the most relevant paper's abstract
+type SemanticScholar struct {
+	Client *http.Client
+	// Key is an optional API key, raising the rate limit
+	Key string
+}
+
+// NewSemanticScholar creates a Semantic Scholar provider with a sensible timeout
+func NewSemanticScholar() *SemanticScholar {
+	return &SemanticScholar{Client: &http.Client{Timeout: 30 * time.Second}}
+}
+
+// Search implements Provider
+func (s *SemanticScholar) Search(ctx context.Context, query string) (string, error) {
+	result, err := s.SearchScored(ctx, query)
+	return result.Text, err
+}
+
+// SearchScored answers with the most relevant paper that has an abstract.
+// Confidence grows with the paper's citation count.
+func (s *SemanticScholar) SearchScored(ctx context.Context, query string) (Result, error) {
+	params := url.Values{
+		"query":  {query},
+		"limit":  {"5"},
+		"fields": {"title,abstract,year,citationCount"},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.semanticscholar.org/graph/v1/paper/search?"+params.Encode(), nil)
+	if err != nil {
+		return Result{}, err
+	}
+	if s.Key != "" {
+		req.Header.Set("x-api-key", s.Key)
+	}
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return Result{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var apiErr struct {
+			Message string `json:"message"`
+		}
+		json.NewDecoder(resp.Body).Decode(&apiErr)
+		return Result{}, fmt.Errorf("semantic scholar answered %d: %s", resp.StatusCode, apiErr.Message)
+	}
+
+	var papers struct {
+		Data []struct {
+			Title         string `json:"title"`
+			Abstract      string `json:"abstract"`
+			Year          int    `json:"year"`
+			CitationCount int    `json:"citationCount"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&papers); err != nil {
+		return Result{}, err
+	}
+	for _, paper := range papers.Data {
+		if paper.Abstract == "" {
+			continue
+		}
+		text := fmt.Sprintf("%s (%d, %d citations): %s", paper.Title, paper.Year, paper.CitationCount, excerpt(paper.Abstract, semanticScholarExcerptWords))
+		return Result{Text: text, Confidence: citationConfidence(paper.CitationCount)}, nil
+	}
+	return Result{}, nil
+}
+
+// citationConfidence maps a citation count to a confidence on a log scale,
+// 10,000 citations or more being certain
+func citationConfidence(citations int) float64 {
+	if citations <= 0 {
+		return 0.05
+	}
+	return math.Min(1, math.Log10(float64(citations+1))/4)
+}
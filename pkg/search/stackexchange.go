@@ -0,0 +1,136 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// stackExchangeExcerptWords is how much of an answer is kept
+const stackExchangeExcerptWords = 60
+
+// StackExchange searches a StackExchange site for answered questions,
+// answering with the question and an excerpt of its accepted answer
+type StackExchange struct {
+	Client *http.Client
+	// Site is the StackExchange site to search, e.g. "stackoverflow"
+	Site string
+	// Key is an optional API key, raising the daily quota
+	Key string
+}
+
+// NewStackExchange creates a StackExchange provider searching Stack Overflow
+func NewStackExchange() *StackExchange {
+	return &StackExchange{Client: &http.Client{Timeout: 30 * time.Second}, Site: "stackoverflow"}
+}
+
+// Search implements Provider
+func (s *StackExchange) Search(ctx context.Context, query string) (string, error) {
+	result, err := s.SearchScored(ctx, query)
+	return result.Text, err
+}
+
+// SearchScored answers with the most relevant question that has an accepted
+// answer. Confidence grows with the answer's score.
+func (s *StackExchange) SearchScored(ctx context.Context, query string) (Result, error) {
+	params := s.params()
+	params.Set("q", query)
+	params.Set("accepted", "True")
+	params.Set("order", "desc")
+	params.Set("sort", "relevance")
+	params.Set("pagesize", "1")
+
+	var questions struct {
+		Items []struct {
+			Title            string `json:"title"`
+			AcceptedAnswerID int    `json:"accepted_answer_id"`
+		} `json:"items"`
+	}
+	if err := s.get(ctx, "/search/advanced", params, &questions); err != nil {
+		return Result{}, err
+	}
+	if len(questions.Items) == 0 || questions.Items[0].AcceptedAnswerID == 0 {
+		return Result{}, nil
+	}
+	question := questions.Items[0]
+
+	params = s.params()
+	params.Set("filter", "withbody")
+	var answers struct {
+		Items []struct {
+			Body  string `json:"body"`
+			Score int    `json:"score"`
+		} `json:"items"`
+	}
+	if err := s.get(ctx, fmt.Sprintf("/answers/%d", question.AcceptedAnswerID), params, &answers); err != nil {
+		return Result{}, err
+	}
+	if len(answers.Items) == 0 {
+		return Result{}, nil
+	}
+	answer := answers.Items[0]
+
+	text := fmt.Sprintf("Q: %s A: %s", html.UnescapeString(question.Title), excerpt(stripHTML(answer.Body), stackExchangeExcerptWords))
+	return Result{Text: text, Confidence: voteConfidence(answer.Score)}, nil
+}
+
+// params holds the parameters every request carries
+func (s *StackExchange) params() url.Values {
+	params := url.Values{"site": {s.Site}}
+	if s.Key != "" {
+		params.Set("key", s.Key)
+	}
+	return params
+}
+
+// get calls the StackExchange API and decodes its answer
+func (s *StackExchange) get(ctx context.Context, path string, params url.Values, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.stackexchange.com/2.3"+path+"?"+params.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var apiErr struct {
+			Message string `json:"error_message"`
+		}
+		json.NewDecoder(resp.Body).Decode(&apiErr)
+		return fmt.Errorf("stackexchange answered %d: %s", resp.StatusCode, apiErr.Message)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// voteConfidence maps an answer's score to a confidence, 10 votes giving a half
+func voteConfidence(score int) float64 {
+	if score <= 0 {
+		return 0.05
+	}
+	return float64(score) / float64(score+10)
+}
+
+var htmlTag = regexp.MustCompile(`<[^>]*>`)
+
+// stripHTML reduces an HTML fragment to its text
+func stripHTML(fragment string) string {
+	return html.UnescapeString(htmlTag.ReplaceAllString(fragment, " "))
+}
+
+// excerpt keeps the first words of text
+func excerpt(text string, words int) string {
+	fields := strings.Fields(text)
+	if len(fields) > words {
+		return strings.Join(fields[:words], " ") + "..."
+	}
+	return strings.Join(fields, " ")
+}
@@ -0,0 +1,134 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// wikipediaExcerptWords is how much of an article's introduction is kept
+const wikipediaExcerptWords = 120
+
+// wikipediaShortSummaryWords is the summary length below which the
+// article's fuller introduction is fetched as well
+const wikipediaShortSummaryWords = 40
+
+// wikipediaConfidence is how far an encyclopedic summary is trusted:
+// reviewed by many, but editable by anyone
+const wikipediaConfidence = 0.7
+
+// Wikipedia looks queries up in Wikipedia, answering with the summary of
+// the most relevant article, and its fuller introduction when the summary
+// is short
+type Wikipedia struct {
+	Client *http.Client
+	// Language is the Wikipedia edition to search, e.g. "en"
+	Language string
+}
+
+// NewWikipedia creates a provider searching English Wikipedia with a sensible timeout
+func NewWikipedia() *Wikipedia {
+	return &Wikipedia{Client: &http.Client{Timeout: 30 * time.Second}, Language: "en"}
+}
+
+// Search implements Provider
+func (w *Wikipedia) Search(ctx context.Context, query string) (string, error) {
+	result, err := w.SearchScored(ctx, query)
+	return result.Text, err
+}
+
+// SearchScored answers with the summary of the most relevant article that
+// is not a disambiguation page
+func (w *Wikipedia) SearchScored(ctx context.Context, query string) (Result, error) {
+	var pages struct {
+		Pages []struct {
+			Key   string `json:"key"`
+			Title string `json:"title"`
+		} `json:"pages"`
+	}
+	params := url.Values{"q": {query}, "limit": {"3"}}
+	if err := w.get(ctx, "/w/rest.php/v1/search/page?"+params.Encode(), &pages); err != nil {
+		return Result{}, err
+	}
+
+	for _, page := range pages.Pages {
+		var summary struct {
+			Type        string `json:"type"`
+			Title       string `json:"title"`
+			Description string `json:"description"`
+			Extract     string `json:"extract"`
+		}
+		if err := w.get(ctx, "/api/rest_v1/page/summary/"+url.PathEscape(page.Key), &summary); err != nil {
+			return Result{}, err
+		}
+		if summary.Type == "disambiguation" || summary.Extract == "" {
+			continue
+		}
+
+		extract := summary.Extract
+		if len(strings.Fields(extract)) < wikipediaShortSummaryWords {
+			if intro, err := w.introduction(ctx, page.Title); err == nil && len(intro) > len(extract) {
+				extract = intro
+			}
+		}
+		title := summary.Title
+		if summary.Description != "" {
+			title += " (" + summary.Description + ")"
+		}
+		text := fmt.Sprintf("%s: %s", title, excerpt(extract, wikipediaExcerptWords))
+		return Result{Text: text, Confidence: wikipediaConfidence}, nil
+	}
+	return Result{}, nil
+}
+
+// introduction fetches the plain text of an article's introduction through
+// the TextExtracts API
+func (w *Wikipedia) introduction(ctx context.Context, title string) (string, error) {
+	params := url.Values{
+		"action":      {"query"},
+		"prop":        {"extracts"},
+		"exintro":     {"1"},
+		"explaintext": {"1"},
+		"redirects":   {"1"},
+		"titles":      {title},
+		"format":      {"json"},
+	}
+	var extracts struct {
+		Query struct {
+			Pages map[string]struct {
+				Extract string `json:"extract"`
+			} `json:"pages"`
+		} `json:"query"`
+	}
+	if err := w.get(ctx, "/w/api.php?"+params.Encode(), &extracts); err != nil {
+		return "", err
+	}
+	for _, page := range extracts.Query.Pages {
+		return strings.TrimSpace(page.Extract), nil
+	}
+	return "", nil
+}
+
+// get calls the Wikipedia edition's API and decodes its answer
+func (w *Wikipedia) get(ctx context.Context, path string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://"+w.Language+".wikipedia.org"+path, nil)
+	if err != nil {
+		return err
+	}
+	// Wikimedia asks every client to identify itself
+	req.Header.Set("User-Agent", "QuantumConsciousness (search provider)")
+
+	resp, err := w.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("wikipedia answered %s", resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
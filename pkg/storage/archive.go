@@ -0,0 +1,136 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// Archive is an append-only store of compressed segments holding what
+// memory no longer keeps, so that it can still be read back
+type Archive interface {
+	Append(segment []byte) error
+	Segments() ([][]byte, error)
+}
+
+// ArchiveRewriter is implemented by archives that can replace every
+// segment they hold, such as when what they archived must be erased
+type ArchiveRewriter interface {
+	Rewrite(segments [][]byte) error
+}
+
+// FileArchive keeps each segment as its own gzip member of a file
+type FileArchive struct {
+	Path string
+}
+
+// NewFileArchive creates an archive in the file at path
+func NewFileArchive(path string) *FileArchive {
+	return &FileArchive{Path: path}
+}
+
+// Append compresses a segment onto the end of the file and syncs it to disk
+func (a *FileArchive) Append(segment []byte) error {
+	compressed, err := compress(segment)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(a.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(compressed); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// Rewrite replaces the archive with segments, writing them to a temporary
+// file renamed over the archive, so a crash leaves one or the other whole
+func (a *FileArchive) Rewrite(segments [][]byte) error {
+	file, err := os.CreateTemp(filepath.Dir(a.Path), filepath.Base(a.Path)+".rewrite-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	for _, segment := range segments {
+		compressed, err := compress(segment)
+		if err == nil {
+			_, err = file.Write(compressed)
+		}
+		if err != nil {
+			file.Close()
+			return err
+		}
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(file.Name(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), a.Path)
+}
+
+// compress makes a segment its own gzip member
+func compress(segment []byte) ([]byte, error) {
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	if _, err := writer.Write(segment); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return compressed.Bytes(), nil
+}
+
+// Segments decompresses every segment, oldest first. A missing archive has
+// none.
+func (a *FileArchive) Segments() ([][]byte, error) {
+	file, err := os.Open(a.Path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	buffered := bufio.NewReader(file)
+	reader, err := gzip.NewReader(buffered)
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var segments [][]byte
+	for {
+		reader.Multistream(false)
+		segment, err := io.ReadAll(reader)
+		if err != nil {
+			return segments, err
+		}
+		segments = append(segments, segment)
+		if err := reader.Reset(buffered); errors.Is(err, io.EOF) {
+			return segments, nil
+		} else if err != nil {
+			return segments, err
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"path/filepath"
+)
+
+// Journal is an append-only record of changes, one entry per line, that
+// memory can be rebuilt from
+type Journal interface {
+	Append(entry []byte) error
+	Entries() ([][]byte, error)
+}
+
+// JournalCompacter is implemented by journals that can replace every entry
+// they hold with a single one, such as when what they recorded must be
+// erased
+type JournalCompacter interface {
+	Compact(entry []byte) error
+}
+
+// FileJournal keeps journal entries as lines of a file
+type FileJournal struct {
+	Path string
+}
+
+// NewFileJournal creates a journal in the file at path
+func NewFileJournal(path string) *FileJournal {
+	return &FileJournal{Path: path}
+}
+
+// Append writes an entry and syncs it to disk before returning, so that
+// whatever is saved after it can be rebuilt
+func (j *FileJournal) Append(entry []byte) error {
+	file, err := os.OpenFile(j.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	line := append(bytes.TrimRight(entry, "\n"), '\n')
+	if _, err := file.Write(line); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// Entries reads every entry, oldest first. A missing journal has none.
+func (j *FileJournal) Entries() ([][]byte, error) {
+	file, err := os.Open(j.Path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var entries [][]byte
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64<<10), 256<<20)
+	for scanner.Scan() {
+		if line := bytes.TrimSpace(scanner.Bytes()); len(line) > 0 {
+			entries = append(entries, append([]byte(nil), line...))
+		}
+	}
+	return entries, scanner.Err()
+}
+
+// Compact replaces the journal with entry alone, writing it to a temporary
+// file renamed over the journal, so a crash leaves one or the other whole
+func (j *FileJournal) Compact(entry []byte) error {
+	file, err := os.CreateTemp(filepath.Dir(j.Path), filepath.Base(j.Path)+".compact-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	line := append(bytes.TrimRight(entry, "\n"), '\n')
+	if _, err := file.Write(line); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(file.Name(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), j.Path)
+}
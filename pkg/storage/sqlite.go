@@ -0,0 +1,234 @@
+package storage
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// SQLiteDrivers are the database/sql driver names OpenSQLite looks for, as
+// registered by github.com/mattn/go-sqlite3 and modernc.org/sqlite
+var SQLiteDrivers = []string{"sqlite3", "sqlite"}
+
+// sqliteSection is a list in the memory document kept as rows of its own table
+type sqliteSection struct {
+	key, table string
+	// text sections hold strings, kept as plain text rather than JSON
+	text bool
+}
+
+// sqliteSections are the lists that grow every cycle
+var sqliteSections = []sqliteSection{
+	{key: "collapsed_states", table: "collapsed_states"},
+	{key: "knowledge_base", table: "knowledge", text: true},
+	{key: "parallel_realities", table: "parallel_realities"},
+}
+
+// SQLite stores memory in a SQLite database. Collapsed states, knowledge
+// and parallel realities are rows of their own tables, one per item in
+// order of position, so they can be queried, and a save only writes the
+// rows that changed; the rest of the document is a single row of the
+// memory table.
+type SQLite struct {
+	DB *sql.DB
+
+	mutex sync.Mutex
+	// saved are the rows as last loaded or saved, by table
+	saved map[string][]string
+}
+
+// OpenSQLite opens the database at path with whichever of SQLiteDrivers the
+// program has registered
+func OpenSQLite(path string) (*SQLite, error) {
+	driver := ""
+	for _, name := range sql.Drivers() {
+		if slices.Contains(SQLiteDrivers, name) {
+			driver = name
+			break
+		}
+	}
+	if driver == "" {
+		return nil, fmt.Errorf("no SQLite driver is registered; build with -tags sqlite, or with another driver registering itself as %s", strings.Join(SQLiteDrivers, " or "))
+	}
+	db, err := sql.Open(driver, path)
+	if err != nil {
+		return nil, err
+	}
+	s, err := NewSQLite(db)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// NewSQLite keeps memory in db, creating its tables if they do not exist
+func NewSQLite(db *sql.DB) (*SQLite, error) {
+	statements := []string{`CREATE TABLE IF NOT EXISTS memory (id INTEGER PRIMARY KEY CHECK (id = 1), document TEXT NOT NULL)`}
+	for _, section := range sqliteSections {
+		statements = append(statements, fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (position INTEGER PRIMARY KEY, value TEXT NOT NULL)`, section.table))
+	}
+	for _, statement := range statements {
+		if _, err := db.Exec(statement); err != nil {
+			return nil, err
+		}
+	}
+	return &SQLite{DB: db}, nil
+}
+
+// Load reassembles the memory document from its tables
+func (s *SQLite) Load() ([]byte, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var rest []byte
+	err := s.DB.QueryRow(`SELECT document FROM memory WHERE id = 1`).Scan(&rest)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: no memory in the database", ErrNotFound)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var document map[string]json.RawMessage
+	if err := json.Unmarshal(rest, &document); err != nil {
+		return nil, fmt.Errorf("stored memory is damaged: %w", err)
+	}
+
+	saved := make(map[string][]string)
+	for _, section := range sqliteSections {
+		rows, err := s.rows(section.table)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]json.RawMessage, len(rows))
+		for i, row := range rows {
+			items[i] = json.RawMessage(row)
+			if section.text {
+				if items[i], err = json.Marshal(row); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if document[section.key], err = json.Marshal(items); err != nil {
+			return nil, err
+		}
+		saved[section.table] = rows
+	}
+	s.saved = saved
+	return json.Marshal(document)
+}
+
+// Save writes the rows that changed since the last load or save, and the
+// rest of the document, in one transaction
+func (s *SQLite) Save(data []byte) error {
+	var document map[string]json.RawMessage
+	if err := json.Unmarshal(data, &document); err != nil {
+		return fmt.Errorf("memory is not a JSON document: %w", err)
+	}
+	tables := make(map[string][]string)
+	for _, section := range sqliteSections {
+		var items []json.RawMessage
+		if raw, ok := document[section.key]; ok {
+			if err := json.Unmarshal(raw, &items); err != nil {
+				return fmt.Errorf("memory section %s is not a list: %w", section.key, err)
+			}
+		}
+		rows := make([]string, len(items))
+		for i, item := range items {
+			rows[i] = string(item)
+			if section.text {
+				if err := json.Unmarshal(item, &rows[i]); err != nil {
+					return fmt.Errorf("memory section %s holds something other than text: %w", section.key, err)
+				}
+			}
+		}
+		tables[section.table] = rows
+		delete(document, section.key)
+	}
+	rest, err := json.Marshal(document)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.saved == nil {
+		saved := make(map[string][]string)
+		for _, section := range sqliteSections {
+			if saved[section.table], err = s.rows(section.table); err != nil {
+				return err
+			}
+		}
+		s.saved = saved
+	}
+
+	tx, err := s.DB.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`INSERT OR REPLACE INTO memory (id, document) VALUES (1, ?)`, string(rest)); err != nil {
+		tx.Rollback()
+		return err
+	}
+	for _, section := range sqliteSections {
+		if err := syncRows(tx, section.table, s.saved[section.table], tables[section.table]); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		// What the database holds is unknown, so the next save compares against it afresh
+		s.saved = nil
+		return err
+	}
+	s.saved = tables
+	return nil
+}
+
+// Close closes the database
+func (s *SQLite) Close() error {
+	return s.DB.Close()
+}
+
+// rows reads a table's values in order of position
+func (s *SQLite) rows(table string) ([]string, error) {
+	result, err := s.DB.Query(fmt.Sprintf(`SELECT value FROM %s ORDER BY position`, table))
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+
+	var rows []string
+	for result.Next() {
+		var value string
+		if err := result.Scan(&value); err != nil {
+			return nil, err
+		}
+		rows = append(rows, value)
+	}
+	return rows, result.Err()
+}
+
+// syncRows turns a table holding saved into one holding rows, writing only
+// the positions whose value changed. Items pruned from the front of a list
+// shift every later position, so pruning rewrites the table.
+func syncRows(tx *sql.Tx, table string, saved, rows []string) error {
+	for i, value := range rows {
+		if i < len(saved) && saved[i] == value {
+			continue
+		}
+		if _, err := tx.Exec(fmt.Sprintf(`INSERT OR REPLACE INTO %s (position, value) VALUES (?, ?)`, table), i, value); err != nil {
+			return err
+		}
+	}
+	if len(rows) < len(saved) {
+		if _, err := tx.Exec(fmt.Sprintf(`DELETE FROM %s WHERE position >= ?`, table), len(rows)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
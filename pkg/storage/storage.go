@@ -0,0 +1,134 @@
+// Package storage defines where the consciousness keeps its memory document.
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// ErrNotFound is returned by Load when no memory has been stored yet
+var ErrNotFound = errors.New("memory not found")
+
+// MaxDocumentBytes is the largest memory document the consciousness loads.
+// Stores reading from a file or the network stop one byte past it, so an
+// oversized document is rejected without being read in full.
+const MaxDocumentBytes = 256 << 20
+
+// Store persists the serialized quantum memory
+type Store interface {
+	Load() ([]byte, error)
+	Save(data []byte) error
+}
+
+// Quarantiner is implemented by stores that can set a rejected document
+// aside, returning where it went, before it is overwritten
+type Quarantiner interface {
+	Quarantine(data []byte) (string, error)
+}
+
+// Vacuumer is implemented by stores that can reclaim space left behind by
+// earlier saves, returning how many things they removed
+type Vacuumer interface {
+	Vacuum() (int, error)
+}
+
+// QuarantineRetention is how long File keeps quarantined documents before
+// Vacuum removes them
+const QuarantineRetention = 30 * 24 * time.Hour
+
+// File stores memory in a single file on disk
+type File struct {
+	Path string
+}
+
+// NewFile creates a file store at path
+func NewFile(path string) *File {
+	return &File{Path: path}
+}
+
+// Load reads the memory file, stopping past MaxDocumentBytes
+func (f *File) Load() ([]byte, error) {
+	file, err := os.Open(f.Path)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, f.Path)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return io.ReadAll(io.LimitReader(file, MaxDocumentBytes+1))
+}
+
+// Save overwrites the memory file
+func (f *File) Save(data []byte) error {
+	return os.WriteFile(f.Path, data, 0644)
+}
+
+// Quarantine copies a rejected document next to the memory file
+func (f *File) Quarantine(data []byte) (string, error) {
+	path := fmt.Sprintf("%s.corrupt-%s", f.Path, time.Now().UTC().Format("20060102T150405Z"))
+	return path, os.WriteFile(path, data, 0644)
+}
+
+// Vacuum removes quarantined copies of the memory file older than
+// QuarantineRetention
+func (f *File) Vacuum() (int, error) {
+	copies, err := filepath.Glob(f.Path + ".corrupt-*")
+	if err != nil {
+		return 0, err
+	}
+	removed := 0
+	for _, path := range copies {
+		info, err := os.Stat(path)
+		if err != nil || time.Since(info.ModTime()) < QuarantineRetention {
+			continue
+		}
+		if err := os.Remove(path); err != nil {
+			return removed, err
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// ErrReadOnly is returned by Save on a read-only store
+var ErrReadOnly = errors.New("store is read-only")
+
+// readOnly loads from a store but never writes to it
+type readOnly struct {
+	Store
+}
+
+// ReadOnly wraps a store so that every Save fails with ErrReadOnly
+func ReadOnly(store Store) Store {
+	return readOnly{store}
+}
+
+// Save refuses to write
+func (readOnly) Save(data []byte) error {
+	return ErrReadOnly
+}
+
+// discard loads from a store but drops what is saved
+type discard struct {
+	Store
+	saved func(data []byte)
+}
+
+// Discard wraps a store so that every Save succeeds without writing
+// anything; saved, when not nil, is given each document that was dropped
+func Discard(store Store, saved func(data []byte)) Store {
+	return discard{Store: store, saved: saved}
+}
+
+// Save drops data
+func (d discard) Save(data []byte) error {
+	if d.saved != nil {
+		d.saved(data)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+// Package storagetest provides an in-memory store for tests.
+package storagetest
+
+import (
+	"fmt"
+	"sync"
+
+	"QuantumConsciousness/pkg/storage"
+)
+
+// Memory keeps the memory document in process and counts saves
+type Memory struct {
+	mutex       sync.Mutex
+	data        []byte
+	saves       int
+	quarantined [][]byte
+}
+
+// New creates an empty in-memory store
+func New() *Memory {
+	return &Memory{}
+}
+
+// NewWithData creates an in-memory store preloaded with a memory document
+func NewWithData(data []byte) *Memory {
+	return &Memory{data: append([]byte(nil), data...)}
+}
+
+// Load implements storage.Store
+func (m *Memory) Load() ([]byte, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.data == nil {
+		return nil, storage.ErrNotFound
+	}
+	return append([]byte(nil), m.data...), nil
+}
+
+// Save implements storage.Store
+func (m *Memory) Save(data []byte) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.data = append([]byte(nil), data...)
+	m.saves++
+	return nil
+}
+
+// Data returns the last saved document
+func (m *Memory) Data() []byte {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return append([]byte(nil), m.data...)
+}
+
+// Saves returns how many times the document was saved
+func (m *Memory) Saves() int {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.saves
+}
+
+// Quarantine implements storage.Quarantiner by keeping the rejected document
+func (m *Memory) Quarantine(data []byte) (string, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.quarantined = append(m.quarantined, append([]byte(nil), data...))
+	return fmt.Sprintf("quarantine #%d", len(m.quarantined)), nil
+}
+
+// Quarantined returns every document set aside so far
+func (m *Memory) Quarantined() [][]byte {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return append([][]byte(nil), m.quarantined...)
+}
+
+// Journal keeps journal entries in process
+type Journal struct {
+	mutex   sync.Mutex
+	entries [][]byte
+}
+
+// NewJournal creates an empty in-memory journal
+func NewJournal() *Journal {
+	return &Journal{}
+}
+
+// Append implements storage.Journal
+func (j *Journal) Append(entry []byte) error {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	j.entries = append(j.entries, append([]byte(nil), entry...))
+	return nil
+}
+
+// Compact implements storage.JournalCompacter
+func (j *Journal) Compact(entry []byte) error {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	j.entries = [][]byte{append([]byte(nil), entry...)}
+	return nil
+}
+
+// Entries implements storage.Journal
+func (j *Journal) Entries() ([][]byte, error) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	entries := make([][]byte, len(j.entries))
+	for i, entry := range j.entries {
+		entries[i] = append([]byte(nil), entry...)
+	}
+	return entries, nil
+}
+
+// Archive keeps archived segments in process, uncompressed
+type Archive struct {
+	mutex    sync.Mutex
+	segments [][]byte
+}
+
+// NewArchive creates an empty in-memory archive
+func NewArchive() *Archive {
+	return &Archive{}
+}
+
+// Append implements storage.Archive
+func (a *Archive) Append(segment []byte) error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.segments = append(a.segments, append([]byte(nil), segment...))
+	return nil
+}
+
+// Segments implements storage.Archive
+func (a *Archive) Segments() ([][]byte, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	segments := make([][]byte, len(a.segments))
+	for i, segment := range a.segments {
+		segments[i] = append([]byte(nil), segment...)
+	}
+	return segments, nil
+}
+
+// Rewrite implements storage.ArchiveRewriter
+func (a *Archive) Rewrite(segments [][]byte) error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.segments = make([][]byte, len(segments))
+	for i, segment := range segments {
+		a.segments[i] = append([]byte(nil), segment...)
+	}
+	return nil
+}
@@ -0,0 +1,713 @@
+// Package toml reads as much TOML as configuration files take, with no
+// dependencies: tables, arrays of tables, dotted and quoted keys, inline
+// tables, arrays, strings of every kind, integers, floats, booleans and
+// dates. Documents decode to the generic values encoding/json makes, so that
+// they can be marshaled and read back into structs with json tags.
+package toml
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// table is a table being read, remembering how it came to be so that
+// defining it twice can be told apart from extending it
+type table struct {
+	values map[string]interface{}
+	// defined marks tables given a header, dotted those made by dotted
+	// keys and inline those written in braces, which cannot be extended
+	defined, dotted, inline bool
+}
+
+// tableArray is an array of tables made by [[headers]], which later headers
+// append to, unlike arrays of inline tables
+type tableArray struct {
+	tables []*table
+}
+
+func newTable() *table {
+	return &table{values: make(map[string]interface{})}
+}
+
+// Parse decodes a TOML document into nested maps. Tables decode to
+// map[string]interface{}, arrays to []interface{}, integers to int64, floats
+// to float64, offset date-times to time.Time and local dates and times to
+// their text.
+func Parse(data []byte) (map[string]interface{}, error) {
+	if !utf8.Valid(data) {
+		return nil, fmt.Errorf("toml: document is not UTF-8")
+	}
+	p := &parser{src: strings.TrimPrefix(string(data), "\ufeff")}
+	root := newTable()
+	if err := p.document(root); err != nil {
+		return nil, err
+	}
+	return plain(root).(map[string]interface{}), nil
+}
+
+// parser reads a document one byte at a time
+type parser struct {
+	src string
+	pos int
+}
+
+// errorf reports a syntax error at the line being read
+func (p *parser) errorf(format string, args ...interface{}) error {
+	line := 1 + strings.Count(p.src[:p.pos], "\n")
+	return fmt.Errorf("toml: line %d: %s", line, fmt.Sprintf(format, args...))
+}
+
+func (p *parser) eof() bool { return p.pos >= len(p.src) }
+
+func (p *parser) peek() byte {
+	if p.eof() {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+func (p *parser) consume(prefix string) bool {
+	if strings.HasPrefix(p.src[p.pos:], prefix) {
+		p.pos += len(prefix)
+		return true
+	}
+	return false
+}
+
+// skipSpace skips spaces and tabs
+func (p *parser) skipSpace() {
+	for !p.eof() && (p.src[p.pos] == ' ' || p.src[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+// skipComment skips a comment up to the end of its line
+func (p *parser) skipComment() {
+	if p.peek() != '#' {
+		return
+	}
+	for !p.eof() && p.src[p.pos] != '\n' && !strings.HasPrefix(p.src[p.pos:], "\r\n") {
+		p.pos++
+	}
+}
+
+// newline consumes a line ending
+func (p *parser) newline() bool {
+	return p.consume("\n") || p.consume("\r\n")
+}
+
+// skipBlank skips whitespace, comments and line endings, as between the
+// values of an array
+func (p *parser) skipBlank() {
+	for {
+		p.skipSpace()
+		p.skipComment()
+		if !p.newline() {
+			return
+		}
+	}
+}
+
+// endOfLine expects nothing but a comment before the line ends
+func (p *parser) endOfLine() error {
+	p.skipSpace()
+	p.skipComment()
+	if !p.eof() && !p.newline() {
+		return p.errorf("unexpected %q after a value", p.peek())
+	}
+	return nil
+}
+
+// document reads headers and key/value pairs until the end
+func (p *parser) document(root *table) error {
+	current := root
+	for {
+		p.skipBlank()
+		if p.eof() {
+			return nil
+		}
+		var err error
+		if p.peek() == '[' {
+			current, err = p.header(root)
+		} else {
+			err = p.keyValue(current)
+		}
+		if err != nil {
+			return err
+		}
+		if err := p.endOfLine(); err != nil {
+			return err
+		}
+	}
+}
+
+// header reads a [table] or [[array of tables]] header and returns the
+// table that the following pairs go into
+func (p *parser) header(root *table) (*table, error) {
+	array := p.consume("[[")
+	if !array {
+		p.consume("[")
+	}
+	p.skipSpace()
+	keys, err := p.key()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	closing := "]"
+	if array {
+		closing = "]]"
+	}
+	if !p.consume(closing) {
+		return nil, p.errorf("expected %s after a table name", closing)
+	}
+
+	parent := root
+	for _, key := range keys[:len(keys)-1] {
+		if parent, err = p.descend(parent, key); err != nil {
+			return nil, err
+		}
+	}
+	last := keys[len(keys)-1]
+	existing, ok := parent.values[last]
+	if array {
+		switch existing := existing.(type) {
+		case nil:
+			t := newTable()
+			parent.values[last] = &tableArray{tables: []*table{t}}
+			return t, nil
+		case *tableArray:
+			t := newTable()
+			existing.tables = append(existing.tables, t)
+			return t, nil
+		}
+		return nil, p.errorf("%s is not an array of tables", strings.Join(keys, "."))
+	}
+	if !ok {
+		t := newTable()
+		t.defined = true
+		parent.values[last] = t
+		return t, nil
+	}
+	t, isTable := existing.(*table)
+	if !isTable || t.defined || t.dotted || t.inline {
+		return nil, p.errorf("%s is defined twice", strings.Join(keys, "."))
+	}
+	t.defined = true
+	return t, nil
+}
+
+// descend goes into the table a header passes through, making it when
+// missing; an array of tables is entered at its last table
+func (p *parser) descend(parent *table, key string) (*table, error) {
+	switch existing := parent.values[key].(type) {
+	case nil:
+		t := newTable()
+		parent.values[key] = t
+		return t, nil
+	case *table:
+		if existing.inline {
+			return nil, p.errorf("inline table %s cannot be extended", key)
+		}
+		return existing, nil
+	case *tableArray:
+		return existing.tables[len(existing.tables)-1], nil
+	}
+	return nil, p.errorf("%s is not a table", key)
+}
+
+// keyValue reads key = value into t, making the tables dotted keys name
+func (p *parser) keyValue(t *table) error {
+	keys, err := p.key()
+	if err != nil {
+		return err
+	}
+	p.skipSpace()
+	if !p.consume("=") {
+		return p.errorf("expected = after %s", strings.Join(keys, "."))
+	}
+	p.skipSpace()
+	value, err := p.value()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys[:len(keys)-1] {
+		switch existing := t.values[key].(type) {
+		case nil:
+			sub := newTable()
+			sub.dotted = true
+			t.values[key] = sub
+			t = sub
+		case *table:
+			if existing.inline || existing.defined {
+				return p.errorf("%s cannot be extended with dotted keys", key)
+			}
+			t = existing
+		default:
+			return p.errorf("%s is not a table", key)
+		}
+	}
+	last := keys[len(keys)-1]
+	if _, ok := t.values[last]; ok {
+		return p.errorf("%s is defined twice", strings.Join(keys, "."))
+	}
+	t.values[last] = value
+	return nil
+}
+
+// key reads a possibly dotted key
+func (p *parser) key() ([]string, error) {
+	var keys []string
+	for {
+		p.skipSpace()
+		key, err := p.simpleKey()
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+		p.skipSpace()
+		if !p.consume(".") {
+			return keys, nil
+		}
+	}
+}
+
+// simpleKey reads a bare or quoted key
+func (p *parser) simpleKey() (string, error) {
+	switch p.peek() {
+	case '"':
+		if strings.HasPrefix(p.src[p.pos:], `"""`) {
+			return "", p.errorf("keys cannot be multi-line strings")
+		}
+		return p.basicString()
+	case '\'':
+		if strings.HasPrefix(p.src[p.pos:], "'''") {
+			return "", p.errorf("keys cannot be multi-line strings")
+		}
+		return p.literalString()
+	}
+	start := p.pos
+	for !p.eof() && isBareKeyByte(p.src[p.pos]) {
+		p.pos++
+	}
+	if p.pos == start {
+		if p.eof() {
+			return "", p.errorf("expected a key")
+		}
+		return "", p.errorf("unexpected %q where a key belongs", p.peek())
+	}
+	return p.src[start:p.pos], nil
+}
+
+func isBareKeyByte(c byte) bool {
+	return c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '_' || c == '-'
+}
+
+// value reads any value
+func (p *parser) value() (interface{}, error) {
+	rest := p.src[p.pos:]
+	switch {
+	case strings.HasPrefix(rest, `"""`):
+		return p.multilineBasicString()
+	case strings.HasPrefix(rest, "'''"):
+		return p.multilineLiteralString()
+	case strings.HasPrefix(rest, `"`):
+		return p.basicString()
+	case strings.HasPrefix(rest, "'"):
+		return p.literalString()
+	case strings.HasPrefix(rest, "["):
+		return p.array()
+	case strings.HasPrefix(rest, "{"):
+		return p.inlineTable()
+	}
+
+	start := p.pos
+	for !p.eof() && isScalarByte(p.src[p.pos]) {
+		p.pos++
+	}
+	// A space may part a date from its time
+	if isDate(p.src[start:p.pos]) && len(p.src) > p.pos+3 && p.src[p.pos] == ' ' &&
+		isDigit(p.src[p.pos+1]) && isDigit(p.src[p.pos+2]) && p.src[p.pos+3] == ':' {
+		p.pos++
+		for !p.eof() && isScalarByte(p.src[p.pos]) {
+			p.pos++
+		}
+	}
+	token := p.src[start:p.pos]
+	if token == "" {
+		if p.eof() {
+			return nil, p.errorf("expected a value")
+		}
+		return nil, p.errorf("unexpected %q where a value belongs", p.peek())
+	}
+	switch token {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "inf", "+inf":
+		return math.Inf(1), nil
+	case "-inf":
+		return math.Inf(-1), nil
+	case "nan", "+nan", "-nan":
+		return math.NaN(), nil
+	}
+	if strings.Contains(token, ":") || isDate(token) {
+		return p.dateTime(token)
+	}
+	return p.number(token)
+}
+
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
+
+// isScalarByte reports whether c may appear in a number, boolean or date
+func isScalarByte(c byte) bool {
+	return isBareKeyByte(c) || c == '+' || c == '.' || c == ':'
+}
+
+// isDate reports whether token starts with a full date, as 1979-05-27
+func isDate(token string) bool {
+	if len(token) < 10 {
+		return false
+	}
+	for i := 0; i < 10; i++ {
+		if (i == 4 || i == 7) != (token[i] == '-') || i != 4 && i != 7 && !isDigit(token[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// number reads an integer or a float
+func (p *parser) number(token string) (interface{}, error) {
+	digits := token
+	if strings.ContainsAny(digits, "_") {
+		for i := 0; i < len(digits); i++ {
+			if digits[i] == '_' && (i == 0 || i == len(digits)-1 || !isHexDigit(digits[i-1]) || !isHexDigit(digits[i+1])) {
+				return nil, p.errorf("invalid number %s", token)
+			}
+		}
+		digits = strings.ReplaceAll(digits, "_", "")
+	}
+	unsigned := strings.TrimLeft(digits, "+-")
+	if len(unsigned) > 1 && unsigned[0] == '0' && (unsigned[1] == 'x' || unsigned[1] == 'o' || unsigned[1] == 'b') {
+		if unsigned != digits {
+			return nil, p.errorf("invalid number %s", token)
+		}
+		n, err := strconv.ParseInt(digits, 0, 64)
+		if err != nil {
+			return nil, p.errorf("invalid number %s", token)
+		}
+		return n, nil
+	}
+	if len(unsigned) > 1 && unsigned[0] == '0' && isDigit(unsigned[1]) {
+		return nil, p.errorf("invalid number %s: leading zeros are not allowed", token)
+	}
+	if !strings.ContainsAny(unsigned, ".eE") {
+		n, err := strconv.ParseInt(digits, 10, 64)
+		if err != nil {
+			return nil, p.errorf("invalid number %s", token)
+		}
+		return n, nil
+	}
+	if i := strings.Index(unsigned, "."); i == 0 || i == len(unsigned)-1 || i > 0 && !isDigit(unsigned[i+1]) {
+		return nil, p.errorf("invalid number %s", token)
+	}
+	f, err := strconv.ParseFloat(digits, 64)
+	if err != nil {
+		return nil, p.errorf("invalid number %s", token)
+	}
+	return f, nil
+}
+
+func isHexDigit(c byte) bool {
+	return isDigit(c) || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
+
+// Layouts of the dates and times TOML writes
+const (
+	localDateTime = "2006-01-02T15:04:05.999999999"
+	localDate     = "2006-01-02"
+	localTime     = "15:04:05.999999999"
+)
+
+// dateTime reads an offset date-time as a time, and a local date-time, date
+// or time as its text
+func (p *parser) dateTime(token string) (interface{}, error) {
+	normalized := token
+	if len(normalized) > 10 && (normalized[10] == ' ' || normalized[10] == 't') {
+		normalized = normalized[:10] + "T" + normalized[11:]
+	}
+	normalized = strings.Replace(normalized, "z", "Z", 1)
+	if t, err := time.Parse(time.RFC3339Nano, normalized); err == nil {
+		return t, nil
+	}
+	for _, layout := range []string{localDateTime, localDate, localTime} {
+		if _, err := time.Parse(layout, normalized); err == nil {
+			return normalized, nil
+		}
+	}
+	return nil, p.errorf("invalid date or time %s", token)
+}
+
+// basicString reads a "string" with escapes
+func (p *parser) basicString() (string, error) {
+	p.pos++
+	var b strings.Builder
+	for {
+		if p.eof() || p.peek() == '\n' || p.peek() == '\r' {
+			return "", p.errorf("unterminated string")
+		}
+		c := p.src[p.pos]
+		switch {
+		case c == '"':
+			p.pos++
+			return b.String(), nil
+		case c == '\\':
+			if err := p.escape(&b); err != nil {
+				return "", err
+			}
+		case c < 0x20 && c != '\t' || c == 0x7f:
+			return "", p.errorf("control character %q in a string", c)
+		default:
+			b.WriteByte(c)
+			p.pos++
+		}
+	}
+}
+
+// multilineBasicString reads a """string""" with escapes, which may span
+// lines and trim them with a backslash at their end
+func (p *parser) multilineBasicString() (string, error) {
+	p.pos += 3
+	p.newline()
+	var b strings.Builder
+	for {
+		if p.eof() {
+			return "", p.errorf("unterminated string")
+		}
+		if p.closesMultiline('"', &b) {
+			return b.String(), nil
+		}
+		c := p.src[p.pos]
+		switch {
+		case c == '\\':
+			end := p.pos + 1
+			for end < len(p.src) && (p.src[end] == ' ' || p.src[end] == '\t') {
+				end++
+			}
+			if end < len(p.src) && (p.src[end] == '\n' || strings.HasPrefix(p.src[end:], "\r\n")) {
+				p.pos = end
+				for !p.eof() && strings.ContainsRune(" \t\r\n", rune(p.src[p.pos])) {
+					p.pos++
+				}
+				continue
+			}
+			if err := p.escape(&b); err != nil {
+				return "", err
+			}
+		case p.newline():
+			b.WriteByte('\n')
+		case c < 0x20 && c != '\t' || c == 0x7f:
+			return "", p.errorf("control character %q in a string", c)
+		default:
+			b.WriteByte(c)
+			p.pos++
+		}
+	}
+}
+
+// literalString reads a 'string' taken as written
+func (p *parser) literalString() (string, error) {
+	p.pos++
+	start := p.pos
+	for {
+		if p.eof() || p.peek() == '\n' || p.peek() == '\r' {
+			return "", p.errorf("unterminated string")
+		}
+		c := p.src[p.pos]
+		if c == '\'' {
+			p.pos++
+			return p.src[start : p.pos-1], nil
+		}
+		if c < 0x20 && c != '\t' || c == 0x7f {
+			return "", p.errorf("control character %q in a string", c)
+		}
+		p.pos++
+	}
+}
+
+// multilineLiteralString reads a string in triple single quotes taken as
+// written, which may span lines
+func (p *parser) multilineLiteralString() (string, error) {
+	p.pos += 3
+	p.newline()
+	var b strings.Builder
+	for {
+		if p.eof() {
+			return "", p.errorf("unterminated string")
+		}
+		if p.closesMultiline('\'', &b) {
+			return b.String(), nil
+		}
+		c := p.src[p.pos]
+		switch {
+		case p.newline():
+			b.WriteByte('\n')
+		case c < 0x20 && c != '\t' || c == 0x7f:
+			return "", p.errorf("control character %q in a string", c)
+		default:
+			b.WriteByte(c)
+			p.pos++
+		}
+	}
+}
+
+// closesMultiline consumes the three quotes ending a multi-line string,
+// writing up to two more quotes before them into the string
+func (p *parser) closesMultiline(quote byte, b *strings.Builder) bool {
+	n := 0
+	for p.pos+n < len(p.src) && p.src[p.pos+n] == quote {
+		n++
+	}
+	if n < 3 {
+		return false
+	}
+	if n > 5 {
+		n = 5
+	}
+	for i := 3; i < n; i++ {
+		b.WriteByte(quote)
+	}
+	p.pos += n
+	return true
+}
+
+// escape reads one backslash escape into b
+func (p *parser) escape(b *strings.Builder) error {
+	p.pos++
+	if p.eof() {
+		return p.errorf("unterminated string")
+	}
+	c := p.src[p.pos]
+	p.pos++
+	switch c {
+	case 'b':
+		b.WriteByte('\b')
+	case 't':
+		b.WriteByte('\t')
+	case 'n':
+		b.WriteByte('\n')
+	case 'f':
+		b.WriteByte('\f')
+	case 'r':
+		b.WriteByte('\r')
+	case 'e':
+		b.WriteByte(0x1b)
+	case '"':
+		b.WriteByte('"')
+	case '\\':
+		b.WriteByte('\\')
+	case 'u', 'U':
+		size := 4
+		if c == 'U' {
+			size = 8
+		}
+		if p.pos+size > len(p.src) {
+			return p.errorf("invalid escape \\%c", c)
+		}
+		code, err := strconv.ParseUint(p.src[p.pos:p.pos+size], 16, 32)
+		if err != nil || !utf8.ValidRune(rune(code)) {
+			return p.errorf("invalid escape \\%c%s", c, p.src[p.pos:p.pos+size])
+		}
+		b.WriteRune(rune(code))
+		p.pos += size
+	default:
+		return p.errorf("invalid escape \\%c", c)
+	}
+	return nil
+}
+
+// array reads [values], which may span lines and end with a comma
+func (p *parser) array() ([]interface{}, error) {
+	p.pos++
+	values := []interface{}{}
+	for {
+		p.skipBlank()
+		if p.consume("]") {
+			return values, nil
+		}
+		value, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+		p.skipBlank()
+		if p.consume("]") {
+			return values, nil
+		}
+		if !p.consume(",") {
+			if p.eof() {
+				return nil, p.errorf("unterminated array")
+			}
+			return nil, p.errorf("expected , or ] in an array, found %q", p.peek())
+		}
+	}
+}
+
+// inlineTable reads {key = value, ...} on one line
+func (p *parser) inlineTable() (*table, error) {
+	p.pos++
+	t := newTable()
+	t.inline = true
+	p.skipSpace()
+	if p.consume("}") {
+		return t, nil
+	}
+	for {
+		if err := p.keyValue(t); err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		if p.consume("}") {
+			return t, nil
+		}
+		if !p.consume(",") {
+			if p.eof() || p.peek() == '\n' || p.peek() == '\r' {
+				return nil, p.errorf("unterminated inline table")
+			}
+			return nil, p.errorf("expected , or } in an inline table, found %q", p.peek())
+		}
+		p.skipSpace()
+	}
+}
+
+// plain turns what was read into maps and slices
+func plain(value interface{}) interface{} {
+	switch value := value.(type) {
+	case *table:
+		m := make(map[string]interface{}, len(value.values))
+		for key, v := range value.values {
+			m[key] = plain(v)
+		}
+		return m
+	case *tableArray:
+		tables := make([]interface{}, len(value.tables))
+		for i, t := range value.tables {
+			tables[i] = plain(t)
+		}
+		return tables
+	case []interface{}:
+		values := make([]interface{}, len(value))
+		for i, v := range value {
+			values[i] = plain(v)
+		}
+		return values
+	}
+	return value
+}
@@ -0,0 +1,134 @@
+package toml
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type doc = map[string]interface{}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  doc
+	}{
+		{
+			name:  "tables",
+			input: "title = \"q\"\n[cycles]\nreflect_every = 3\n[cycles.limits]\nmax = 2\n",
+			want:  doc{"title": "q", "cycles": doc{"reflect_every": int64(3), "limits": doc{"max": int64(2)}}},
+		},
+		{
+			name:  "dotted and quoted keys",
+			input: "a.b = 1\n\"c d\".'e' = 2\n",
+			want:  doc{"a": doc{"b": int64(1)}, "c d": doc{"e": int64(2)}},
+		},
+		{
+			name:  "arrays of tables",
+			input: "[[committee]]\nname = \"sage\"\n[[committee]]\nname = \"rebel\"\n[committee.projection]\nrebellion = 1.0\n",
+			want: doc{"committee": []interface{}{
+				doc{"name": "sage"},
+				doc{"name": "rebel", "projection": doc{"rebellion": 1.0}},
+			}},
+		},
+		{
+			name:  "inline tables",
+			input: "wave = { curiosity = 0.9, nested = { on = true } }\nempty = {}\n",
+			want:  doc{"wave": doc{"curiosity": 0.9, "nested": doc{"on": true}}, "empty": doc{}},
+		},
+		{
+			name:  "arrays",
+			input: "contexts = [\n  \"time\", # comment\n  'space',\n]\nmixed = [[1, 2], [\"a\"]]\n",
+			want:  doc{"contexts": []interface{}{"time", "space"}, "mixed": []interface{}{[]interface{}{int64(1), int64(2)}, []interface{}{"a"}}},
+		},
+		{
+			name:  "strings with escapes",
+			input: `basic = "tab\there \"quoted\" \\ \u00e9 \U0001F30C"` + "\nliteral = 'C:\\path\\n'\n",
+			want:  doc{"basic": "tab\there \"quoted\" \\ é 🌌", "literal": `C:\path\n`},
+		},
+		{
+			name:  "multi-line strings",
+			input: "basic = \"\"\"\nfirst \\\n   second\"\"\"\nliteral = '''\nkeep \\n\n'''\n",
+			want:  doc{"basic": "first second", "literal": "keep \\n\n"},
+		},
+		{
+			name:  "integers",
+			input: "plain = 42\nsigned = -17\nseparated = 1_000\nhex = 0xff\noctal = 0o17\nbinary = 0b101\n",
+			want:  doc{"plain": int64(42), "signed": int64(-17), "separated": int64(1000), "hex": int64(255), "octal": int64(15), "binary": int64(5)},
+		},
+		{
+			name:  "floats",
+			input: "plain = 0.5\nexponent = 5e-3\nboth = -1.5E2\nbig = inf\n",
+			want:  doc{"plain": 0.5, "exponent": 0.005, "both": -150.0, "big": math.Inf(1)},
+		},
+		{
+			name:  "booleans and dates",
+			input: "on = true\noff = false\nlocal = 2026-10-16\nat = 07:32:00\n",
+			want:  doc{"on": true, "off": false, "local": "2026-10-16", "at": "07:32:00"},
+		},
+		{
+			name:  "offset date-time",
+			input: "at = 2026-10-16T07:32:00Z\n",
+			want:  doc{"at": time.Date(2026, 10, 16, 7, 32, 0, 0, time.UTC)},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Parse([]byte(test.input))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %#v\nwant %#v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseNaN(t *testing.T) {
+	got, err := Parse([]byte("x = nan\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, ok := got["x"].(float64); !ok || !math.IsNaN(f) {
+		t.Errorf("got %#v, want NaN", got["x"])
+	}
+}
+
+func TestParseMalformed(t *testing.T) {
+	tests := []struct {
+		name, input, want string
+	}{
+		{"missing equals", "key 1\n", "line 1: expected = after key"},
+		{"missing value", "key =\n", "line 1: unexpected '\\n' where a value belongs"},
+		{"value trailer", "key = 1 2\n", "line 1: unexpected '2' after a value"},
+		{"duplicate key", "a = 1\na = 2\n", "line 2: a is defined twice"},
+		{"duplicate table", "[a]\n[a]\n", "line 2: a is defined twice"},
+		{"table over value", "a = 1\n[a]\n", "line 2: a is defined twice"},
+		{"extended inline table", "a = { b = 1 }\n[a.c]\n", "line 2: inline table a cannot be extended"},
+		{"array of tables over table", "[a]\n[[a]]\n", "line 2: a is not an array of tables"},
+		{"unterminated string", "a = \"open\n", "line 1: unterminated string"},
+		{"unterminated array", "a = [1, 2\n", "line 2: unterminated array"},
+		{"invalid escape", `a = "\q"`, "line 1: invalid escape \\q"},
+		{"leading zero", "a = 012\n", "leading zeros are not allowed"},
+		{"stray underscore", "a = 1__0\n", "line 1: invalid number 1__0"},
+		{"bad float", "a = 1.\n", "line 1: invalid number 1."},
+		{"bad date", "a = 2026-13-01\n", "line 1: invalid date or time 2026-13-01"},
+		{"unclosed header", "[a\n", "line 1: expected ] after a table name"},
+		{"not UTF-8", "a = \"\xff\"\n", "document is not UTF-8"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Parse([]byte(test.input))
+			if err == nil {
+				t.Fatalf("parsed %q", test.input)
+			}
+			if !strings.HasPrefix(err.Error(), "toml: ") || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("error %q, want it to mention %q", err, test.want)
+			}
+		})
+	}
+}
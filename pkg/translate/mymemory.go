@@ -0,0 +1,85 @@
+package translate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// myMemoryMaxBytes is the longest text MyMemory translates in one request
+const myMemoryMaxBytes = 500
+
+// MyMemory translates with the free MyMemory translation API
+type MyMemory struct {
+	Client *http.Client
+	// Email is optional; MyMemory grants a larger daily quota to callers who give one
+	Email string
+}
+
+// NewMyMemory creates a MyMemory translator with a sensible timeout
+func NewMyMemory() *MyMemory {
+	return &MyMemory{Client: &http.Client{Timeout: 30 * time.Second}}
+}
+
+// Translate implements Translator. Text longer than MyMemory accepts is cut
+// short at a word boundary first.
+func (m *MyMemory) Translate(ctx context.Context, text, from, to string) (string, error) {
+	if from == to || text == "" {
+		return text, nil
+	}
+	params := url.Values{
+		"q":        {truncateBytes(text, myMemoryMaxBytes)},
+		"langpair": {from + "|" + to},
+	}
+	if m.Email != "" {
+		params.Set("de", m.Email)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.mymemory.translated.net/get?"+params.Encode(), nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := m.Client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var answer struct {
+		ResponseData struct {
+			TranslatedText string `json:"translatedText"`
+		} `json:"responseData"`
+		// ResponseStatus is a number on success and sometimes a string on failure
+		ResponseStatus  interface{} `json:"responseStatus"`
+		ResponseDetails string      `json:"responseDetails"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&answer); err != nil {
+		return "", err
+	}
+	if status := fmt.Sprint(answer.ResponseStatus); resp.StatusCode != http.StatusOK || status != "200" {
+		return "", fmt.Errorf("mymemory answered %s: %s", status, answer.ResponseDetails)
+	}
+	return html.UnescapeString(answer.ResponseData.TranslatedText), nil
+}
+
+// truncateBytes cuts text to at most limit bytes, at the last space if there is one
+func truncateBytes(text string, limit int) string {
+	if len(text) <= limit {
+		return text
+	}
+	cut := limit
+	for cut > 0 && text[cut] != ' ' {
+		cut--
+	}
+	if cut == 0 {
+		// No space: back off to a rune boundary instead
+		cut = limit
+		for cut > 0 && text[cut]&0xC0 == 0x80 {
+			cut--
+		}
+	}
+	return text[:cut]
+}
@@ -0,0 +1,13 @@
+// Package translate defines how the consciousness reads and asks beyond English.
+package translate
+
+import "context"
+
+// English is the language the consciousness thinks in
+const English = "en"
+
+// Translator translates text between languages named by ISO 639-1 codes,
+// e.g. "en" or "de"
+type Translator interface {
+	Translate(ctx context.Context, text, from, to string) (string, error)
+}
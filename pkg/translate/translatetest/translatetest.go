@@ -0,0 +1,42 @@
+// Package translatetest provides a deterministic, offline translator for tests.
+package translatetest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Fake "translates" by tagging text with its target language, e.g.
+// "[de] text", and records every request
+type Fake struct {
+	// Err, when set, fails every translation
+	Err error
+
+	mutex    sync.Mutex
+	requests []string
+}
+
+// New creates a fake translator
+func New() *Fake {
+	return &Fake{}
+}
+
+// Translate implements translate.Translator
+func (f *Fake) Translate(ctx context.Context, text, from, to string) (string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.requests = append(f.requests, fmt.Sprintf("%s→%s: %s", from, to, text))
+	if f.Err != nil {
+		return "", f.Err
+	}
+	return fmt.Sprintf("[%s] %s", to, text), nil
+}
+
+// Requests returns every request so far, as "from→to: text"
+func (f *Fake) Requests() []string {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return append([]string(nil), f.requests...)
+}